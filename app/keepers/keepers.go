@@ -395,7 +395,14 @@ func (appKeepers *AppKeepers) InitKeepers(
 
 	// claimsmanagerModule := claimsmanager.NewAppModule(appCodec, appKeepers.ClaimsManagerKeeper)
 
-	appKeepers.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(appCodec, appKeepers.keys[interchainquerytypes.StoreKey], appKeepers.IBCKeeper)
+	appKeepers.InterchainQueryKeeper = interchainquerykeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[interchainquerytypes.StoreKey],
+		appKeepers.GetSubspace(interchainquerytypes.ModuleName),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.IBCKeeper,
+	)
 
 	// interchainQueryModule := interchainquery.NewAppModule(appCodec, appKeepers.InterchainQueryKeeper)
 
@@ -529,7 +536,7 @@ func (*AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *cod
 	paramsKeeper.Subspace(claimsmanagertypes.ModuleName).WithKeyTable(claimsmanagertypes.ParamKeyTable())
 	paramsKeeper.Subspace(minttypes.ModuleName).WithKeyTable(minttypes.ParamKeyTable())
 	paramsKeeper.Subspace(interchainstakingtypes.ModuleName).WithKeyTable(interchainstakingtypes.ParamKeyTable())
-	paramsKeeper.Subspace(interchainquerytypes.ModuleName)
+	paramsKeeper.Subspace(interchainquerytypes.ModuleName).WithKeyTable(interchainquerytypes.ParamKeyTable())
 	paramsKeeper.Subspace(participationrewardstypes.ModuleName)
 
 	return paramsKeeper
@@ -555,6 +562,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.ClaimsManagerKeeper.Hooks(),
 			appKeepers.InterchainstakingKeeper.Hooks(),
 			appKeepers.ParticipationRewardsKeeper.Hooks(),
+			appKeepers.InterchainQueryKeeper.Hooks(),
 		),
 	)
 
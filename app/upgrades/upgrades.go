@@ -30,6 +30,9 @@ func Upgrades() []Upgrade {
 
 		// v1.10.1 - HandleFailedUndelegate BurnAmount zero guard hotfix
 		{UpgradeName: V0101001UpgradeName, CreateUpgradeHandler: NoOpHandler},
+
+		// v1.11.0 - Unbonding batches decoupled from epochs
+		{UpgradeName: V0101100UpgradeName, CreateUpgradeHandler: V0101100UpgradeHandler},
 	}
 }
 
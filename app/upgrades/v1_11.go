@@ -0,0 +1,78 @@
+package upgrades
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/quicksilver-zone/quicksilver/app/keepers"
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	prtypes "github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// V0101100UpgradeHandler handles the v1.11.0 upgrade.
+// This upgrade decouples unbonding batches from epochs:
+// - UnbondingRecords are keyed by batch id; existing records take their epoch number as batch id.
+// - Each zone is given an UnbondingSchedule, with a sequence starting after the current epoch
+// so that new batch ids never collide with those of in-flight undelegations.
+//
+// It also sets the participationrewards pricing params introduced in v1.11.0 to
+// their defaults, which disable the checks, and migrates the Umee protocol data
+// to a money market configured for the Umee chain.
+func V0101100UpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	appKeepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting v1.11.0 upgrade...")
+
+		// the key of an unbonding record is unchanged, as the batch id of existing records is their epoch number.
+		records := []icstypes.UnbondingRecord{}
+		appKeepers.InterchainstakingKeeper.IterateUnbondingRecords(ctx, func(_ int64, record icstypes.UnbondingRecord) (stop bool) {
+			records = append(records, record)
+			return false
+		})
+
+		sequences := map[string]int64{}
+		for _, record := range records {
+			if record.BatchId == 0 {
+				record.BatchId = record.EpochNumber
+				appKeepers.InterchainstakingKeeper.SetUnbondingRecord(ctx, record)
+			}
+			if record.BatchId > sequences[record.ChainId] {
+				sequences[record.ChainId] = record.BatchId
+			}
+		}
+
+		currentEpoch := appKeepers.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+		appKeepers.InterchainstakingKeeper.IterateZones(ctx, func(_ int64, zone *icstypes.Zone) (stop bool) {
+			sequence := currentEpoch
+			if sequences[zone.ChainId] > sequence {
+				sequence = sequences[zone.ChainId]
+			}
+			appKeepers.InterchainstakingKeeper.SetUnbondingSchedule(ctx, icstypes.UnbondingSchedule{
+				ChainId:         zone.ChainId,
+				Sequence:        sequence,
+				LastBatchHeight: ctx.BlockHeight(),
+				LastBatchTime:   ctx.BlockTime(),
+			})
+			ctx.Logger().Info("initialised unbonding schedule", "chain_id", zone.ChainId, "sequence", sequence)
+			return false
+		})
+
+		prSubspace := appKeepers.GetSubspace(prtypes.ModuleName)
+		prSubspace.Set(ctx, prtypes.KeyMinPoolLiquidity, prtypes.DefaultMinPoolLiquidity)
+		prSubspace.Set(ctx, prtypes.KeyMaxPriceDeviation, prtypes.DefaultMaxPriceDeviation)
+		prSubspace.Set(ctx, prtypes.KeyMaxPoolDataAge, prtypes.DefaultMaxPoolDataAge)
+		prSubspace.Set(ctx, prtypes.KeyScoringWeights, prtypes.DefaultScoringWeights)
+
+		if err := appKeepers.ParticipationRewardsKeeper.MigrateUmeeProtocolData(ctx); err != nil {
+			return nil, err
+		}
+
+		ctx.Logger().Info("Upgrade v1.11.0 complete")
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/quicksilver-zone/quicksilver/app/upgrades"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	prkeeper "github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
+	prtypes "github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
 func init() {
@@ -440,6 +442,7 @@ func (s *AppTestSuite) TestV010706UpgradeHandler() {
 		ChainId:        "sommelier-3",
 		Validator:      "sommvaloper10m5g48u53vss7xqmqw6089d02ua0d85d7s8gu0",
 		EpochNumber:    133,
+		BatchId:        133,
 		CompletionTime: time.Time{},
 		RelatedTxhash: []string{
 			"0fc9c66af331cbb3b015f97a2257220e16c2d58f75f5cdcbce3e77cb90570834",
@@ -457,6 +460,7 @@ func (s *AppTestSuite) TestV010706UpgradeHandler() {
 		ChainId:        "sommelier-3",
 		Validator:      "sommvaloper1y0few0kgxa7vtq8nskjsdwdtyqglj3k5pv2c4d",
 		EpochNumber:    238,
+		BatchId:        238,
 		CompletionTime: completion,
 		RelatedTxhash: []string{
 			"184365ad8ea78478cf49e7fb28c829f64aa9dac026905bef239f8fb16cc3dcb6",
@@ -468,6 +472,7 @@ func (s *AppTestSuite) TestV010706UpgradeHandler() {
 		ChainId:        "sommelier-3",
 		Validator:      "sommvaloper1y0few0kgxa7vtq8nskjsdwdtyqglj3k5pv2c4d",
 		EpochNumber:    243,
+		BatchId:        243,
 		CompletionTime: ctx.BlockTime().Add(-time.Hour * 12),
 		RelatedTxhash: []string{
 			"bb09ae31a44f83a292c4ddf16870b033daccc1ea9fb1620ccf36c1e232af11c3",
@@ -545,6 +550,7 @@ func (s *AppTestSuite) TestV010800UpgradeHandler() {
 		ChainId:        "juno-1",
 		Validator:      "junovaloper10m5g48u53vss7xqmqw6089d02ua0d85d7s8gu0",
 		EpochNumber:    277,
+		BatchId:        277,
 		CompletionTime: time.Time{},
 		RelatedTxhash: []string{
 			"0fc9c66af331cbb3b015f97a2257220e16c2d58f75f5cdcbce3e77cb90570834",
@@ -562,6 +568,7 @@ func (s *AppTestSuite) TestV010800UpgradeHandler() {
 		ChainId:        "juno-1",
 		Validator:      "junovaloper1y0few0kgxa7vtq8nskjsdwdtyqglj3k5pv2c4d",
 		EpochNumber:    277,
+		BatchId:        277,
 		CompletionTime: completion,
 		RelatedTxhash: []string{
 			"184365ad8ea78478cf49e7fb28c829f64aa9dac026905bef239f8fb16cc3dcb6",
@@ -573,6 +580,7 @@ func (s *AppTestSuite) TestV010800UpgradeHandler() {
 		ChainId:        "sommelier-3",
 		Validator:      "sommvaloper1y0few0kgxa7vtq8nskjsdwdtyqglj3k5pv2c4d",
 		EpochNumber:    277,
+		BatchId:        277,
 		CompletionTime: ctx.BlockTime().Add(time.Hour * 36),
 		RelatedTxhash: []string{
 			"bb09ae31a44f83a292c4ddf16870b033daccc1ea9fb1620ccf36c1e232af11c3",
@@ -604,3 +612,72 @@ func (s *AppTestSuite) TestV010800UpgradeHandler() {
 	_, found = app.InterchainstakingKeeper.GetWithdrawalRecord(ctx, "juno-1", "quick194dawsp29zcp4s9r6hdppdak0cy5kf3xqumt9x", 266)
 	s.False(found)
 }
+
+func (s *AppTestSuite) TestV0101100UpgradeHandler() {
+	s.InitV160TestZones()
+	app := s.GetQuicksilverApp(s.chainA)
+
+	ctx := s.chainA.GetContext()
+
+	// unbonding records written prior to v1.11.0 are keyed by epoch number and have no batch id.
+	legacyRecords := []icstypes.UnbondingRecord{
+		{
+			ChainId:       "juno-1",
+			Validator:     "junovaloper10m5g48u53vss7xqmqw6089d02ua0d85d7s8gu0",
+			EpochNumber:   276,
+			RelatedTxhash: []string{"0fc9c66af331cbb3b015f97a2257220e16c2d58f75f5cdcbce3e77cb90570834"},
+			Amount:        sdk.NewCoin("ujuno", math.NewInt(1000000)),
+		},
+		{
+			ChainId:       "juno-1",
+			Validator:     "junovaloper1y0few0kgxa7vtq8nskjsdwdtyqglj3k5pv2c4d",
+			EpochNumber:   277,
+			RelatedTxhash: []string{"184365ad8ea78478cf49e7fb28c829f64aa9dac026905bef239f8fb16cc3dcb6"},
+			Amount:        sdk.NewCoin("ujuno", math.NewInt(2000000)),
+		},
+	}
+	store := ctx.KVStore(app.GetKey(icstypes.StoreKey))
+	for _, record := range legacyRecords {
+		store.Set(icstypes.GetUnbondingKey(record.ChainId, record.Validator, record.EpochNumber), app.InterchainstakingKeeper.GetCodec().MustMarshal(&record))
+	}
+
+	//nolint:staticcheck // SA1019 umee params written prior to v1.11.0 are migrated to a money market.
+	s.NoError(prkeeper.MarshalAndSetProtocolData(ctx, app.ParticipationRewardsKeeper, prtypes.ProtocolDataTypeUmeeParams, &prtypes.UmeeParamsProtocolData{ChainID: "umee-1"}))
+
+	handler := upgrades.V0101100UpgradeHandler(app.mm, app.configurator, &app.AppKeepers)
+
+	_, err := handler(ctx, types.Plan{}, app.mm.GetVersionMap())
+	s.NoError(err)
+
+	for _, legacy := range legacyRecords {
+		record, found := app.InterchainstakingKeeper.GetUnbondingRecord(ctx, legacy.ChainId, legacy.Validator, legacy.EpochNumber)
+		s.True(found)
+		s.Equal(legacy.EpochNumber, record.BatchId)
+		s.Equal(legacy.Amount, record.Amount)
+	}
+	s.Len(app.InterchainstakingKeeper.AllUnbondingRecords(ctx), len(legacyRecords))
+
+	currentEpoch := app.EpochsKeeper.GetEpochInfo(ctx, "epoch").CurrentEpoch
+	app.InterchainstakingKeeper.IterateZones(ctx, func(_ int64, zone *icstypes.Zone) (stop bool) {
+		schedule, found := app.InterchainstakingKeeper.GetUnbondingSchedule(ctx, zone.ChainId)
+		s.True(found)
+		s.Equal(ctx.BlockHeight(), schedule.LastBatchHeight)
+		if zone.ChainId == "juno-1" {
+			s.Equal(int64(277), schedule.Sequence)
+		} else {
+			s.Equal(currentEpoch, schedule.Sequence)
+		}
+		return false
+	})
+	prParams := app.ParticipationRewardsKeeper.GetParams(ctx)
+	s.True(prParams.MinPoolLiquidity.IsZero())
+	s.True(prParams.MaxPriceDeviation.IsZero())
+	s.Zero(prParams.MaxPoolDataAge)
+	s.Equal(prtypes.DefaultScoringWeights, prParams.ScoringWeights)
+
+	_, market, err := prkeeper.GetAndUnmarshalProtocolData[*prtypes.MoneyMarketProtocolData](ctx, app.ParticipationRewardsKeeper, "umee-1", prtypes.ProtocolDataTypeMoneyMarket)
+	s.NoError(err)
+	s.Equal(prtypes.UmeeMoneyMarket("umee-1"), *market)
+	_, found := app.ParticipationRewardsKeeper.GetProtocolData(ctx, prtypes.ProtocolDataTypeUmeeParams, prtypes.UmeeParamsKey) //nolint:staticcheck // SA1019 the umee params are removed by the migration.
+	s.False(found)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/quicksilver-zone/quicksilver/app"
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+)
+
+const (
+	FlagClaimsFormat = "format"
+	FlagClaimsOutput = "output"
+)
+
+// exportClaimsCmd exports a snapshot of the claims held in the application state at a height.
+func exportClaimsCmd(ac appCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-claims [height]",
+		Short: "Export the claims held in state at a height as CSV or JSON",
+		Long: `Export the current and last epoch claims held in the application state at the given height, or the latest
+height if omitted, labelled with the epoch at that height. The node must be stopped, and the height must not have been pruned.
+Exporting at the last height of each epoch yields the claims from which the rewards of that epoch were allocated.`,
+		Example: "quicksilverd export-claims 1200000 --format csv --output claims-1200000.csv",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			height := int64(-1)
+			if len(args) == 1 {
+				var err error
+				height, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil || height <= 0 {
+					return fmt.Errorf("invalid height %q", args[0])
+				}
+			}
+
+			format, err := cmd.Flags().GetString(FlagClaimsFormat)
+			if err != nil {
+				return err
+			}
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("unsupported format %q, expected csv or json", format)
+			}
+
+			output, err := cmd.Flags().GetString(FlagClaimsOutput)
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			qsApp := app.NewQuicksilver(
+				serverCtx.Logger,
+				db,
+				nil,
+				height == -1,
+				map[int64]bool{},
+				serverCtx.Config.RootDir,
+				cast.ToUint(serverCtx.Viper.Get(server.FlagInvCheckPeriod)),
+				ac.encCfg,
+				serverCtx.Viper,
+				false,
+				false,
+				"",
+			)
+			if height != -1 {
+				if err := qsApp.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			ctx := qsApp.NewContext(true, tmproto.Header{Height: qsApp.LastBlockHeight()})
+			epoch := qsApp.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+			snapshot := qsApp.ClaimsManagerKeeper.ClaimsSnapshot(ctx, epoch)
+
+			var out io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			if format == "csv" {
+				return snapshot.WriteCSV(out)
+			}
+			return snapshot.WriteJSON(out)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagClaimsFormat, "json", "The snapshot format, csv or json")
+	cmd.Flags().String(FlagClaimsOutput, "", "The file to write the snapshot to; defaults to stdout")
+	return cmd
+}
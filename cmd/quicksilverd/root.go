@@ -112,6 +112,7 @@ func NewRootCmd() (*cobra.Command, app.EncodingConfig) {
 		encCfg: app.MakeEncodingConfig(),
 	}
 	server.AddCommands(rootCmd, app.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
+	rootCmd.AddCommand(exportClaimsCmd(ac))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/quicksilver-zone/quicksilver/app"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/signer"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FlagListen         = "listen"
+	FlagKeyringBackend = "keyring-backend"
+	FlagKeyringDir     = "keyring-dir"
+	FlagChainIDs       = "chain-ids"
+	FlagMsgTypes       = "msg-types"
+	FlagTLSCert        = "tls-cert"
+	FlagTLSKey         = "tls-key"
+	FlagTLSClientCA    = "tls-client-ca"
+)
+
+func init() {
+	rootCmd.AddCommand(SignerServerCommand())
+}
+
+func SignerServerCommand() *cobra.Command {
+	signerServerCommand := &cobra.Command{
+		Use:   "signer-server",
+		Short: "Run a reference remote signer",
+		Long: `Run a reference implementation of the remote signer protocol, signing with the keys of a cosmos sdk keyring.
+The passphrase of a file keyring is read from the ` + types.PassphraseEnv + ` environment variable. The signer only
+listens on a non-loopback address if clients are authenticated with mutual TLS, by giving --tls-client-ca.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homepath, err := cmd.Flags().GetString(FlagHomePath)
+			if err != nil {
+				return err
+			}
+			listen, _ := cmd.Flags().GetString(FlagListen)
+			backend, _ := cmd.Flags().GetString(FlagKeyringBackend)
+			dir, _ := cmd.Flags().GetString(FlagKeyringDir)
+			chainIDs, _ := cmd.Flags().GetStringSlice(FlagChainIDs)
+			msgTypes, _ := cmd.Flags().GetStringSlice(FlagMsgTypes)
+			tlsConfig := signer.TLSConfig{}
+			tlsConfig.CertPath, _ = cmd.Flags().GetString(FlagTLSCert)
+			tlsConfig.KeyPath, _ = cmd.Flags().GetString(FlagTLSKey)
+			tlsConfig.CAPath, _ = cmd.Flags().GetString(FlagTLSClientCA)
+
+			if !tlsConfig.Mutual() && !signer.IsLoopback(listen) {
+				return fmt.Errorf("refusing to listen on non-loopback address %s without mutual tls; set --%s, --%s and --%s", listen, FlagTLSCert, FlagTLSKey, FlagTLSClientCA)
+			}
+
+			if dir == "" {
+				dir = homepath
+			}
+
+			encodingCfg := app.MakeEncodingConfig()
+			kr, err := signer.OpenKeyring(backend, dir, os.Getenv(types.PassphraseEnv), codec.NewProtoCodec(encodingCfg.InterfaceRegistry))
+			if err != nil {
+				return err
+			}
+
+			var creds credentials.TransportCredentials
+			if tlsConfig.Enabled() {
+				if creds, err = tlsConfig.ServerCredentials(); err != nil {
+					return fmt.Errorf("unable to load tls config: %w", err)
+				}
+			}
+
+			listener, err := net.Listen("tcp", listen)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("remote signer listening on %s", listener.Addr())
+			return signer.NewServer(kr, chainIDs, msgTypes).NewGRPCServer(creds).Serve(listener)
+		},
+	}
+
+	signerServerCommand.Flags().String(FlagHomePath, types.DefaultConfigPath, "homedir")
+	signerServerCommand.Flags().String(FlagListen, "127.0.0.1:9091", "address to listen on")
+	signerServerCommand.Flags().String(FlagKeyringBackend, "file", "keyring backend (file, test or os)")
+	signerServerCommand.Flags().String(FlagKeyringDir, "", "keyring directory; defaults to homedir")
+	signerServerCommand.Flags().StringSlice(FlagChainIDs, nil, "chain ids to sign for; defaults to any")
+	signerServerCommand.Flags().StringSlice(FlagMsgTypes, signer.DefaultMsgTypes, "type urls of the messages to sign; empty signs any")
+	signerServerCommand.Flags().String(FlagTLSCert, "", "tls certificate file")
+	signerServerCommand.Flags().String(FlagTLSKey, "", "tls key file")
+	signerServerCommand.Flags().String(FlagTLSClientCA, "", "ca certificate file against which client certificates are verified, requiring mutual tls")
+	return signerServerCommand
+}
@@ -0,0 +1,345 @@
+package runner
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+const (
+	// healthTimeout bounds the time taken to check the RPC health of each chain.
+	healthTimeout = 10 * time.Second
+	// HealthCheckInterval is the interval between checks of the RPC health of each chain.
+	HealthCheckInterval = 30 * time.Second
+)
+
+// ChainStatus is the state of a chain, as served by the admin API.
+type ChainStatus struct {
+	ChainId string `json:"chain_id"`
+	// QueueDepth is the number of query responses from the chain awaiting submission.
+	QueueDepth int    `json:"queue_depth"`
+	Paused     bool   `json:"paused"`
+	Healthy    bool   `json:"healthy"`
+	Height     int64  `json:"height,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Latency    string `json:"latency"`
+	// CheckedAt is the time RPC health was last checked; zero if it has not been checked yet.
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// RequestStatus is a query for which a request is in flight.
+type RequestStatus struct {
+	QueryId string    `json:"query_id"`
+	ChainId string    `json:"chain_id"`
+	Type    string    `json:"type"`
+	Height  int64     `json:"height"`
+	Since   time.Time `json:"since"`
+}
+
+// TxStatus is a successfully broadcast tx.
+type TxStatus struct {
+	Hash     string    `json:"hash"`
+	Messages int       `json:"messages"`
+	Time     time.Time `json:"time"`
+}
+
+// HAStatus is the assignment of chains to the nodes of a highly available deployment.
+type HAStatus struct {
+	NodeIndex  int              `json:"node_index"`
+	NodeCount  int              `json:"node_count"`
+	Redundancy int              `json:"redundancy"`
+	Chains     []string         `json:"chains"`
+	Assignment map[int][]string `json:"assignment"`
+}
+
+// Status is the state of the relayer, as served by the admin API.
+type Status struct {
+	Version      string          `json:"version"`
+	DefaultChain ChainStatus     `json:"default_chain"`
+	Chains       []ChainStatus   `json:"chains"`
+	InFlight     []RequestStatus `json:"in_flight"`
+	Ignored      []IgnoredQuery  `json:"ignored"`
+	DeadLetters  int             `json:"dead_letters"`
+	LastTx       *TxStatus       `json:"last_tx"`
+	HA           HAStatus        `json:"ha"`
+}
+
+var (
+	adminMutex = sync.Mutex{}
+	// queued holds the ids of the queries of each chain with responses awaiting submission.
+	queued   = make(map[string]map[string]bool)
+	requests = make(map[string]RequestStatus)
+	paused   = make(map[string]bool)
+	lastTx   *TxStatus
+	// health holds the result of the last RPC health check of each chain.
+	health = make(map[string]ChainStatus)
+)
+
+// markQueued records that a query response is awaiting submission.
+func markQueued(msg *qstypes.MsgSubmitQueryResponse) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	if queued[msg.ChainId] == nil {
+		queued[msg.ChainId] = make(map[string]bool)
+	}
+	queued[msg.ChainId][msg.QueryId] = true
+}
+
+// markSettled records that a query response has left the send queue.
+func markSettled(msg *qstypes.MsgSubmitQueryResponse) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	delete(queued[msg.ChainId], msg.QueryId)
+}
+
+// startRequest records that a request for query is in flight, until endRequest is called.
+func startRequest(query Query) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	requests[query.QueryId] = RequestStatus{QueryId: query.QueryId, ChainId: query.ChainId, Type: query.Type, Height: query.Height, Since: time.Now().UTC()}
+}
+
+func endRequest(queryId string) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	delete(requests, queryId)
+}
+
+// recordTx records a successfully broadcast tx.
+func recordTx(hash string, messages int) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	lastTx = &TxStatus{Hash: hash, Messages: messages, Time: time.Now().UTC()}
+}
+
+// isPaused returns true if relaying for the chain is paused.
+func isPaused(chainId string) bool {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	return paused[chainId]
+}
+
+// setPaused pauses or resumes relaying for the chain. Queries for a paused chain are not requested, and their
+// responses are dropped rather than submitted; they are asked again by the historic query sweep once resumed.
+func setPaused(chainId string, pause bool) {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	if pause {
+		paused[chainId] = true
+	} else {
+		delete(paused, chainId)
+	}
+}
+
+// retryQuery clears the dead letter, ignore and failed attempts of a query, such that it is asked again by the next
+// historic query sweep. It returns whether the query was dead lettered and whether it was ignored.
+func retryQuery(queryId string, logger log.Logger) (bool, bool) {
+	failuresMutex.Lock()
+	_, deadLettered := deadLetters[queryId]
+	_, wasIgnored := ignored[queryId]
+	delete(deadLetters, queryId)
+	delete(ignored, queryId)
+	delete(attempts, queryId)
+	failuresMutex.Unlock()
+
+	cache.Del("query/" + queryId)
+	if err := workStore.Delete(store.BucketDeadLetters, queryId); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove dead letter", "id", queryId, "error", err)
+	}
+	if err := workStore.Delete(store.BucketIgnored, queryId); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove ignored query", "id", queryId, "error", err)
+	}
+	_ = logger.Log("msg", "Retrying query", "id", queryId, "dead_lettered", deadLettered, "ignored", wasIgnored)
+	return deadLettered, wasIgnored
+}
+
+// dropIgnored clears the temporarily ignored queries, returning the number cleared. Dead letters are retained.
+func dropIgnored(logger log.Logger) int {
+	failuresMutex.Lock()
+	keys := make([]string, 0, len(ignored))
+	for queryId := range ignored {
+		keys = append(keys, queryId)
+	}
+	ignored = make(map[string]IgnoredQuery)
+	failuresMutex.Unlock()
+
+	if err := workStore.Delete(store.BucketIgnored, keys...); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove ignored queries", "error", err)
+	}
+	_ = logger.Log("msg", "Dropped ignored queries", "count", len(keys))
+	return len(keys)
+}
+
+// checkHealth checks the RPC health of a chain by fetching its current height, and records the result.
+func checkHealth(ctx context.Context, chain *types.ReadOnlyChainConfig, logger log.Logger) {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	start := time.Now()
+	height, err := chain.GetCurrentHeight(ctx, cache, logger)
+	result := ChainStatus{ChainId: chain.ChainID, Healthy: err == nil, Height: height, Latency: time.Since(start).String(), CheckedAt: time.Now().UTC()}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	health[chain.ChainID] = result
+}
+
+// refreshHealth checks the RPC health of every chain concurrently.
+func refreshHealth(ctx context.Context, cfg *types.Config, logger log.Logger) {
+	wg := sync.WaitGroup{}
+	for _, chain := range cfg.Chains {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkHealth(ctx, chain, logger)
+		}()
+	}
+	if cfg.DefaultChain != nil {
+		checkHealth(ctx, cfg.DefaultChain.ReadOnlyChainConfig, logger)
+	}
+	wg.Wait()
+}
+
+// monitorHealth refreshes the RPC health of every chain each HealthCheckInterval until ctx is done, such that the
+// status served by the admin API never waits on, nor is able to trigger, RPC requests.
+func monitorHealth(ctx context.Context, cfg *types.Config, logger log.Logger) {
+	ticker := time.NewTicker(HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		refreshHealth(ctx, cfg, logger)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// chainStatus returns the state of a chain, with its RPC health as of the last check.
+func chainStatus(chainId string) ChainStatus {
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	status, checked := health[chainId]
+	if !checked {
+		status = ChainStatus{ChainId: chainId, Error: "not yet checked"}
+	}
+	status.QueueDepth = len(queued[chainId])
+	status.Paused = paused[chainId]
+	return status
+}
+
+// getStatus returns the state of the relayer.
+func getStatus(cfg *types.Config) Status {
+	status := Status{
+		Version: VERSION,
+		Chains:  make([]ChainStatus, len(cfg.Chains)),
+		Ignored: IgnoredQueries(),
+		HA: HAStatus{
+			NodeIndex:  cfg.HA.NodeIndex,
+			NodeCount:  cfg.HA.NodeCount,
+			Redundancy: cfg.HA.Redundancy,
+			Assignment: cfg.HA.Assignment,
+		},
+	}
+
+	chainIds := make([]string, 0, len(cfg.Chains))
+	for chainId := range cfg.Chains {
+		chainIds = append(chainIds, chainId)
+	}
+	sort.Strings(chainIds)
+	status.HA.Chains = chainIds
+
+	for i, chainId := range chainIds {
+		status.Chains[i] = chainStatus(chainId)
+	}
+	if cfg.DefaultChain != nil {
+		status.DefaultChain = chainStatus(cfg.DefaultChain.ChainID)
+	}
+
+	failuresMutex.Lock()
+	status.DeadLetters = len(deadLetters)
+	failuresMutex.Unlock()
+
+	adminMutex.Lock()
+	defer adminMutex.Unlock()
+	status.InFlight = make([]RequestStatus, 0, len(requests))
+	for _, request := range requests {
+		status.InFlight = append(status.InFlight, request)
+	}
+	sort.Slice(status.InFlight, func(i, j int) bool { return status.InFlight[i].Since.Before(status.InFlight[j].Since) })
+	status.LastTx = lastTx
+	return status
+}
+
+func writeJSON(w http.ResponseWriter, code int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// authorize requires the bearer token cfg.AdminToken for requests to handler. The actions fail closed: if no token is
+// set, requests are forbidden.
+func authorize(cfg *types.Config, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if cfg.AdminToken == "" {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "admin actions are disabled; set AdminToken to enable them"})
+			return
+		}
+		expected := "Bearer " + cfg.AdminToken
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// registerAdminHandlers registers the handlers of the admin API with mux.
+func registerAdminHandlers(mux *http.ServeMux, cfg *types.Config, logger log.Logger) {
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, getStatus(cfg))
+	})
+
+	mux.HandleFunc("GET /deadletters", deadLettersHandler)
+
+	mux.HandleFunc("GET /ignored", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, IgnoredQueries())
+	})
+
+	mux.HandleFunc("DELETE /ignored", authorize(cfg, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]int{"dropped": dropIgnored(logger)})
+	}))
+
+	mux.HandleFunc("POST /queries/{id}/retry", authorize(cfg, func(w http.ResponseWriter, r *http.Request) {
+		queryId := r.PathValue("id")
+		deadLettered, wasIgnored := retryQuery(queryId, logger)
+		writeJSON(w, http.StatusOK, map[string]any{"query_id": queryId, "dead_lettered": deadLettered, "ignored": wasIgnored})
+	}))
+
+	pause := func(pause bool) http.HandlerFunc {
+		return authorize(cfg, func(w http.ResponseWriter, r *http.Request) {
+			chainId := r.PathValue("id")
+			if _, ok := cfg.Chains[chainId]; !ok {
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": "unknown chain " + chainId})
+				return
+			}
+			setPaused(chainId, pause)
+			_ = logger.Log("msg", "Set chain paused", "chain", chainId, "paused", pause)
+			writeJSON(w, http.StatusOK, map[string]any{"chain_id": chainId, "paused": pause})
+		})
+	}
+	mux.HandleFunc("POST /chains/{id}/pause", pause(true))
+	mux.HandleFunc("POST /chains/{id}/resume", pause(false))
+}
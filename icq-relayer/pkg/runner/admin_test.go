@@ -0,0 +1,248 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// fakeRPCClient is an RPCClientI that serves only Block, returning a block at height, or err.
+type fakeRPCClient struct {
+	types.RPCClientI
+	height int64
+	err    error
+}
+
+func (f *fakeRPCClient) Block(_ context.Context, _ *int64) (*coretypes.ResultBlock, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &coretypes.ResultBlock{Block: &tmtypes.Block{LastCommit: &tmtypes.Commit{Height: f.height + 1}}}, nil
+}
+
+const adminToken = "secret"
+
+func setupAdmin(t *testing.T) (*types.Config, *http.ServeMux, prommetrics.Metrics) {
+	t.Helper()
+	cfg, metrics := setupFailures(t)
+
+	adminMutex.Lock()
+	queued = make(map[string]map[string]bool)
+	requests = make(map[string]RequestStatus)
+	paused = make(map[string]bool)
+	lastTx = nil
+	health = make(map[string]ChainStatus)
+	adminMutex.Unlock()
+
+	chain := func(chainId string, client types.RPCClientI) *types.ReadOnlyChainConfig {
+		chain := types.DefaultReadOnlyChainConfig(chainId, "")
+		chain.QueryRetries, chain.QueryRetryDelayMilliseconds = 1, 0
+		chain.Client = client
+		return chain
+	}
+	cfg.DefaultChain = &types.ChainConfig{ReadOnlyChainConfig: chain("quicksilver-2", &fakeRPCClient{height: 100})}
+	cfg.Chains = map[string]*types.ReadOnlyChainConfig{
+		"cosmoshub-4": chain("cosmoshub-4", &fakeRPCClient{height: 200}),
+		"osmosis-1":   chain("osmosis-1", &fakeRPCClient{err: errors.New("connection refused")}),
+	}
+	cfg.AdminToken = adminToken
+	cfg.HA = types.HAConfig{NodeCount: 2, NodeIndex: 0, Redundancy: 1, Assignment: map[int][]string{0: {"cosmoshub-4", "osmosis-1"}, 1: {"juno-1"}}}
+
+	mux := http.NewServeMux()
+	registerAdminHandlers(mux, cfg, log.NewNopLogger())
+	return cfg, mux, metrics
+}
+
+func serve(mux *http.ServeMux, method, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAdminStatus(t *testing.T) {
+	cfg, mux, _ := setupAdmin(t)
+
+	markQueued(&qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: "a"})
+	markQueued(&qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: "b"})
+	markQueued(&qstypes.MsgSubmitQueryResponse{ChainId: "osmosis-1", QueryId: "c"})
+	settle([]sdk.Msg{&qstypes.MsgSubmitQueryResponse{ChainId: "osmosis-1", QueryId: "c"}}, "", false, log.NewNopLogger())
+	startRequest(Query{QueryId: "d", ChainId: "osmosis-1", Type: "store/bank/key", Height: 10})
+	ignoreQuery("e", "proof_invalid: unable to validate proof", time.Minute, log.NewNopLogger())
+	recordTx("ABCD", 3)
+	setPaused("osmosis-1", true)
+	refreshHealth(context.Background(), cfg, log.NewNopLogger())
+
+	rec := serve(mux, http.MethodGet, "/status", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	status := Status{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+
+	require.Equal(t, "quicksilver-2", status.DefaultChain.ChainId)
+	require.True(t, status.DefaultChain.Healthy)
+	require.Equal(t, int64(100), status.DefaultChain.Height)
+
+	require.Len(t, status.Chains, 2)
+	require.False(t, status.Chains[0].CheckedAt.IsZero())
+	require.Equal(t, ChainStatus{ChainId: "cosmoshub-4", QueueDepth: 2, Healthy: true, Height: 200, Latency: status.Chains[0].Latency, CheckedAt: status.Chains[0].CheckedAt}, status.Chains[0])
+	require.Equal(t, "osmosis-1", status.Chains[1].ChainId)
+	require.Zero(t, status.Chains[1].QueueDepth)
+	require.True(t, status.Chains[1].Paused)
+	require.False(t, status.Chains[1].Healthy)
+	require.Contains(t, status.Chains[1].Error, "connection refused")
+
+	require.Len(t, status.InFlight, 1)
+	require.Equal(t, "d", status.InFlight[0].QueryId)
+	require.Len(t, status.Ignored, 1)
+	require.Equal(t, "e", status.Ignored[0].QueryId)
+	require.Equal(t, "proof_invalid: unable to validate proof", status.Ignored[0].Reason)
+	require.Equal(t, "ABCD", status.LastTx.Hash)
+	require.Equal(t, []string{"cosmoshub-4", "osmosis-1"}, status.HA.Chains)
+	require.Equal(t, []string{"juno-1"}, status.HA.Assignment[1])
+
+	endRequest("d")
+	rec = serve(mux, http.MethodGet, "/status", "")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Empty(t, status.InFlight)
+}
+
+func TestAdminStatusCachedHealth(t *testing.T) {
+	cfg, mux, _ := setupAdmin(t)
+
+	status := Status{}
+	rec := serve(mux, http.MethodGet, "/status", "")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.False(t, status.Chains[0].Healthy)
+	require.Equal(t, "not yet checked", status.Chains[0].Error)
+	require.True(t, status.Chains[0].CheckedAt.IsZero())
+
+	refreshHealth(context.Background(), cfg, log.NewNopLogger())
+	cfg.Chains["cosmoshub-4"].Client = &fakeRPCClient{err: errors.New("connection refused")}
+
+	// the status serves the last check, rather than querying the chain.
+	rec = serve(mux, http.MethodGet, "/status", "")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.True(t, status.Chains[0].Healthy)
+	require.Equal(t, int64(200), status.Chains[0].Height)
+
+	cache.Del("currentblock/cosmoshub-4")
+	refreshHealth(context.Background(), cfg, log.NewNopLogger())
+	rec = serve(mux, http.MethodGet, "/status", "")
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.False(t, status.Chains[0].Healthy)
+	require.Contains(t, status.Chains[0].Error, "connection refused")
+}
+
+func TestAdminRetryQuery(t *testing.T) {
+	_, mux, _ := setupAdmin(t)
+	logger := log.NewNopLogger()
+
+	deadLetter(&qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: "a"}, Failure{Class: FailureQueryNotFound}, 1, logger)
+	ignoreQuery("b", "message_failed", time.Minute, logger)
+	cache.Set("query/b", true, 0)
+	cache.Wait()
+	require.True(t, isIgnored("a"))
+	require.True(t, isIgnored("b"))
+
+	rec := serve(mux, http.MethodPost, "/queries/a/retry", adminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"query_id":"a","dead_lettered":true,"ignored":false}`, rec.Body.String())
+	require.False(t, isIgnored("a"))
+	require.Empty(t, DeadLetters())
+
+	rec = serve(mux, http.MethodPost, "/queries/b/retry", adminToken)
+	require.JSONEq(t, `{"query_id":"b","dead_lettered":false,"ignored":true}`, rec.Body.String())
+	require.False(t, isIgnored("b"))
+	cache.Wait()
+	_, found := cache.Get("query/b")
+	require.False(t, found)
+}
+
+func TestAdminDropIgnored(t *testing.T) {
+	_, mux, _ := setupAdmin(t)
+	logger := log.NewNopLogger()
+
+	deadLetter(&qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: "a"}, Failure{Class: FailureQueryNotFound}, 1, logger)
+	ignoreQuery("b", "message_failed", time.Minute, logger)
+	ignoreQuery("c", "message_failed", time.Minute, logger)
+
+	rec := serve(mux, http.MethodGet, "/ignored", "")
+	ignores := []IgnoredQuery{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &ignores))
+	require.Len(t, ignores, 2)
+
+	rec = serve(mux, http.MethodDelete, "/ignored", adminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"dropped":2}`, rec.Body.String())
+	require.Empty(t, IgnoredQueries())
+	require.False(t, isIgnored("b"))
+	// dead letters are retained.
+	require.True(t, isIgnored("a"))
+}
+
+func TestAdminPauseChain(t *testing.T) {
+	cfg, mux, metrics := setupAdmin(t)
+
+	rec := serve(mux, http.MethodPost, "/chains/juno-1/pause", adminToken)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(mux, http.MethodPost, "/chains/osmosis-1/pause", adminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.True(t, isPaused("osmosis-1"))
+
+	// responses for a paused chain are dropped rather than submitted.
+	client := &fakeTxClient{hash: "ABCD"}
+	msgs := []Message{
+		{Msg: &qstypes.MsgSubmitQueryResponse{ChainId: "osmosis-1", QueryId: "a"}},
+		{Msg: &qstypes.MsgSubmitQueryResponse{ChainId: "cosmoshub-4", QueryId: "b"}},
+	}
+	flush(cfg, client, msgs, log.NewNopLogger(), metrics)
+	require.Len(t, client.batches, 1)
+	require.Len(t, client.batches[0], 1)
+	require.Equal(t, "b", client.batches[0][0].(*qstypes.MsgSubmitQueryResponse).QueryId)
+
+	rec = serve(mux, http.MethodPost, "/chains/osmosis-1/resume", adminToken)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, isPaused("osmosis-1"))
+
+	rec = serve(mux, http.MethodGet, "/chains/osmosis-1/pause", "")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAdminToken(t *testing.T) {
+	cfg, mux, _ := setupAdmin(t)
+
+	require.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodPost, "/chains/osmosis-1/pause", "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve(mux, http.MethodPost, "/chains/osmosis-1/pause", "wrong").Code)
+	require.False(t, isPaused("osmosis-1"))
+
+	require.Equal(t, http.StatusOK, serve(mux, http.MethodPost, "/chains/osmosis-1/pause", adminToken).Code)
+	require.True(t, isPaused("osmosis-1"))
+
+	// state is readable without the token.
+	require.Equal(t, http.StatusOK, serve(mux, http.MethodGet, "/ignored", "").Code)
+
+	// the actions fail closed without a token configured.
+	cfg.AdminToken = ""
+	require.Equal(t, http.StatusForbidden, serve(mux, http.MethodPost, "/chains/osmosis-1/resume", "").Code)
+	require.Equal(t, http.StatusForbidden, serve(mux, http.MethodDelete, "/ignored", "").Code)
+	require.Equal(t, http.StatusForbidden, serve(mux, http.MethodPost, "/queries/a/retry", "").Code)
+	require.True(t, isPaused("osmosis-1"))
+}
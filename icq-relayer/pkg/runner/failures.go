@@ -0,0 +1,364 @@
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/go-kit/log"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// FailureClass classifies why a batch of messages could not be submitted.
+type FailureClass string
+
+const (
+	FailureOutOfGas         FailureClass = "out_of_gas"
+	FailureSequenceMismatch FailureClass = "sequence_mismatch"
+	FailureProofInvalid     FailureClass = "proof_invalid"
+	FailureQueryNotFound    FailureClass = "query_not_found"
+	FailureClientNotUpdated FailureClass = "client_not_updated"
+	FailureTxTooLarge       FailureClass = "tx_too_large"
+	// FailureMessageFailed is the failure of a message for any other reason, such as an error in its callback.
+	FailureMessageFailed FailureClass = "message_failed"
+	// FailureUnknown is any other failure of the tx as a whole, such as a node being unreachable.
+	FailureUnknown FailureClass = "unknown"
+)
+
+// Failure is a classified failure to submit a batch. Index is the index in the batch of the message that failed,
+// or -1 if the failure is not attributable to a single message.
+type Failure struct {
+	Class FailureClass
+	Index int
+	Err   error
+}
+
+// RetryPolicy determines how messages that failed with a class of failure are retried. A message is retried after
+// Backoff, doubling on each further attempt up to MaxBackoff, and is dead lettered once it has failed MaxAttempts
+// times, or never if MaxAttempts is zero. If Requery is set, the query is asked again rather than its response
+// resubmitted.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+	Requery     bool
+}
+
+// RetryPolicies are the retry policies of each class of failure. Failures of a batch, rather than of a single
+// message, are retried for every message in the batch.
+var RetryPolicies = map[FailureClass]RetryPolicy{
+	// gas is estimated again on resubmission.
+	FailureOutOfGas: {MaxAttempts: 3, Backoff: 2 * time.Second, MaxBackoff: 30 * time.Second},
+	// the signing account is resynchronised with the chain before resubmission.
+	FailureSequenceMismatch: {MaxAttempts: 5, Backoff: time.Second, MaxBackoff: 10 * time.Second},
+	FailureProofInvalid:     {MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 10 * time.Minute, Requery: true},
+	FailureQueryNotFound:    {MaxAttempts: 1},
+	// the client update is prepared again before resubmission.
+	FailureClientNotUpdated: {MaxAttempts: 5, Backoff: 5 * time.Second, MaxBackoff: time.Minute},
+	// the batch size is reduced before resubmission.
+	FailureTxTooLarge:    {MaxAttempts: 5},
+	FailureMessageFailed: {MaxAttempts: 3, Backoff: 5 * time.Minute, MaxBackoff: 20 * time.Minute, Requery: true},
+	// failures of transport, such as the node being unreachable, are not attributable to the message, so are retried
+	// until the node recovers rather than dead lettered.
+	FailureUnknown: {Backoff: 5 * time.Second, MaxBackoff: time.Minute},
+}
+
+// DeadLetterTTL is the time after which a dead lettered query is asked again.
+var DeadLetterTTL = 24 * time.Hour
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.Backoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, max(p.Backoff, p.MaxBackoff))
+}
+
+var messageIndexRegex = regexp.MustCompile(`failed to execute message; message index: (\d+)`)
+
+// classifyFailure classifies the failure to submit msgs, given the code and error returned by the TxClient.
+func classifyFailure(msgs []sdk.Msg, code uint32, err error) Failure {
+	failure := Failure{Class: FailureUnknown, Index: -1, Err: err}
+	if err == nil {
+		return failure
+	}
+
+	if match := messageIndexRegex.FindStringSubmatch(err.Error()); match != nil {
+		if idx, err := strconv.Atoi(match[1]); err == nil && idx < len(msgs) {
+			failure.Index = idx
+		}
+	}
+
+	codespace := sdkerrors.RootCodespace
+	var txErr *types.TxError
+	if errors.As(err, &txErr) {
+		codespace, code = txErr.Codespace, txErr.Code
+	}
+	msg := strings.ToLower(err.Error())
+
+	switch {
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrOutOfGas.ABCICode(), strings.Contains(msg, "out of gas"):
+		failure.Class = FailureOutOfGas
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrWrongSequence.ABCICode(), strings.Contains(msg, "account sequence mismatch"):
+		failure.Class = FailureSequenceMismatch
+	case codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrTxTooLarge.ABCICode(), strings.Contains(msg, "request body too large"):
+		failure.Class = FailureTxTooLarge
+	case containsAny(msg, "query not found", "no query found", "unable to find query", "query no longer exists"):
+		failure.Class = FailureQueryNotFound
+	case codespace == clienttypes.SubModuleName,
+		containsAny(msg, "consensus state not found", "unable to fetch consensus state", "client state is not active", "invalid client header"):
+		failure.Class = FailureClientNotUpdated
+	case failure.Index >= 0 && isClientUpdate(msgs[failure.Index]):
+		// a client update that could not be verified, likely against a node that is not yet synced.
+		failure.Class = FailureClientNotUpdated
+	case codespace == qstypes.ModuleName && code == qstypes.ErrInvalidProof.ABCICode(), strings.Contains(msg, "proof"):
+		failure.Class = FailureProofInvalid
+	case failure.Index >= 0:
+		failure.Class = FailureMessageFailed
+	}
+
+	// failures of the tx as a whole are not attributable to the message being executed when they occurred.
+	switch failure.Class {
+	case FailureOutOfGas, FailureSequenceMismatch, FailureTxTooLarge:
+		failure.Index = -1
+	}
+	return failure
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+func isClientUpdate(msg sdk.Msg) bool {
+	_, ok := msg.(*clienttypes.MsgUpdateClient)
+	return ok
+}
+
+// DeadLetter is a query response that was not submitted after exhausting the retries of its failure class. The
+// query is ignored until its dead letter is removed, or expires after DeadLetterTTL.
+type DeadLetter struct {
+	QueryId  string       `json:"query_id"`
+	ChainId  string       `json:"chain_id"`
+	Height   int64        `json:"height"`
+	Class    FailureClass `json:"class"`
+	Attempts int          `json:"attempts"`
+	Error    string       `json:"error"`
+	Time     time.Time    `json:"time"`
+}
+
+// IgnoredQuery is a query that is temporarily ignored, such that it is asked again once Until has passed.
+type IgnoredQuery struct {
+	QueryId string    `json:"query_id"`
+	Reason  string    `json:"reason"`
+	Until   time.Time `json:"until"`
+}
+
+var (
+	failuresMutex = sync.Mutex{}
+	// attempts counts the failed attempts to submit the response to each query since it was last submitted.
+	attempts    = make(map[string]int)
+	deadLetters = make(map[string]DeadLetter)
+	ignored     = make(map[string]IgnoredQuery)
+)
+
+// recordAttempt records a failed attempt to submit the response to a query, returning the number of attempts.
+func recordAttempt(queryId string) int {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	attempts[queryId]++
+	return attempts[queryId]
+}
+
+// clearAttempts clears the failed attempts of a query, once its response has been submitted.
+func clearAttempts(queryId string) {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	delete(attempts, queryId)
+}
+
+func deadLetter(msg *qstypes.MsgSubmitQueryResponse, failure Failure, count int, logger log.Logger) {
+	letter := DeadLetter{
+		QueryId:  msg.QueryId,
+		ChainId:  msg.ChainId,
+		Height:   msg.Height,
+		Class:    failure.Class,
+		Attempts: count,
+		Time:     time.Now().UTC(),
+	}
+	if failure.Err != nil {
+		letter.Error = failure.Err.Error()
+	}
+
+	failuresMutex.Lock()
+	delete(attempts, msg.QueryId)
+	deadLetters[msg.QueryId] = letter
+	failuresMutex.Unlock()
+
+	if err := workStore.Put(store.BucketDeadLetters, msg.QueryId, letter, DeadLetterTTL); err != nil {
+		_ = logger.Log("msg", "Error: Could not persist dead letter", "id", msg.QueryId, "error", err)
+	}
+	_ = logger.Log("msg", "Dead lettered query", "id", msg.QueryId, "class", failure.Class, "attempts", count, "err", letter.Error)
+}
+
+// expired returns true if the dead letter has outlived DeadLetterTTL.
+func (l DeadLetter) expired(now time.Time) bool {
+	return !now.Before(l.Time.Add(DeadLetterTTL))
+}
+
+// isIgnored returns true if the query is temporarily ignored, or dead lettered.
+func isIgnored(queryId string) bool {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	now := time.Now()
+	if ignore, found := ignored[queryId]; found {
+		if now.Before(ignore.Until) {
+			return true
+		}
+		delete(ignored, queryId)
+	}
+	if letter, found := deadLetters[queryId]; found {
+		if !letter.expired(now) {
+			return true
+		}
+		delete(deadLetters, queryId)
+	}
+	return false
+}
+
+// IgnoredQueries returns the temporarily ignored queries, soonest to be asked again first.
+func IgnoredQueries() []IgnoredQuery {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	now := time.Now()
+	queries := make([]IgnoredQuery, 0, len(ignored))
+	for queryId, ignore := range ignored {
+		if now.Before(ignore.Until) {
+			queries = append(queries, ignore)
+		} else {
+			delete(ignored, queryId)
+		}
+	}
+	sort.Slice(queries, func(i, j int) bool { return queries[i].Until.Before(queries[j].Until) })
+	return queries
+}
+
+// DeadLetters returns the unexpired dead letters, most recent first.
+func DeadLetters() []DeadLetter {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	now := time.Now()
+	letters := make([]DeadLetter, 0, len(deadLetters))
+	for queryId, letter := range deadLetters {
+		if letter.expired(now) {
+			delete(deadLetters, queryId)
+			continue
+		}
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool { return letters[i].Time.After(letters[j].Time) })
+	return letters
+}
+
+// restoreDeadLetters restores the persisted dead letters.
+func restoreDeadLetters(logger log.Logger) {
+	failuresMutex.Lock()
+	defer failuresMutex.Unlock()
+	if err := workStore.Iterate(store.BucketDeadLetters, func(key string, _ time.Duration, value []byte) error {
+		letter := DeadLetter{}
+		if err := json.Unmarshal(value, &letter); err == nil {
+			deadLetters[key] = letter
+		}
+		return nil
+	}); err != nil {
+		_ = logger.Log("msg", "Error: Could not restore dead letters", "error", err)
+	}
+}
+
+// deadLettersHandler serves the dead letters as JSON.
+func deadLettersHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(DeadLetters())
+}
+
+// handleFailure retries or dead letters the messages of a batch that failed to be submitted, according to the retry
+// policy of the failure. entries are the messages of the batch, with owners mapping each message in msgs to its entry.
+func handleFailure(entries []Message, owners []int, msgs []sdk.Msg, failure Failure, logger log.Logger) {
+	policy, ok := RetryPolicies[failure.Class]
+	if !ok {
+		policy = RetryPolicies[FailureUnknown]
+	}
+
+	failed := entries
+	if failure.Index >= 0 {
+		owner := owners[failure.Index]
+		failed = entries[owner : owner+1]
+		// the rest of the batch did not fail, so is resubmitted without delay.
+		for i, entry := range entries {
+			if i != owner {
+				requeue(entry, 0)
+			}
+		}
+	}
+
+	switch failure.Class {
+	case FailureTxTooLarge:
+		batchSizeMutex.Lock()
+		TxMsgs = max(1, TxMsgs*3/4)
+		size := TxMsgs
+		LastReduced = time.Now()
+		batchSizeMutex.Unlock()
+		_ = logger.Log("msg", "tx too large: reduced batchsize", "size", size)
+	case FailureClientNotUpdated:
+		// drop the client update, such that it is prepared again when the message is requeued.
+		for _, entry := range failed {
+			if entry.ClientUpdate != nil {
+				cacheKey := fmt.Sprintf("cu/%s-%d", entry.ClientUpdate.ConnectionId, entry.ClientUpdate.Height)
+				cache.Del(cacheKey)
+				if err := workStore.Delete(store.BucketClientUpdates, cacheKey); err != nil {
+					_ = logger.Log("msg", "Error: Could not remove client update", "key", cacheKey, "error", err)
+				}
+			}
+		}
+	}
+
+	for _, entry := range failed {
+		msg := entry.Msg.(*qstypes.MsgSubmitQueryResponse)
+		count := recordAttempt(msg.QueryId)
+		switch {
+		case policy.MaxAttempts > 0 && count >= policy.MaxAttempts:
+			deadLetter(msg, failure, count, logger)
+			settle([]sdk.Msg{msg}, "", false, logger)
+		case policy.Requery:
+			// drop the response, and ignore the query until the historic query sweep should ask it again.
+			ignoreQuery(msg.QueryId, fmt.Sprintf("%s: %v", failure.Class, failure.Err), policy.backoff(count), logger)
+			settle([]sdk.Msg{msg}, "", false, logger)
+			_ = logger.Log("msg", "Failed to submit query response; requerying after backoff", "id", msg.QueryId, "class", failure.Class, "attempt", count, "backoff", policy.backoff(count))
+		default:
+			requeue(entry, policy.backoff(count))
+			_ = logger.Log("msg", "Failed to submit query response; retrying after backoff", "id", msg.QueryId, "class", failure.Class, "attempt", count, "backoff", policy.backoff(count))
+		}
+	}
+}
+
+// requeue adds entry to the send queue after delay.
+func requeue(entry Message, delay time.Duration) {
+	cache.Set("query/"+entry.Msg.(*qstypes.MsgSubmitQueryResponse).QueryId, true, 0)
+	go func() { time.Sleep(delay); sendQueue <- entry }()
+}
@@ -0,0 +1,374 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/dgraph-io/ristretto"
+	"github.com/go-kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// fakeTxClient is a TxClient that returns a scripted result from SignAndBroadcastMsg, recording the batches it is given.
+type fakeTxClient struct {
+	hash    string
+	code    uint32
+	err     error
+	batches [][]sdk.Msg
+}
+
+var _ types.TxClient = &fakeTxClient{}
+
+func (f *fakeTxClient) Init(_ *codec.ProtoCodec, _ *ristretto.Cache) error { return nil }
+
+func (f *fakeTxClient) GetClientState(_ context.Context, _ string, _ log.Logger, _ prommetrics.Metrics) (*clienttypes.QueryClientStateResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeTxClient) GetClientStateHeights(_ context.Context, _, _ string, _ uint64, _ log.Logger, _ prommetrics.Metrics, _ int) ([]clienttypes.Height, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeTxClient) GetClientId(_ context.Context, _ string, _ log.Logger, _ prommetrics.Metrics) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (f *fakeTxClient) SignAndBroadcastMsg(_ context.Context, _ *client.Context, exec []sdk.Msg, _ string) (string, uint32, error) {
+	f.batches = append(f.batches, exec)
+	return f.hash, f.code, f.err
+}
+
+func setupFailures(t *testing.T) (*types.Config, prommetrics.Metrics) {
+	t.Helper()
+
+	var err error
+	cache, err = ristretto.NewCache(&ristretto.Config{NumCounters: 1e4, MaxCost: 1 << 20, BufferItems: 64})
+	require.NoError(t, err)
+
+	failuresMutex.Lock()
+	attempts = make(map[string]int)
+	deadLetters = make(map[string]DeadLetter)
+	ignored = make(map[string]IgnoredQuery)
+	failuresMutex.Unlock()
+
+	MaxTxMsgs, TxMsgs = 10, 10
+
+	policies := RetryPolicies
+	RetryPolicies = make(map[FailureClass]RetryPolicy, len(policies))
+	for class, policy := range policies {
+		policy.Backoff, policy.MaxBackoff = policy.Backoff/1000, policy.MaxBackoff/1000
+		RetryPolicies[class] = policy
+	}
+	t.Cleanup(func() { RetryPolicies = policies })
+
+	return &types.Config{}, *prommetrics.NewMetrics(prometheus.NewRegistry())
+}
+
+func response(queryId string) Message {
+	return Message{Msg: &qstypes.MsgSubmitQueryResponse{ChainId: "test-1", QueryId: queryId, Height: 10}}
+}
+
+// receive returns the next n messages on the send queue.
+func receive(t *testing.T, n int) []string {
+	t.Helper()
+	ids := []string{}
+	for len(ids) < n {
+		select {
+		case msg := <-sendQueue:
+			ids = append(ids, msg.Msg.(*qstypes.MsgSubmitQueryResponse).QueryId)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected %d requeued messages, got %v", n, ids)
+		}
+	}
+	return ids
+}
+
+// requireNoneQueued checks that no message is sent to the send queue within a second.
+func requireNoneQueued(t *testing.T) {
+	t.Helper()
+	select {
+	case msg := <-sendQueue:
+		t.Fatalf("unexpected requeued message %v", msg.Msg)
+	case <-time.After(time.Second):
+	}
+}
+
+func TestClassifyFailure(t *testing.T) {
+	msgs := []sdk.Msg{
+		&clienttypes.MsgUpdateClient{},
+		&qstypes.MsgSubmitQueryResponse{QueryId: "a"},
+		&qstypes.MsgSubmitQueryResponse{QueryId: "b"},
+	}
+
+	tests := []struct {
+		name  string
+		code  uint32
+		err   error
+		class FailureClass
+		index int
+	}{
+		{"out of gas", 11, &types.TxError{Codespace: "sdk", Code: 11, Log: "out of gas in location: ReadFlat"}, FailureOutOfGas, -1},
+		{"out of gas in simulation", 0, errors.New("rpc error: out of gas in location: WriteFlat; gasWanted: 100"), FailureOutOfGas, -1},
+		{"sequence mismatch", 32, &types.TxError{Codespace: "sdk", Code: 32, Log: "account sequence mismatch, expected 5, got 4"}, FailureSequenceMismatch, -1},
+		{"tx too large", 21, &types.TxError{Codespace: "sdk", Code: 21, Log: "tx too large"}, FailureTxTooLarge, -1},
+		{"client state not active", 29, &types.TxError{Codespace: "client", Code: 29, Log: "client state is not active"}, FailureClientNotUpdated, -1},
+		{"consensus state missing", 0, errors.New("failed to execute message; message index: 1: unable to fetch consensus state for height 10"), FailureClientNotUpdated, 1},
+		{"client update rejected", 0, errors.New("failed to execute message; message index: 0: invalid header"), FailureClientNotUpdated, 0},
+		{"proof invalid", 0, errors.New("failed to execute message; message index: 2: unable to validate proof"), FailureProofInvalid, 2},
+		{"proof invalid code", 1, &types.TxError{Codespace: qstypes.ModuleName, Code: qstypes.ErrInvalidProof.ABCICode(), Log: "failed to execute message; message index: 1: query a: no proof submitted"}, FailureProofInvalid, 1},
+		{"query not found", 0, errors.New("failed to execute message; message index: 1: query no longer exists"), FailureQueryNotFound, 1},
+		{"callback failed", 0, errors.New("failed to execute message; message index: 2: unable to unmarshal balance"), FailureMessageFailed, 2},
+		{"index out of range", 0, errors.New("failed to execute message; message index: 7: unable to unmarshal balance"), FailureUnknown, -1},
+		{"unreachable", 0, errors.New("post failed: connection refused"), FailureUnknown, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure := classifyFailure(msgs, tt.code, tt.err)
+			require.Equal(t, tt.class, failure.Class)
+			require.Equal(t, tt.index, failure.Index)
+			require.Equal(t, tt.err, failure.Err)
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	require.Equal(t, time.Second, policy.backoff(1))
+	require.Equal(t, 2*time.Second, policy.backoff(2))
+	require.Equal(t, 4*time.Second, policy.backoff(3))
+	require.Equal(t, 5*time.Second, policy.backoff(4))
+	require.Equal(t, time.Duration(0), RetryPolicy{}.backoff(3))
+}
+
+func TestFlushSuccess(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+	recordAttempt("a")
+
+	client := &fakeTxClient{hash: "ABCD"}
+	flush(cfg, client, []Message{response("a"), response("b"), response("a")}, log.NewNopLogger(), metrics)
+
+	require.Len(t, client.batches, 1)
+	require.Len(t, client.batches[0], 2, "duplicate responses should be submitted once")
+	require.Empty(t, attempts)
+	requireNoneQueued(t)
+}
+
+func TestFlushAlreadyInMempool(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+
+	client := &fakeTxClient{hash: "ABCD", code: 19, err: &types.TxError{Codespace: "sdk", Code: 19, Log: "tx already exists in cache"}}
+	flush(cfg, client, []Message{response("a")}, log.NewNopLogger(), metrics)
+
+	require.Empty(t, attempts)
+	require.Empty(t, DeadLetters())
+	requireNoneQueued(t)
+}
+
+func TestFlushBatchFailureRetriesAll(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+
+	client := &fakeTxClient{code: 32, err: &types.TxError{Codespace: "sdk", Code: 32, Log: "account sequence mismatch, expected 5, got 4"}}
+	flush(cfg, client, []Message{response("a"), response("b")}, log.NewNopLogger(), metrics)
+
+	require.ElementsMatch(t, []string{"a", "b"}, receive(t, 2))
+	require.Equal(t, map[string]int{"a": 1, "b": 1}, attempts)
+}
+
+func TestFlushMessageFailureRequeriesOne(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+
+	client := &fakeTxClient{err: errors.New("failed to execute message; message index: 1: unable to validate proof")}
+	flush(cfg, client, []Message{response("a"), response("b"), response("c")}, log.NewNopLogger(), metrics)
+
+	// the rest of the batch is resubmitted, and the failed query is ignored until it is asked again.
+	require.True(t, isIgnored("b"))
+	require.False(t, isIgnored("a"))
+	require.ElementsMatch(t, []string{"a", "c"}, receive(t, 2))
+	requireNoneQueued(t)
+	require.Equal(t, map[string]int{"b": 1}, attempts)
+	require.Empty(t, DeadLetters())
+}
+
+func TestFlushClientNotUpdated(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+
+	cache.Set("cu/connection-0-10", &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0"}, 5)
+	cache.Wait()
+	entry := response("a")
+	entry.ClientUpdate = &ClientUpdateRequirement{ConnectionId: "connection-0", ChainId: "test-1", Height: 10}
+
+	client := &fakeTxClient{err: errors.New("failed to execute message; message index: 0: invalid header")}
+	flush(cfg, client, []Message{entry}, log.NewNopLogger(), metrics)
+
+	require.Len(t, client.batches[0], 2)
+	require.Equal(t, []string{"a"}, receive(t, 1))
+	cache.Wait()
+	_, found := cache.Get("cu/connection-0-10")
+	require.False(t, found, "client update should be prepared again")
+}
+
+func TestFlushTxTooLarge(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+
+	client := &fakeTxClient{code: 21, err: &types.TxError{Codespace: "sdk", Code: 21, Log: "tx too large"}}
+	flush(cfg, client, []Message{response("a")}, log.NewNopLogger(), metrics)
+
+	require.Equal(t, 7, TxMsgs)
+	require.Equal(t, []string{"a"}, receive(t, 1))
+}
+
+// blockingTxClient is a TxClient whose broadcasts report that they started, then block until released.
+type blockingTxClient struct {
+	fakeTxClient
+	started chan string
+	release chan struct{}
+}
+
+func (b *blockingTxClient) SignAndBroadcastMsg(_ context.Context, _ *client.Context, exec []sdk.Msg, _ string) (string, uint32, error) {
+	b.started <- exec[0].(*qstypes.MsgSubmitQueryResponse).QueryId
+	<-b.release
+	return "ABCD", 0, nil
+}
+
+func TestDispatchParallel(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+	cfg.DefaultChain = &types.ChainConfig{Accounts: make([]*types.Account, 3)}
+
+	for _, queryId := range []string{"a", "b", "c", "d"} {
+		recordAttempt(queryId)
+	}
+
+	client := &blockingTxClient{started: make(chan string), release: make(chan struct{})}
+	dispatch := newDispatcher(cfg, client, log.NewNopLogger(), metrics)
+	done := make(chan struct{})
+	go func() {
+		for _, queryId := range []string{"a", "b", "c", "d"} {
+			dispatch([]Message{response(queryId)})
+		}
+		close(done)
+	}()
+
+	// a batch is broadcast by each account at once.
+	started := []string{}
+	for len(started) < 3 {
+		select {
+		case queryId := <-client.started:
+			started = append(started, queryId)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected 3 concurrent broadcasts, got %v", started)
+		}
+	}
+	require.ElementsMatch(t, []string{"a", "b", "c"}, started)
+
+	// and the next waits for one to complete.
+	select {
+	case queryId := <-client.started:
+		t.Fatalf("unexpected broadcast of %s while all accounts are busy", queryId)
+	case <-time.After(200 * time.Millisecond):
+	}
+	client.release <- struct{}{}
+	select {
+	case queryId := <-client.started:
+		require.Equal(t, "d", queryId)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a broadcast once an account is free")
+	}
+
+	for i := 0; i < 3; i++ {
+		client.release <- struct{}{}
+	}
+	<-done
+	require.Eventually(t, func() bool {
+		failuresMutex.Lock()
+		defer failuresMutex.Unlock()
+		return len(attempts) == 0
+	}, 5*time.Second, 10*time.Millisecond, "all batches should be settled as submitted")
+}
+
+func TestFlushDeadLetters(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+	logger := log.NewNopLogger()
+
+	// a query that no longer exists is dead lettered on its first failure.
+	client := &fakeTxClient{err: errors.New("failed to execute message; message index: 0: query no longer exists")}
+	flush(cfg, client, []Message{response("a")}, logger, metrics)
+	require.True(t, isIgnored("a"))
+
+	// others are dead lettered once they exhaust their attempts.
+	client = &fakeTxClient{code: 11, err: &types.TxError{Codespace: "sdk", Code: 11, Log: "out of gas in location: ReadFlat"}}
+	for i := 1; i < RetryPolicies[FailureOutOfGas].MaxAttempts; i++ {
+		flush(cfg, client, []Message{response("b")}, logger, metrics)
+		require.Equal(t, []string{"b"}, receive(t, 1))
+	}
+	flush(cfg, client, []Message{response("b")}, logger, metrics)
+	requireNoneQueued(t)
+
+	letters := DeadLetters()
+	require.Len(t, letters, 2)
+	require.Equal(t, "b", letters[0].QueryId)
+	require.Equal(t, FailureOutOfGas, letters[0].Class)
+	require.Equal(t, RetryPolicies[FailureOutOfGas].MaxAttempts, letters[0].Attempts)
+	require.Equal(t, "a", letters[1].QueryId)
+	require.Equal(t, FailureQueryNotFound, letters[1].Class)
+	require.Empty(t, attempts)
+
+	// dead lettered queries are not submitted again.
+	flush(cfg, client, []Message{response("a"), response("b")}, logger, metrics)
+	require.Len(t, client.batches, RetryPolicies[FailureOutOfGas].MaxAttempts)
+
+	rec := httptest.NewRecorder()
+	deadLettersHandler(rec, httptest.NewRequest("GET", "/deadletters", nil))
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	served := []DeadLetter{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	require.Len(t, served, 2)
+	require.Equal(t, letters[0].QueryId, served[0].QueryId)
+	require.Equal(t, letters[0].Error, served[0].Error)
+}
+
+func TestFlushUnknownFailureNeverDeadLettered(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+	logger := log.NewNopLogger()
+
+	// transport failures are retried beyond the attempts of any other class, rather than dead lettered.
+	client := &fakeTxClient{err: errors.New("post failed: connection refused")}
+	for i := 0; i < 10; i++ {
+		flush(cfg, client, []Message{response("a")}, logger, metrics)
+		require.Equal(t, []string{"a"}, receive(t, 1))
+	}
+	require.Equal(t, map[string]int{"a": 10}, attempts)
+	require.Empty(t, DeadLetters())
+	require.False(t, isIgnored("a"))
+}
+
+func TestDeadLetterTTL(t *testing.T) {
+	setupFailures(t)
+	logger := log.NewNopLogger()
+
+	ttl := DeadLetterTTL
+	DeadLetterTTL = 100 * time.Millisecond
+	t.Cleanup(func() { DeadLetterTTL = ttl })
+
+	deadLetter(&qstypes.MsgSubmitQueryResponse{ChainId: "test-1", QueryId: "a"}, Failure{Class: FailureQueryNotFound}, 1, logger)
+	require.True(t, isIgnored("a"))
+	require.Len(t, DeadLetters(), 1)
+
+	// the query is asked again once its dead letter expires.
+	time.Sleep(DeadLetterTTL)
+	require.False(t, isIgnored("a"))
+	require.Empty(t, DeadLetters())
+}
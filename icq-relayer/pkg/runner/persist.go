@@ -0,0 +1,199 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/go-kit/log"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+const (
+	// StoreFile is the name of the work queue store, relative to the home path.
+	StoreFile = "queue.db"
+
+	// queries and responses older than this are stale, and are picked up again by the historic query sweep instead.
+	pendingTTL      = 30 * time.Minute
+	clientUpdateTTL = 10 * time.Minute
+	submittedTTL    = 24 * time.Hour
+)
+
+// workStore persists in flight work across restarts; nil unless PersistentQueue is enabled.
+var workStore *store.Store
+
+// storedMessage is the persisted form of a Message.
+type storedMessage struct {
+	Response     []byte
+	ClientUpdate *ClientUpdateRequirement
+}
+
+// idempotencyKey identifies a query response, such that the same result is never submitted twice.
+func idempotencyKey(msg *qstypes.MsgSubmitQueryResponse) string {
+	return fmt.Sprintf("%s/%s/%d", msg.ChainId, msg.QueryId, msg.Height)
+}
+
+// persistQuery records a query for which a request is about to be made.
+func persistQuery(query Query, logger log.Logger) {
+	if err := workStore.Put(store.BucketQueries, query.QueryId, query, pendingTTL); err != nil {
+		_ = logger.Log("msg", "Error: Could not persist query", "id", query.QueryId, "error", err)
+	}
+}
+
+// enqueue persists a query response and adds it to the send queue.
+func enqueue(cfg *types.Config, msg *qstypes.MsgSubmitQueryResponse, clientUpdate *ClientUpdateRequirement, logger log.Logger) {
+	bz, err := cfg.ProtoCodec.Marshal(msg)
+	if err == nil {
+		err = workStore.Put(store.BucketResponses, idempotencyKey(msg), storedMessage{Response: bz, ClientUpdate: clientUpdate}, pendingTTL)
+	}
+	if err != nil {
+		_ = logger.Log("msg", "Error: Could not persist query response", "id", msg.QueryId, "error", err)
+	}
+	markQueued(msg)
+	sendQueue <- Message{Msg: msg, ClientUpdate: clientUpdate}
+}
+
+// ignoreQuery ignores a query for ttl.
+func ignoreQuery(queryId string, reason string, ttl time.Duration, logger log.Logger) {
+	ignore := IgnoredQuery{QueryId: queryId, Reason: reason, Until: time.Now().Add(ttl)}
+	failuresMutex.Lock()
+	ignored[queryId] = ignore
+	failuresMutex.Unlock()
+	if err := workStore.Put(store.BucketIgnored, queryId, ignore, ttl); err != nil {
+		_ = logger.Log("msg", "Error: Could not persist ignored query", "id", queryId, "error", err)
+	}
+}
+
+// settle removes the query responses in msgs from the store once they have left the send queue, recording
+// their idempotency keys if they were submitted.
+func settle(msgs []sdk.Msg, hash string, submitted bool, logger log.Logger) {
+	keys := []string{}
+	for _, msg := range msgs {
+		if msg, ok := msg.(*qstypes.MsgSubmitQueryResponse); ok {
+			markSettled(msg)
+			key := idempotencyKey(msg)
+			keys = append(keys, key)
+			if submitted {
+				clearAttempts(msg.QueryId)
+				if err := workStore.Put(store.BucketSubmitted, key, hash, submittedTTL); err != nil {
+					_ = logger.Log("msg", "Error: Could not persist submitted query response", "key", key, "error", err)
+				}
+			}
+		}
+	}
+	if err := workStore.Delete(store.BucketResponses, keys...); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove settled query responses", "error", err)
+	}
+}
+
+// persistClientUpdate records a prepared client update under its cache key.
+func persistClientUpdate(cfg *types.Config, cacheKey string, msg *clienttypes.MsgUpdateClient, logger log.Logger) {
+	bz, err := cfg.ProtoCodec.Marshal(msg)
+	if err == nil {
+		err = workStore.Put(store.BucketClientUpdates, cacheKey, bz, clientUpdateTTL)
+	}
+	if err != nil {
+		_ = logger.Log("msg", "Error: Could not persist client update", "key", cacheKey, "error", err)
+	}
+}
+
+// storedClientUpdate returns a persisted client update, restoring it to the cache.
+func storedClientUpdate(cfg *types.Config, cacheKey string) (sdk.Msg, bool) {
+	var bz []byte
+	if found, err := workStore.Get(store.BucketClientUpdates, cacheKey, &bz); err != nil || !found {
+		return nil, false
+	}
+	msg := &clienttypes.MsgUpdateClient{}
+	if err := cfg.ProtoCodec.Unmarshal(bz, msg); err != nil {
+		return nil, false
+	}
+	cache.SetWithTTL(cacheKey, msg, 5, clientUpdateTTL)
+	return msg, true
+}
+
+// resume restores the ignore cache, and requeues the query responses and requests that were pending when the
+// relayer last stopped. Responses that were already submitted are discarded.
+func resume(cfg *types.Config, logger log.Logger, metrics prommetrics.Metrics) {
+	if workStore == nil {
+		return
+	}
+
+	restoreDeadLetters(logger)
+
+	failuresMutex.Lock()
+	if err := workStore.Iterate(store.BucketIgnored, func(key string, remaining time.Duration, value []byte) error {
+		// ignores persisted by earlier versions have no reason.
+		ignore := IgnoredQuery{}
+		_ = json.Unmarshal(value, &ignore)
+		ignore.QueryId, ignore.Until = key, time.Now().Add(remaining)
+		ignored[key] = ignore
+		return nil
+	}); err != nil {
+		_ = logger.Log("msg", "Error: Could not restore ignored queries", "error", err)
+	}
+	failuresMutex.Unlock()
+
+	stored := map[string]Message{}
+	stale := []string{}
+	if err := workStore.Iterate(store.BucketResponses, func(key string, _ time.Duration, value []byte) error {
+		entry := storedMessage{}
+		msg := &qstypes.MsgSubmitQueryResponse{}
+		if err := json.Unmarshal(value, &entry); err != nil || cfg.ProtoCodec.Unmarshal(entry.Response, msg) != nil {
+			stale = append(stale, key)
+			return nil
+		}
+		stored[key] = Message{Msg: msg, ClientUpdate: entry.ClientUpdate}
+		return nil
+	}); err != nil {
+		_ = logger.Log("msg", "Error: Could not restore query responses", "error", err)
+	}
+
+	messages := []Message{}
+	for key, message := range stored {
+		if workStore.Has(store.BucketSubmitted, key) {
+			stale = append(stale, key)
+			continue
+		}
+		messages = append(messages, message)
+	}
+	if err := workStore.Delete(store.BucketResponses, stale...); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove stale query responses", "error", err)
+	}
+
+	queries := []Query{}
+	if err := workStore.Iterate(store.BucketQueries, func(_ string, _ time.Duration, value []byte) error {
+		query := Query{}
+		if err := json.Unmarshal(value, &query); err == nil {
+			queries = append(queries, query)
+		}
+		return nil
+	}); err != nil {
+		_ = logger.Log("msg", "Error: Could not restore queries", "error", err)
+	}
+
+	_ = logger.Log("msg", "Resuming persisted work", "responses", len(messages), "queries", len(queries))
+
+	resumed := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		msg := message.Msg.(*qstypes.MsgSubmitQueryResponse)
+		queryId := msg.QueryId
+		resumed[queryId] = true
+		markQueued(msg)
+		cache.Set("query/"+queryId, true, 0)
+		go func(message Message) { sendQueue <- message }(message)
+	}
+
+	for _, query := range queries {
+		if resumed[query.QueryId] {
+			continue
+		}
+		cache.Set("query/"+query.QueryId, true, 0)
+		go doRequestWithMetrics(cfg, query, log.With(logger, "src_chain", query.ChainId), metrics)
+		time.Sleep(75 * time.Millisecond) // try to avoid thundering herd.
+	}
+}
@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// openWorkStore opens the work store at path, closing it at the end of the test.
+func openWorkStore(t *testing.T, path string) {
+	t.Helper()
+	var err error
+	workStore, err = store.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = workStore.Close()
+		workStore = nil
+	})
+}
+
+func TestResume(t *testing.T) {
+	cfg, metrics := setupFailures(t)
+	cfg.ProtoCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	logger := log.NewNopLogger()
+	path := filepath.Join(t.TempDir(), StoreFile)
+	openWorkStore(t, path)
+
+	for _, queryId := range []string{"submitted", "broadcast", "pending"} {
+		msg := response(queryId).Msg.(*qstypes.MsgSubmitQueryResponse)
+		go enqueue(cfg, msg, nil, logger)
+		require.Equal(t, []string{queryId}, receive(t, 1))
+	}
+	// the request of the pending response is still recorded, as it is only removed once the request returns.
+	persistQuery(Query{ChainId: "test-1", QueryId: "pending", Height: 10}, logger)
+
+	// the first response was submitted and settled, the second was broadcast but the relayer stopped before
+	// settling it.
+	settle([]sdk.Msg{response("submitted").Msg}, "hash", true, logger)
+	require.NoError(t, workStore.Put(store.BucketSubmitted, idempotencyKey(response("broadcast").Msg.(*qstypes.MsgSubmitQueryResponse)), "hash", submittedTTL))
+
+	// restart.
+	require.NoError(t, workStore.Close())
+	cfg, metrics = setupFailures(t)
+	cfg.ProtoCodec = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	openWorkStore(t, path)
+
+	resume(cfg, logger, metrics)
+	require.Equal(t, []string{"pending"}, receive(t, 1))
+	requireNoneQueued(t)
+
+	// the submitted response is discarded from the store, leaving only the pending one.
+	keys := []string{}
+	require.NoError(t, workStore.Iterate(store.BucketResponses, func(key string, _ time.Duration, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	require.Equal(t, []string{"test-1/pending/10"}, keys)
+}
@@ -10,7 +10,7 @@ import (
 	"math/rand"
 	"net/http"
 	"os"
-	"regexp"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -24,6 +24,7 @@ import (
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	querytypes "github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	authtxtypes "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -34,6 +35,7 @@ import (
 	"github.com/go-kit/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/store"
 	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/types"
 	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
 	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
@@ -65,6 +67,7 @@ var (
 	sendQueue             = make(chan Message)
 	cache                 *ristretto.Cache
 	LastReduced           = time.Now()
+	batchSizeMutex        = sync.Mutex{}
 
 	// Variables used for retries
 	RtyAttNum = uint(5)
@@ -73,6 +76,13 @@ var (
 	RtyErr    = retry.LastErrorOnly(true)
 )
 
+// batchSize returns the current maximum number of messages per tx, which batches broadcasting concurrently adjust.
+func batchSize() int {
+	batchSizeMutex.Lock()
+	defer batchSizeMutex.Unlock()
+	return TxMsgs
+}
+
 func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 	MaxTxMsgs = cfg.MaxMsgsPerTx
 	if MaxTxMsgs == 0 {
@@ -100,7 +110,19 @@ func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 		panic("unable to start ristretto cache")
 	}
 
+	if cfg.PersistentQueue {
+		workStore, err = store.Open(filepath.Join(cfg.HomePath, StoreFile))
+		if err != nil {
+			return err
+		}
+		defer workStore.Close()
+	}
+
 	http.Handle("/metrics", promHandler)
+	registerAdminHandlers(http.DefaultServeMux, cfg, log.With(logger, "worker", "admin"))
+	if cfg.AdminToken == "" {
+		_ = logger.Log("msg", "AdminToken is not set; admin API actions are disabled")
+	}
 	go func() {
 		stdlog.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", cfg.BindPort), nil))
 	}()
@@ -117,6 +139,12 @@ func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 		return err
 	}
 
+	if err := cfg.DefaultChain.InitSigners(ctx, cfg.HomePath); err != nil {
+		_ = logger.Log("worker", "init", "msg", "unable to initialise signer", "error", err)
+		return err
+	}
+	_ = logger.Log("worker", "init", "msg", "configured signer", "type", cfg.DefaultChain.Signer.Type, "address", cfg.DefaultChain.GetAddress(), "accounts", len(cfg.DefaultChain.Accounts))
+
 	for _, c := range cfg.Chains {
 		if err := c.Init(cfg.ProtoCodec, cache); err != nil {
 			return err
@@ -125,6 +153,8 @@ func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 		_ = logger.Log("worker", "init", "msg", "configured chain", "chain", c.ChainID)
 	}
 
+	go monitorHealth(ctx, cfg, log.With(logger, "worker", "health"))
+
 	query := tmquery.MustParse(fmt.Sprintf("message.module='%s'", "interchainquery"))
 
 	wg := &sync.WaitGroup{}
@@ -151,13 +181,15 @@ func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err := FlushSendQueue(cfg, log.With(logger, "worker", "flusher", "chain", cfg.DefaultChain.ChainID), metrics)
+		err := FlushSendQueue(cfg, cfg.DefaultChain, log.With(logger, "worker", "flusher", "chain", cfg.DefaultChain.ChainID), metrics)
 		if err != nil {
 			_ = logger.Log("Flush Go-routine Bailing")
 			panic(err)
 		}
 	}()
 
+	go resume(cfg, log.With(logger, "worker", "resume"), metrics)
+
 	for _, chainCfg := range cfg.Chains {
 		wg.Add(1)
 		go func(defaultClient *types.ChainConfig, srcClient *types.ReadOnlyChainConfig, logger log.Logger) {
@@ -165,6 +197,9 @@ func Run(ctx context.Context, cfg *types.Config, errHandler func(error)) error {
 		CNT:
 			for {
 				time.Sleep(HistoricQueryInterval)
+				if isPaused(srcClient.ChainID) {
+					continue CNT
+				}
 				req := &qstypes.QueryRequestsRequest{
 					Pagination: &querytypes.PageRequest{Limit: 500},
 					ChainId:    srcClient.ChainID,
@@ -210,6 +245,20 @@ type Query struct {
 	Request       []byte
 }
 
+// resolveQueryHeight returns the height a query should be answered at, given the height requested on chain.
+// Exact heights are answered as is; 'at or after' heights are answered at the current height once the chain
+// has reached them. ok is false if the chain has not yet reached the requested height.
+func resolveQueryHeight(requested int64, exact bool, current int64) (height int64, ok bool) {
+	switch {
+	case requested > 0 && exact:
+		return requested, true
+	case current < requested:
+		return 0, false
+	default:
+		return current, true
+	}
+}
+
 func handleHistoricRequests(cfg *types.Config, queryClient *types.ReadOnlyChainConfig, queries []qstypes.Query, sourceChainId string, logger log.Logger, metrics prommetrics.Metrics) {
 	metrics.HistoricQueries.WithLabelValues("historic-queries").Set(float64(len(queries)))
 
@@ -223,7 +272,7 @@ func handleHistoricRequests(cfg *types.Config, queryClient *types.ReadOnlyChainC
 		return queries[i].CallbackId == "allbalances" || queries[i].CallbackId == "depositinterval" || queries[i].CallbackId == "deposittx" || queries[i].LastEmission.GT(queries[j].LastEmission)
 	})
 
-	for _, query := range queries[0:int(math.Min(float64(len(queries)), float64(TxMsgs)))] {
+	for _, query := range queries[0:int(math.Min(float64(len(queries)), float64(batchSize())))] {
 		q := Query{}
 		q.SourceChainId = sourceChainId
 		q.ChainId = query.ChainId
@@ -237,18 +286,23 @@ func handleHistoricRequests(cfg *types.Config, queryClient *types.ReadOnlyChainC
 			continue
 		}
 
-		if _, found := cache.Get("ignore/" + q.QueryId); found {
+		if isIgnored(q.QueryId) {
 			logger.Log("msg", "Query already in ignore cache", "id", q.QueryId)
 			continue
 		}
 
-		var err error
-		q.Height, err = queryClient.GetCurrentHeight(ctx, cache, log.With(logger, "chain", queryClient.ChainID))
+		currentHeight, err := queryClient.GetCurrentHeight(ctx, cache, log.With(logger, "chain", queryClient.ChainID))
 		if err != nil {
 			_ = logger.Log("msg", "Error getting current height", "error", err)
 			continue
 		}
 
+		var ok bool
+		if q.Height, ok = resolveQueryHeight(query.Height, query.ExactHeight, currentHeight); !ok {
+			_ = logger.Log("msg", "Chain has not reached requested height", "id", query.Id, "height", query.Height, "current", currentHeight)
+			continue
+		}
+
 		handle := false
 		if len(cfg.AllowedQueries) == 0 {
 			handle = true
@@ -270,6 +324,7 @@ func handleHistoricRequests(cfg *types.Config, queryClient *types.ReadOnlyChainC
 		time.Sleep(75 * time.Millisecond) // try to avoid thundering herd.
 
 		cache.Set("query/"+q.QueryId, true, 0)
+		persistQuery(q, logger)
 
 		go doRequestWithMetrics(cfg, q, logger, metrics)
 	}
@@ -284,6 +339,7 @@ func handleEvent(cfg *types.Config, event coretypes.ResultEvent, logger log.Logg
 	types := event.Events["message.type"]
 	request := event.Events["message.request"]
 	height := event.Events["message.height"]
+	exactHeight := event.Events["message.exact_height"]
 
 	items := len(queryIds)
 
@@ -324,24 +380,44 @@ func handleEvent(cfg *types.Config, event coretypes.ResultEvent, logger log.Logg
 			continue
 		}
 
-		if _, found := cache.Get("ignore/" + queryIds[i]); found {
+		if isPaused(chains[i]) {
+			logger.Log("worker", "handler", "msg", "Ignoring current query; chain is paused", "id", queryIds[i], "chain", chains[i])
+			continue
+		}
+
+		if isIgnored(queryIds[i]) {
 			logger.Log("msg", "Query already in ignore cache", "id", queryIds[i])
 			// break if this is in the cache
 			continue
 		}
 
-		if h == 0 {
+		exact := false
+		if len(exactHeight) > i {
+			// absent on chains that predate height-pinned queries.
+			exact, _ = strconv.ParseBool(exactHeight[i])
+		}
+
+		if h == 0 || !exact {
 			currentheight, err := client.GetCurrentHeight(ctx, cache, log.With(logger, "chain", chains[i]))
 			if err != nil {
 				logger.Log("worker", "handler", "msg", "error getting current block", "height", currentheight)
 				continue
 			}
+			if h > 0 {
+				if _, ok := resolveQueryHeight(h, exact, currentheight); !ok {
+					// picked up again by the historic query sweep once the chain reaches h.
+					logger.Log("worker", "handler", "msg", "chain has not reached requested height", "id", queryIds[i], "height", h, "current", currentheight)
+					continue
+				}
+				h = 0 // at or after: answer at latest.
+			}
 		}
 		cache.Set("query/"+queryIds[i], true, 0) // just long enough to not duplicate.
 		queries = append(queries, Query{source[0], connections[i], chains[i], queryIds[i], types[i], h, req})
 	}
 
 	for _, q := range queries {
+		persistQuery(q, logger)
 		go doRequestWithMetrics(cfg, q, log.With(logger, "src_chain", q.ChainId), metrics)
 		time.Sleep(75 * time.Millisecond) // try to avoid thundering herd.
 	}
@@ -386,7 +462,13 @@ func retryLightblock(ctx context.Context, chain *types.ReadOnlyChainConfig, heig
 func doRequestWithMetrics(cfg *types.Config, query Query, logger log.Logger, metrics prommetrics.Metrics) {
 	startTime := time.Now()
 	metrics.Requests.WithLabelValues("requests", query.Type).Inc()
+	startRequest(query)
 	doRequest(cfg, query, logger, metrics)
+	endRequest(query.QueryId)
+	// any response was persisted before being queued, so the request need not be resumed.
+	if err := workStore.Delete(store.BucketQueries, query.QueryId); err != nil {
+		_ = logger.Log("msg", "Error: Could not remove query", "id", query.QueryId, "error", err)
+	}
 	endTime := time.Now()
 	metrics.RequestsLatency.WithLabelValues("request-latency", query.Type).Observe(endTime.Sub(startTime).Seconds())
 }
@@ -498,7 +580,7 @@ func doRequest(cfg *types.Config, query Query, logger log.Logger, metrics promme
 	case "ibc.ClientUpdate":
 		// return a dummy message to settle the query.
 		msg := &qstypes.MsgSubmitQueryResponse{ChainId: query.ChainId, QueryId: query.QueryId, Result: []byte{}, Height: int64(sdk.BigEndianToUint64(query.Request)), ProofOps: &crypto.ProofOps{}, FromAddress: cfg.DefaultChain.GetAddress()}
-		sendQueue <- Message{Msg: msg, ClientUpdate: &ClientUpdateRequirement{ConnectionId: query.ConnectionId, ChainId: query.ChainId, Height: int64(sdk.BigEndianToUint64(query.Request))}}
+		enqueue(cfg, msg, &ClientUpdateRequirement{ConnectionId: query.ConnectionId, ChainId: query.ChainId, Height: int64(sdk.BigEndianToUint64(query.Request))}, logger)
 		return
 	default:
 		res, err = client.RunABCIQuery(ctx, "/"+query.Type, query.Request, query.Height, prove, metrics)
@@ -515,14 +597,17 @@ func doRequest(cfg *types.Config, query Query, logger log.Logger, metrics promme
 		clientUpdate = &ClientUpdateRequirement{ConnectionId: query.ConnectionId, ChainId: query.ChainId, Height: res.Height}
 	}
 
-	sendQueue <- Message{Msg: msg, ClientUpdate: clientUpdate}
+	enqueue(cfg, msg, clientUpdate, logger)
 }
 
 func asyncCacheClientUpdate(ctx context.Context, cfg *types.Config, client *types.ReadOnlyChainConfig, query Query, height int64, logger log.Logger, metrics prommetrics.Metrics) error {
 	cacheKey := fmt.Sprintf("cu/%s-%d", query.ConnectionId, height)
 	queryKey := fmt.Sprintf("cuquery/%s-%d", query.ConnectionId, height)
 
-	_, ok := cache.Get("cu/" + cacheKey)
+	_, ok := cache.Get(cacheKey)
+	if !ok {
+		_, ok = storedClientUpdate(cfg, cacheKey)
+	}
 	if ok {
 		fmt.Println("cache found for ", cacheKey)
 		return nil
@@ -551,18 +636,23 @@ func asyncCacheClientUpdate(ctx context.Context, cfg *types.Config, client *type
 			_ = logger.Log("msg", fmt.Sprintf("Error: Could not create msg update: %s", err))
 			return err
 		}
-		cache.SetWithTTL(cacheKey, msg, 5, 10*time.Minute)
+		cache.SetWithTTL(cacheKey, msg, 5, clientUpdateTTL)
+		persistClientUpdate(cfg, cacheKey, msg, logger)
 		return nil
 	}
 }
 
-func getCachedClientUpdate(connectionId string, height int64) (sdk.Msg, error) {
+func getCachedClientUpdate(cfg *types.Config, connectionId string, height int64, logger log.Logger) (sdk.Msg, error) {
 	cacheKey := fmt.Sprintf("cu/%s-%d", connectionId, height)
 	cu, ok := cache.Get(cacheKey)
 	if ok {
 		fmt.Printf("cache hit for %s-%d\n", connectionId, height)
 		return cu.(sdk.Msg), nil
 	}
+	if cu, ok := storedClientUpdate(cfg, cacheKey); ok {
+		_ = logger.Log("msg", "Client update restored from store", "connection", connectionId, "height", height)
+		return cu, nil
+	}
 	fmt.Printf("cache miss for %s-%d\n", connectionId, height)
 	return nil, errors.New("client update not found")
 }
@@ -622,12 +712,14 @@ func getHeader(ctx context.Context, cfg *types.Config, client *types.ReadOnlyCha
 	return header, nil
 }
 
-func FlushSendQueue(cfg *types.Config, logger log.Logger, metrics prommetrics.Metrics) error {
+func FlushSendQueue(cfg *types.Config, client types.TxClient, logger log.Logger, metrics prommetrics.Metrics) error {
 	time.Sleep(WaitInterval)
 	toSend := []Message{}
 	ch := sendQueue
+	dispatch := newDispatcher(cfg, client, logger, metrics)
 
 	for {
+		batchSizeMutex.Lock()
 		if LastReduced.Add(time.Second * 30).Before(time.Now()) {
 			if 2*TxMsgs > MaxTxMsgs {
 				TxMsgs = MaxTxMsgs
@@ -637,9 +729,11 @@ func FlushSendQueue(cfg *types.Config, logger log.Logger, metrics prommetrics.Me
 			_ = logger.Log("msg", "increased batchsize", "size", TxMsgs)
 			LastReduced = time.Now()
 		}
+		size := TxMsgs
+		batchSizeMutex.Unlock()
 
-		if len(toSend) > 5*TxMsgs {
-			flush(cfg, toSend, logger, metrics)
+		if len(toSend) > 5*size {
+			dispatch(toSend)
 			toSend = []Message{}
 		}
 		select {
@@ -656,71 +750,82 @@ func FlushSendQueue(cfg *types.Config, logger log.Logger, metrics prommetrics.Me
 			}
 
 		case <-time.After(WaitInterval):
-			flush(cfg, toSend, logger, metrics)
+			dispatch(toSend)
 			metrics.SendQueue.WithLabelValues("send-queue").Set(float64(len(sendQueue)))
 			toSend = []Message{}
 		}
 	}
 }
 
-// TODO: refactor me!
-func flush(cfg *types.Config, toSend []Message, logger log.Logger, metrics prommetrics.Metrics) {
+// newDispatcher returns a function that flushes batches concurrently, up to one per signing account; further
+// batches wait for one to complete.
+func newDispatcher(cfg *types.Config, client types.TxClient, logger log.Logger, metrics prommetrics.Metrics) func([]Message) {
+	inFlight := make(chan struct{}, max(1, len(cfg.DefaultChain.Accounts)))
+	return func(batch []Message) {
+		if len(batch) == 0 {
+			return
+		}
+		inFlight <- struct{}{}
+		go func() {
+			defer func() { <-inFlight }()
+			flush(cfg, client, batch, logger, metrics)
+		}()
+	}
+}
+
+// flush submits a batch of messages with client. If the batch fails, its messages are retried or dead lettered
+// according to the retry policy of the class of failure.
+func flush(cfg *types.Config, client types.TxClient, toSend []Message, logger log.Logger, metrics prommetrics.Metrics) {
 	fmt.Println("flush messages", len(toSend))
-	if len(toSend) > 0 {
-		_ = logger.Log("msg", fmt.Sprintf("Sending batch of %d messages", len(toSend)))
-		msgs := prepareMessages(toSend, logger)
-		if len(msgs) > 0 {
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
-			defer cancel()
-			hash, code, err := cfg.DefaultChain.SignAndBroadcastMsg(ctx, cfg.ClientContext, msgs, VERSION)
-
-			switch {
-			case err == nil:
-				_ = logger.Log("msg", fmt.Sprintf("Sent batch of %d (deduplicated) messages [hash: %s]", len(msgs), hash))
-			case code == 12:
-				_ = logger.Log("msg", "Not enough gas")
-			case code == 19:
-				_ = logger.Log("msg", "Tx already in mempool")
-			case strings.Contains(err.Error(), "request body too large"):
-				TxMsgs = max(1, TxMsgs*3/4)
-				LastReduced = time.Now()
-				_ = logger.Log("msg", "body too large: reduced batchsize", "size", TxMsgs)
-			case strings.Contains(err.Error(), "failed to execute message"):
-				regex := regexp.MustCompile(`failed to execute message; message index: (\d+)`)
-				match := regex.FindStringSubmatch(err.Error())
-				idx, _ := strconv.Atoi(match[1])
-				badMsg, ok := msgs[idx].(*qstypes.MsgSubmitQueryResponse)
-				if !ok {
-					_ = logger.Log("msg", "Failed to execute non QueryResponse (probably submitting a ClientUpdate to a syncing node)", "index", match[1], "err", err.Error())
-					metrics.FailedTxs.WithLabelValues("failed_txs").Inc()
-					break
-				}
-				cache.SetWithTTL("ignore/"+badMsg.QueryId, true, 1, time.Minute*5)
-				_ = logger.Log("msg", "Failed to execute message; ignoring for five minutes", "index", match[1], "err", err.Error())
-			case code == 65536:
-				_ = logger.Log("msg", "error in tx", "err", err.Error())
-			default:
-				_ = logger.Log("msg", "Failed to submit; nevermind, we'll try again!", "err", err)
-				metrics.FailedTxs.WithLabelValues("failed_txs").Inc()
-			}
+	if len(toSend) == 0 {
+		return
+	}
 
-		}
+	_ = logger.Log("msg", fmt.Sprintf("Sending batch of %d messages", len(toSend)))
+	msgs, entries, owners := prepareMessages(cfg, toSend, logger)
+	if len(msgs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	hash, code, err := client.SignAndBroadcastMsg(ctx, cfg.ClientContext, msgs, VERSION)
+
+	var txErr *types.TxError
+	switch {
+	case err == nil:
+		settle(msgs, hash, true, logger)
+		recordTx(hash, len(msgs))
+		_ = logger.Log("msg", fmt.Sprintf("Sent batch of %d (deduplicated) messages [hash: %s]", len(msgs), hash))
+	case errors.As(err, &txErr) && txErr.Codespace == sdkerrors.RootCodespace && txErr.Code == sdkerrors.ErrTxInMempoolCache.ABCICode():
+		settle(msgs, hash, true, logger)
+		_ = logger.Log("msg", "Tx already in mempool")
+	default:
+		failure := classifyFailure(msgs, code, err)
+		metrics.FailedTxs.WithLabelValues("failed_txs").Inc()
+		_ = logger.Log("msg", "Failed to submit batch", "class", failure.Class, "index", failure.Index, "err", err)
+		handleFailure(entries, owners, msgs, failure, logger)
 	}
 }
 
-func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
+// prepareMessages returns the deduplicated messages of msgSlice to be submitted, requeueing those that are not ready.
+// It also returns the entries of msgSlice that are included, and for each message, the index of its entry.
+func prepareMessages(cfg *types.Config, msgSlice []Message, logger log.Logger) ([]sdk.Msg, []Message, []int) {
 	keys := make(map[string]bool)
 
 	list := []sdk.Msg{}
+	entries := []Message{}
+	owners := []int{}
 
 	exists := func(keys map[string]bool, key string) bool {
 		_, ok := keys[key]
 		return ok
 	}
 
+	size := batchSize()
 	for _, entry := range msgSlice {
 		// fmt.Println("prepareMessages", idx)
-		if len(list) > TxMsgs {
+		if len(list) > size {
 			// fmt.Println("transaction full; requeueing")
 			go func(entry Message) { time.Sleep(time.Second * 2); sendQueue <- entry }(entry) // client update not ready; requeue.
 			continue
@@ -732,8 +837,23 @@ func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
 			continue // unable to cast message to MsgSubmitQueryResponse
 		}
 
-		if _, ok := cache.Get("ignore/" + msg.QueryId); ok {
+		if isIgnored(msg.QueryId) {
 			logger.Log("msg", "Query already in ignore cache", "id", msg.QueryId)
+			settle([]sdk.Msg{msg}, "", false, logger)
+			continue
+		}
+
+		if isPaused(msg.ChainId) {
+			// asked again by the historic query sweep once the chain is resumed.
+			logger.Log("msg", "Dropping query response; chain is paused", "id", msg.QueryId, "chain", msg.ChainId)
+			settle([]sdk.Msg{msg}, "", false, logger)
+			cache.Del("query/" + msg.QueryId)
+			continue
+		}
+
+		if workStore.Has(store.BucketSubmitted, idempotencyKey(msg)) {
+			logger.Log("msg", "Query response already submitted", "id", msg.QueryId, "height", msg.Height)
+			settle([]sdk.Msg{msg}, "", false, logger)
 			continue
 		}
 
@@ -744,7 +864,7 @@ func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
 
 		if entry.ClientUpdate != nil && !exists(keys, fmt.Sprintf("%s-%d", entry.ClientUpdate.ConnectionId, entry.ClientUpdate.Height)) {
 			// fmt.Println("client update required")
-			cu, err := getCachedClientUpdate(entry.ClientUpdate.ConnectionId, entry.ClientUpdate.Height)
+			cu, err := getCachedClientUpdate(cfg, entry.ClientUpdate.ConnectionId, entry.ClientUpdate.Height, logger)
 			if err != nil {
 				// fmt.Println("client update not ready; requeueing")
 				go func(entry Message) { time.Sleep(time.Second * 2); sendQueue <- entry }(entry) // client update not ready; requeue.
@@ -752,6 +872,8 @@ func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
 				fmt.Println("client update ready; adding update and query response to send list")
 				list = append(list, cu)
 				list = append(list, entry.Msg)
+				owners = append(owners, len(entries), len(entries))
+				entries = append(entries, entry)
 				keys[msg.QueryId] = true
 				keys[fmt.Sprintf("%s-%d", entry.ClientUpdate.ConnectionId, entry.ClientUpdate.Height)] = true
 				cache.Del("query/" + msg.QueryId)
@@ -759,6 +881,8 @@ func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
 		} else {
 			fmt.Println("adding query response to send list")
 			list = append(list, entry.Msg)
+			owners = append(owners, len(entries))
+			entries = append(entries, entry)
 			keys[msg.QueryId] = true
 			cache.Del("query/" + msg.QueryId)
 		}
@@ -767,7 +891,7 @@ func prepareMessages(msgSlice []Message, logger log.Logger) []sdk.Msg {
 
 	fmt.Printf("prepared %d messages\n", len(list))
 
-	return list
+	return list, entries, owners
 }
 
 func Close(cfg *types.Config) error {
@@ -0,0 +1,54 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// KeyringServiceName is the service name under which keys are stored in the os keyring backend.
+const KeyringServiceName = "icq-relayer"
+
+// OpenKeyring opens the cosmos sdk keyring in dir with the given backend, being one of file, test or os.
+// The passphrase of the file backend is read from passphrase, as it would be entered at the terminal.
+func OpenKeyring(backend string, dir string, passphrase string, cdc codec.Codec) (keyring.Keyring, error) {
+	switch backend {
+	case keyring.BackendFile, keyring.BackendTest, keyring.BackendOS:
+	default:
+		return nil, fmt.Errorf("unsupported keyring backend %q, expected file, test or os", backend)
+	}
+	// the file backend prompts for the passphrase, and for confirmation when creating a new keyring.
+	input := strings.NewReader(strings.Repeat(passphrase+"\n", 2))
+	return keyring.New(KeyringServiceName, backend, dir, input, cdc)
+}
+
+// KeyringSigner signs with a named key in a cosmos sdk keyring.
+type KeyringSigner struct {
+	kr   keyring.Keyring
+	name string
+}
+
+// NewKeyringSigner returns a KeyringSigner for the named key in kr.
+func NewKeyringSigner(kr keyring.Keyring, name string) (*KeyringSigner, error) {
+	if _, err := kr.Key(name); err != nil {
+		return nil, fmt.Errorf("unable to find key %q in keyring: %w", name, err)
+	}
+	return &KeyringSigner{kr: kr, name: name}, nil
+}
+
+func (s *KeyringSigner) PubKey(_ context.Context) (cryptotypes.PubKey, error) {
+	record, err := s.kr.Key(s.name)
+	if err != nil {
+		return nil, err
+	}
+	return record.GetPubKey()
+}
+
+func (s *KeyringSigner) Sign(_ context.Context, _ string, signBytes []byte) ([]byte, error) {
+	signature, _, err := s.kr.Sign(s.name, signBytes)
+	return signature, err
+}
@@ -0,0 +1,285 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// grpcCodec encodes the gogoproto messages of the remote signer protocol.
+func grpcCodec() grpc.ServerOption {
+	return grpc.ForceServerCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec())
+}
+
+// TLSConfig locates the certificates securing the connection between the relayer and a remote signer.
+type TLSConfig struct {
+	// CAPath is the certificate of the authority that issued the certificates of the peer.
+	CAPath string
+	// CertPath and KeyPath are the certificate and key presented to the peer.
+	CertPath string
+	KeyPath  string
+}
+
+// Enabled returns true if the connection is to be secured with TLS.
+func (c TLSConfig) Enabled() bool {
+	return c.CAPath != "" || c.CertPath != "" || c.KeyPath != ""
+}
+
+// Mutual returns true if both peers are to present certificates verified against CAPath.
+func (c TLSConfig) Mutual() bool {
+	return c.CAPath != "" && c.CertPath != "" && c.KeyPath != ""
+}
+
+// loadCertPool returns a pool of the certificates in the file at path.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// ClientCredentials returns the credentials of a relayer connecting to a remote signer, verifying the signer's certificate
+// against CAPath, and presenting CertPath if set.
+func (c TLSConfig) ClientCredentials() (credentials.TransportCredentials, error) {
+	if c.CAPath == "" {
+		return nil, fmt.Errorf("a ca certificate is required to verify the remote signer")
+	}
+	pool, err := loadCertPool(c.CAPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	if c.CertPath != "" || c.KeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// ServerCredentials returns the credentials of a remote signer, presenting CertPath and, if CAPath is set, requiring
+// clients to present a certificate issued by that authority.
+func (c TLSConfig) ServerCredentials() (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if c.CAPath != "" {
+		if config.ClientCAs, err = loadCertPool(c.CAPath); err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// IsLoopback returns true if the host of address, being host:port, is a loopback address.
+func IsLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// RemoteSigner signs with a named key held by a remote signer, over the protocol defined in
+// proto/icqrelayer/signer/v1/signer.proto.
+type RemoteSigner struct {
+	conn   *grpc.ClientConn
+	client SignerClient
+	name   string
+
+	mu     sync.Mutex
+	pubKey cryptotypes.PubKey
+}
+
+// NewRemoteSigner returns a RemoteSigner for the named key of the remote signer at address. The connection is secured
+// with TLS if tlsConfig is enabled, and mutually authenticated if it has a client certificate. An unsecured connection
+// is only permitted to a loopback address.
+func NewRemoteSigner(address string, name string, tlsConfig TLSConfig, opts ...grpc.DialOption) (*RemoteSigner, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig.Enabled() {
+		var err error
+		if creds, err = tlsConfig.ClientCredentials(); err != nil {
+			return nil, fmt.Errorf("unable to load remote signer tls config: %w", err)
+		}
+	} else if !IsLoopback(address) {
+		return nil, fmt.Errorf("remote signer %s is not a loopback address, and so must be secured with tls", address)
+	}
+
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec())),
+	}, opts...)
+	conn, err := grpc.NewClient(address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to remote signer %s: %w", address, err)
+	}
+
+	return &RemoteSigner{conn: conn, client: NewSignerClient(conn), name: name}, nil
+}
+
+// Close closes the connection to the remote signer.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+// PubKey returns the public key of the named key, which is requested from the remote signer once.
+func (s *RemoteSigner) PubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pubKey != nil {
+		return s.pubKey, nil
+	}
+
+	res, err := s.client.PubKey(ctx, &PubKeyRequest{KeyName: s.name})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get public key from remote signer: %w", err)
+	}
+	if s.pubKey, err = decodePubKey(res.Algo, res.PubKey); err != nil {
+		return nil, err
+	}
+	return s.pubKey, nil
+}
+
+func (s *RemoteSigner) Sign(ctx context.Context, chainID string, signBytes []byte) ([]byte, error) {
+	res, err := s.client.Sign(ctx, &SignRequest{KeyName: s.name, SignBytes: signBytes, ChainId: chainID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign with remote signer: %w", err)
+	}
+	return res.Signature, nil
+}
+
+// DefaultMsgTypes are the type urls of the messages broadcast by the relayer.
+var DefaultMsgTypes = []string{
+	"/quicksilver.interchainquery.v1.MsgSubmitQueryResponse",
+	"/ibc.core.client.v1.MsgUpdateClient",
+}
+
+// Server is the reference implementation of the remote signer protocol, signing with the keys of a keyring.
+type Server struct {
+	kr       keyring.Keyring
+	chainIDs map[string]bool
+	msgTypes map[string]bool
+}
+
+var _ SignerServer = &Server{}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// NewServer returns a Server signing with the keys of kr. If chainIDs are given, only transactions for those chains are
+// signed; if msgTypes are given, only transactions containing only messages of those type urls are signed.
+func NewServer(kr keyring.Keyring, chainIDs []string, msgTypes []string) *Server {
+	return &Server{kr: kr, chainIDs: toSet(chainIDs), msgTypes: toSet(msgTypes)}
+}
+
+// NewGRPCServer returns a grpc server, secured with the given credentials if not nil, serving s.
+func (s *Server) NewGRPCServer(creds credentials.TransportCredentials) *grpc.Server {
+	opts := []grpc.ServerOption{grpcCodec()}
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+	srv := grpc.NewServer(opts...)
+	RegisterSignerServer(srv, s)
+	return srv
+}
+
+func (s *Server) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	record, err := s.kr.Key(req.KeyName)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "key %q not found", req.KeyName)
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	algo, bz, err := encodePubKey(pubKey)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &PubKeyResponse{Algo: algo, PubKey: bz}, nil
+}
+
+// checkSignDoc decodes the sign bytes of a SignRequest, being a SIGN_MODE_DIRECT SignDoc, and checks the transaction
+// against the chains and message types the server is permitted to sign for.
+func (s *Server) checkSignDoc(req *SignRequest) error {
+	if len(req.SignBytes) == 0 {
+		return status.Error(codes.InvalidArgument, "sign bytes must not be empty")
+	}
+	doc := txtypes.SignDoc{}
+	if err := doc.Unmarshal(req.SignBytes); err != nil {
+		return status.Errorf(codes.InvalidArgument, "sign bytes are not a sign doc: %v", err)
+	}
+	// the chain id of the request is informational; the sign doc is what is signed.
+	if doc.ChainId != req.ChainId {
+		return status.Errorf(codes.InvalidArgument, "sign doc chain id %q does not match request chain id %q", doc.ChainId, req.ChainId)
+	}
+	if len(s.chainIDs) > 0 && !s.chainIDs[doc.ChainId] {
+		return status.Errorf(codes.PermissionDenied, "not permitted to sign for chain %q", doc.ChainId)
+	}
+
+	body := txtypes.TxBody{}
+	if err := body.Unmarshal(doc.BodyBytes); err != nil {
+		return status.Errorf(codes.InvalidArgument, "sign doc body is invalid: %v", err)
+	}
+	if len(body.Messages) == 0 {
+		return status.Error(codes.InvalidArgument, "sign doc has no messages")
+	}
+	if len(s.msgTypes) > 0 {
+		for _, msg := range body.Messages {
+			if !s.msgTypes[msg.TypeUrl] {
+				return status.Errorf(codes.PermissionDenied, "not permitted to sign message %s", msg.TypeUrl)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if err := s.checkSignDoc(req); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.kr.Key(req.KeyName); err != nil {
+		return nil, status.Errorf(codes.NotFound, "key %q not found", req.KeyName)
+	}
+
+	signature, _, err := s.kr.Sign(req.KeyName, req.SignBytes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &SignResponse{Signature: signature}, nil
+}
@@ -0,0 +1,202 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testCA is a certificate authority issuing the certificates of a test.
+type testCA struct {
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	dir    string
+	serial int64
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	ca := &testCA{dir: t.TempDir()}
+	ca.cert, ca.key = ca.issue(t, "ca", nil)
+	return ca
+}
+
+// issue returns a certificate for dnsNames signed by the ca, or self signed if the ca is not yet initialised.
+func (ca *testCA) issue(t *testing.T, name string, dnsNames []string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	parent, parentKey := ca.cert, ca.key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// writeCert writes a certificate and key issued by the ca for dnsNames, returning their paths.
+func (ca *testCA) writeCert(t *testing.T, name string, dnsNames ...string) (string, string) {
+	t.Helper()
+	cert, key := ca.issue(t, name, dnsNames)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath, keyPath := filepath.Join(ca.dir, name+".crt"), filepath.Join(ca.dir, name+".key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certPath, keyPath
+}
+
+// caPath writes the certificate of the ca, returning its path.
+func (ca *testCA) caPath(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(ca.dir, "ca.crt")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+	return path
+}
+
+// serveBufconn serves server over an in-memory listener, secured with serverTLS, returning a dial option connecting to it.
+func serveBufconn(t *testing.T, server *Server, serverTLS TLSConfig) grpc.DialOption {
+	t.Helper()
+	creds, err := serverTLS.ServerCredentials()
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1 << 20)
+	srv := server.NewGRPCServer(creds)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.writeCert(t, "server", "bufnet")
+	clientCert, clientKey := ca.writeCert(t, "client")
+	dialer := serveBufconn(t, NewServer(newTestKeyring(t), []string{"quicksilver-2"}, DefaultMsgTypes), TLSConfig{CAPath: ca.caPath(t), CertPath: serverCert, KeyPath: serverKey})
+
+	signer, err := NewRemoteSigner("passthrough:///bufnet", "relayer", TLSConfig{CAPath: ca.caPath(t), CertPath: clientCert, KeyPath: clientKey}, dialer)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = signer.Close() })
+	requireSigns(t, signer)
+
+	ctx := context.Background()
+	pubKey, err := signer.PubKey(ctx)
+	require.NoError(t, err)
+
+	// both message types of the relayer are permitted together.
+	bz := signBytes(t, "quicksilver-2", DefaultMsgTypes...)
+	signature, err := signer.Sign(ctx, "quicksilver-2", bz)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(bz, signature))
+
+	tests := []struct {
+		name      string
+		chainID   string
+		signBytes []byte
+		code      codes.Code
+	}{
+		{"chain not allowed", "cosmoshub-4", signBytes(t, "cosmoshub-4", DefaultMsgTypes[0]), codes.PermissionDenied},
+		{"request chain id differs from sign doc", "quicksilver-2", signBytes(t, "cosmoshub-4", DefaultMsgTypes[0]), codes.InvalidArgument},
+		{"message type not allowed", "quicksilver-2", signBytes(t, "quicksilver-2", DefaultMsgTypes[0], "/cosmos.bank.v1beta1.MsgSend"), codes.PermissionDenied},
+		{"no messages", "quicksilver-2", signBytes(t, "quicksilver-2"), codes.InvalidArgument},
+		{"not a sign doc", "quicksilver-2", []byte{0xff, 0xff, 0xff}, codes.InvalidArgument},
+		{"empty", "quicksilver-2", nil, codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := signer.Sign(ctx, test.chainID, test.signBytes)
+			require.Error(t, err)
+			require.Equal(t, test.code, status.Code(err), err.Error())
+		})
+	}
+
+	unknown, err := NewRemoteSigner("passthrough:///bufnet", "unknown", TLSConfig{CAPath: ca.caPath(t), CertPath: clientCert, KeyPath: clientKey}, dialer)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = unknown.Close() })
+	_, err = unknown.PubKey(ctx)
+	require.ErrorContains(t, err, "NotFound")
+}
+
+func TestRemoteSignerMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.writeCert(t, "server", "bufnet")
+	dialer := serveBufconn(t, NewServer(newTestKeyring(t), nil, nil), TLSConfig{CAPath: ca.caPath(t), CertPath: serverCert, KeyPath: serverKey})
+	ctx := context.Background()
+
+	// a client without a certificate is refused.
+	signer, err := NewRemoteSigner("passthrough:///bufnet", "relayer", TLSConfig{CAPath: ca.caPath(t)}, dialer)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = signer.Close() })
+	_, err = signer.PubKey(ctx)
+	require.Error(t, err)
+
+	// as is a client with a certificate from another authority.
+	other := newTestCA(t)
+	otherCert, otherKey := other.writeCert(t, "client")
+	signer, err = NewRemoteSigner("passthrough:///bufnet", "relayer", TLSConfig{CAPath: ca.caPath(t), CertPath: otherCert, KeyPath: otherKey}, dialer)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = signer.Close() })
+	_, err = signer.PubKey(ctx)
+	require.Error(t, err)
+
+	// and a server with a certificate from another authority is refused by the client.
+	clientCert, clientKey := ca.writeCert(t, "client")
+	signer, err = NewRemoteSigner("passthrough:///bufnet", "relayer", TLSConfig{CAPath: other.caPath(t), CertPath: clientCert, KeyPath: clientKey}, dialer)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = signer.Close() })
+	_, err = signer.PubKey(ctx)
+	require.Error(t, err)
+}
+
+func TestNewRemoteSignerInsecure(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:9091", "localhost:9091", "[::1]:9091"} {
+		signer, err := NewRemoteSigner(address, "relayer", TLSConfig{})
+		require.NoError(t, err, address)
+		require.NoError(t, signer.Close())
+	}
+	for _, address := range []string{"10.0.0.2:9091", "signer.example.com:9091", "0.0.0.0:9091", ":9091"} {
+		_, err := NewRemoteSigner(address, "relayer", TLSConfig{})
+		require.ErrorContains(t, err, "must be secured with tls", address)
+	}
+
+	// a client certificate without a ca is rejected, rather than connecting without verifying the signer.
+	_, err := NewRemoteSigner("10.0.0.2:9091", "relayer", TLSConfig{CertPath: "client.crt", KeyPath: "client.key"})
+	require.ErrorContains(t, err, "ca certificate is required")
+}
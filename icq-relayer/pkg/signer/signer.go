@@ -0,0 +1,105 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	home "github.com/mitchellh/go-homedir"
+)
+
+// Signer signs transactions on behalf of the relayer account, without necessarily exposing the key.
+type Signer interface {
+	// PubKey returns the public key of the signing account.
+	PubKey(ctx context.Context) (cryptotypes.PubKey, error)
+	// Sign returns the signature of signBytes, being the sign bytes of a transaction for chainID.
+	Sign(ctx context.Context, chainID string, signBytes []byte) ([]byte, error)
+}
+
+var (
+	_ Signer = &PrivKeySigner{}
+	_ Signer = &KeyringSigner{}
+	_ Signer = &RemoteSigner{}
+)
+
+// PrivKeySigner signs with a private key held in memory.
+type PrivKeySigner struct {
+	privKey cryptotypes.PrivKey
+}
+
+// NewMnemonicSigner returns a PrivKeySigner for the secp256k1 key derived, on the cosmos hd path, from the plaintext
+// mnemonic in the file at path.
+func NewMnemonicSigner(path string) (*PrivKeySigner, error) {
+	path, err := home.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	mnemonicBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	mnemonic := strings.Trim(string(mnemonicBytes), "\n")
+
+	hdPath := hd.CreateHDPath(118, 0, 0)
+	derivedPriv, err := hd.Secp256k1.Derive()(mnemonic, "", hdPath.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivKeySigner{privKey: hd.Secp256k1.Generate()(derivedPriv)}, nil
+}
+
+// NewKeystoreSigner returns a PrivKeySigner for the key in the file at path, being an armored private key encrypted with
+// passphrase, as exported by 'quicksilverd keys export'.
+func NewKeystoreSigner(path string, passphrase string) (*PrivKeySigner, error) {
+	path, err := home.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	armor, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(string(armor), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt keystore %s: %w", path, err)
+	}
+	if algo != string(hd.Secp256k1Type) {
+		return nil, fmt.Errorf("unsupported key algorithm %q, expected %s", algo, hd.Secp256k1Type)
+	}
+
+	return &PrivKeySigner{privKey: privKey}, nil
+}
+
+func (s *PrivKeySigner) PubKey(_ context.Context) (cryptotypes.PubKey, error) {
+	return s.privKey.PubKey(), nil
+}
+
+func (s *PrivKeySigner) Sign(_ context.Context, _ string, signBytes []byte) ([]byte, error) {
+	return s.privKey.Sign(signBytes)
+}
+
+// encodePubKey returns the algorithm and bytes of a public key, as transmitted by the remote signer protocol.
+func encodePubKey(pubKey cryptotypes.PubKey) (string, []byte, error) {
+	if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+		return "", nil, fmt.Errorf("unsupported public key type %s, expected %s", pubKey.Type(), hd.Secp256k1Type)
+	}
+	return string(hd.Secp256k1Type), pubKey.Bytes(), nil
+}
+
+// decodePubKey is the inverse of encodePubKey.
+func decodePubKey(algo string, bz []byte) (cryptotypes.PubKey, error) {
+	if algo != string(hd.Secp256k1Type) {
+		return nil, fmt.Errorf("unsupported key algorithm %q, expected %s", algo, hd.Secp256k1Type)
+	}
+	if len(bz) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length %d, expected %d", len(bz), secp256k1.PubKeySize)
+	}
+	return &secp256k1.PubKey{Key: bz}, nil
+}
@@ -0,0 +1,115 @@
+package signer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func newTestKeyring(t *testing.T) keyring.Keyring {
+	t.Helper()
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry))
+	_, err := kr.NewAccount("relayer", testMnemonic, "", hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	require.NoError(t, err)
+	return kr
+}
+
+// signBytes returns the sign bytes of a transaction for chainID containing messages of the given type urls.
+func signBytes(t *testing.T, chainID string, typeURLs ...string) []byte {
+	t.Helper()
+	body := txtypes.TxBody{}
+	for _, typeURL := range typeURLs {
+		body.Messages = append(body.Messages, &codectypes.Any{TypeUrl: typeURL})
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	bz, err := (&txtypes.SignDoc{BodyBytes: bodyBytes, ChainId: chainID, AccountNumber: 1}).Marshal()
+	require.NoError(t, err)
+	return bz
+}
+
+// requireSigns asserts that signer produces signatures verified by its public key, which is that of the test mnemonic.
+func requireSigns(t *testing.T, signer Signer) {
+	t.Helper()
+	ctx := context.Background()
+
+	pubKey, err := signer.PubKey(ctx)
+	require.NoError(t, err)
+	expected, err := NewMnemonicSigner(writeFile(t, "mnemonic", testMnemonic+"\n"))
+	require.NoError(t, err)
+	expectedPubKey, err := expected.PubKey(ctx)
+	require.NoError(t, err)
+	require.True(t, expectedPubKey.Equals(pubKey))
+
+	bz := signBytes(t, "quicksilver-2", DefaultMsgTypes[0])
+	signature, err := signer.Sign(ctx, "quicksilver-2", bz)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(bz, signature))
+	require.False(t, pubKey.VerifySignature(signBytes(t, "quicksilver-2", DefaultMsgTypes[1]), signature))
+}
+
+func writeFile(t *testing.T, name string, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestKeyringSigner(t *testing.T) {
+	kr := newTestKeyring(t)
+
+	_, err := NewKeyringSigner(kr, "missing")
+	require.ErrorContains(t, err, `unable to find key "missing"`)
+
+	signer, err := NewKeyringSigner(kr, "relayer")
+	require.NoError(t, err)
+	requireSigns(t, signer)
+}
+
+func TestOpenKeyring(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	_, err := OpenKeyring("memory", t.TempDir(), "", cdc)
+	require.ErrorContains(t, err, "unsupported keyring backend")
+
+	dir := t.TempDir()
+	kr, err := OpenKeyring(keyring.BackendFile, dir, "passphrase", cdc)
+	require.NoError(t, err)
+	_, err = kr.NewAccount("relayer", testMnemonic, "", hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the key is readable on reopening the keyring with the passphrase.
+	kr, err = OpenKeyring(keyring.BackendFile, dir, "passphrase", cdc)
+	require.NoError(t, err)
+	signer, err := NewKeyringSigner(kr, "relayer")
+	require.NoError(t, err)
+	requireSigns(t, signer)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	armor, err := newTestKeyring(t).ExportPrivKeyArmor("relayer", "passphrase")
+	require.NoError(t, err)
+	path := writeFile(t, "relayer.key", armor)
+
+	_, err = NewKeystoreSigner(path, "wrong")
+	require.ErrorContains(t, err, "unable to decrypt keystore")
+
+	signer, err := NewKeystoreSigner(path, "passphrase")
+	require.NoError(t, err)
+	requireSigns(t, signer)
+}
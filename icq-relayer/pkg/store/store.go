@@ -0,0 +1,203 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// BucketQueries holds queries for which a request is in flight, keyed by query id.
+	BucketQueries = "queries"
+	// BucketResponses holds prepared query responses awaiting submission, keyed by idempotency key.
+	BucketResponses = "responses"
+	// BucketClientUpdates holds prepared client updates, keyed by connection id and height.
+	BucketClientUpdates = "client_updates"
+	// BucketIgnored holds queries that are temporarily ignored, keyed by query id.
+	BucketIgnored = "ignored"
+	// BucketSubmitted holds the idempotency keys of responses that have been submitted.
+	BucketSubmitted = "submitted"
+	// BucketDeadLetters holds the dead letters of responses that exhausted their retries, keyed by query id.
+	BucketDeadLetters = "dead_letters"
+)
+
+var buckets = []string{BucketQueries, BucketResponses, BucketClientUpdates, BucketIgnored, BucketSubmitted, BucketDeadLetters}
+
+// Store is an embedded on-disk store for relayer work that would otherwise be lost on restart.
+// All methods are no-ops on a nil Store, so persistence may be disabled by leaving it unset.
+type Store struct {
+	db *bolt.DB
+}
+
+type record struct {
+	Expires time.Time       `json:"expires,omitempty"`
+	Value   json.RawMessage `json:"value"`
+}
+
+func (r record) expired(now time.Time) bool {
+	return !r.Expires.IsZero() && now.After(r.Expires)
+}
+
+// Open opens the store at path, creating it if it does not exist, and prunes expired entries.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open store %s: %w", path, err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, name := range buckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	s := &Store{db: db}
+	for _, name := range buckets {
+		if err := s.Prune(name); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	if s == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+// Put JSON encodes value and stores it under key. A ttl of zero never expires.
+func (s *Store) Put(bucket, key string, value any, ttl time.Duration) error {
+	if s == nil {
+		return nil
+	}
+
+	r := record{}
+	if ttl > 0 {
+		r.Expires = time.Now().Add(ttl)
+	}
+
+	var err error
+	if r.Value, err = json.Marshal(value); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucket)).Put([]byte(key), bz)
+	})
+}
+
+// Get decodes the unexpired value stored under key into value, returning false if there is none.
+func (s *Store) Get(bucket, key string, value any) (bool, error) {
+	if s == nil {
+		return false, nil
+	}
+
+	var r record
+	found := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bz := tx.Bucket([]byte(bucket)).Get([]byte(key))
+		if bz == nil {
+			return nil
+		}
+		if err := json.Unmarshal(bz, &r); err != nil {
+			return err
+		}
+		found = !r.expired(time.Now())
+		return nil
+	})
+	if err != nil || !found {
+		return false, err
+	}
+	return true, json.Unmarshal(r.Value, value)
+}
+
+// Has returns true if an unexpired value is stored under key.
+func (s *Store) Has(bucket, key string) bool {
+	var value json.RawMessage
+	found, err := s.Get(bucket, key, &value)
+	return err == nil && found
+}
+
+// Delete removes keys from bucket.
+func (s *Store) Delete(bucket string, keys ...string) error {
+	if s == nil || len(keys) == 0 {
+		return nil
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		for _, key := range keys {
+			if err := b.Delete([]byte(key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Iterate calls fn with each unexpired entry in bucket, and the time remaining until it expires, or zero if it does not.
+// Values are passed still encoded, to be decoded with json.Unmarshal.
+func (s *Store) Iterate(bucket string, fn func(key string, remaining time.Duration, value []byte) error) error {
+	if s == nil {
+		return nil
+	}
+	now := time.Now()
+	return s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucket)).ForEach(func(k, v []byte) error {
+			var r record
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+			if r.expired(now) {
+				return nil
+			}
+			remaining := time.Duration(0)
+			if !r.Expires.IsZero() {
+				remaining = r.Expires.Sub(now)
+			}
+			return fn(string(k), remaining, r.Value)
+		})
+	})
+}
+
+// Prune removes expired and undecodable entries from bucket.
+func (s *Store) Prune(bucket string) error {
+	if s == nil {
+		return nil
+	}
+	now := time.Now()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		stale := [][]byte{}
+		if err := b.ForEach(func(k, v []byte) error {
+			var r record
+			if err := json.Unmarshal(v, &r); err != nil || r.expired(now) {
+				stale = append(stale, k)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range stale {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,125 @@
+package store
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+type entry struct {
+	Name  string
+	Count int
+}
+
+func openTestStore(t *testing.T, path string) *Store {
+	t.Helper()
+	s, err := Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.db")
+	s := openTestStore(t, path)
+
+	require.NoError(t, s.Put(BucketQueries, "a", entry{Name: "a", Count: 1}, 0))
+	require.NoError(t, s.Put(BucketQueries, "b", entry{Name: "b", Count: 2}, time.Hour))
+
+	value := entry{}
+	found, err := s.Get(BucketQueries, "a", &value)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, entry{Name: "a", Count: 1}, value)
+
+	// buckets are independent.
+	found, err = s.Get(BucketResponses, "a", &value)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.False(t, s.Has(BucketQueries, "c"))
+
+	// values survive reopening the store.
+	require.NoError(t, s.Close())
+	s = openTestStore(t, path)
+
+	values := map[string]entry{}
+	require.NoError(t, s.Iterate(BucketQueries, func(key string, remaining time.Duration, bz []byte) error {
+		value := entry{}
+		require.NoError(t, json.Unmarshal(bz, &value))
+		values[key] = value
+		if key == "a" {
+			require.Zero(t, remaining)
+		} else {
+			require.Greater(t, remaining, 59*time.Minute)
+			require.LessOrEqual(t, remaining, time.Hour)
+		}
+		return nil
+	}))
+	require.Equal(t, map[string]entry{"a": {Name: "a", Count: 1}, "b": {Name: "b", Count: 2}}, values)
+
+	require.NoError(t, s.Delete(BucketQueries, "a", "missing"))
+	require.False(t, s.Has(BucketQueries, "a"))
+	require.True(t, s.Has(BucketQueries, "b"))
+}
+
+func TestTTL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "queue.db")
+	s := openTestStore(t, path)
+
+	require.NoError(t, s.Put(BucketSubmitted, "expiring", "hash", time.Millisecond))
+	require.NoError(t, s.Put(BucketSubmitted, "kept", "hash", time.Hour))
+	time.Sleep(10 * time.Millisecond)
+
+	// expired entries are hidden before they are pruned.
+	value := ""
+	found, err := s.Get(BucketSubmitted, "expiring", &value)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.False(t, s.Has(BucketSubmitted, "expiring"))
+	keys := []string{}
+	require.NoError(t, s.Iterate(BucketSubmitted, func(key string, _ time.Duration, _ []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	require.Equal(t, []string{"kept"}, keys)
+
+	// and removed by a prune, as on opening the store.
+	require.NoError(t, s.Prune(BucketSubmitted))
+	count := 0
+	require.NoError(t, s.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket([]byte(BucketSubmitted)).Stats().KeyN
+		return nil
+	}))
+	require.Equal(t, 1, count)
+
+	// overwriting an entry replaces its ttl.
+	require.NoError(t, s.Put(BucketSubmitted, "kept", "hash", time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.Close())
+	s = openTestStore(t, path)
+	require.NoError(t, s.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket([]byte(BucketSubmitted)).Stats().KeyN
+		return nil
+	}))
+	require.Zero(t, count)
+}
+
+func TestNilStore(t *testing.T) {
+	var s *Store
+	require.NoError(t, s.Put(BucketQueries, "a", "value", 0))
+	found, err := s.Get(BucketQueries, "a", new(string))
+	require.NoError(t, err)
+	require.False(t, found)
+	require.False(t, s.Has(BucketQueries, "a"))
+	require.NoError(t, s.Delete(BucketQueries, "a"))
+	require.NoError(t, s.Iterate(BucketQueries, func(string, time.Duration, []byte) error {
+		t.Fatal("unexpected entry in nil store")
+		return nil
+	}))
+	require.NoError(t, s.Prune(BucketQueries))
+	require.NoError(t, s.Close())
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkcryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/signer"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+var (
+	// MaxSequenceRetries is the number of times a tx is re-signed after a sequence mismatch before giving up.
+	MaxSequenceRetries = 3
+	// TxPollInterval is the interval at which a broadcast tx is polled for until it is included in a block.
+	TxPollInterval = time.Second
+)
+
+// Account is a signing account of the relayer. Its sequence is tracked locally, so that a tx may be broadcast
+// without waiting for the previous one to be included in a block. An account is used by one broadcast at a time.
+type Account struct {
+	Signer  signer.Signer
+	PubKey  sdkcryptotypes.PubKey
+	Address sdktypes.AccAddress
+
+	synced   bool
+	number   uint64
+	sequence uint64
+}
+
+// NewAccount returns the Account signed for by s.
+func NewAccount(ctx context.Context, s signer.Signer) (*Account, error) {
+	pubKey, err := s.PubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{Signer: s, PubKey: pubKey, Address: sdktypes.AccAddress(pubKey.Address())}, nil
+}
+
+// sync fetches the account number and sequence from chain, unless they are already known.
+func (a *Account) sync(cliContext client.Context) error {
+	if a.synced {
+		return nil
+	}
+	number, sequence, err := cliContext.AccountRetriever.GetAccountNumberSequence(cliContext, a.Address)
+	if err != nil {
+		return err
+	}
+	a.number, a.sequence, a.synced = number, sequence, true
+	return nil
+}
+
+// resync adopts the sequence expected by the chain, as reported in a sequence mismatch error. The chain checks
+// sequences against its mempool, so this accounts for txs that are pending but not yet included in a block.
+// If the expected sequence cannot be determined, it is fetched from chain on the next sync.
+func (a *Account) resync(errLog string) {
+	if expected, ok := expectedSequence(errLog); ok {
+		a.sequence = expected
+		return
+	}
+	a.synced = false
+}
+
+var sequenceMismatchRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+), got (\d+)`)
+
+// expectedSequence returns the expected sequence reported by a sequence mismatch error.
+func expectedSequence(errLog string) (uint64, bool) {
+	match := sequenceMismatchRegex.FindStringSubmatch(errLog)
+	if match == nil {
+		return 0, false
+	}
+	expected, err := strconv.ParseUint(match[1], 10, 64)
+	return expected, err == nil
+}
+
+// withSigner returns msgs to be signed by address, copying those that name their signer.
+func withSigner(msgs []sdktypes.Msg, address string) []sdktypes.Msg {
+	out := make([]sdktypes.Msg, len(msgs))
+	for i, msg := range msgs {
+		switch msg := msg.(type) {
+		case *qstypes.MsgSubmitQueryResponse:
+			signed := *msg
+			signed.FromAddress = address
+			out[i] = &signed
+		case *clienttypes.MsgUpdateClient:
+			signed := *msg
+			signed.Signer = address
+			out[i] = &signed
+		default:
+			out[i] = msg
+		}
+	}
+	return out
+}
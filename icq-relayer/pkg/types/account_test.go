@@ -0,0 +1,282 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/signer"
+	qstypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestExpectedSequence(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      string
+		expected uint64
+		ok       bool
+	}{
+		{"raw log", "account sequence mismatch, expected 12, got 11: incorrect account sequence", 12, true},
+		{"simulation error", "rpc error: code = Unknown desc = account sequence mismatch, expected 7, got 9: incorrect account sequence [cosmos/cosmos-sdk@v0.47.17/x/auth/ante/sigverify.go:269] with gas used: '35012'", 7, true},
+		{"zero", "account sequence mismatch, expected 0, got 1", 0, true},
+		{"no expected sequence", "incorrect account sequence", 0, false},
+		{"other error", "out of gas in location: ReadFlat", 0, false},
+		{"overflow", "account sequence mismatch, expected 18446744073709551616, got 1", 0, false},
+		{"empty", "", 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected, ok := expectedSequence(test.log)
+			require.Equal(t, test.ok, ok)
+			if ok {
+				require.Equal(t, test.expected, expected)
+			}
+		})
+	}
+}
+
+func TestResync(t *testing.T) {
+	tests := []struct {
+		name     string
+		log      string
+		sequence uint64
+		synced   bool
+	}{
+		{"adopts expected sequence", "account sequence mismatch, expected 8, got 5", 8, true},
+		{"adopts lower expected sequence", "account sequence mismatch, expected 3, got 5", 3, true},
+		{"refetches unknown sequence", "incorrect account sequence", 5, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			account := &Account{synced: true, number: 1, sequence: 5}
+			account.resync(test.log)
+			require.Equal(t, test.sequence, account.sequence)
+			require.Equal(t, test.synced, account.synced)
+			require.Equal(t, uint64(1), account.number)
+		})
+	}
+}
+
+func TestWithSigner(t *testing.T) {
+	response := &qstypes.MsgSubmitQueryResponse{QueryId: "a", FromAddress: "primary"}
+	update := &clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: "primary"}
+	send := &banktypes.MsgSend{FromAddress: "primary"}
+
+	tests := []struct {
+		name     string
+		msgs     []sdktypes.Msg
+		expected []sdktypes.Msg
+	}{
+		{"empty", []sdktypes.Msg{}, []sdktypes.Msg{}},
+		{"query response", []sdktypes.Msg{response}, []sdktypes.Msg{&qstypes.MsgSubmitQueryResponse{QueryId: "a", FromAddress: "pool"}}},
+		{"client update", []sdktypes.Msg{update}, []sdktypes.Msg{&clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: "pool"}}},
+		{"other messages are unchanged", []sdktypes.Msg{update, response, send}, []sdktypes.Msg{
+			&clienttypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: "pool"},
+			&qstypes.MsgSubmitQueryResponse{QueryId: "a", FromAddress: "pool"},
+			send,
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, withSigner(test.msgs, "pool"))
+		})
+	}
+
+	// the messages of the caller, that may be requeued for another account, are not modified.
+	require.Equal(t, "primary", response.FromAddress)
+	require.Equal(t, "primary", update.Signer)
+}
+
+// fakeAccountRetriever returns the scripted sequences of an account, one per fetch, repeating the last.
+type fakeAccountRetriever struct {
+	client.AccountRetriever
+	sequences []uint64
+	fetches   int
+}
+
+func (f *fakeAccountRetriever) GetAccountNumberSequence(_ client.Context, _ sdktypes.AccAddress) (uint64, uint64, error) {
+	sequence := f.sequences[min(f.fetches, len(f.sequences)-1)]
+	f.fetches++
+	return 1, sequence, nil
+}
+
+// fakeNode returns the scripted results of broadcasting txs, recording the sequence each tx is signed with.
+type fakeNode struct {
+	client.TendermintRPC
+	txConfig  client.TxConfig
+	results   []*coretypes.ResultBroadcastTx
+	err       error
+	sequences []uint64
+}
+
+func (f *fakeNode) BroadcastTxSync(_ context.Context, txBytes tmtypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	tx, err := f.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	f.sequences = append(f.sequences, signatures[0].Sequence)
+	if f.err != nil {
+		return nil, f.err
+	}
+	result := f.results[min(len(f.sequences), len(f.results))-1]
+	result.Hash = []byte{byte(len(f.sequences))}
+	return result, nil
+}
+
+// fakeTxService simulates txs, failing with the scripted errors before succeeding.
+type fakeTxService struct {
+	txtypes.UnimplementedServiceServer
+	errs []error
+}
+
+func (f *fakeTxService) Simulate(_ context.Context, _ *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	return &txtypes.SimulateResponse{GasInfo: &sdktypes.GasInfo{GasUsed: 100000}}, nil
+}
+
+func TestBroadcastSequence(t *testing.T) {
+	mismatch := func(expected, got string) *coretypes.ResultBroadcastTx {
+		return &coretypes.ResultBroadcastTx{Codespace: "sdk", Code: 32, Log: "account sequence mismatch, expected " + expected + ", got " + got + ": incorrect account sequence"}
+	}
+	accepted := &coretypes.ResultBroadcastTx{}
+
+	tests := []struct {
+		name         string
+		onChain      []uint64
+		simulateErrs []error
+		results      []*coretypes.ResultBroadcastTx
+		broadcastErr error
+		// expected outcome
+		code      uint32
+		err       bool
+		broadcast []uint64
+		sequence  uint64
+		synced    bool
+		fetches   int
+	}{
+		{
+			name: "accepted", onChain: []uint64{5}, results: []*coretypes.ResultBroadcastTx{accepted},
+			broadcast: []uint64{5}, sequence: 6, synced: true, fetches: 1,
+		},
+		{
+			name: "broadcast mismatch adopts the expected sequence", onChain: []uint64{5}, results: []*coretypes.ResultBroadcastTx{mismatch("8", "5"), accepted},
+			broadcast: []uint64{5, 8}, sequence: 9, synced: true, fetches: 1,
+		},
+		{
+			name: "simulation mismatch adopts the expected sequence", onChain: []uint64{5}, simulateErrs: []error{errors.New("account sequence mismatch, expected 6, got 5: incorrect account sequence")},
+			results: []*coretypes.ResultBroadcastTx{accepted}, broadcast: []uint64{6}, sequence: 7, synced: true, fetches: 1,
+		},
+		{
+			name: "mismatch without an expected sequence refetches it", onChain: []uint64{5, 9},
+			results:   []*coretypes.ResultBroadcastTx{{Codespace: "sdk", Code: 32, Log: "incorrect account sequence"}, accepted},
+			broadcast: []uint64{5, 9}, sequence: 10, synced: true, fetches: 2,
+		},
+		{
+			name: "repeated mismatches give up", onChain: []uint64{5}, results: []*coretypes.ResultBroadcastTx{mismatch("8", "5")},
+			code: 32, err: true, broadcast: []uint64{5, 8, 8, 8}, sequence: 8, synced: true, fetches: 1,
+		},
+		{
+			name: "repeated simulation mismatches give up", onChain: []uint64{5},
+			simulateErrs: []error{errors.New("account sequence mismatch, expected 6, got 5"), errors.New("account sequence mismatch, expected 7, got 6"), errors.New("account sequence mismatch, expected 8, got 7"), errors.New("account sequence mismatch, expected 9, got 8")},
+			code: 65536, err: true, broadcast: nil, sequence: 8, synced: true, fetches: 1,
+		},
+		{
+			name: "other failures keep the sequence", onChain: []uint64{5}, results: []*coretypes.ResultBroadcastTx{{Codespace: "sdk", Code: 11, Log: "out of gas"}},
+			code: 11, err: true, broadcast: []uint64{5}, sequence: 5, synced: true, fetches: 1,
+		},
+		{
+			name: "broadcast error refetches the sequence", onChain: []uint64{5}, broadcastErr: errors.New("connection refused"),
+			code: 65536, err: true, broadcast: []uint64{5}, sequence: 5, synced: false, fetches: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			chain, cliContext, account := setupBroadcast(t, test.simulateErrs)
+			retriever := &fakeAccountRetriever{sequences: test.onChain}
+			node := &fakeNode{txConfig: cliContext.TxConfig, results: test.results, err: test.broadcastErr}
+			cliContext = cliContext.WithAccountRetriever(retriever).WithClient(node)
+
+			hash, code, err := chain.broadcast(context.Background(), &cliContext, account, []sdktypes.Msg{&qstypes.MsgSubmitQueryResponse{QueryId: "a"}}, "test")
+			require.Equal(t, test.err, err != nil, err)
+			require.Equal(t, test.code, code)
+			if !test.err {
+				require.NotEmpty(t, hash)
+			}
+			require.Equal(t, test.broadcast, node.sequences)
+			require.Equal(t, test.sequence, account.sequence)
+			require.Equal(t, test.synced, account.synced)
+			require.Equal(t, test.fetches, retriever.fetches)
+		})
+	}
+}
+
+// setupBroadcast returns a chain and client context, simulating txs with a fake tx service that fails with
+// simulateErrs, and the account of the test mnemonic.
+func setupBroadcast(t *testing.T, simulateErrs []error) (*ChainConfig, client.Context, *Account) {
+	t.Helper()
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	qstypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ForceServerCodec(cdc.GRPCCodec()))
+	txtypes.RegisterServiceServer(server, &fakeTxService{errs: simulateErrs})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	path := filepath.Join(t.TempDir(), "mnemonic")
+	require.NoError(t, os.WriteFile(path, []byte(testMnemonic), 0o600))
+	s, err := signer.NewMnemonicSigner(path)
+	require.NoError(t, err)
+	account, err := NewAccount(context.Background(), s)
+	require.NoError(t, err)
+
+	chain := &ChainConfig{ReadOnlyChainConfig: &ReadOnlyChainConfig{ChainID: "quicksilver-2"}, Prefix: "quick", GasPrice: "0.0001uqck", GasMultiplier: 1.2}
+	cliContext := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes)).
+		WithChainID(chain.ChainID).
+		WithBroadcastMode("sync").
+		WithGRPCClient(conn)
+	return chain, cliContext, account
+}
@@ -7,9 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +28,7 @@ import (
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	"github.com/dgraph-io/ristretto"
+	"github.com/quicksilver-zone/quicksilver/icq-relayer/pkg/signer"
 	"github.com/quicksilver-zone/quicksilver/icq-relayer/prommetrics"
 	celestiatypes "github.com/quicksilver-zone/quicksilver/third-party-chains/celestia-types/types"
 	"github.com/quicksilver-zone/quicksilver/utils/proofs"
@@ -37,13 +38,11 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/crypto/hd"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	sdkcryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	log2 "github.com/go-kit/log"
@@ -67,12 +66,42 @@ type ReadOnlyChainConfig struct {
 type ChainConfig struct {
 	*ReadOnlyChainConfig
 	MnemonicPath           string
+	Signer                 SignerConfig
+	Pool                   []SignerConfig
+	PoolFeeGranter         bool
 	Prefix                 string
 	TxSubmitTimeoutSeconds int
 	GasLimit               int
 	GasPrice               string
 	GasMultiplier          float64
 	AddressBytes           sdktypes.AccAddress `toml:"-"`
+	Accounts               []*Account          `toml:"-"`
+	accounts               chan *Account
+}
+
+const (
+	SignerMnemonic = "mnemonic"
+	SignerKeyring  = "keyring"
+	SignerKeystore = "keystore"
+	SignerRemote   = "remote"
+
+	// PassphraseEnv is the environment variable from which the passphrase of a file keyring or keystore is read.
+	PassphraseEnv = "ICQ_RELAYER_PASSPHRASE"
+)
+
+// SignerConfig configures how transactions are signed. Type is one of mnemonic (the default, deriving the key
+// from the plaintext mnemonic at MnemonicPath), keyring, keystore or remote.
+type SignerConfig struct {
+	Type           string
+	MnemonicPath   string
+	KeyName        string
+	KeyringBackend string
+	KeyringDir     string
+	KeystorePath   string
+	RemoteAddress  string
+	RemoteCAPath   string
+	RemoteCertPath string
+	RemoteKeyPath  string
 }
 
 func (r *ChainConfig) GetClient() *rpchttp.HTTP {
@@ -336,6 +365,22 @@ type TxClient interface {
 	SignAndBroadcastMsg(ctx context.Context, cliContext *client.Context, exec []sdktypes.Msg, memo string) (string, uint32, error)
 }
 
+// TxError is returned by a TxClient when a tx is rejected or fails, carrying its ABCI result.
+type TxError struct {
+	Hash      string
+	Codespace string
+	Code      uint32
+	Log       string
+}
+
+func (e *TxError) Error() string {
+	return fmt.Sprintf("transaction %s failed: codespace %s, code %d: %s", e.Hash, e.Codespace, e.Code, e.Log)
+}
+
+func newTxError(res *sdktypes.TxResponse) *TxError {
+	return &TxError{Hash: res.TxHash, Codespace: res.Codespace, Code: res.Code, Log: res.RawLog}
+}
+
 var (
 	_ TxClient    = &ChainConfig{}
 	_ QueryClient = &ChainConfig{}
@@ -357,47 +402,87 @@ func (c *ChainConfig) GetAddress() string {
 }
 
 func (c *ChainConfig) GetAddressBytes() sdktypes.AccAddress {
-	if c.AddressBytes == nil {
-		_, addrBytes := c.GetPrivKeyAddress()
-		c.AddressBytes = addrBytes
-	}
 	return c.AddressBytes
 }
 
-func (c *ChainConfig) GetPrivKeyAddress() (sdkcryptotypes.PrivKey, sdktypes.AccAddress) {
-	kb, err := keyring.New("icq-relayer", keyring.BackendMemory, "", nil, c.Codec)
-	if err != nil {
-		log.Fatal(err)
+// InitSigners initialises the signing accounts of the chain: the primary account described by Signer, and the
+// accounts of the pool, that broadcast txs in parallel. If PoolFeeGranter is set, the fees of the pool accounts are
+// paid by the primary account, which must have granted them a fee allowance. Relative paths are resolved against
+// homePath.
+func (c *ChainConfig) InitSigners(ctx context.Context, homePath string) error {
+	configs := append([]SignerConfig{c.Signer}, c.Pool...)
+	if configs[0].MnemonicPath == "" {
+		configs[0].MnemonicPath = c.MnemonicPath
 	}
 
-	path, err := home.Expand(c.MnemonicPath)
-	if err != nil {
-		log.Fatal(err)
-	}
-	mnemonicBytes, err := os.ReadFile(path)
-	if err != nil {
-		log.Fatal(err)
+	c.Accounts = make([]*Account, 0, len(configs))
+	c.accounts = make(chan *Account, len(configs))
+	for i, config := range configs {
+		s, err := c.newSigner(config, homePath)
+		if err != nil {
+			return fmt.Errorf("unable to initialise %s signer %d: %w", config.Type, i, err)
+		}
+		account, err := NewAccount(ctx, s)
+		if err != nil {
+			return err
+		}
+		c.Accounts = append(c.Accounts, account)
+		c.accounts <- account
 	}
-	mnemonic := strings.Trim(string(mnemonicBytes), "\n")
 
-	keyringAlgos, _ := kb.SupportedAlgorithms()
+	c.AddressBytes = c.Accounts[0].Address
+	return nil
+}
 
-	algo, err := keyring.NewSigningAlgoFromString(string(hd.Secp256k1Type), keyringAlgos)
-	if err != nil {
-		log.Fatal(err)
+func (c *ChainConfig) newSigner(config SignerConfig, homePath string) (signer.Signer, error) {
+	resolve := func(path string) (string, error) {
+		path, err := home.Expand(path)
+		if err != nil || path == "" || filepath.IsAbs(path) {
+			return path, err
+		}
+		return filepath.Join(homePath, path), nil
 	}
 
-	hdPath := hd.CreateHDPath(118, 0, 0)
-
-	derivedPriv, err := algo.Derive()(mnemonic, "", hdPath.String())
-	if err != nil {
-		log.Fatal(err)
+	switch config.Type {
+	case "", SignerMnemonic:
+		return signer.NewMnemonicSigner(config.MnemonicPath)
+	case SignerKeyring:
+		dir, err := resolve(config.KeyringDir)
+		if err != nil {
+			return nil, err
+		}
+		if dir == "" {
+			dir = homePath
+		}
+		kr, err := signer.OpenKeyring(config.KeyringBackend, dir, os.Getenv(PassphraseEnv), c.Codec)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewKeyringSigner(kr, config.KeyName)
+	case SignerKeystore:
+		path, err := resolve(config.KeystorePath)
+		if err != nil {
+			return nil, err
+		}
+		return signer.NewKeystoreSigner(path, os.Getenv(PassphraseEnv))
+	case SignerRemote:
+		caPath, err := resolve(config.RemoteCAPath)
+		if err != nil {
+			return nil, err
+		}
+		certPath, err := resolve(config.RemoteCertPath)
+		if err != nil {
+			return nil, err
+		}
+		keyPath, err := resolve(config.RemoteKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig := signer.TLSConfig{CAPath: caPath, CertPath: certPath, KeyPath: keyPath}
+		return signer.NewRemoteSigner(config.RemoteAddress, config.KeyName, tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported signer type %q, expected %s, %s, %s or %s", config.Type, SignerMnemonic, SignerKeyring, SignerKeystore, SignerRemote)
 	}
-
-	privKey := algo.Generate()(derivedPriv)
-
-	addrBytes := sdktypes.AccAddress(privKey.PubKey().Address())
-	return privKey, addrBytes
 }
 
 func Bech32ifyAddressBytes(prefix string, address sdktypes.AccAddress) (string, error) {
@@ -473,7 +558,7 @@ func (r *ReadOnlyChainConfig) GetCurrentHeight(ctx context.Context, cache *ristr
 	return currentheight.(int64), nil
 }
 
-func (c *ChainConfig) prepareBytes(txBuilder client.TxBuilder, cliContext client.Context, signerData xauthsigning.SignerData, gas uint64, codec *codec.ProtoCodec, memo string) ([]byte, error) {
+func (c *ChainConfig) prepareBytes(ctx context.Context, account *Account, txBuilder client.TxBuilder, cliContext client.Context, signerData xauthsigning.SignerData, gas uint64, memo string) ([]byte, error) {
 	txBuilder.SetGasLimit(gas)
 	fee, err := sdktypes.ParseDecCoin(c.GasPrice)
 	if err != nil {
@@ -484,85 +569,153 @@ func (c *ChainConfig) prepareBytes(txBuilder client.TxBuilder, cliContext client
 	txBuilder.SetFeeAmount(sdktypes.NewCoins(feeCoin))
 	txBuilder.SetMemo(memo)
 
-	privKey, _ := c.GetPrivKeyAddress()
-	sigv2, err := tx.SignWithPrivKey(
-		cliContext.TxConfig.SignModeHandler().DefaultMode(), signerData, txBuilder, privKey, cliContext.TxConfig, signerData.Sequence)
-	if err != nil {
+	// set an empty signature first, such that the signer info is included in the sign bytes.
+	signMode := cliContext.TxConfig.SignModeHandler().DefaultMode()
+	sigv2 := signing.SignatureV2{PubKey: account.PubKey, Data: &signing.SingleSignatureData{SignMode: signMode}, Sequence: signerData.Sequence}
+	if err := txBuilder.SetSignatures(sigv2); err != nil {
 		return []byte{}, err
 	}
 
-	err = txBuilder.SetSignatures(sigv2)
+	signBytes, err := cliContext.TxConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return []byte{}, err
+	}
+	signature, err := account.Signer.Sign(ctx, c.ChainID, signBytes)
 	if err != nil {
 		return []byte{}, err
 	}
+
+	sigv2.Data = &signing.SingleSignatureData{SignMode: signMode, Signature: signature}
+	if err := txBuilder.SetSignatures(sigv2); err != nil {
+		return []byte{}, err
+	}
 	return cliContext.TxConfig.TxEncoder()(txBuilder.GetTx())
 }
 
+// SignAndBroadcastMsg signs exec with the next free account, broadcasts it, and waits for it to be included in a
+// block. The account is freed for the next tx once this one has been accepted into the mempool.
 func (c *ChainConfig) SignAndBroadcastMsg(ctx context.Context, cliContext *client.Context, exec []sdktypes.Msg, version string) (string, uint32, error) {
-	// Build the factory CLI
-	// Create a new TxBuilder.
-	txBuilder := cliContext.TxConfig.NewTxBuilder()
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.TxSubmitTimeoutSeconds)*time.Second)
+	defer cancel()
 
-	err := txBuilder.SetMsgs(exec...)
+	var account *Account
+	select {
+	case account = <-c.accounts:
+	case <-ctx.Done():
+		return "", 65536, fmt.Errorf("no signing account available: %w", ctx.Err())
+	}
+	hash, code, err := c.broadcast(ctx, cliContext, account, exec, version)
+	c.accounts <- account
 	if err != nil {
-		return "", 65536, err
+		return hash, code, err
 	}
 
+	return c.waitForTx(ctx, cliContext, hash)
+}
+
+// broadcast signs exec with account at its locally tracked sequence, and broadcasts it, re-signing if the sequence
+// does not match that expected by the chain.
+func (c *ChainConfig) broadcast(ctx context.Context, cliContext *client.Context, account *Account, exec []sdktypes.Msg, memo string) (string, uint32, error) {
 	SetSDKConfigPrefix(c.Prefix)
-	ac, seq, err := cliContext.AccountRetriever.GetAccountNumberSequence(*cliContext, c.GetAddressBytes())
+	address, err := Bech32ifyAddressBytes(c.Prefix, account.Address)
 	if err != nil {
 		return "", 65536, err
 	}
 
-	signerData := xauthsigning.SignerData{
-		ChainID:       c.ChainID,
-		AccountNumber: ac,
-		Sequence:      seq,
-	}
-
-	txBytes, err := c.prepareBytes(txBuilder, *cliContext, signerData, 1000000, c.Codec, version)
-	if err != nil {
+	// Build the factory CLI
+	// Create a new TxBuilder.
+	txBuilder := cliContext.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(withSigner(exec, address)...); err != nil {
 		return "", 65536, err
 	}
-
-	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.TxSubmitTimeoutSeconds)*time.Second)
-	defer cancel()
+	if c.PoolFeeGranter && !account.Address.Equals(c.AddressBytes) {
+		txBuilder.SetFeeGranter(c.AddressBytes)
+	}
 
 	serviceClient := txtypes.NewServiceClient(cliContext)
 
-	simRes, err := serviceClient.Simulate(ctx, &txtypes.SimulateRequest{
-		TxBytes: txBytes,
-	})
-	if err != nil {
-		return "", 65536, err
-	}
+	for attempt := 0; ; attempt++ {
+		if err := account.sync(*cliContext); err != nil {
+			return "", 65536, err
+		}
 
-	gas := uint64(float64(simRes.GasInfo.GasUsed) * c.GasMultiplier)
+		signerData := xauthsigning.SignerData{
+			Address:       address,
+			ChainID:       c.ChainID,
+			AccountNumber: account.number,
+			Sequence:      account.sequence,
+			PubKey:        account.PubKey,
+		}
 
-	txBytes, err = c.prepareBytes(txBuilder, *cliContext, signerData, gas, c.Codec, version)
-	if err != nil {
-		return "", 65536, err
-	}
+		txBytes, err := c.prepareBytes(ctx, account, txBuilder, *cliContext, signerData, 1000000, memo)
+		if err != nil {
+			return "", 65536, err
+		}
 
-	res, err := serviceClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
-		TxBytes: txBytes,
-		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_ASYNC,
-	})
+		simRes, err := serviceClient.Simulate(ctx, &txtypes.SimulateRequest{
+			TxBytes: txBytes,
+		})
+		if err != nil {
+			if _, ok := expectedSequence(err.Error()); ok && attempt < MaxSequenceRetries {
+				account.resync(err.Error())
+				continue
+			}
+			return "", 65536, err
+		}
 
-	time.Sleep(10 * time.Second)
-	txRes, err := serviceClient.GetTx(ctx, &txtypes.GetTxRequest{
-		Hash: res.TxResponse.TxHash,
-	})
+		gas := uint64(float64(simRes.GasInfo.GasUsed) * c.GasMultiplier)
 
-	switch {
-	case err != nil:
-		// log.Err(err).Msg("Transaction error")
-		return "", 65536, err
-	case txRes.TxResponse.Code > 0:
-		// log.Error().Msgf("Transaction failed: %v", res.TxResponse)
-		return "err", res.TxResponse.Code, fmt.Errorf("transaction failed: %v", res.TxResponse)
-	default:
-		// log.Info().Msgf("Transaction broadcast successfully: %s", res.TxResponse.TxHash)
-		return txRes.TxResponse.TxHash, txRes.TxResponse.Code, nil
+		txBytes, err = c.prepareBytes(ctx, account, txBuilder, *cliContext, signerData, gas, memo)
+		if err != nil {
+			return "", 65536, err
+		}
+
+		res, err := serviceClient.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+			TxBytes: txBytes,
+			Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+		})
+		if err != nil {
+			// the tx may or may not have reached the mempool.
+			account.synced = false
+			return "", 65536, err
+		}
+
+		switch {
+		case res.TxResponse.Code == 0:
+			account.sequence++
+			return res.TxResponse.TxHash, 0, nil
+		case res.TxResponse.Codespace == sdkerrors.RootCodespace && res.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode() && attempt < MaxSequenceRetries:
+			account.resync(res.TxResponse.RawLog)
+		default:
+			return res.TxResponse.TxHash, res.TxResponse.Code, newTxError(res.TxResponse)
+		}
+	}
+}
+
+// waitForTx polls for the tx with hash until it is included in a block, or ctx is done.
+func (c *ChainConfig) waitForTx(ctx context.Context, cliContext *client.Context, hash string) (string, uint32, error) {
+	serviceClient := txtypes.NewServiceClient(cliContext)
+	ticker := time.NewTicker(TxPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return hash, 65536, fmt.Errorf("transaction %s not included: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+
+		txRes, err := serviceClient.GetTx(ctx, &txtypes.GetTxRequest{
+			Hash: hash,
+		})
+		switch {
+		case err != nil:
+			// not yet included.
+			continue
+		case txRes.TxResponse.Code > 0:
+			return hash, txRes.TxResponse.Code, newTxError(txRes.TxResponse)
+		default:
+			return txRes.TxResponse.TxHash, txRes.TxResponse.Code, nil
+		}
 	}
 }
@@ -17,23 +17,27 @@ import (
 
 // Config represents the config file for the relayer
 type Config struct {
-	BindPort       int
-	MaxMsgsPerTx   int
-	MaxTxsPerQuery uint64
-	AllowedQueries []string
-	SkipEpoch      bool
-	HA             HAConfig
-	DefaultChain   *ChainConfig
-	Chains         map[string]*ReadOnlyChainConfig
-	ProtoCodec     *codec.ProtoCodec `toml:"-"`
-	ClientContext  *client.Context   `toml:"-"`
-	HomePath       string            `toml:"-"`
+	BindPort        int
+	AdminToken      string // bearer token required by the admin API actions, which are disabled if unset.
+	MaxMsgsPerTx    int
+	MaxTxsPerQuery  uint64
+	AllowedQueries  []string
+	SkipEpoch       bool
+	PersistentQueue bool
+	HA              HAConfig
+	DefaultChain    *ChainConfig
+	Chains          map[string]*ReadOnlyChainConfig
+	ProtoCodec      *codec.ProtoCodec `toml:"-"`
+	ClientContext   *client.Context   `toml:"-"`
+	HomePath        string            `toml:"-"`
 }
 
 type HAConfig struct {
 	NodeCount  int
 	NodeIndex  int
 	Redundancy int
+	// Assignment is the chains assigned to each node by FilterHA.
+	Assignment map[int][]string `toml:"-"`
 }
 
 var (
@@ -74,6 +78,7 @@ func InitializeConfigFromToml(homepath string) Config {
 func NewConfig() Config {
 	return Config{
 		BindPort:       2112,
+		AdminToken:     "", // the admin API actions are disabled until a token is set.
 		MaxMsgsPerTx:   40,
 		MaxTxsPerQuery: 50,
 		AllowedQueries: []string{},
@@ -82,6 +87,7 @@ func NewConfig() Config {
 			ReadOnlyChainConfig: DefaultReadOnlyChainConfig("quicksilver-2", "https://quicksilver-2.rpc.quicksilver.zone:443"),
 			Prefix:              "quick",
 			MnemonicPath:        "./seed",
+			Signer:              SignerConfig{Type: SignerMnemonic},
 			GasLimit:            150000000,
 			GasPrice:            "0.00025uqck",
 			GasMultiplier:       1.25,
@@ -138,6 +144,7 @@ func (c *Config) FilterHA() {
 		}
 	}
 
+	c.HA.Assignment = result
 	filterMap(c.Chains, result[c.HA.NodeIndex])
 }
 
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "lockup"
+
+	StoreKey = ModuleName
+)
+
+var (
+	// KeyPrefixPeriodLock defines prefix to store period lock by ID.
+	KeyPrefixPeriodLock = []byte{0x02}
+	// KeyIndexSeparator defines separator between keys when combine, it should be one that is not used in denom expression.
+	KeyIndexSeparator = []byte{0xFF}
+)
+
+// GetPeriodLockKey returns the store key of the period lock with the given ID.
+func GetPeriodLockKey(id uint64) []byte {
+	key := make([]byte, 0, len(KeyPrefixPeriodLock)+len(KeyIndexSeparator)+8)
+	key = append(key, KeyPrefixPeriodLock...)
+	key = append(key, KeyIndexSeparator...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
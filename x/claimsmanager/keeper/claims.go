@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -40,6 +42,7 @@ func (k Keeper) SetClaim(ctx sdk.Context, claim *types.Claim) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
 	bz := k.cdc.MustMarshal(claim)
 	store.Set(types.GetKeyClaim(claim.ChainId, claim.UserAddress, claim.Module, claim.SourceChainId), bz)
+	k.emitClaimEvent(ctx, &types.EventClaimSet{Claim: *claim})
 }
 
 // SetLastEpochClaim sets claim for last epoch.
@@ -53,12 +56,21 @@ func (k Keeper) SetLastEpochClaim(ctx sdk.Context, claim *types.Claim) {
 func (k Keeper) DeleteClaim(ctx sdk.Context, claim *types.Claim) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
 	store.Delete(types.GetKeyClaim(claim.ChainId, claim.UserAddress, claim.Module, claim.SourceChainId))
+	k.emitClaimEvent(ctx, &types.EventClaimPruned{Claim: *claim})
 }
 
 // DeleteLastEpochClaim deletes claim for last epoch.
 func (k Keeper) DeleteLastEpochClaim(ctx sdk.Context, claim *types.Claim) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
 	store.Delete(types.GetKeyLastEpochClaim(claim.ChainId, claim.UserAddress, claim.Module, claim.SourceChainId))
+	k.emitClaimEvent(ctx, &types.EventClaimPruned{Claim: *claim, LastEpoch: true})
+}
+
+// emitClaimEvent emits a typed claim lifecycle event.
+func (k Keeper) emitClaimEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("unable to emit claim event", "event", proto.MessageName(event), "error", err)
+	}
 }
 
 // IterateClaims iterates through zone claims.
@@ -261,7 +273,10 @@ func (k Keeper) ClearClaims(ctx sdk.Context, chainID string) {
 
 	for ; iterator.Valid(); iterator.Next() {
 		key := iterator.Key()
+		claim := types.Claim{}
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
 		store.Delete(key)
+		k.emitClaimEvent(ctx, &types.EventClaimPruned{Claim: claim})
 	}
 }
 
@@ -273,7 +288,10 @@ func (k Keeper) ClearLastEpochClaims(ctx sdk.Context, chainID string) {
 
 	for ; iterator.Valid(); iterator.Next() {
 		key := iterator.Key()
+		claim := types.Claim{}
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
 		store.Delete(key)
+		k.emitClaimEvent(ctx, &types.EventClaimPruned{Claim: claim, LastEpoch: true})
 	}
 }
 
@@ -287,9 +305,28 @@ func (k Keeper) ArchiveAndGarbageCollectClaims(ctx sdk.Context, chainID string)
 
 	for ; iterator.Valid(); iterator.Next() {
 		key := iterator.Key()
+		claim := types.Claim{}
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
 		store.Delete(key)
 		newKey := types.KeyPrefixLastEpochClaim
 		newKey = append(newKey, key[1:]...) // update prefix from KeyPrefixClaim to KeyPrefixLastEpochClaim
 		store.Set(newKey, iterator.Value())
+		k.emitClaimEvent(ctx, &types.EventClaimArchived{Claim: claim})
 	}
 }
+
+// ClaimsSnapshot returns a snapshot of the current and last epoch claims of all zones, labelled with epoch.
+func (k Keeper) ClaimsSnapshot(ctx sdk.Context, epoch int64) types.ClaimsSnapshot {
+	snapshot := types.ClaimsSnapshot{Height: ctx.BlockHeight(), Epoch: epoch, Claims: []types.ClaimSnapshotEntry{}}
+
+	k.IterateAllClaims(ctx, func(_ int64, _ []byte, claim types.Claim) (stop bool) {
+		snapshot.Claims = append(snapshot.Claims, types.NewClaimSnapshotEntry(types.SnapshotStoreCurrent, claim))
+		return false
+	})
+	k.IterateAllLastEpochClaims(ctx, func(_ int64, _ []byte, claim types.Claim) (stop bool) {
+		snapshot.Claims = append(snapshot.Claims, types.NewClaimSnapshotEntry(types.SnapshotStoreLastEpoch, claim))
+		return false
+	})
+
+	return snapshot
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 )
@@ -197,6 +199,51 @@ func (suite *KeeperTestSuite) TestKeeper_ClaimStore() {
 	suite.Require().Equal(0, len(claims))
 }
 
+func (suite *KeeperTestSuite) TestKeeper_ClaimEvents() {
+	k := suite.GetQuicksilverApp(suite.chainA).ClaimsManagerKeeper
+
+	current := types.NewClaim(testAddress, suite.chainB.ChainID, types.ClaimTypeLiquidToken, "", math.NewInt(100))
+	previous := types.NewClaim(testAddress, suite.chainB.ChainID, types.ClaimTypeOsmosisPool, "osmosis-1", math.NewInt(200))
+
+	ctx := suite.chainA.GetContext()
+	k.SetLastEpochClaim(ctx, &previous)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.SetClaim(ctx, &current)
+	k.ArchiveAndGarbageCollectClaims(ctx, suite.chainB.ChainID)
+
+	var events []any
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		typed, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		events = append(events, typed)
+	}
+
+	suite.Require().Equal([]any{
+		&types.EventClaimSet{Claim: current},
+		&types.EventClaimPruned{Claim: previous, LastEpoch: true},
+		&types.EventClaimArchived{Claim: current},
+	}, events)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ClaimsSnapshot() {
+	k := suite.GetQuicksilverApp(suite.chainA).ClaimsManagerKeeper
+	ctx := suite.chainA.GetContext()
+
+	current := types.NewClaim(testAddress, suite.chainB.ChainID, types.ClaimTypeLiquidToken, "", math.NewInt(100))
+	previous := types.NewClaim(testAddress, suite.chainB.ChainID, types.ClaimTypeOsmosisPool, "osmosis-1", math.NewInt(200))
+	k.SetClaim(ctx, &current)
+	k.SetLastEpochClaim(ctx, &previous)
+
+	snapshot := k.ClaimsSnapshot(ctx, 7)
+	suite.Require().Equal(ctx.BlockHeight(), snapshot.Height)
+	suite.Require().Equal(int64(7), snapshot.Epoch)
+	suite.Require().Equal([]types.ClaimSnapshotEntry{
+		types.NewClaimSnapshotEntry(types.SnapshotStoreCurrent, current),
+		types.NewClaimSnapshotEntry(types.SnapshotStoreLastEpoch, previous),
+	}, snapshot.Claims)
+}
+
 func (suite *KeeperTestSuite) TestIterateUserClaims() {
 	k := suite.GetQuicksilverApp(suite.chainA).ClaimsManagerKeeper
 
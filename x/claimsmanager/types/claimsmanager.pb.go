@@ -37,6 +37,8 @@ const (
 	ClaimTypeSifchainPool  ClaimType = 4 // Deprecated: Do not use.
 	ClaimTypeUmeeToken     ClaimType = 5
 	ClaimTypeOsmosisCLPool ClaimType = 6
+	ClaimTypeCosmWasm      ClaimType = 7
+	ClaimTypeMoneyMarket   ClaimType = 8
 )
 
 var ClaimType_name = map[int32]string{
@@ -47,6 +49,8 @@ var ClaimType_name = map[int32]string{
 	4: "ClaimTypeSifchainPool",
 	5: "ClaimTypeUmeeToken",
 	6: "ClaimTypeOsmosisCLPool",
+	7: "ClaimTypeCosmWasm",
+	8: "ClaimTypeMoneyMarket",
 }
 
 var ClaimType_value = map[string]int32{
@@ -57,6 +61,8 @@ var ClaimType_value = map[string]int32{
 	"ClaimTypeSifchainPool":  4,
 	"ClaimTypeUmeeToken":     5,
 	"ClaimTypeOsmosisCLPool": 6,
+	"ClaimTypeCosmWasm":      7,
+	"ClaimTypeMoneyMarket":   8,
 }
 
 func (x ClaimType) String() string {
@@ -200,45 +206,46 @@ func init() {
 }
 
 var fileDescriptor_086999747d797382 = []byte{
-	// 602 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xc1, 0x4f, 0xd4, 0x4e,
-	0x18, 0xed, 0xec, 0x76, 0x0b, 0x3b, 0xf0, 0xfb, 0xb9, 0x4e, 0x80, 0x94, 0x45, 0xba, 0x84, 0x83,
-	0x12, 0x0d, 0xad, 0xe0, 0x49, 0x8c, 0x31, 0xb0, 0x27, 0x12, 0x0c, 0xa4, 0x60, 0x4c, 0xbc, 0x34,
-	0x43, 0x3b, 0x74, 0x27, 0xbb, 0x9d, 0x29, 0x33, 0x53, 0xe2, 0xfa, 0x17, 0x70, 0xf4, 0xe8, 0x91,
-	0xc4, 0x9b, 0x27, 0x0f, 0xfc, 0x0f, 0x72, 0x24, 0x9c, 0x8c, 0x07, 0x62, 0xe0, 0xe2, 0x9f, 0x61,
-	0x3a, 0x6d, 0xca, 0x2e, 0x07, 0x6f, 0xf3, 0xbd, 0xef, 0x7b, 0x7d, 0xf3, 0xde, 0xd7, 0x81, 0xcf,
-	0x8f, 0x33, 0x1a, 0xf6, 0x25, 0x1d, 0x9c, 0x10, 0xe1, 0x85, 0x03, 0x4c, 0x13, 0x99, 0x60, 0x86,
-	0x63, 0x22, 0xbc, 0x93, 0xb5, 0x71, 0xc0, 0x4d, 0x05, 0x57, 0x1c, 0x3d, 0x1a, 0x61, 0xb8, 0xe3,
-	0x03, 0x27, 0x6b, 0xed, 0xf9, 0x90, 0xcb, 0x84, 0xcb, 0x40, 0xcf, 0x7a, 0x45, 0x51, 0x10, 0xdb,
-	0x33, 0x31, 0x8f, 0x79, 0x81, 0xe7, 0xa7, 0x12, 0x5d, 0x54, 0x84, 0x45, 0x44, 0x24, 0x94, 0x29,
-	0x2f, 0x14, 0xc3, 0x54, 0x71, 0x2f, 0x15, 0x9c, 0x1f, 0x15, 0xed, 0x65, 0x04, 0xad, 0x3d, 0x2c,
-	0x70, 0x22, 0x37, 0x26, 0x4f, 0xcf, 0x3a, 0xc6, 0x97, 0xb3, 0x8e, 0xb1, 0xfc, 0xbd, 0x06, 0x1b,
-	0xdd, 0x5c, 0x18, 0xbd, 0x82, 0xd3, 0x99, 0x24, 0x22, 0xc0, 0x51, 0x24, 0x88, 0x94, 0x36, 0x58,
-	0x02, 0x2b, 0xcd, 0x2d, 0xfb, 0xea, 0x7c, 0x75, 0xa6, 0x94, 0xde, 0x2c, 0x3a, 0xfb, 0x4a, 0x50,
-	0x16, 0xfb, 0x53, 0xf9, 0x74, 0x09, 0xa1, 0x79, 0x38, 0x19, 0xf6, 0x30, 0x65, 0x01, 0x8d, 0xec,
-	0x5a, 0x4e, 0xf4, 0x27, 0x74, 0xbd, 0x1d, 0xa1, 0x37, 0xd0, 0x4a, 0x78, 0x94, 0x0d, 0x88, 0x5d,
-	0x5f, 0x02, 0x2b, 0xff, 0xaf, 0x3f, 0x71, 0xff, 0x65, 0xda, 0xd5, 0x97, 0x39, 0x18, 0xa6, 0xc4,
-	0x2f, 0x69, 0xe8, 0x31, 0x7c, 0x20, 0x79, 0x26, 0x42, 0x12, 0x54, 0x12, 0xa6, 0x96, 0xf8, 0xaf,
-	0x80, 0xbb, 0xa5, 0xd0, 0x02, 0x9c, 0x08, 0x70, 0xc2, 0x33, 0xa6, 0xec, 0xc6, 0x12, 0x58, 0x31,
-	0xb7, 0x6a, 0x36, 0xf0, 0xad, 0x4d, 0x8d, 0xa0, 0x2e, 0xb4, 0xca, 0x9e, 0xa5, 0x7d, 0x3d, 0xbb,
-	0xb8, 0xee, 0x18, 0xbf, 0xae, 0x3b, 0xb3, 0x85, 0x37, 0x19, 0xf5, 0x5d, 0xca, 0xbd, 0x04, 0xab,
-	0x9e, 0xbb, 0xcd, 0xd4, 0xd5, 0xf9, 0x2a, 0x2c, 0x4d, 0x6f, 0x33, 0xe5, 0x97, 0xd4, 0x0d, 0x33,
-	0x8f, 0x6d, 0xf9, 0x1b, 0x80, 0x8d, 0xbd, 0x3c, 0x56, 0xd4, 0x82, 0xf5, 0x3e, 0x19, 0xea, 0xa4,
-	0xa6, 0xfd, 0xfc, 0x88, 0x10, 0x34, 0x23, 0xac, 0xb0, 0xce, 0x60, 0xda, 0xd7, 0x67, 0xf4, 0x12,
-	0x36, 0xf5, 0x16, 0x02, 0x9e, 0x4a, 0x9d, 0xc1, 0xd4, 0xfa, 0x82, 0x7b, 0xb7, 0x29, 0xb7, 0xd8,
-	0x94, 0xab, 0x3f, 0xb9, 0x9b, 0x4a, 0xff, 0x6e, 0x1a, 0xcd, 0x41, 0xab, 0x47, 0x68, 0xdc, 0x53,
-	0xda, 0x71, 0xdd, 0x2f, 0x2b, 0xe4, 0x40, 0x58, 0x0c, 0xa9, 0x61, 0x4a, 0xb4, 0xdb, 0xa6, 0x3f,
-	0x82, 0x14, 0xfb, 0xfd, 0x73, 0xd6, 0x31, 0x9e, 0xfe, 0x00, 0xb0, 0x59, 0x45, 0x8a, 0xe6, 0x20,
-	0xaa, 0x8a, 0x77, 0x2c, 0x22, 0x47, 0x94, 0x91, 0xa8, 0x65, 0x20, 0x1b, 0xce, 0x54, 0xf8, 0x0e,
-	0x3d, 0xce, 0x68, 0x74, 0xc0, 0xfb, 0x84, 0xb5, 0xc0, 0x58, 0x67, 0x37, 0x0f, 0x84, 0xca, 0x3d,
-	0xce, 0x07, 0xad, 0x1a, 0x9a, 0x85, 0x0f, 0xab, 0xce, 0x5b, 0x92, 0x1c, 0x0a, 0xcc, 0x48, 0xab,
-	0x8e, 0x16, 0xe1, 0x6c, 0x05, 0xef, 0xd3, 0x23, 0xbd, 0x32, 0xcd, 0x30, 0xdb, 0xb5, 0x49, 0x30,
-	0x7e, 0x83, 0x84, 0x90, 0x42, 0xa7, 0x81, 0xda, 0x70, 0xee, 0xbe, 0x4e, 0x77, 0x47, 0xf3, 0xac,
-	0xb6, 0x79, 0xfa, 0xd5, 0x31, 0xb6, 0xde, 0x5f, 0xdc, 0x38, 0xe0, 0xf2, 0xc6, 0x01, 0xbf, 0x6f,
-	0x1c, 0xf0, 0xf9, 0xd6, 0x31, 0x2e, 0x6f, 0x1d, 0xe3, 0xe7, 0xad, 0x63, 0x7c, 0x78, 0x1d, 0x53,
-	0xd5, 0xcb, 0x0e, 0xdd, 0x90, 0x27, 0xde, 0xc8, 0xbf, 0xb5, 0xfa, 0x89, 0x33, 0x32, 0x0a, 0x78,
-	0x1f, 0xef, 0xbd, 0xca, 0x3c, 0x2b, 0x79, 0x68, 0xe9, 0xd7, 0xf1, 0xe2, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x82, 0x96, 0x83, 0xbf, 0x03, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x3f, 0x4f, 0xdc, 0x3e,
+	0x18, 0x8e, 0xef, 0x4f, 0xb8, 0x33, 0xfc, 0x7e, 0x4d, 0x2d, 0x40, 0xe1, 0x28, 0x39, 0xc4, 0xd0,
+	0xa2, 0x56, 0x24, 0x85, 0x4e, 0xa5, 0xaa, 0x2a, 0xb8, 0x09, 0x09, 0x04, 0x0a, 0x54, 0x48, 0x5d,
+	0x4e, 0x26, 0x31, 0x77, 0xd6, 0x9d, 0xed, 0x60, 0x3b, 0xa8, 0xd7, 0x4f, 0xc0, 0xd8, 0xb1, 0x23,
+	0x52, 0xb7, 0x4e, 0x1d, 0xf8, 0x10, 0x8c, 0x88, 0xa9, 0xea, 0x80, 0x2a, 0x58, 0xfa, 0x11, 0x3a,
+	0x56, 0x71, 0xa2, 0xe3, 0x8e, 0xa1, 0x9b, 0xdf, 0xe7, 0x7d, 0x9f, 0x3c, 0x7e, 0x9e, 0x37, 0x86,
+	0x2f, 0x4f, 0x52, 0x1a, 0xf5, 0x14, 0xed, 0x9f, 0x12, 0x19, 0x44, 0x7d, 0x4c, 0x99, 0x62, 0x98,
+	0xe3, 0x0e, 0x91, 0xc1, 0xe9, 0xea, 0x38, 0xe0, 0x27, 0x52, 0x68, 0x81, 0x9e, 0x8c, 0x30, 0xfc,
+	0xf1, 0x81, 0xd3, 0xd5, 0xc6, 0x5c, 0x24, 0x14, 0x13, 0xaa, 0x6d, 0x66, 0x83, 0xbc, 0xc8, 0x89,
+	0x8d, 0xe9, 0x8e, 0xe8, 0x88, 0x1c, 0xcf, 0x4e, 0x05, 0xba, 0xa0, 0x09, 0x8f, 0x89, 0x64, 0x94,
+	0xeb, 0x20, 0x92, 0x83, 0x44, 0x8b, 0x20, 0x91, 0x42, 0x1c, 0xe7, 0xed, 0x25, 0x04, 0xed, 0x3d,
+	0x2c, 0x31, 0x53, 0xeb, 0xb5, 0xb3, 0xf3, 0xa6, 0xf5, 0xe5, 0xbc, 0x69, 0x2d, 0x7d, 0x2f, 0xc1,
+	0x6a, 0x2b, 0x13, 0x46, 0x6f, 0xe0, 0x54, 0xaa, 0x88, 0x6c, 0xe3, 0x38, 0x96, 0x44, 0x29, 0x17,
+	0x2c, 0x82, 0xe5, 0xfa, 0xa6, 0x7b, 0x7d, 0xb1, 0x32, 0x5d, 0x48, 0x6f, 0xe4, 0x9d, 0x7d, 0x2d,
+	0x29, 0xef, 0x84, 0x93, 0xd9, 0x74, 0x01, 0xa1, 0x39, 0x58, 0x8b, 0xba, 0x98, 0xf2, 0x36, 0x8d,
+	0xdd, 0x52, 0x46, 0x0c, 0x27, 0x4c, 0xbd, 0x15, 0xa3, 0x77, 0xd0, 0x66, 0x22, 0x4e, 0xfb, 0xc4,
+	0x2d, 0x2f, 0x82, 0xe5, 0xff, 0xd7, 0x9e, 0xf9, 0xff, 0x32, 0xed, 0x9b, 0xcb, 0x1c, 0x0c, 0x12,
+	0x12, 0x16, 0x34, 0xf4, 0x14, 0x3e, 0x52, 0x22, 0x95, 0x11, 0x69, 0x0f, 0x25, 0x2a, 0x46, 0xe2,
+	0xbf, 0x1c, 0x6e, 0x15, 0x42, 0xf3, 0x70, 0xa2, 0x8d, 0x99, 0x48, 0xb9, 0x76, 0xab, 0x8b, 0x60,
+	0xb9, 0xb2, 0x59, 0x72, 0x41, 0x68, 0x6f, 0x18, 0x04, 0xb5, 0xa0, 0x5d, 0xf4, 0x6c, 0xe3, 0xeb,
+	0xc5, 0xe5, 0x4d, 0xd3, 0xfa, 0x79, 0xd3, 0x9c, 0xc9, 0xbd, 0xa9, 0xb8, 0xe7, 0x53, 0x11, 0x30,
+	0xac, 0xbb, 0xfe, 0x16, 0xd7, 0xd7, 0x17, 0x2b, 0xb0, 0x30, 0xbd, 0xc5, 0x75, 0x58, 0x50, 0xd7,
+	0x2b, 0x59, 0x6c, 0x4b, 0xdf, 0x00, 0xac, 0xee, 0x65, 0xb1, 0x22, 0x07, 0x96, 0x7b, 0x64, 0x60,
+	0x92, 0x9a, 0x0a, 0xb3, 0x23, 0x42, 0xb0, 0x12, 0x63, 0x8d, 0x4d, 0x06, 0x53, 0xa1, 0x39, 0xa3,
+	0xd7, 0xb0, 0x6e, 0xb6, 0xd0, 0x16, 0x89, 0x32, 0x19, 0x4c, 0xae, 0xcd, 0xfb, 0xf7, 0x9b, 0xf2,
+	0xf3, 0x4d, 0xf9, 0xe6, 0x93, 0xbb, 0x89, 0x0a, 0xef, 0xa7, 0xd1, 0x2c, 0xb4, 0xbb, 0x84, 0x76,
+	0xba, 0xda, 0x38, 0x2e, 0x87, 0x45, 0x85, 0x3c, 0x08, 0xf3, 0x21, 0x3d, 0x48, 0x88, 0x71, 0x5b,
+	0x0f, 0x47, 0x90, 0x7c, 0xbf, 0xbf, 0xcf, 0x9b, 0xd6, 0xf3, 0x3f, 0x00, 0xd6, 0x87, 0x91, 0xa2,
+	0x59, 0x88, 0x86, 0xc5, 0x7b, 0x1e, 0x93, 0x63, 0xca, 0x49, 0xec, 0x58, 0xc8, 0x85, 0xd3, 0x43,
+	0x7c, 0x9b, 0x9e, 0xa4, 0x34, 0x3e, 0x10, 0x3d, 0xc2, 0x1d, 0x30, 0xd6, 0xd9, 0xcd, 0x02, 0xa1,
+	0x6a, 0x4f, 0x88, 0xbe, 0x53, 0x42, 0x33, 0xf0, 0xf1, 0xb0, 0xb3, 0x43, 0xd8, 0x91, 0xc4, 0x9c,
+	0x38, 0x65, 0xb4, 0x00, 0x67, 0x86, 0xf0, 0x3e, 0x3d, 0x36, 0x2b, 0x33, 0x8c, 0x4a, 0xa3, 0x54,
+	0x03, 0xe3, 0x37, 0x60, 0x84, 0xe4, 0x3a, 0x55, 0xd4, 0x80, 0xb3, 0x0f, 0x75, 0x5a, 0xdb, 0x86,
+	0x67, 0x8f, 0x29, 0xb5, 0x84, 0x62, 0x87, 0x58, 0x31, 0x67, 0x62, 0xec, 0x6a, 0x3b, 0x82, 0x93,
+	0xc1, 0x0e, 0x96, 0x3d, 0xa2, 0x9d, 0x5a, 0xa3, 0x72, 0xf6, 0xd5, 0xb3, 0x36, 0x0f, 0x2f, 0x6f,
+	0x3d, 0x70, 0x75, 0xeb, 0x81, 0x5f, 0xb7, 0x1e, 0xf8, 0x7c, 0xe7, 0x59, 0x57, 0x77, 0x9e, 0xf5,
+	0xe3, 0xce, 0xb3, 0x3e, 0xbc, 0xed, 0x50, 0xdd, 0x4d, 0x8f, 0xfc, 0x48, 0xb0, 0x60, 0xe4, 0x67,
+	0x5c, 0xf9, 0x24, 0x38, 0x19, 0x05, 0x82, 0x8f, 0x0f, 0x9e, 0x71, 0x16, 0xae, 0x3a, 0xb2, 0xcd,
+	0x73, 0x7a, 0xf5, 0x77, 0x00, 0xfd, 0x5b, 0x2a, 0xbc, 0xf0, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+const (
+	SnapshotStoreCurrent   = "current"
+	SnapshotStoreLastEpoch = "last_epoch"
+)
+
+// ClaimsSnapshot is a point in time export of the claims held in state, being
+// the claims of the current epoch and those archived from the last epoch.
+type ClaimsSnapshot struct {
+	Height int64                `json:"height"`
+	Epoch  int64                `json:"epoch"`
+	Claims []ClaimSnapshotEntry `json:"claims"`
+}
+
+// ClaimSnapshotEntry is a claim in a ClaimsSnapshot, with the store it was read from.
+type ClaimSnapshotEntry struct {
+	Store         string `json:"store"`
+	ChainID       string `json:"chain_id"`
+	UserAddress   string `json:"user_address"`
+	ClaimType     string `json:"claim_type"`
+	SourceChainID string `json:"source_chain_id"`
+	Amount        string `json:"amount"`
+}
+
+// NewClaimSnapshotEntry returns the snapshot entry of a claim read from store.
+func NewClaimSnapshotEntry(store string, claim Claim) ClaimSnapshotEntry {
+	return ClaimSnapshotEntry{
+		Store:         store,
+		ChainID:       claim.ChainId,
+		UserAddress:   claim.UserAddress,
+		ClaimType:     claim.Module.String(),
+		SourceChainID: claim.SourceChainId,
+		Amount:        claim.Amount.String(),
+	}
+}
+
+// WriteJSON writes the snapshot to w as a JSON document.
+func (s ClaimsSnapshot) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
+
+// WriteCSV writes the snapshot to w as CSV, with a header row and one row per claim.
+func (s ClaimsSnapshot) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"height", "epoch", "store", "chain_id", "user_address", "claim_type", "source_chain_id", "amount"}); err != nil {
+		return err
+	}
+
+	height := strconv.FormatInt(s.Height, 10)
+	epoch := strconv.FormatInt(s.Epoch, 10)
+	for _, entry := range s.Claims {
+		if err := writer.Write([]string{height, epoch, entry.Store, entry.ChainID, entry.UserAddress, entry.ClaimType, entry.SourceChainID, entry.Amount}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+)
+
+func TestClaimsSnapshot_Write(t *testing.T) {
+	snapshot := types.ClaimsSnapshot{
+		Height: 1200,
+		Epoch:  7,
+		Claims: []types.ClaimSnapshotEntry{
+			types.NewClaimSnapshotEntry(types.SnapshotStoreCurrent, types.NewClaim("quick1user", "cosmoshub-4", types.ClaimTypeLiquidToken, "osmosis-1", math.NewInt(100))),
+			types.NewClaimSnapshotEntry(types.SnapshotStoreLastEpoch, types.NewClaim("quick1user", "cosmoshub-4", types.ClaimTypeOsmosisPool, "osmosis-1", math.NewInt(200))),
+		},
+	}
+
+	csvOut := bytes.Buffer{}
+	require.NoError(t, snapshot.WriteCSV(&csvOut))
+	require.Equal(t, `height,epoch,store,chain_id,user_address,claim_type,source_chain_id,amount
+1200,7,current,cosmoshub-4,quick1user,ClaimTypeLiquidToken,osmosis-1,100
+1200,7,last_epoch,cosmoshub-4,quick1user,ClaimTypeOsmosisPool,osmosis-1,200
+`, csvOut.String())
+
+	jsonOut := bytes.Buffer{}
+	require.NoError(t, snapshot.WriteJSON(&jsonOut))
+	decoded := types.ClaimsSnapshot{}
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &decoded))
+	require.Equal(t, snapshot, decoded)
+}
@@ -10,16 +10,24 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
 	// set registered zones info from genesis
 	for _, query := range genState.Queries {
 		// Initialize empty epoch values via Cosmos SDK
 		k.SetQuery(ctx, query)
 	}
+
+	for _, relayer := range genState.Relayers {
+		k.SetRelayer(ctx, relayer)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Queries: k.AllQueries(ctx),
+		Queries:  k.AllQueries(ctx),
+		Params:   k.GetParams(ctx),
+		Relayers: k.AllRelayers(ctx),
 	}
 }
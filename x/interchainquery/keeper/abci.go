@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/hex"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/math"
@@ -9,43 +10,53 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 )
 
-const DefaultTTL = 1000
+const (
+	// DefaultTTL is the number of blocks a non-periodic query waits for a response
+	// after emission, when no ttl was specified in the request.
+	DefaultTTL = 1000
+	// maxBackoffShift bounds the exponential backoff applied to the ttl.
+	maxBackoffShift = 16
+)
 
 // EndBlocker of interchainquery module.
 func (k Keeper) EndBlocker(ctx sdk.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	_ = k.Logger(ctx)
 	events := sdk.Events{}
+	maxRetries := k.GetParams(ctx).GetMaxRetriesOrDefault()
 	// emit events for periodic queries
 	k.IterateQueries(ctx, func(_ int64, queryInfo types.Query) (stop bool) {
-		// if !periodic, and emission was too long ago, delete
+		// if !periodic, and emission was too long ago, retry or delete.
 		if queryInfo.Period.IsNegative() && // not periodic
 			!queryInfo.LastEmission.IsNil() && // has been emitted
 			!queryInfo.LastEmission.IsZero() && // has been emitted
-			queryInfo.LastEmission.LT(math.NewInt(ctx.BlockHeight()-DefaultTTL)) { // TODO: add per query TTL
-			k.DeleteQuery(ctx, queryInfo.Id)
+			queryInfo.LastEmission.Add(math.NewIntFromUint64(QueryExpiryWindow(queryInfo))).LT(math.NewInt(ctx.BlockHeight())) {
+			if queryInfo.Retries >= maxRetries {
+				k.Logger(ctx).Info("Interchainquery expired", "id", queryInfo.Id, "callback", queryInfo.CallbackId, "retries", queryInfo.Retries)
+				k.DeleteQuery(ctx, queryInfo.Id)
+				events = append(events, timeoutEvent(queryInfo))
+				k.handleTimeout(ctx, queryInfo)
+				return false
+			}
+
+			queryInfo.Retries++
+			k.Logger(ctx).Info("Interchainquery re-emitted after ttl", "id", queryInfo.Id, "retries", queryInfo.Retries)
+			k.recordFailure(ctx, queryInfo)
+			k.recordEmission(ctx, queryInfo)
+			events = append(events, emissionEvent(queryInfo))
+			queryInfo.LastEmission = sdk.NewInt(ctx.BlockHeight())
+			k.SetQuery(ctx, queryInfo)
 			return false
 		}
 
 		if queryInfo.LastEmission.IsNil() || queryInfo.LastEmission.IsZero() || queryInfo.LastEmission.Add(queryInfo.Period).Equal(sdk.NewInt(ctx.BlockHeight())) {
 			k.Logger(ctx).Debug("Interchainquery event emitted", "id", queryInfo.Id)
-			event := sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-				sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
-				sdk.NewAttribute(types.AttributeKeyQueryID, queryInfo.Id),
-				sdk.NewAttribute(types.AttributeKeyChainID, queryInfo.ChainId),
-				sdk.NewAttribute(types.AttributeKeyConnectionID, queryInfo.ConnectionId),
-				sdk.NewAttribute(types.AttributeKeyType, queryInfo.QueryType),
-				// TODO: add height to request type
-				sdk.NewAttribute(types.AttributeKeyHeight, "0"),
-				sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(queryInfo.Request)),
-			)
-
-			events = append(events, event)
+			k.recordEmission(ctx, queryInfo)
+			events = append(events, emissionEvent(queryInfo))
 			queryInfo.LastEmission = sdk.NewInt(ctx.BlockHeight())
 			k.SetQuery(ctx, queryInfo)
 
@@ -57,3 +68,68 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 		ctx.EventManager().EmitEvents(events)
 	}
 }
+
+// QueryExpiryWindow returns the number of blocks after its last emission that a
+// query is considered expired. The ttl of the query (or DefaultTTL if unset) is
+// doubled for every retry already performed.
+func QueryExpiryWindow(query types.Query) uint64 {
+	ttl := query.Ttl
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	shift := query.Retries
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	return ttl << shift
+}
+
+// handleTimeout invokes the timeout callback of the module owning the expired
+// query. State changes made by the callback are discarded if it returns an error.
+func (k Keeper) handleTimeout(ctx sdk.Context, query types.Query) {
+	if query.CallbackId == "" {
+		return
+	}
+
+	for _, key := range utils.Keys(k.callbacks) {
+		module := k.callbacks[key]
+		if !module.Has(query.CallbackId) {
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		if err := module.CallTimeout(cacheCtx, query.CallbackId, query); err != nil {
+			k.Logger(ctx).Error("error in timeout callback", "error", err, "id", query.Id, "callback", query.CallbackId, "type", query.QueryType)
+			return
+		}
+		write()
+		// only a single callback is expected per request.
+		return
+	}
+}
+
+func emissionEvent(query types.Query) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQuery),
+		sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, query.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(query.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyExactHeight, strconv.FormatBool(query.ExactHeight)),
+		sdk.NewAttribute(types.AttributeKeyRequest, hex.EncodeToString(query.Request)),
+	)
+}
+
+func timeoutEvent(query types.Query) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueTimeout),
+		sdk.NewAttribute(types.AttributeKeyQueryID, query.Id),
+		sdk.NewAttribute(types.AttributeKeyChainID, query.ChainId),
+		sdk.NewAttribute(types.AttributeKeyType, query.QueryType),
+		sdk.NewAttribute(types.AttributeKeyRetries, strconv.FormatUint(query.Retries, 10)),
+	)
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+const (
+	timeoutCallbackID        = "testtimeout"
+	failingTimeoutCallbackID = "testtimeoutfail"
+)
+
+// timeoutCallbacks is a callback handler whose timeout callback records the
+// expired query in the store, then fails for failingTimeoutCallbackID.
+type timeoutCallbacks struct {
+	key storetypes.StoreKey
+}
+
+func timedOutKey(id string) []byte {
+	return append([]byte("testtimedout/"), id...)
+}
+
+func (c timeoutCallbacks) AddCallback(string, interface{}) icqtypes.QueryCallbacks { return c }
+
+func (c timeoutCallbacks) RegisterCallbacks() icqtypes.QueryCallbacks { return c }
+
+func (timeoutCallbacks) Call(sdk.Context, string, []byte, icqtypes.Query) error { return nil }
+
+func (timeoutCallbacks) Has(id string) bool {
+	return id == timeoutCallbackID || id == failingTimeoutCallbackID
+}
+
+func (c timeoutCallbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	ctx.KVStore(c.key).Set(timedOutKey(query.Id), []byte{1})
+	if id == failingTimeoutCallbackID {
+		return errors.New("timeout callback failed")
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestQueryExpiryWindow() {
+	tests := []struct {
+		name     string
+		ttl      uint64
+		retries  uint64
+		expected uint64
+	}{
+		{"default ttl", 0, 0, keeper.DefaultTTL},
+		{"default ttl, one retry", 0, 1, 2 * keeper.DefaultTTL},
+		{"custom ttl", 50, 0, 50},
+		{"custom ttl, two retries", 50, 2, 200},
+		{"backoff is bounded", 1, 64, 1 << 16},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.Equal(test.expected, keeper.QueryExpiryWindow(icqtypes.Query{Ttl: test.ttl, Retries: test.retries}))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEndBlockerExpiry() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	tests := []struct {
+		name           string
+		callbackID     string
+		lastEmission   int64 // blocks before current height
+		retries        uint64
+		expectFound    bool
+		expectRetries  uint64
+		expectEmitted  bool
+		expectTimedOut bool
+		maxRetries     uint64 // max retries param; zero leaves the default params
+	}{
+		{"within ttl", timeoutCallbackID, 5, 0, true, 0, false, false, 0},
+		{"ttl elapsed, re-emitted", timeoutCallbackID, 11, 0, true, 1, true, false, 0},
+		{"within backoff window", timeoutCallbackID, 15, 1, true, 1, false, false, 0},
+		{"backoff window elapsed, re-emitted", timeoutCallbackID, 21, 1, true, 2, true, false, 0},
+		{"retries exhausted, dropped", timeoutCallbackID, 1000, icqtypes.DefaultMaxRetries, false, 0, false, true, 0},
+		{"retries below raised max, re-emitted", timeoutCallbackID, 1000, icqtypes.DefaultMaxRetries, true, icqtypes.DefaultMaxRetries + 1, true, false, 5},
+		{"retries exhausted at lowered max, dropped", timeoutCallbackID, 1000, 1, false, 0, false, true, 1},
+		// state changes of a failing timeout callback are discarded.
+		{"retries exhausted, failing timeout callback", failingTimeoutCallbackID, 1000, icqtypes.DefaultMaxRetries, false, 0, false, false, 0},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			quicksilver := suite.GetSimApp(suite.chainA)
+			icqKeeper := quicksilver.InterchainQueryKeeper
+			key := quicksilver.GetKey(icqtypes.StoreKey)
+			suite.NoError(icqKeeper.SetCallbackHandler("test", timeoutCallbacks{key: key}))
+			ctx := suite.chainA.GetContext()
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10000)
+			if test.maxRetries != 0 {
+				params := icqtypes.DefaultParams()
+				params.MaxRetries = test.maxRetries
+				icqKeeper.SetParams(ctx, params)
+			}
+
+			query := icqKeeper.NewQuery(
+				"test",
+				suite.path.EndpointB.ConnectionID,
+				suite.chainB.ChainID,
+				"cosmos.staking.v1beta1.Query/Validators",
+				bz,
+				sdk.NewInt(-1),
+				test.callbackID,
+				10,
+			)
+			query.LastEmission = sdk.NewInt(ctx.BlockHeight() - test.lastEmission)
+			query.Retries = test.retries
+			icqKeeper.SetQuery(ctx, *query)
+
+			icqKeeper.EndBlocker(ctx)
+
+			suite.Equal(test.expectTimedOut, ctx.KVStore(key).Has(timedOutKey(query.Id)))
+
+			stored, found := icqKeeper.GetQuery(ctx, query.Id)
+			suite.Equal(test.expectFound, found)
+			if !found {
+				return
+			}
+			suite.Equal(test.expectRetries, stored.Retries)
+			if test.expectEmitted {
+				suite.Equal(sdk.NewInt(ctx.BlockHeight()), stored.LastEmission)
+			} else {
+				suite.Equal(query.LastEmission, stored.LastEmission)
+			}
+		})
+	}
+}
@@ -48,3 +48,151 @@ func (k Keeper) Queries(c context.Context, req *types.QueryRequestsRequest) (*ty
 		Pagination: pageRes,
 	}, nil
 }
+
+// Params returns the interchainquery module parameters.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// QueryHistory returns the retained DataPoints for a query, oldest first.
+func (k Keeper) QueryHistory(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "query id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var dataPoints []types.DataPoint
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixData, types.GetDataPointPrefix(req.Id)...))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var point types.DataPoint
+		if err := k.cdc.Unmarshal(value, &point); err != nil {
+			return err
+		}
+		dataPoints = append(dataPoints, point)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoryResponse{
+		DataPoints: dataPoints,
+		Pagination: pageRes,
+	}, nil
+}
+
+// QueryStats returns emission and response statistics for a query.
+func (k Keeper) QueryStats(c context.Context, req *types.QueryStatsRequest) (*types.QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stats, found := k.GetQueryStats(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no stats found for query %s", req.Id)
+	}
+
+	return &types.QueryStatsResponse{Stats: withLatency(stats)}, nil
+}
+
+// ChainQueryStats returns statistics for every query of a chain.
+func (k Keeper) ChainQueryStats(c context.Context, req *types.QueryChainQueryStatsRequest) (*types.QueryChainQueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var stats []types.QueryStatsWithLatency
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryStats)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var s types.QueryStats
+		if err := k.cdc.Unmarshal(value, &s); err != nil {
+			return false, err
+		}
+
+		if s.ChainId != req.ChainId {
+			return false, nil
+		}
+		if accumulate {
+			stats = append(stats, withLatency(s))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChainQueryStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
+// withLatency decorates query stats with the mean response latency in blocks.
+func withLatency(stats types.QueryStats) types.QueryStatsWithLatency {
+	latency := sdk.ZeroDec()
+	if stats.ResponseCount > 0 {
+		latency = sdk.NewDecFromInt(sdk.NewIntFromUint64(stats.TotalLatency)).QuoInt(sdk.NewIntFromUint64(stats.ResponseCount))
+	}
+	return types.QueryStatsWithLatency{Stats: stats, AverageLatency: latency}
+}
+
+// Relayers returns the response records of all relayers.
+func (k Keeper) Relayers(c context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var relayers []types.Relayer
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var relayer types.Relayer
+		if err := k.cdc.Unmarshal(value, &relayer); err != nil {
+			return err
+		}
+		relayers = append(relayers, relayer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayersResponse{
+		Relayers:   relayers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Relayer returns the response record of a relayer.
+func (k Keeper) Relayer(c context.Context, req *types.QueryRelayerRequest) (*types.QueryRelayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	relayer, found := k.GetRelayer(ctx, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no record found for relayer %s", req.Address)
+	}
+
+	return &types.QueryRelayerResponse{Relayer: relayer}, nil
+}
@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
 	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 )
 
@@ -37,3 +39,135 @@ func (suite *KeeperTestSuite) TestQueries() {
 	suite.Equal(sdk.NewInt(200), res.Queries[0].Period)
 	suite.Equal("", res.Queries[0].CallbackId)
 }
+
+func (suite *KeeperTestSuite) TestQueryHistoryAndStats() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	params := icqtypes.DefaultParams()
+	params.DataPointRetention = 2
+	icqKeeper.SetParams(ctx, params)
+
+	icqKeeper.MakeRequest(
+		ctx,
+		suite.path.EndpointB.ConnectionID,
+		suite.chainB.ChainID,
+		"cosmos.staking.v1beta1.Query/Validators",
+		bz,
+		sdk.NewInt(1),
+		"",
+		"",
+		0,
+	)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "", "")
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	respond := func(i int64) {
+		// emit, then respond two blocks later.
+		icqKeeper.EndBlocker(ctx)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+		_, err := icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     id,
+			Result:      []byte{byte(i)},
+			Height:      suite.chainB.CurrentHeader.Height + i,
+			FromAddress: TestOwnerAddress,
+		})
+		suite.NoError(err)
+		// realign with the query period for the next emission.
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() - 1)
+	}
+	for i := int64(1); i <= 3; i++ {
+		respond(i)
+	}
+
+	icqsrvSrv := icqtypes.QuerySrvrServer(icqKeeper)
+
+	// only the last two data points are retained.
+	history, err := icqsrvSrv.QueryHistory(sdk.WrapSDKContext(ctx), &icqtypes.QueryHistoryRequest{Id: id})
+	suite.NoError(err)
+	suite.Len(history.DataPoints, 2)
+	suite.Equal([]byte{2}, history.DataPoints[0].Value)
+	suite.Equal([]byte{3}, history.DataPoints[1].Value)
+	suite.Equal(sdk.NewInt(suite.chainB.CurrentHeader.Height+3), history.DataPoints[1].RemoteHeight)
+
+	stats, err := icqsrvSrv.QueryStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryStatsRequest{Id: id})
+	suite.NoError(err)
+	suite.Equal(uint64(3), stats.Stats.Stats.EmissionCount)
+	suite.Equal(uint64(3), stats.Stats.Stats.ResponseCount)
+	suite.Equal(uint64(0), stats.Stats.Stats.FailureCount)
+	suite.Equal(suite.chainB.CurrentHeader.Height+3, stats.Stats.Stats.LastResponseHeight)
+	suite.Equal(sdk.NewDec(2), stats.Stats.AverageLatency)
+
+	chainStats, err := icqsrvSrv.ChainQueryStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryChainQueryStatsRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Len(chainStats.Stats, 1)
+	suite.Equal(id, chainStats.Stats[0].Stats.Id)
+
+	_, err = icqsrvSrv.QueryStats(sdk.WrapSDKContext(ctx), &icqtypes.QueryStatsRequest{Id: "unknown"})
+	suite.Error(err)
+
+	// lowering the retention prunes the excess data points on the next response.
+	params.DataPointRetention = 1
+	icqKeeper.SetParams(ctx, params)
+	respond(4)
+	history, err = icqsrvSrv.QueryHistory(sdk.WrapSDKContext(ctx), &icqtypes.QueryHistoryRequest{Id: id})
+	suite.NoError(err)
+	suite.Len(history.DataPoints, 1)
+	suite.Equal([]byte{4}, history.DataPoints[0].Value)
+
+	// history outlives the query, until it is pruned at the end of the epoch.
+	icqKeeper.DeleteQuery(ctx, id)
+	history, err = icqsrvSrv.QueryHistory(sdk.WrapSDKContext(ctx), &icqtypes.QueryHistoryRequest{Id: id})
+	suite.NoError(err)
+	suite.Len(history.DataPoints, 1)
+	_, found := icqKeeper.GetQueryStats(ctx, id)
+	suite.True(found)
+
+	suite.NoError(icqKeeper.AfterEpochEnd(ctx, epochstypes.EpochIdentifierEpoch, 1))
+	history, err = icqsrvSrv.QueryHistory(sdk.WrapSDKContext(ctx), &icqtypes.QueryHistoryRequest{Id: id})
+	suite.NoError(err)
+	suite.Empty(history.DataPoints)
+	_, found = icqKeeper.GetQueryStats(ctx, id)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestPruneQueryHistory() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", []byte{0x01}, sdk.NewInt(1), "", "", 0)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", []byte{0x01}, "", "")
+
+	icqKeeper.SetQueryStats(ctx, icqtypes.QueryStats{Id: id, ChainId: suite.chainB.ChainID, ResponseCount: 5})
+	for sequence := uint64(1); sequence <= 5; sequence++ {
+		icqKeeper.SetDataPoint(ctx, sequence, icqtypes.DataPoint{Id: id, RemoteHeight: sdk.NewInt(int64(sequence)), LocalHeight: sdk.NewInt(1), Value: []byte{byte(sequence)}})
+	}
+
+	values := func() []byte {
+		values := []byte{}
+		icqKeeper.IterateDataPoints(ctx, id, func(_ int64, point icqtypes.DataPoint) bool {
+			values = append(values, point.Value...)
+			return false
+		})
+		return values
+	}
+
+	// a lowered retention prunes existing data points without waiting for a response.
+	params := icqtypes.DefaultParams()
+	params.DataPointRetention = 2
+	icqKeeper.SetParams(ctx, params)
+	icqKeeper.PruneQueryHistory(ctx)
+	suite.Equal([]byte{4, 5}, values())
+
+	// disabling retention prunes them all, retaining the statistics of pending queries.
+	params.DataPointRetention = 0
+	icqKeeper.SetParams(ctx, params)
+	icqKeeper.PruneQueryHistory(ctx)
+	suite.Empty(values())
+	_, found := icqKeeper.GetQueryStats(ctx, id)
+	suite.True(found)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// ----------------------------------------------------------------
+// Query statistics
+
+// GetQueryStats returns the statistics recorded for the given query id.
+func (k Keeper) GetQueryStats(ctx sdk.Context, id string) (types.QueryStats, bool) {
+	stats := types.QueryStats{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryStats)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return stats, false
+	}
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetQueryStats sets the statistics for a query.
+func (k Keeper) SetQueryStats(ctx sdk.Context, stats types.QueryStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryStats)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set([]byte(stats.Id), bz)
+}
+
+// DeleteQueryStats deletes the statistics for a query.
+func (k Keeper) DeleteQueryStats(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryStats)
+	store.Delete([]byte(id))
+}
+
+// IterateQueryStats iterates through all recorded query statistics.
+func (k Keeper) IterateQueryStats(ctx sdk.Context, fn func(index int64, stats types.QueryStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQueryStats)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		stats := types.QueryStats{}
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if stop := fn(i, stats); stop {
+			break
+		}
+		i++
+	}
+}
+
+// queryStats returns the statistics for the given query, initialising a new
+// record if none exists.
+func (k Keeper) queryStats(ctx sdk.Context, query types.Query) types.QueryStats {
+	stats, found := k.GetQueryStats(ctx, query.Id)
+	if !found {
+		stats = types.QueryStats{
+			Id:         query.Id,
+			ChainId:    query.ChainId,
+			QueryType:  query.QueryType,
+			CallbackId: query.CallbackId,
+		}
+	}
+	return stats
+}
+
+// recordEmission increments the emission count of a query.
+func (k Keeper) recordEmission(ctx sdk.Context, query types.Query) {
+	stats := k.queryStats(ctx, query)
+	stats.EmissionCount++
+	k.SetQueryStats(ctx, stats)
+}
+
+// recordFailure increments the failure count of a query; failures are expired
+// emissions and rejected responses.
+func (k Keeper) recordFailure(ctx sdk.Context, query types.Query) {
+	stats := k.queryStats(ctx, query)
+	stats.FailureCount++
+	k.SetQueryStats(ctx, stats)
+}
+
+// recordResponse updates the statistics of a query upon an accepted response,
+// and stores a DataPoint for the response if data point retention is enabled.
+func (k Keeper) recordResponse(ctx sdk.Context, query types.Query, remoteHeight int64, result []byte) {
+	stats := k.queryStats(ctx, query)
+	stats.ResponseCount++
+	stats.LastResponseHeight = remoteHeight
+	stats.LastResponseLocalHeight = ctx.BlockHeight()
+	if !query.LastEmission.IsNil() && query.LastEmission.IsPositive() && ctx.BlockHeight() > query.LastEmission.Int64() {
+		stats.TotalLatency += uint64(ctx.BlockHeight() - query.LastEmission.Int64())
+	}
+	k.SetQueryStats(ctx, stats)
+
+	retention := k.GetParams(ctx).DataPointRetention
+	if retention > 0 {
+		k.SetDataPoint(ctx, stats.ResponseCount, types.DataPoint{
+			Id:           query.Id,
+			RemoteHeight: sdk.NewInt(remoteHeight),
+			LocalHeight:  sdk.NewInt(ctx.BlockHeight()),
+			Value:        result,
+		})
+	}
+	// prune the data points that fell out of the retention window, which may be
+	// several if the retention has been lowered since the last response.
+	k.pruneDataPoints(ctx, query.Id, stats.ResponseCount, retention)
+}
+
+// ----------------------------------------------------------------
+// Data points
+
+// SetDataPoint stores a DataPoint for a query, indexed by sequence.
+func (k Keeper) SetDataPoint(ctx sdk.Context, sequence uint64, point types.DataPoint) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	bz := k.cdc.MustMarshal(&point)
+	store.Set(types.GetDataPointKey(point.Id, sequence), bz)
+}
+
+// DeleteDataPoint deletes the DataPoint for a query at the given sequence.
+func (k Keeper) DeleteDataPoint(ctx sdk.Context, id string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixData)
+	store.Delete(types.GetDataPointKey(id, sequence))
+}
+
+// IterateDataPoints iterates through the DataPoints of a query, oldest first.
+func (k Keeper) IterateDataPoints(ctx sdk.Context, id string, fn func(index int64, point types.DataPoint) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixData, types.GetDataPointPrefix(id)...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		point := types.DataPoint{}
+		k.cdc.MustUnmarshal(iterator.Value(), &point)
+		if stop := fn(i, point); stop {
+			break
+		}
+		i++
+	}
+}
+
+// pruneDataPoints deletes the DataPoints of a query outside the retention
+// window ending at sequence latest; all of them if retention is zero.
+func (k Keeper) pruneDataPoints(ctx sdk.Context, id string, latest uint64, retention uint64) {
+	if latest <= retention {
+		return
+	}
+	cutoff := latest - retention
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixData, types.GetDataPointPrefix(id)...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	// keys are ordered by sequence, so iteration stops at the first retained point.
+	keys := [][]byte{}
+	for ; iterator.Valid() && sdk.BigEndianToUint64(iterator.Key()) <= cutoff; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// deleteQueryHistory removes the statistics and DataPoints of a query.
+func (k Keeper) deleteQueryHistory(ctx sdk.Context, stats types.QueryStats) {
+	k.pruneDataPoints(ctx, stats.Id, stats.ResponseCount, 0)
+	k.DeleteQueryStats(ctx, stats.Id)
+}
+
+// PruneQueryHistory applies the DataPointRetention param to the history of
+// every query, such that lowering it prunes existing DataPoints, and removes
+// the history of queries that have since been deleted.
+func (k Keeper) PruneQueryHistory(ctx sdk.Context) {
+	retention := k.GetParams(ctx).DataPointRetention
+
+	stale := []types.QueryStats{}
+	k.IterateQueryStats(ctx, func(_ int64, stats types.QueryStats) bool {
+		if _, found := k.GetQuery(ctx, stats.Id); !found {
+			stale = append(stale, stats)
+			return false
+		}
+		k.pruneDataPoints(ctx, stats.Id, stats.ResponseCount, retention)
+		return false
+	})
+	for _, stats := range stale {
+		k.deleteQueryHistory(ctx, stats)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -19,26 +20,56 @@ import (
 
 // Keeper of this module maintains collections of registered zones.
 type Keeper struct {
-	cdc       codec.Codec
-	storeKey  storetypes.StoreKey
-	callbacks map[string]types.QueryCallbacks
-	IBCKeeper *ibckeeper.Keeper
+	cdc           codec.Codec
+	storeKey      storetypes.StoreKey
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	callbacks     map[string]types.QueryCallbacks
+	IBCKeeper     *ibckeeper.Keeper
 }
 
 // NewKeeper returns a new instance of zones Keeper.
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, ibcKeeper *ibckeeper.Keeper) Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	ibcKeeper *ibckeeper.Keeper,
+) Keeper {
 	if ibcKeeper == nil {
 		panic("ibcKeeper is nil")
 	}
 
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		callbacks: make(map[string]types.QueryCallbacks),
-		IBCKeeper: ibcKeeper,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSpace:    ps,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		callbacks:     make(map[string]types.QueryCallbacks),
+		IBCKeeper:     ibcKeeper,
 	}
 }
 
+// GetParams returns the total set of interchainquery parameters. Parameters
+// absent from the store, e.g. prior to an upgrade, take their zero value.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of interchainquery parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
 func (k *Keeper) SetCallbackHandler(module string, handler types.QueryCallbacks) error {
 	_, found := k.callbacks[module]
 	if found {
@@ -63,6 +94,25 @@ func (k *Keeper) MakeRequest(
 	module string,
 	callbackID string,
 	ttl uint64,
+) {
+	k.MakeRequestAtHeight(ctx, connectionID, chainID, queryType, request, period, module, callbackID, ttl, 0, false)
+}
+
+// MakeRequestAtHeight registers a query to be answered at a given remote height. If exact is
+// true the response must be for exactly height, otherwise any response at or after height is
+// accepted. A height of zero requests the latest height.
+func (k *Keeper) MakeRequestAtHeight(
+	ctx sdk.Context,
+	connectionID,
+	chainID,
+	queryType string,
+	request []byte,
+	period math.Int,
+	module string,
+	callbackID string,
+	ttl uint64,
+	height int64,
+	exact bool,
 ) {
 	k.Logger(ctx).Debug(
 		"MakeRequest",
@@ -74,6 +124,8 @@ func (k *Keeper) MakeRequest(
 		"module", module,
 		"callback", callbackID,
 		"ttl", ttl,
+		"height", height,
+		"exact_height", exact,
 	)
 	key := GenerateQueryHash(connectionID, chainID, queryType, request, module, callbackID)
 	existingQuery, found := k.GetQuery(ctx, key)
@@ -91,11 +143,18 @@ func (k *Keeper) MakeRequest(
 			}
 		}
 		newQuery := k.NewQuery(module, connectionID, chainID, queryType, request, period, callbackID, ttl)
+		newQuery.Height = height
+		newQuery.ExactHeight = exact
 		k.SetQuery(ctx, *newQuery)
 	} else {
 		// a re-request of an existing query triggers resetting of height to trigger immediately.
+		// the retry count is reset, and the ttl of the new request applies.
 		k.Logger(ctx).Debug("re-request", "LastHeight", existingQuery.LastHeight)
 		existingQuery.LastHeight = sdk.ZeroInt()
+		existingQuery.Retries = 0
+		existingQuery.Ttl = ttl
+		existingQuery.Height = height
+		existingQuery.ExactHeight = exact
 		k.SetQuery(ctx, existingQuery)
 	}
 }
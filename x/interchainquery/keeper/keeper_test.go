@@ -220,6 +220,62 @@ func (suite *KeeperTestSuite) TestSubmitQueryResponse() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitQueryResponseAtHeight() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	qvr := stakingtypes.QueryValidatorsResponse{
+		Validators: suite.GetSimApp(suite.chainB).StakingKeeper.GetBondedValidatorsByPower(suite.chainB.GetContext()),
+	}
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	pinned := suite.chainB.CurrentHeader.Height - 1
+
+	icqKeeper.MakeRequestAtHeight(
+		ctx,
+		suite.path.EndpointB.ConnectionID,
+		suite.chainB.ChainID,
+		"cosmos.staking.v1beta1.Query/Validators",
+		bz,
+		sdk.NewInt(-1),
+		"",
+		"",
+		0,
+		pinned,
+		true,
+	)
+
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "", "")
+	query, found := icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+	suite.Equal(pinned, query.Height)
+	suite.True(query.ExactHeight)
+
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	qmsg := icqtypes.MsgSubmitQueryResponse{
+		ChainId:     suite.chainB.ChainID,
+		QueryId:     id,
+		Result:      suite.GetSimApp(suite.chainB).AppCodec().MustMarshalJSON(&qvr),
+		Height:      suite.chainB.CurrentHeader.Height,
+		FromAddress: TestOwnerAddress,
+	}
+
+	// response at the wrong height is ignored, and the query remains.
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.NoError(err)
+	_, found = icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+
+	// response at the pinned height is accepted, and the query is removed.
+	qmsg.Height = pinned
+	_, err = icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &qmsg)
+	suite.NoError(err)
+	_, found = icqKeeper.GetQuery(ctx, id)
+	suite.False(found)
+}
+
 func newSimAppPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
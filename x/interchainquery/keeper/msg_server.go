@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 
+	sdkioerrors "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/utils"
@@ -30,6 +32,8 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	if !found {
 		k.Logger(ctx).Debug("query not found", "QueryID", msg.QueryId)
+		// the query has most likely been satisfied by another relayer.
+		k.recordDuplicate(ctx, msg.FromAddress)
 
 		return &types.MsgSubmitQueryResponseResponse{}, nil
 	}
@@ -39,8 +43,17 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		k.Logger(ctx).Error("negative height in message", "msgHeight", msg.Height)
 		return &types.MsgSubmitQueryResponseResponse{}, fmt.Errorf("negative height: %d", msg.Height)
 	}
-	if latest > uint64(msg.Height) && q.QueryType != "tendermint.Tx" && q.QueryType != "ibc.ClientUpdate" {
+	if !q.AcceptsHeight(msg.Height) {
+		k.Logger(ctx).Error("ignoring query result at unexpected height", "id", q.Id, "type", q.QueryType, "queryHeight", q.Height, "exact", q.ExactHeight, "msgHeight", msg.Height)
+		k.recordFailure(ctx, q)
+		k.recordRejected(ctx, msg.FromAddress)
+		return &types.MsgSubmitQueryResponseResponse{}, nil
+	}
+	// height-pinned queries may legitimately be answered below the latest known height.
+	if !q.ExactHeight && latest > uint64(msg.Height) && q.QueryType != "tendermint.Tx" && q.QueryType != "ibc.ClientUpdate" {
 		k.Logger(ctx).Error("ignoring stale query result", "id", q.Id, "type", q.QueryType, "latestHeight", latest, "msgHeight", msg.Height)
+		k.recordFailure(ctx, q)
+		k.recordRejected(ctx, msg.FromAddress)
 		// technically this is an error, but will cause the entire tx to fail
 		// if we have one 'bad' message, so we can just no-op here.
 		return &types.MsgSubmitQueryResponseResponse{}, nil
@@ -50,6 +63,7 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	// - indicated by query.LastHeight matching current Block Height;
 	if q.LastHeight.Int64() == ctx.BlockHeader().Height {
 		k.Logger(ctx).Debug("ignoring duplicate query", "id", q.Id, "type", q.QueryType)
+		k.recordDuplicate(ctx, msg.FromAddress)
 		// technically this is an error, but will cause the entire tx to fail
 		// if we have one 'bad' message, so we can just no-op here.
 		return &types.MsgSubmitQueryResponseResponse{}, nil
@@ -58,8 +72,12 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 	pathParts := strings.Split(q.QueryType, "/")
 	if pathParts[len(pathParts)-1] == "key" {
 		if err := utils.ValidateProofOps(ctx, k.IBCKeeper, q.ConnectionId, q.ChainId, msg.Height, pathParts[1], q.Request, msg.Result, msg.ProofOps); err != nil {
-			k.Logger(ctx).Error("failed to validate proofops", "id", q.Id, "type", q.QueryType)
-			return nil, err
+			err = sdkioerrors.Wrapf(types.ErrInvalidProof, "query %s: %v", q.Id, err)
+			k.Logger(ctx).Error("ignoring query result with invalid proof", "id", q.Id, "type", q.QueryType, "relayer", msg.FromAddress, "error", err)
+			k.recordRejected(ctx, msg.FromAddress)
+			// as for responses at an unexpected height, no-op rather than failing the
+			// tx, so that the rejection is recorded against the relayer.
+			return &types.MsgSubmitQueryResponseResponse{}, nil
 		}
 	}
 
@@ -68,10 +86,14 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 
 	callbackExecuted := false
 
+	// callbacks receive the remote height of the response being processed.
+	callbackQuery := q
+	callbackQuery.Height = msg.Height
+
 	for _, key := range utils.Keys(k.callbacks) {
 		module := k.callbacks[key]
 		if module.Has(q.CallbackId) {
-			err := module.Call(ctx, q.CallbackId, msg.Result, q)
+			err := module.Call(ctx, q.CallbackId, msg.Result, callbackQuery)
 			callbackExecuted = true
 			if err != nil {
 				// not edge case: proceed with regular error handling!
@@ -88,7 +110,7 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		}
 	}
 
-	if pathParts[len(pathParts)-1] == "key" {
+	if pathParts[len(pathParts)-1] == "key" && uint64(msg.Height) > latest {
 		k.SetLatestHeight(ctx, msg.ChainId, uint64(msg.Height))
 	}
 
@@ -97,6 +119,9 @@ func (k msgServer) SubmitQueryResponse(goCtx context.Context, msg *types.MsgSubm
 		return nil, fmt.Errorf("expected callback %s, but did not find it", q.CallbackId)
 	}
 
+	k.recordResponse(ctx, q, msg.Height, msg.Result)
+	k.recordAccepted(ctx, msg.FromAddress)
+
 	// check for and delete non-repeating queries, update any other
 	// - Period.IsNegative() indicates a single query;
 	// - noDelete indicates a response that triggered a re-query;
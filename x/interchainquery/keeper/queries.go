@@ -61,7 +61,8 @@ func (k Keeper) SetQuery(ctx sdk.Context, query types.Query) {
 	store.Set([]byte(query.Id), bz)
 }
 
-// DeleteQuery delete query info.
+// DeleteQuery delete query info. The history of the query is retained until
+// it is pruned at the end of the epoch.
 func (k Keeper) DeleteQuery(ctx sdk.Context, id string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixQuery)
 	store.Delete([]byte(id))
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+// GetRelayer returns the response record of the given relayer.
+func (k Keeper) GetRelayer(ctx sdk.Context, address sdk.AccAddress) (types.Relayer, bool) {
+	relayer := types.Relayer{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	bz := store.Get(address)
+	if len(bz) == 0 {
+		return relayer, false
+	}
+	k.cdc.MustUnmarshal(bz, &relayer)
+	return relayer, true
+}
+
+// SetRelayer sets the response record of a relayer.
+func (k Keeper) SetRelayer(ctx sdk.Context, relayer types.Relayer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	bz := k.cdc.MustMarshal(&relayer)
+	store.Set(sdk.MustAccAddressFromBech32(relayer.Address), bz)
+}
+
+// IterateRelayers iterates through all relayer records.
+func (k Keeper) IterateRelayers(ctx sdk.Context, fn func(index int64, relayer types.Relayer) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		relayer := types.Relayer{}
+		k.cdc.MustUnmarshal(iterator.Value(), &relayer)
+		if stop := fn(i, relayer); stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllRelayers returns all relayer records.
+func (k Keeper) AllRelayers(ctx sdk.Context) []types.Relayer {
+	relayers := []types.Relayer{}
+	k.IterateRelayers(ctx, func(_ int64, relayer types.Relayer) (stop bool) {
+		relayers = append(relayers, relayer)
+		return false
+	})
+	return relayers
+}
+
+// DeleteRelayer deletes the response record of a relayer.
+func (k Keeper) DeleteRelayer(ctx sdk.Context, address sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRelayer)
+	store.Delete(address)
+}
+
+// updateRelayer applies fn to the record of the given relayer. A record is only
+// created if create is true, such that records are not persisted for any
+// signer of a response, only for relayers that have had a response accepted.
+// Invalid addresses are ignored.
+func (k Keeper) updateRelayer(ctx sdk.Context, address string, create bool, fn func(relayer *types.Relayer)) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		k.Logger(ctx).Error("unable to record relayer response", "relayer", address, "error", err)
+		return
+	}
+	relayer, found := k.GetRelayer(ctx, addr)
+	if !found {
+		if !create {
+			return
+		}
+		relayer = types.Relayer{Address: addr.String()}
+	}
+	fn(&relayer)
+	k.SetRelayer(ctx, relayer)
+}
+
+// recordAccepted records a response that satisfied a pending query.
+func (k Keeper) recordAccepted(ctx sdk.Context, address string) {
+	k.updateRelayer(ctx, address, true, func(relayer *types.Relayer) {
+		relayer.Accepted++
+		relayer.EpochAccepted++
+	})
+}
+
+// recordDuplicate records a response for a query that was already satisfied.
+func (k Keeper) recordDuplicate(ctx sdk.Context, address string) {
+	k.updateRelayer(ctx, address, false, func(relayer *types.Relayer) {
+		relayer.Duplicates++
+	})
+}
+
+// recordRejected records a response rejected for an invalid proof, or a stale or
+// unexpected height.
+func (k Keeper) recordRejected(ctx sdk.Context, address string) {
+	k.updateRelayer(ctx, address, false, func(relayer *types.Relayer) {
+		relayer.Rejected++
+	})
+}
+
+// DistributeRelayerRewards pays up to RewardsPerEpoch from the reward pool
+// account to relayers, in proportion to the responses each relayer had
+// accepted during the epoch, and resets the per-epoch counters. Records of
+// relayers that have never had a response accepted are pruned.
+func (k Keeper) DistributeRelayerRewards(ctx sdk.Context) {
+	relayers := []types.Relayer{}
+	for _, relayer := range k.AllRelayers(ctx) {
+		if relayer.Accepted == 0 {
+			k.DeleteRelayer(ctx, sdk.MustAccAddressFromBech32(relayer.Address))
+			continue
+		}
+		relayers = append(relayers, relayer)
+	}
+
+	total := math.ZeroInt()
+	for _, relayer := range relayers {
+		total = total.Add(math.NewIntFromUint64(relayer.EpochAccepted))
+	}
+
+	rewards := k.relayerRewards(ctx)
+
+	for _, relayer := range relayers {
+		if relayer.EpochAccepted == 0 {
+			continue
+		}
+
+		if !rewards.IsZero() {
+			amount := sdk.Coins{}
+			for _, coin := range rewards {
+				share := coin.Amount.Mul(math.NewIntFromUint64(relayer.EpochAccepted)).Quo(total)
+				amount = amount.Add(sdk.NewCoin(coin.Denom, share))
+			}
+
+			if !amount.IsZero() {
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.GetParams(ctx).RewardPoolAccount, sdk.MustAccAddressFromBech32(relayer.Address), amount); err != nil {
+					k.Logger(ctx).Error("unable to pay relayer reward", "relayer", relayer.Address, "amount", amount, "error", err)
+				} else {
+					relayer.Rewarded = relayer.Rewarded.Add(amount...)
+					ctx.EventManager().EmitEvent(
+						sdk.NewEvent(
+							types.EventTypeRelayerReward,
+							sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+							sdk.NewAttribute(types.AttributeKeyRelayer, relayer.Address),
+							sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+						),
+					)
+				}
+			}
+		}
+
+		relayer.EpochAccepted = 0
+		k.SetRelayer(ctx, relayer)
+	}
+}
+
+// relayerRewards returns the amount available for distribution this epoch; the
+// lesser of RewardsPerEpoch and the balance of the reward pool account.
+func (k Keeper) relayerRewards(ctx sdk.Context) sdk.Coins {
+	params := k.GetParams(ctx)
+	if params.RewardPoolAccount == "" || params.RewardsPerEpoch.IsZero() {
+		return sdk.Coins{}
+	}
+
+	pool := k.accountKeeper.GetModuleAddress(params.RewardPoolAccount)
+	if pool == nil {
+		k.Logger(ctx).Error("relayer reward pool account does not exist", "account", params.RewardPoolAccount)
+		return sdk.Coins{}
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, pool)
+	return balance.Min(params.RewardsPerEpoch)
+}
@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	supplytypes "github.com/quicksilver-zone/quicksilver/x/supply/types"
+)
+
+func (suite *KeeperTestSuite) TestRelayerRecords() {
+	bondedQuery := stakingtypes.QueryValidatorsRequest{Status: stakingtypes.BondStatusBonded}
+	bz, err := bondedQuery.Marshal()
+	suite.NoError(err)
+
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+	icqsrvSrv := icqtypes.QuerySrvrServer(icqKeeper)
+
+	first := addressutils.GenerateAccAddressForTest()
+	second := addressutils.GenerateAccAddressForTest()
+	pinned := suite.chainB.CurrentHeader.Height - 1
+
+	icqKeeper.MakeRequestAtHeight(
+		ctx,
+		suite.path.EndpointB.ConnectionID,
+		suite.chainB.ChainID,
+		"cosmos.staking.v1beta1.Query/Validators",
+		bz,
+		sdk.NewInt(-1),
+		"",
+		"",
+		0,
+		pinned,
+		true,
+	)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "", "")
+
+	other := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "cosmos.staking.v1beta1.Query/Validators", bz, "other", "")
+	icqKeeper.MakeRequestAtHeight(
+		ctx,
+		suite.path.EndpointB.ConnectionID,
+		suite.chainB.ChainID,
+		"cosmos.staking.v1beta1.Query/Validators",
+		bz,
+		sdk.NewInt(-1),
+		"other",
+		"",
+		0,
+		pinned,
+		true,
+	)
+
+	submit := func(from sdk.AccAddress, queryID string, height int64) {
+		_, err := icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     queryID,
+			Result:      []byte{0x01},
+			Height:      height,
+			FromAddress: from.String(),
+		})
+		suite.NoError(err)
+	}
+
+	// wrong height is rejected; the pinned height is accepted; later responses are duplicates.
+	// records are only kept for relayers that have had a response accepted.
+	submit(second, id, pinned+1)
+	submit(first, id, pinned)
+	submit(second, id, pinned)
+	submit(first, id, pinned)
+	submit(first, other, pinned+1)
+
+	res, err := icqsrvSrv.Relayer(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayerRequest{Address: first.String()})
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.Relayer.Accepted)
+	suite.Equal(uint64(1), res.Relayer.EpochAccepted)
+	suite.Equal(uint64(1), res.Relayer.Duplicates)
+	suite.Equal(uint64(1), res.Relayer.Rejected)
+
+	_, err = icqsrvSrv.Relayer(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayerRequest{Address: second.String()})
+	suite.Error(err)
+
+	all, err := icqsrvSrv.Relayers(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayersRequest{})
+	suite.NoError(err)
+	suite.Len(all.Relayers, 1)
+
+	_, err = icqsrvSrv.Relayer(sdk.WrapSDKContext(ctx), &icqtypes.QueryRelayerRequest{Address: addressutils.GenerateAccAddressForTest().String()})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestSubmitQueryResponseInvalidProof() {
+	icqKeeper := suite.GetSimApp(suite.chainA).InterchainQueryKeeper
+	ctx := suite.chainA.GetContext()
+	icqmsgSrv := keeper.NewMsgServerImpl(icqKeeper)
+
+	relayer := addressutils.GenerateAccAddressForTest()
+	unknown := addressutils.GenerateAccAddressForTest()
+	icqKeeper.SetRelayer(ctx, icqtypes.Relayer{Address: relayer.String(), Accepted: 1})
+	icqKeeper.MakeRequest(ctx, suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "store/bank/key", []byte{0x01}, sdk.NewInt(-1), "", "", 0)
+	id := keeper.GenerateQueryHash(suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, "store/bank/key", []byte{0x01}, "", "")
+
+	submit := func(from sdk.AccAddress) {
+		_, err := icqmsgSrv.SubmitQueryResponse(sdk.WrapSDKContext(ctx), &icqtypes.MsgSubmitQueryResponse{
+			ChainId:     suite.chainB.ChainID,
+			QueryId:     id,
+			Result:      []byte{0x01},
+			Height:      suite.chainB.CurrentHeader.Height,
+			FromAddress: from.String(),
+		})
+		// the response is rejected without failing the tx, so the rejection is kept.
+		suite.NoError(err)
+	}
+	submit(relayer)
+	submit(unknown)
+
+	// the query remains pending.
+	_, found := icqKeeper.GetQuery(ctx, id)
+	suite.True(found)
+
+	actual, found := icqKeeper.GetRelayer(ctx, relayer)
+	suite.True(found)
+	suite.Equal(uint64(1), actual.Rejected)
+	suite.Equal(uint64(1), actual.Accepted)
+
+	// no record is kept of relayers that have not had a response accepted.
+	_, found = icqKeeper.GetRelayer(ctx, unknown)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestDistributeRelayerRewards() {
+	tests := []struct {
+		name            string
+		poolAccount     string
+		rewardsPerEpoch sdk.Coins
+		poolBalance     sdk.Coins
+		expectedFirst   sdk.Coins
+		expectedSecond  sdk.Coins
+	}{
+		{
+			name:            "rewards disabled",
+			poolAccount:     "",
+			rewardsPerEpoch: sdk.NewCoins(sdk.NewInt64Coin("uqck", 40)),
+			poolBalance:     sdk.NewCoins(sdk.NewInt64Coin("uqck", 100)),
+			expectedFirst:   sdk.Coins{},
+			expectedSecond:  sdk.Coins{},
+		},
+		{
+			name:            "pro rata share of rewards per epoch",
+			poolAccount:     supplytypes.AirdropAccount,
+			rewardsPerEpoch: sdk.NewCoins(sdk.NewInt64Coin("uqck", 40)),
+			poolBalance:     sdk.NewCoins(sdk.NewInt64Coin("uqck", 100)),
+			expectedFirst:   sdk.NewCoins(sdk.NewInt64Coin("uqck", 30)),
+			expectedSecond:  sdk.NewCoins(sdk.NewInt64Coin("uqck", 10)),
+		},
+		{
+			name:            "bounded by pool balance",
+			poolAccount:     supplytypes.AirdropAccount,
+			rewardsPerEpoch: sdk.NewCoins(sdk.NewInt64Coin("uqck", 40)),
+			poolBalance:     sdk.NewCoins(sdk.NewInt64Coin("uqck", 21)),
+			expectedFirst:   sdk.NewCoins(sdk.NewInt64Coin("uqck", 15)),
+			expectedSecond:  sdk.NewCoins(sdk.NewInt64Coin("uqck", 5)),
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			quicksilver := suite.GetSimApp(suite.chainA)
+			icqKeeper := quicksilver.InterchainQueryKeeper
+			ctx := suite.chainA.GetContext()
+
+			suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, "mint", test.poolBalance))
+			suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToModule(ctx, "mint", supplytypes.AirdropAccount, test.poolBalance))
+
+			params := icqtypes.DefaultParams()
+			params.RewardPoolAccount = test.poolAccount
+			params.RewardsPerEpoch = test.rewardsPerEpoch
+			icqKeeper.SetParams(ctx, params)
+
+			first := addressutils.GenerateAccAddressForTest()
+			second := addressutils.GenerateAccAddressForTest()
+			icqKeeper.SetRelayer(ctx, icqtypes.Relayer{Address: first.String(), Accepted: 3, EpochAccepted: 3})
+			icqKeeper.SetRelayer(ctx, icqtypes.Relayer{Address: second.String(), Accepted: 1, EpochAccepted: 1})
+			idle := addressutils.GenerateAccAddressForTest()
+			icqKeeper.SetRelayer(ctx, icqtypes.Relayer{Address: idle.String(), Duplicates: 2, Rejected: 1})
+
+			icqKeeper.DistributeRelayerRewards(ctx)
+
+			// records of relayers without accepted responses are pruned.
+			_, found := icqKeeper.GetRelayer(ctx, idle)
+			suite.False(found)
+
+			suite.True(test.expectedFirst.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, first)))
+			suite.True(test.expectedSecond.IsEqual(quicksilver.BankKeeper.GetAllBalances(ctx, second)))
+
+			for _, addr := range []sdk.AccAddress{first, second} {
+				relayer, found := icqKeeper.GetRelayer(ctx, addr)
+				suite.True(found)
+				suite.Equal(uint64(0), relayer.EpochAccepted)
+				suite.True(quicksilver.BankKeeper.GetAllBalances(ctx, addr).IsEqual(relayer.Rewarded))
+			}
+		})
+	}
+}
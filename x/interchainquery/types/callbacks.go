@@ -9,4 +9,8 @@ type QueryCallbacks interface {
 	RegisterCallbacks() QueryCallbacks
 	Call(ctx sdk.Context, id string, args []byte, query Query) error
 	Has(id string) bool
+	// CallTimeout is invoked when a query with the given callback id expires
+	// without a response; implementations without a timeout handler for id
+	// should return nil.
+	CallTimeout(ctx sdk.Context, id string, query Query) error
 }
@@ -1,8 +1,19 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	sdkioerrors "cosmossdk.io/errors"
+)
 
 var (
 	ErrAlreadyFulfilled  = errors.New("query already fulfilled")
 	ErrSucceededNoDelete = errors.New("query succeeded; do not not execute default behavior")
 )
+
+// x/interchainquery module sentinel errors.
+var (
+	// ErrInvalidProof is the error of a query response whose proof could not be validated. Such responses are
+	// rejected without failing the tx, so that the rejection is recorded against the relayer.
+	ErrInvalidProof = sdkioerrors.Register(ModuleName, 1, "invalid proof")
+)
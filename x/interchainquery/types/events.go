@@ -8,7 +8,14 @@ const (
 	AttributeKeyParams       = "parameters"
 	AttributeKeyRequest      = "request"
 	AttributeKeyHeight       = "height"
+	AttributeKeyExactHeight  = "exact_height"
+	AttributeKeyRetries      = "retries"
+	AttributeKeyRelayer      = "relayer"
+	AttributeKeyAmount       = "amount"
 
 	AttributeValueCategory = ModuleName
 	AttributeValueQuery    = "query"
+	AttributeValueTimeout  = "timeout"
+
+	EventTypeRelayerReward = "relayer_reward"
 )
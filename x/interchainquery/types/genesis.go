@@ -1,18 +1,35 @@
 package types
 
-func NewGenesisState(queries []Query) *GenesisState {
-	return &GenesisState{Queries: queries}
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewGenesisState(queries []Query, params Params, relayers []Relayer) *GenesisState {
+	return &GenesisState{Queries: queries, Params: params, Relayers: relayers}
 }
 
 // DefaultGenesisState returns the default Capability genesis state.
 func DefaultGenesisState() *GenesisState {
 	var queries []Query
-	return NewGenesisState(queries)
+	var relayers []Relayer
+	return NewGenesisState(queries, DefaultParams(), relayers)
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
-func (GenesisState) Validate() error {
-	// TODO: validate genesis state.
-	return nil
+func (gs GenesisState) Validate() error {
+	// TODO: validate genesis queries.
+	seen := make(map[string]bool, len(gs.Relayers))
+	for _, relayer := range gs.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer.Address); err != nil {
+			return fmt.Errorf("invalid relayer address %q: %w", relayer.Address, err)
+		}
+		if seen[relayer.Address] {
+			return fmt.Errorf("duplicate relayer %s", relayer.Address)
+		}
+		seen[relayer.Address] = true
+	}
+	return gs.Params.Validate()
 }
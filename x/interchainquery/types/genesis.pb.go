@@ -25,7 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
-	Queries []Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Queries  []Query   `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries"`
+	Params   Params    `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Relayers []Relayer `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,21 +72,24 @@ func init() {
 }
 
 var fileDescriptor_90232048b76e95cc = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
+	// 270 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x2c, 0xcd, 0x4c,
 	0xce, 0x2e, 0xce, 0xcc, 0x29, 0x4b, 0x2d, 0xd2, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48,
 	0xcc, 0xcc, 0x2b, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x43, 0x52, 0xad, 0x87, 0xa6, 0x5a,
 	0xaf, 0xcc, 0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x54, 0x1f, 0xc4, 0x82, 0xe8, 0x92,
-	0x32, 0x21, 0x60, 0x07, 0xba, 0x41, 0x60, 0x5d, 0x4a, 0xd1, 0x5c, 0x3c, 0xee, 0x10, 0xcb, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x5c, 0xb9, 0xd8, 0x41, 0xd2, 0x99, 0xa9, 0xc5, 0x12, 0x8c, 0x0a,
-	0xcc, 0x1a, 0xdc, 0x46, 0xaa, 0x7a, 0xf8, 0x5d, 0xa3, 0x17, 0x08, 0x62, 0x38, 0xb1, 0x9c, 0xb8,
-	0x27, 0xcf, 0x10, 0x04, 0xd3, 0x6b, 0xc5, 0xd2, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xf2, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xec, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0x91, 0xcc, 0xd7, 0xad, 0xca, 0xcf, 0x4b, 0x45, 0x16, 0xd0, 0xaf, 0xc0,
-	0xf0, 0x4a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xf9, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x19, 0x5e, 0x1c, 0x65, 0x5a, 0x01, 0x00, 0x00,
+	0x32, 0x21, 0x60, 0x07, 0xba, 0x41, 0x60, 0x5d, 0x4a, 0x6f, 0x18, 0xb9, 0x78, 0xdc, 0x21, 0xb6,
+	0x07, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x72, 0xb1, 0x83, 0xe4, 0x33, 0x53, 0x8b, 0x25, 0x18,
+	0x15, 0x98, 0x35, 0xb8, 0x8d, 0x54, 0xf5, 0xf0, 0x3b, 0x47, 0x2f, 0x10, 0xc4, 0x70, 0x62, 0x39,
+	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xa6, 0x57, 0xc8, 0x85, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7,
+	0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x8d, 0x90, 0x29, 0x01, 0x60, 0xd5, 0x50, 0x63,
+	0xa0, 0x7a, 0x85, 0x3c, 0xb9, 0x38, 0x8a, 0x52, 0x73, 0x12, 0x2b, 0x53, 0x8b, 0x8a, 0x25, 0x98,
+	0xc1, 0xae, 0x51, 0x27, 0x64, 0x4e, 0x10, 0x44, 0x3d, 0xd4, 0x20, 0xb8, 0x76, 0x2b, 0x96, 0x8e,
+	0x05, 0xf2, 0x0c, 0x4e, 0x91, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
+	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
+	0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x64, 0x85, 0x6e, 0x55,
+	0x7e, 0x5e, 0x2a, 0xb2, 0x80, 0x7e, 0x05, 0x46, 0xe0, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
+	0x81, 0x03, 0xd4, 0x18, 0x30, 0x00, 0x9f, 0x3c, 0x02, 0x93, 0xec, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -107,6 +112,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relayers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Queries) > 0 {
 		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -147,6 +176,14 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Relayers) > 0 {
+		for _, e := range m.Relayers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -219,6 +256,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, Relayer{})
+			if err := m.Relayers[len(m.Relayers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -40,6 +42,16 @@ type Query struct {
 	CallbackId   string                `protobuf:"bytes,8,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
 	Ttl          uint64                `protobuf:"varint,9,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	LastEmission cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=last_emission,json=lastEmission,proto3,customtype=cosmossdk.io/math.Int" json:"last_emission"`
+	// retries is the number of times a non-periodic query has been re-emitted
+	// after its ttl elapsed without a response.
+	Retries uint64 `protobuf:"varint,11,opt,name=retries,proto3" json:"retries,omitempty"`
+	// height is the remote chain height the query must be answered at; zero
+	// means latest. Callbacks receive the query with height set to the remote
+	// height of the response being processed.
+	Height int64 `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	// exact_height requires the response to be for exactly height; otherwise
+	// any response at or after height is accepted.
+	ExactHeight bool `protobuf:"varint,13,opt,name=exact_height,json=exactHeight,proto3" json:"exact_height,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -124,6 +136,322 @@ func (m *Query) GetTtl() uint64 {
 	return 0
 }
 
+func (m *Query) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *Query) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Query) GetExactHeight() bool {
+	if m != nil {
+		return m.ExactHeight
+	}
+	return false
+}
+
+// Params holds parameters for the interchainquery module.
+type Params struct {
+	// data_point_retention is the number of DataPoints kept per query; zero
+	// disables query history.
+	DataPointRetention uint64 `protobuf:"varint,1,opt,name=data_point_retention,json=dataPointRetention,proto3" json:"data_point_retention,omitempty"`
+	// reward_pool_account is the name of the module account relayer rewards are
+	// paid from; either the x/supply incentive pool, or empty to disable rewards.
+	RewardPoolAccount string `protobuf:"bytes,2,opt,name=reward_pool_account,json=rewardPoolAccount,proto3" json:"reward_pool_account,omitempty"`
+	// rewards_per_epoch is the maximum amount distributed to relayers each
+	// epoch, in proportion to their accepted responses.
+	RewardsPerEpoch github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards_per_epoch,json=rewardsPerEpoch,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_per_epoch"`
+	// max_retries is the number of times an expired non-periodic query is
+	// re-emitted before it is dropped; zero applies the default of 3.
+	MaxRetries uint64 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDataPointRetention() uint64 {
+	if m != nil {
+		return m.DataPointRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRewardPoolAccount() string {
+	if m != nil {
+		return m.RewardPoolAccount
+	}
+	return ""
+}
+
+func (m *Params) GetRewardsPerEpoch() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardsPerEpoch
+	}
+	return nil
+}
+
+func (m *Params) GetMaxRetries() uint64 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// Relayer tracks the responses submitted by a MsgSubmitQueryResponse signer.
+type Relayer struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// accepted is the number of responses that satisfied a pending query.
+	Accepted uint64 `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// duplicates is the number of responses for queries that were already
+	// satisfied.
+	Duplicates uint64 `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	// rejected is the number of responses rejected for an invalid proof, a stale
+	// height or an unexpected height.
+	Rejected uint64 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// epoch_accepted is the number of accepted responses in the current epoch.
+	EpochAccepted uint64 `protobuf:"varint,5,opt,name=epoch_accepted,json=epochAccepted,proto3" json:"epoch_accepted,omitempty"`
+	// rewarded is the total amount of rewards paid to the relayer.
+	Rewarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewarded"`
+}
+
+func (m *Relayer) Reset()         { *m = Relayer{} }
+func (m *Relayer) String() string { return proto.CompactTextString(m) }
+func (*Relayer) ProtoMessage()    {}
+func (*Relayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{2}
+}
+func (m *Relayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Relayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Relayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Relayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Relayer.Merge(m, src)
+}
+func (m *Relayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Relayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Relayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Relayer proto.InternalMessageInfo
+
+func (m *Relayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Relayer) GetAccepted() uint64 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
+}
+
+func (m *Relayer) GetDuplicates() uint64 {
+	if m != nil {
+		return m.Duplicates
+	}
+	return 0
+}
+
+func (m *Relayer) GetRejected() uint64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *Relayer) GetEpochAccepted() uint64 {
+	if m != nil {
+		return m.EpochAccepted
+	}
+	return 0
+}
+
+func (m *Relayer) GetRewarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewarded
+	}
+	return nil
+}
+
+// QueryStats tracks emissions and responses of a query over its lifetime.
+type QueryStats struct {
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	QueryType  string `protobuf:"bytes,3,opt,name=query_type,json=queryType,proto3" json:"query_type,omitempty"`
+	CallbackId string `protobuf:"bytes,4,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	// emission_count is the number of times the query has been emitted,
+	// including re-emissions after ttl expiry.
+	EmissionCount uint64 `protobuf:"varint,5,opt,name=emission_count,json=emissionCount,proto3" json:"emission_count,omitempty"`
+	// response_count is the number of accepted responses.
+	ResponseCount uint64 `protobuf:"varint,6,opt,name=response_count,json=responseCount,proto3" json:"response_count,omitempty"`
+	// failure_count is the number of rejected (stale or wrong height) responses
+	// and ttl expiries.
+	FailureCount uint64 `protobuf:"varint,7,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// last_response_height is the remote height of the last accepted response.
+	LastResponseHeight int64 `protobuf:"varint,8,opt,name=last_response_height,json=lastResponseHeight,proto3" json:"last_response_height,omitempty"`
+	// last_response_local_height is the local height of the last accepted
+	// response.
+	LastResponseLocalHeight int64 `protobuf:"varint,9,opt,name=last_response_local_height,json=lastResponseLocalHeight,proto3" json:"last_response_local_height,omitempty"`
+	// total_latency is the sum of blocks between emission and response over all
+	// accepted responses.
+	TotalLatency uint64 `protobuf:"varint,10,opt,name=total_latency,json=totalLatency,proto3" json:"total_latency,omitempty"`
+}
+
+func (m *QueryStats) Reset()         { *m = QueryStats{} }
+func (m *QueryStats) String() string { return proto.CompactTextString(m) }
+func (*QueryStats) ProtoMessage()    {}
+func (*QueryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12f0828e1ddee43, []int{3}
+}
+func (m *QueryStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStats.Merge(m, src)
+}
+func (m *QueryStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStats proto.InternalMessageInfo
+
+func (m *QueryStats) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryStats) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryStats) GetQueryType() string {
+	if m != nil {
+		return m.QueryType
+	}
+	return ""
+}
+
+func (m *QueryStats) GetCallbackId() string {
+	if m != nil {
+		return m.CallbackId
+	}
+	return ""
+}
+
+func (m *QueryStats) GetEmissionCount() uint64 {
+	if m != nil {
+		return m.EmissionCount
+	}
+	return 0
+}
+
+func (m *QueryStats) GetResponseCount() uint64 {
+	if m != nil {
+		return m.ResponseCount
+	}
+	return 0
+}
+
+func (m *QueryStats) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+func (m *QueryStats) GetLastResponseHeight() int64 {
+	if m != nil {
+		return m.LastResponseHeight
+	}
+	return 0
+}
+
+func (m *QueryStats) GetLastResponseLocalHeight() int64 {
+	if m != nil {
+		return m.LastResponseLocalHeight
+	}
+	return 0
+}
+
+func (m *QueryStats) GetTotalLatency() uint64 {
+	if m != nil {
+		return m.TotalLatency
+	}
+	return 0
+}
+
 type DataPoint struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// change these to uint64 in v0.5.0
@@ -136,7 +464,7 @@ func (m *DataPoint) Reset()         { *m = DataPoint{} }
 func (m *DataPoint) String() string { return proto.CompactTextString(m) }
 func (*DataPoint) ProtoMessage()    {}
 func (*DataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12f0828e1ddee43, []int{1}
+	return fileDescriptor_e12f0828e1ddee43, []int{4}
 }
 func (m *DataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,7 +533,7 @@ func (m *GetTxsEventRequest) Reset()         { *m = GetTxsEventRequest{} }
 func (m *GetTxsEventRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxsEventRequest) ProtoMessage()    {}
 func (*GetTxsEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12f0828e1ddee43, []int{2}
+	return fileDescriptor_e12f0828e1ddee43, []int{5}
 }
 func (m *GetTxsEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -280,6 +608,9 @@ func (m *GetTxsEventRequest) GetQuery() string {
 
 func init() {
 	proto.RegisterType((*Query)(nil), "quicksilver.interchainquery.v1.Query")
+	proto.RegisterType((*Params)(nil), "quicksilver.interchainquery.v1.Params")
+	proto.RegisterType((*Relayer)(nil), "quicksilver.interchainquery.v1.Relayer")
+	proto.RegisterType((*QueryStats)(nil), "quicksilver.interchainquery.v1.QueryStats")
 	proto.RegisterType((*DataPoint)(nil), "quicksilver.interchainquery.v1.DataPoint")
 	proto.RegisterType((*GetTxsEventRequest)(nil), "quicksilver.interchainquery.v1.GetTxsEventRequest")
 }
@@ -289,50 +620,115 @@ func init() {
 }
 
 var fileDescriptor_e12f0828e1ddee43 = []byte{
-	// 651 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xf3, 0xb7, 0x99, 0xa4, 0x55, 0xb5, 0xea, 0x4f, 0x72, 0xa3, 0x1f, 0x49, 0x54, 0x24,
-	0x14, 0x15, 0x6a, 0xab, 0x05, 0xce, 0x48, 0x81, 0x0a, 0x82, 0x10, 0x04, 0xab, 0x17, 0xb8, 0x44,
-	0x1b, 0x7b, 0xe5, 0xac, 0x6a, 0xef, 0xba, 0xde, 0x4d, 0x94, 0xf0, 0x14, 0x3c, 0x06, 0x0f, 0xc0,
-	0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x2a, 0xd4, 0x9e, 0xe0, 0xca, 0x0b, 0xa0, 0xfd, 0x93, 0x36,
-	0x6a, 0x4f, 0xe5, 0x36, 0xf3, 0xcd, 0x37, 0x9f, 0xe7, 0x4b, 0x66, 0x16, 0x9e, 0x9c, 0x4c, 0x69,
-	0x78, 0x2c, 0x68, 0x32, 0x23, 0xb9, 0x4f, 0x99, 0x24, 0x79, 0x38, 0xc1, 0x94, 0x9d, 0x4c, 0x49,
-	0xbe, 0xf0, 0x67, 0xfb, 0x37, 0x21, 0x2f, 0xcb, 0xb9, 0xe4, 0xa8, 0xbd, 0xd2, 0xe5, 0xdd, 0xa4,
-	0xcc, 0xf6, 0x5b, 0xbb, 0x21, 0x17, 0x29, 0x17, 0xfe, 0x18, 0x0b, 0xe2, 0x2f, 0xb5, 0xc6, 0x44,
-	0xe2, 0x7d, 0x3f, 0xc3, 0x31, 0x65, 0x58, 0x52, 0xce, 0x8c, 0x56, 0xab, 0x63, 0xb9, 0x72, 0x7e,
-	0xc5, 0x11, 0x24, 0x9f, 0xd1, 0x90, 0x58, 0xc2, 0xb6, 0x21, 0x8c, 0x74, 0xe6, 0x9b, 0xc4, 0x96,
-	0xb6, 0x62, 0x1e, 0x73, 0x83, 0xab, 0xc8, 0xa2, 0xff, 0xc7, 0x9c, 0xc7, 0x09, 0xf1, 0x71, 0x46,
-	0x7d, 0xcc, 0x18, 0x97, 0xfa, 0x73, 0xb6, 0x67, 0xe7, 0x4b, 0x09, 0x2a, 0xef, 0xd5, 0x48, 0x68,
-	0x03, 0x8a, 0x34, 0x72, 0x9d, 0xae, 0xd3, 0xab, 0x07, 0x45, 0x1a, 0xa1, 0xfb, 0xb0, 0x1e, 0x72,
-	0xc6, 0x48, 0xa8, 0xe8, 0x23, 0x1a, 0xb9, 0x45, 0x5d, 0x6a, 0x5e, 0x83, 0x83, 0x08, 0x6d, 0xc3,
-	0x9a, 0xf6, 0xaa, 0xea, 0x25, 0x5d, 0xaf, 0xe9, 0x7c, 0x10, 0xa1, 0x7b, 0x00, 0xda, 0xeb, 0x48,
-	0x2e, 0x32, 0xe2, 0x96, 0x75, 0xb1, 0xae, 0x91, 0xa3, 0x45, 0x46, 0x90, 0x0b, 0xb5, 0x9c, 0x9c,
-	0x4c, 0x89, 0x90, 0x6e, 0xa5, 0xeb, 0xf4, 0x9a, 0xc1, 0x32, 0x45, 0xcf, 0xa1, 0x9a, 0x91, 0x9c,
-	0xf2, 0xc8, 0xad, 0xaa, 0xa6, 0xfe, 0xc3, 0xd3, 0xf3, 0x4e, 0xe1, 0xc7, 0x79, 0xe7, 0x3f, 0x63,
-	0x56, 0x44, 0xc7, 0x1e, 0xe5, 0x7e, 0x8a, 0xe5, 0xc4, 0x1b, 0x30, 0xf9, 0xed, 0xeb, 0x1e, 0xd8,
-	0x5f, 0x61, 0xc0, 0x64, 0x60, 0x5b, 0xd1, 0x1b, 0x68, 0x24, 0x58, 0xc8, 0xd1, 0x84, 0xd0, 0x78,
-	0x22, 0xdd, 0xda, 0xdd, 0x95, 0x40, 0xf5, 0xbf, 0xd2, 0xed, 0xa8, 0x03, 0x8d, 0x10, 0x27, 0xc9,
-	0x18, 0x87, 0xc7, 0xca, 0xe9, 0x9a, 0x36, 0x03, 0x4b, 0x68, 0x10, 0xa1, 0x4d, 0x28, 0x49, 0x99,
-	0xb8, 0xf5, 0xae, 0xd3, 0x2b, 0x07, 0x2a, 0x44, 0x43, 0x58, 0xd7, 0x03, 0x90, 0x94, 0x0a, 0x41,
-	0x39, 0x73, 0xe1, 0xee, 0x23, 0x34, 0x95, 0xc2, 0xa1, 0x15, 0xd8, 0xf9, 0xe5, 0x40, 0xfd, 0x05,
-	0x96, 0x78, 0xc8, 0x29, 0x93, 0xb7, 0xfe, 0xae, 0x21, 0xac, 0xe7, 0x24, 0xe5, 0x92, 0x2c, 0x2d,
-	0x17, 0xff, 0xe1, 0x7b, 0x46, 0xc1, 0x9a, 0x7e, 0x0b, 0xcd, 0x84, 0x87, 0x38, 0x59, 0x0a, 0x96,
-	0xee, 0x2e, 0xd8, 0xd0, 0x02, 0x56, 0x6f, 0x17, 0x2a, 0x33, 0x9c, 0x4c, 0xcd, 0x2e, 0x34, 0xfb,
-	0x5b, 0xbf, 0xcf, 0x3b, 0x9b, 0x39, 0x11, 0xd3, 0x44, 0x3e, 0xe2, 0x29, 0x95, 0x24, 0xcd, 0xe4,
-	0x22, 0x30, 0x94, 0x9d, 0x3f, 0x0e, 0xa0, 0x97, 0x44, 0x1e, 0xcd, 0xc5, 0xe1, 0x8c, 0x30, 0x19,
-	0xd8, 0xd5, 0x68, 0x41, 0x95, 0xa8, 0x5c, 0xb8, 0x4e, 0xb7, 0xd4, 0xab, 0xf7, 0x8b, 0xae, 0x13,
-	0x58, 0x04, 0xbd, 0x06, 0xb8, 0xbe, 0x26, 0xed, 0xbe, 0x71, 0xf0, 0xc0, 0xb3, 0xc3, 0xa8, 0xd3,
-	0xf3, 0x96, 0x07, 0xa9, 0xcf, 0xca, 0x1b, 0xe2, 0x98, 0x58, 0x5d, 0xad, 0xb3, 0xd2, 0x8d, 0x9e,
-	0xc2, 0x1a, 0xcf, 0x23, 0x92, 0x8f, 0xc6, 0x0b, 0x6d, 0x7b, 0xe3, 0xa0, 0xb5, 0x54, 0x92, 0xf3,
-	0x2b, 0x85, 0x77, 0x8a, 0xd2, 0x5f, 0x04, 0x35, 0x6e, 0x02, 0x84, 0xa0, 0x9c, 0xe1, 0xd8, 0x18,
-	0x2c, 0x07, 0x3a, 0x46, 0x5b, 0x50, 0x49, 0x68, 0x4a, 0xcd, 0x96, 0x97, 0x03, 0x93, 0x28, 0x54,
-	0x4f, 0x63, 0x56, 0x3c, 0x30, 0x49, 0xff, 0xc3, 0xe9, 0x45, 0xdb, 0x39, 0xbb, 0x68, 0x3b, 0x3f,
-	0x2f, 0xda, 0xce, 0xe7, 0xcb, 0x76, 0xe1, 0xec, 0xb2, 0x5d, 0xf8, 0x7e, 0xd9, 0x2e, 0x7c, 0x7c,
-	0x16, 0x53, 0x39, 0x99, 0x8e, 0xbd, 0x90, 0xa7, 0xfe, 0xca, 0x6b, 0xb3, 0xf7, 0x89, 0x33, 0xb2,
-	0x0a, 0xf8, 0xf3, 0x5b, 0xcf, 0x96, 0x3a, 0x3f, 0x31, 0xae, 0xea, 0x73, 0x7f, 0xfc, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x3b, 0xb8, 0x23, 0x3d, 0xe2, 0x04, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0xce, 0xd8, 0x8e, 0x3f, 0xca, 0x76, 0xde, 0x7d, 0x1b, 0x03, 0xb3, 0x16, 0xd8, 0xc6, 0xab,
+	0x45, 0xd6, 0x42, 0xec, 0x24, 0xc0, 0x05, 0x0e, 0x28, 0x0e, 0x11, 0x04, 0x45, 0x60, 0x66, 0xf7,
+	0x02, 0x97, 0x51, 0x7b, 0xa6, 0xb1, 0x9b, 0xcc, 0x4c, 0x4f, 0xba, 0xdb, 0x5e, 0x9b, 0x1f, 0x81,
+	0x38, 0x72, 0xdc, 0x0b, 0x17, 0xce, 0xfb, 0x23, 0xf6, 0xb8, 0xda, 0x13, 0xe2, 0x10, 0x50, 0x72,
+	0x01, 0xae, 0x9c, 0x41, 0xa8, 0x3f, 0xc6, 0xeb, 0x24, 0x12, 0x52, 0x10, 0x27, 0x4f, 0x3d, 0x4f,
+	0x55, 0x4d, 0x55, 0xb9, 0x9f, 0xea, 0x81, 0xb7, 0x4f, 0x67, 0x34, 0x38, 0x11, 0x34, 0x9a, 0x13,
+	0x3e, 0xa0, 0x89, 0x24, 0x3c, 0x98, 0x62, 0x9a, 0x9c, 0xce, 0x08, 0x5f, 0x0e, 0xe6, 0xbb, 0x57,
+	0xa1, 0x7e, 0xca, 0x99, 0x64, 0xa8, 0xb5, 0x16, 0xd5, 0xbf, 0xea, 0x32, 0xdf, 0x6d, 0xde, 0x0b,
+	0x98, 0x88, 0x99, 0x18, 0x8c, 0xb1, 0x20, 0x83, 0x2c, 0xd7, 0x98, 0x48, 0xbc, 0x3b, 0x48, 0xf1,
+	0x84, 0x26, 0x58, 0x52, 0x96, 0x98, 0x5c, 0xcd, 0xd6, 0xba, 0x6f, 0xe6, 0x15, 0x30, 0x9a, 0xf1,
+	0x6d, 0xcb, 0xcb, 0xc5, 0x8a, 0x15, 0x84, 0xcf, 0x69, 0x40, 0xac, 0xc3, 0x6d, 0xe3, 0xe0, 0x6b,
+	0x6b, 0x60, 0x0c, 0x4b, 0x35, 0x26, 0x6c, 0xc2, 0x0c, 0xae, 0x9e, 0x2c, 0xfa, 0xca, 0x84, 0xb1,
+	0x49, 0x44, 0x06, 0x38, 0xa5, 0x03, 0x9c, 0x24, 0x4c, 0xea, 0x72, 0x6c, 0x4c, 0xf7, 0xcf, 0x3c,
+	0x6c, 0x7e, 0xa6, 0x4a, 0x46, 0x5b, 0x90, 0xa3, 0xa1, 0xeb, 0x74, 0x9c, 0x5e, 0xc5, 0xcb, 0xd1,
+	0x10, 0xdd, 0x81, 0x7a, 0xc0, 0x92, 0x84, 0x04, 0xca, 0xdd, 0xa7, 0xa1, 0x9b, 0xd3, 0x54, 0xed,
+	0x39, 0x78, 0x14, 0xa2, 0xdb, 0x50, 0xd6, 0xb3, 0x50, 0x7c, 0x5e, 0xf3, 0x25, 0x6d, 0x1f, 0x85,
+	0xe8, 0x55, 0x00, 0x3d, 0x0b, 0x5f, 0x2e, 0x53, 0xe2, 0x16, 0x34, 0x59, 0xd1, 0xc8, 0x83, 0x65,
+	0x4a, 0x90, 0x0b, 0x25, 0x4e, 0x4e, 0x67, 0x44, 0x48, 0x77, 0xb3, 0xe3, 0xf4, 0x6a, 0x5e, 0x66,
+	0xa2, 0x03, 0x28, 0xa6, 0x84, 0x53, 0x16, 0xba, 0x45, 0x15, 0x34, 0x7c, 0xe3, 0xc9, 0x59, 0x7b,
+	0xe3, 0xa7, 0xb3, 0xf6, 0x8b, 0xa6, 0x59, 0x11, 0x9e, 0xf4, 0x29, 0x1b, 0xc4, 0x58, 0x4e, 0xfb,
+	0x47, 0x89, 0x7c, 0xf6, 0x78, 0x1b, 0xec, 0x14, 0x8e, 0x12, 0xe9, 0xd9, 0x50, 0x74, 0x0c, 0xd5,
+	0x08, 0x0b, 0xe9, 0x4f, 0x09, 0x9d, 0x4c, 0xa5, 0x5b, 0xba, 0x79, 0x26, 0x50, 0xf1, 0x1f, 0xe9,
+	0x70, 0xd4, 0x86, 0x6a, 0x80, 0xa3, 0x68, 0x8c, 0x83, 0x13, 0xd5, 0x69, 0x59, 0x37, 0x03, 0x19,
+	0x74, 0x14, 0xa2, 0x5b, 0x90, 0x97, 0x32, 0x72, 0x2b, 0x1d, 0xa7, 0x57, 0xf0, 0xd4, 0x23, 0x1a,
+	0x41, 0x5d, 0x17, 0x40, 0x62, 0x2a, 0x04, 0x65, 0x89, 0x0b, 0x37, 0x2f, 0xa1, 0xa6, 0x32, 0x1c,
+	0xda, 0x04, 0x66, 0x62, 0x92, 0x53, 0x22, 0xdc, 0xaa, 0x7e, 0x4f, 0x66, 0xa2, 0x97, 0xa0, 0x68,
+	0xfb, 0xac, 0x75, 0x9c, 0x5e, 0xde, 0xb3, 0x16, 0x7a, 0x0d, 0x6a, 0x64, 0x81, 0x83, 0xd5, 0x14,
+	0xea, 0x1d, 0xa7, 0x57, 0xf6, 0xaa, 0x1a, 0x33, 0x9d, 0x75, 0xbf, 0xc9, 0x41, 0x71, 0x84, 0x39,
+	0x8e, 0x05, 0xda, 0x81, 0x46, 0x88, 0x25, 0xf6, 0x53, 0x46, 0x13, 0xe9, 0x73, 0x22, 0x49, 0xa2,
+	0xfe, 0x65, 0x7d, 0x24, 0x0a, 0x1e, 0x52, 0xdc, 0x48, 0x51, 0x5e, 0xc6, 0xa0, 0x3e, 0xbc, 0xc0,
+	0xc9, 0x43, 0xcc, 0x43, 0x3f, 0x65, 0x2c, 0xf2, 0x71, 0x10, 0xb0, 0x59, 0x22, 0xed, 0x41, 0xf9,
+	0xbf, 0xa1, 0x46, 0x8c, 0x45, 0xfb, 0x86, 0x40, 0x0f, 0xc1, 0x82, 0xc2, 0x4f, 0x09, 0xf7, 0x49,
+	0xca, 0x82, 0xa9, 0x9b, 0xef, 0xe4, 0x7b, 0xd5, 0xbd, 0xdb, 0x7d, 0xdb, 0xb7, 0x12, 0x46, 0xdf,
+	0x1e, 0xfd, 0xfe, 0x01, 0xa3, 0xc9, 0x70, 0x47, 0x8d, 0xec, 0x87, 0x9f, 0xdb, 0xbd, 0x09, 0x95,
+	0xd3, 0xd9, 0xb8, 0x1f, 0xb0, 0xd8, 0x9e, 0x7b, 0xfb, 0xb3, 0x2d, 0xc2, 0x93, 0x81, 0x3a, 0x65,
+	0x42, 0x07, 0x08, 0xef, 0x7f, 0xf6, 0x2d, 0x23, 0xc2, 0x0f, 0xd5, 0x3b, 0xd4, 0xff, 0x17, 0xe3,
+	0x85, 0x9f, 0x8d, 0xaf, 0xa0, 0x3b, 0x82, 0x18, 0x2f, 0x3c, 0x83, 0xbc, 0x5b, 0xfe, 0xee, 0x51,
+	0x7b, 0xe3, 0xd7, 0x47, 0x6d, 0xa7, 0xfb, 0x7d, 0x0e, 0x4a, 0x1e, 0x89, 0xf0, 0x92, 0x70, 0xb4,
+	0x07, 0x25, 0x1c, 0x86, 0x9c, 0x08, 0x61, 0x74, 0x31, 0x74, 0x9f, 0x3d, 0xde, 0x6e, 0xd8, 0x42,
+	0xf7, 0x0d, 0x73, 0x5f, 0x72, 0x9a, 0x4c, 0xbc, 0xcc, 0x11, 0x35, 0xa1, 0x8c, 0x83, 0x80, 0xa4,
+	0x92, 0x18, 0xc5, 0x14, 0xbc, 0x95, 0x8d, 0x5a, 0x00, 0xe1, 0x2c, 0x8d, 0x68, 0x80, 0x25, 0x11,
+	0x5a, 0x2f, 0x05, 0x6f, 0x0d, 0x51, 0xb1, 0x9c, 0x7c, 0x45, 0x02, 0x15, 0x6b, 0x6a, 0x5c, 0xd9,
+	0xe8, 0x2e, 0x6c, 0xe9, 0x79, 0xf9, 0xab, 0xec, 0x9b, 0xda, 0xa3, 0xae, 0xd1, 0xfd, 0xec, 0x15,
+	0x13, 0x28, 0x9b, 0xe6, 0x89, 0x92, 0xcf, 0x7f, 0x3e, 0xd9, 0x55, 0xf2, 0xee, 0x5f, 0x39, 0x00,
+	0xbd, 0x38, 0xee, 0x4b, 0x2c, 0xc5, 0xb5, 0xed, 0xb1, 0xbe, 0x18, 0x72, 0xff, 0xb4, 0x18, 0xf2,
+	0x57, 0x17, 0xc3, 0x15, 0xad, 0x15, 0xae, 0x69, 0x4d, 0x4d, 0xc2, 0x6a, 0xc2, 0x37, 0x07, 0x2e,
+	0x9b, 0x84, 0x45, 0x0f, 0xf4, 0x61, 0xbb, 0x0b, 0x5b, 0x9c, 0x88, 0x94, 0x25, 0x82, 0x58, 0xb7,
+	0xa2, 0x71, 0xcb, 0x50, 0xe3, 0x76, 0x07, 0xea, 0x5f, 0x62, 0x1a, 0xcd, 0x78, 0xe6, 0x55, 0xd2,
+	0x5e, 0x35, 0x0b, 0x1a, 0xa7, 0x1d, 0x68, 0x68, 0x31, 0xaf, 0x12, 0x5a, 0x41, 0x95, 0xb5, 0xdc,
+	0x90, 0xe2, 0x3c, 0x4b, 0xd9, 0x8d, 0xf1, 0x1e, 0x34, 0x2f, 0x47, 0x44, 0x2c, 0xc0, 0x51, 0x16,
+	0x57, 0xd1, 0x71, 0x2f, 0xaf, 0xc7, 0x1d, 0x2b, 0xde, 0x06, 0xdf, 0x81, 0xba, 0x64, 0x12, 0x47,
+	0x7e, 0x84, 0x25, 0x49, 0x82, 0xa5, 0xde, 0x1d, 0x05, 0xaf, 0xa6, 0xc1, 0x63, 0x83, 0x75, 0x7f,
+	0x73, 0xa0, 0xf2, 0x41, 0xa6, 0xc9, 0x6b, 0xf3, 0x1f, 0x41, 0x9d, 0x93, 0x98, 0xc9, 0x55, 0xa9,
+	0xb9, 0x7f, 0xb1, 0x7e, 0x4c, 0x06, 0x5b, 0xd4, 0x27, 0x50, 0xbb, 0xd4, 0x43, 0xfe, 0xe6, 0x09,
+	0xab, 0xd1, 0x5a, 0x93, 0xf7, 0x60, 0x73, 0x8e, 0xa3, 0x99, 0xb9, 0x1a, 0x6a, 0xc3, 0xc6, 0xef,
+	0x67, 0xed, 0x5b, 0x9c, 0x88, 0x59, 0x24, 0xdf, 0x64, 0x31, 0x95, 0x24, 0x4e, 0xe5, 0xd2, 0x33,
+	0x2e, 0xdd, 0x3f, 0x1c, 0x40, 0x1f, 0x12, 0xf9, 0x60, 0x21, 0x0e, 0xe7, 0x44, 0x6d, 0x20, 0x73,
+	0x53, 0x34, 0xa1, 0x48, 0x94, 0xad, 0xe4, 0x99, 0xef, 0x55, 0x86, 0x39, 0xd7, 0xf1, 0x2c, 0x82,
+	0x3e, 0x06, 0x78, 0x7e, 0xf9, 0xea, 0xee, 0xab, 0x7b, 0xaf, 0x5f, 0x92, 0x42, 0x76, 0x7f, 0x1b,
+	0x41, 0x8c, 0xf0, 0x84, 0xd8, 0xbc, 0x3a, 0xcf, 0x5a, 0x34, 0x7a, 0x07, 0xca, 0x8c, 0x87, 0x84,
+	0xfb, 0xe3, 0xa5, 0x6e, 0x7b, 0x6b, 0xaf, 0x99, 0x65, 0x92, 0x8b, 0x55, 0x86, 0x4f, 0x95, 0xcb,
+	0x70, 0xe9, 0x95, 0x98, 0x79, 0x40, 0x08, 0x0a, 0x29, 0x9e, 0x10, 0x2b, 0x65, 0xfd, 0x8c, 0x1a,
+	0xb0, 0x19, 0xd1, 0x98, 0x66, 0x67, 0xd6, 0x18, 0x0a, 0xd5, 0xd5, 0x98, 0x1b, 0xcf, 0x33, 0xc6,
+	0xf0, 0xf3, 0x27, 0xe7, 0x2d, 0xe7, 0xe9, 0x79, 0xcb, 0xf9, 0xe5, 0xbc, 0xe5, 0x7c, 0x7b, 0xd1,
+	0xda, 0x78, 0x7a, 0xd1, 0xda, 0xf8, 0xf1, 0xa2, 0xb5, 0xf1, 0xc5, 0xfb, 0x6b, 0x82, 0x5d, 0xfb,
+	0x38, 0xd9, 0xfe, 0x9a, 0x25, 0x64, 0x1d, 0x18, 0x2c, 0xae, 0x7d, 0xe5, 0x68, 0x35, 0x8f, 0x8b,
+	0xfa, 0xf6, 0x7f, 0xeb, 0xef, 0x01, 0x00, 0xe3, 0x51, 0x73, 0x41, 0x11, 0x09, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataPointRetention != that1.DataPointRetention {
+		return false
+	}
+	if this.RewardPoolAccount != that1.RewardPoolAccount {
+		return false
+	}
+	if len(this.RewardsPerEpoch) != len(that1.RewardsPerEpoch) {
+		return false
+	}
+	for i := range this.RewardsPerEpoch {
+		if !this.RewardsPerEpoch[i].Equal(&that1.RewardsPerEpoch[i]) {
+			return false
+		}
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	return true
+}
 func (m *Query) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -353,6 +749,26 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExactHeight {
+		i--
+		if m.ExactHeight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Height != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Retries != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.LastEmission.Size()
 		i -= size
@@ -433,7 +849,7 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -443,54 +859,51 @@ func (m *DataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Value)))
+	if m.MaxRetries != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.MaxRetries))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	{
-		size := m.LocalHeight.Size()
-		i -= size
-		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.RewardsPerEpoch) > 0 {
+		for iNdEx := len(m.RewardsPerEpoch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerEpoch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RemoteHeight.Size()
-		i -= size
-		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	if len(m.RewardPoolAccount) > 0 {
+		i -= len(m.RewardPoolAccount)
+		copy(dAtA[i:], m.RewardPoolAccount)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.RewardPoolAccount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Id)))
+	if m.DataPointRetention != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.DataPointRetention))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsEventRequest) Marshal() (dAtA []byte, err error) {
+func (m *Relayer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -500,25 +913,227 @@ func (m *GetTxsEventRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxsEventRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Relayer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Relayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x32
+	if len(m.Rewarded) > 0 {
+		for iNdEx := len(m.Rewarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if m.Limit != 0 {
-		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Limit))
+	if m.EpochAccepted != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.EpochAccepted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rejected != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Rejected))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duplicates != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Duplicates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Accepted != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Accepted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalLatency != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.TotalLatency))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastResponseLocalHeight != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.LastResponseLocalHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastResponseHeight != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.LastResponseHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ResponseCount != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.ResponseCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EmissionCount != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.EmissionCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CallbackId) > 0 {
+		i -= len(m.CallbackId)
+		copy(dAtA[i:], m.CallbackId)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.CallbackId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QueryType) > 0 {
+		i -= len(m.QueryType)
+		copy(dAtA[i:], m.QueryType)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.QueryType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.LocalHeight.Size()
+		i -= size
+		if _, err := m.LocalHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RemoteHeight.Size()
+		i -= size
+		if _, err := m.RemoteHeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxsEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintInterchainquery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Limit != 0 {
+		i = encodeVarintInterchainquery(dAtA, i, uint64(m.Limit))
 		i--
 		dAtA[i] = 0x28
 	}
@@ -606,6 +1221,114 @@ func (m *Query) Size() (n int) {
 	}
 	l = m.LastEmission.Size()
 	n += 1 + l + sovInterchainquery(uint64(l))
+	if m.Retries != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Retries))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Height))
+	}
+	if m.ExactHeight {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataPointRetention != 0 {
+		n += 1 + sovInterchainquery(uint64(m.DataPointRetention))
+	}
+	l = len(m.RewardPoolAccount)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if len(m.RewardsPerEpoch) > 0 {
+		for _, e := range m.RewardsPerEpoch {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovInterchainquery(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *Relayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if m.Accepted != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Accepted))
+	}
+	if m.Duplicates != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Duplicates))
+	}
+	if m.Rejected != 0 {
+		n += 1 + sovInterchainquery(uint64(m.Rejected))
+	}
+	if m.EpochAccepted != 0 {
+		n += 1 + sovInterchainquery(uint64(m.EpochAccepted))
+	}
+	if len(m.Rewarded) > 0 {
+		for _, e := range m.Rewarded {
+			l = e.Size()
+			n += 1 + l + sovInterchainquery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	l = len(m.QueryType)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	l = len(m.CallbackId)
+	if l > 0 {
+		n += 1 + l + sovInterchainquery(uint64(l))
+	}
+	if m.EmissionCount != 0 {
+		n += 1 + sovInterchainquery(uint64(m.EmissionCount))
+	}
+	if m.ResponseCount != 0 {
+		n += 1 + sovInterchainquery(uint64(m.ResponseCount))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovInterchainquery(uint64(m.FailureCount))
+	}
+	if m.LastResponseHeight != 0 {
+		n += 1 + sovInterchainquery(uint64(m.LastResponseHeight))
+	}
+	if m.LastResponseLocalHeight != 0 {
+		n += 1 + sovInterchainquery(uint64(m.LastResponseLocalHeight))
+	}
+	if m.TotalLatency != 0 {
+		n += 1 + sovInterchainquery(uint64(m.TotalLatency))
+	}
 	return n
 }
 
@@ -1012,6 +1735,702 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactHeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExactHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPointRetention", wireType)
+			}
+			m.DataPointRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataPointRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPoolAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPoolAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerEpoch = append(m.RewardsPerEpoch, types.Coin{})
+			if err := m.RewardsPerEpoch[len(m.RewardsPerEpoch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Relayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Relayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Relayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			m.Accepted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accepted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duplicates", wireType)
+			}
+			m.Duplicates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duplicates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			m.Rejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAccepted", wireType)
+			}
+			m.EpochAccepted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochAccepted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewarded = append(m.Rewarded, types.Coin{})
+			if err := m.Rewarded[len(m.Rewarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCount", wireType)
+			}
+			m.EmissionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCount", wireType)
+			}
+			m.ResponseCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResponseHeight", wireType)
+			}
+			m.LastResponseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastResponseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResponseLocalHeight", wireType)
+			}
+			m.LastResponseLocalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastResponseLocalHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLatency", wireType)
+			}
+			m.TotalLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLatency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInterchainquery(dAtA[iNdEx:])
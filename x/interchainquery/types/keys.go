@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "interchainquery"
@@ -19,10 +23,24 @@ const (
 	prefixData         = 0x01
 	prefixQuery        = 0x02
 	prefixLatestHeight = 0x03
+	prefixQueryStats   = 0x04
+	prefixRelayer      = 0x05
 )
 
 var (
 	KeyPrefixData         = []byte{prefixData}
 	KeyPrefixQuery        = []byte{prefixQuery}
 	KeyPrefixLatestHeight = []byte{prefixLatestHeight}
+	KeyPrefixQueryStats   = []byte{prefixQueryStats}
+	KeyPrefixRelayer      = []byte{prefixRelayer}
 )
+
+// GetDataPointPrefix returns the store prefix for DataPoints of the given query id.
+func GetDataPointPrefix(id string) []byte {
+	return append([]byte(id), byte(0x00))
+}
+
+// GetDataPointKey returns the store key for a DataPoint of the given query id, indexed by sequence.
+func GetDataPointKey(id string, sequence uint64) []byte {
+	return append(GetDataPointPrefix(id), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	supplytypes "github.com/quicksilver-zone/quicksilver/x/supply/types"
+)
+
+var (
+	KeyDataPointRetention = []byte("DataPointRetention")
+	KeyRewardPoolAccount  = []byte("RewardPoolAccount")
+	KeyRewardsPerEpoch    = []byte("RewardsPerEpoch")
+	KeyMaxRetries         = []byte("MaxRetries")
+
+	DefaultDataPointRetention = uint64(0)
+	DefaultRewardPoolAccount  = ""
+	DefaultRewardsPerEpoch    = sdk.Coins{}
+	// DefaultMaxRetries is the number of times an expired non-periodic query is
+	// re-emitted before it is dropped and the timeout callback is invoked. It
+	// also applies while the max retries param is unset.
+	DefaultMaxRetries = uint64(3)
+)
+
+// ParamKeyTable for interchainquery module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new interchainquery Params instance.
+func NewParams(dataPointRetention uint64, rewardPoolAccount string, rewardsPerEpoch sdk.Coins, maxRetries uint64) Params {
+	return Params{
+		DataPointRetention: dataPointRetention,
+		RewardPoolAccount:  rewardPoolAccount,
+		RewardsPerEpoch:    rewardsPerEpoch,
+		MaxRetries:         maxRetries,
+	}
+}
+
+// DefaultParams default interchainquery params.
+func DefaultParams() Params {
+	return NewParams(DefaultDataPointRetention, DefaultRewardPoolAccount, DefaultRewardsPerEpoch, DefaultMaxRetries)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDataPointRetention, &p.DataPointRetention, validateUint64),
+		paramtypes.NewParamSetPair(KeyRewardPoolAccount, &p.RewardPoolAccount, validateRewardPoolAccount),
+		paramtypes.NewParamSetPair(KeyRewardsPerEpoch, &p.RewardsPerEpoch, validateCoins),
+		paramtypes.NewParamSetPair(KeyMaxRetries, &p.MaxRetries, validateUint64),
+	}
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateRewardPoolAccount restricts relayer rewards to being paid from the
+// x/supply incentive pool, such that no other module account may be drained.
+func validateRewardPoolAccount(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != "" && v != supplytypes.AirdropAccount {
+		return fmt.Errorf("invalid reward pool account %q; must be empty or %q", v, supplytypes.AirdropAccount)
+	}
+
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+// Validate performs stateless validity checks on params.
+func (p *Params) Validate() error {
+	if err := validateUint64(p.DataPointRetention); err != nil {
+		return err
+	}
+	if err := validateRewardPoolAccount(p.RewardPoolAccount); err != nil {
+		return err
+	}
+	if err := validateCoins(p.RewardsPerEpoch); err != nil {
+		return err
+	}
+	return validateUint64(p.MaxRetries)
+}
+
+// GetMaxRetriesOrDefault returns the max retries param, or DefaultMaxRetries if unset.
+func (p Params) GetMaxRetriesOrDefault() uint64 {
+	if p.MaxRetries == 0 {
+		return DefaultMaxRetries
+	}
+	return p.MaxRetries
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+	supplytypes "github.com/quicksilver-zone/quicksilver/x/supply/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("uqck", 40))
+
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"incentive pool", types.NewParams(0, supplytypes.AirdropAccount, rewards, 0), false},
+		{"other module account", types.NewParams(0, "distribution", rewards, 0), true},
+		{"unknown account", types.NewParams(0, "unknown", rewards, 0), true},
+		{"max retries", types.NewParams(0, "", nil, 10), false},
+		{"invalid rewards", types.NewParams(0, supplytypes.AirdropAccount, sdk.Coins{sdk.Coin{Denom: "uqck", Amount: sdk.NewInt(-1)}}, 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsGetMaxRetriesOrDefault(t *testing.T) {
+	require.Equal(t, types.DefaultMaxRetries, types.Params{}.GetMaxRetriesOrDefault())
+	require.Equal(t, uint64(10), types.NewParams(0, "", nil, 10).GetMaxRetriesOrDefault())
+}
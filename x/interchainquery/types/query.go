@@ -0,0 +1,14 @@
+package types
+
+// AcceptsHeight returns true if a response produced at the given remote height
+// satisfies the height requirement of the query.
+func (q Query) AcceptsHeight(height int64) bool {
+	switch {
+	case q.Height <= 0:
+		return true
+	case q.ExactHeight:
+		return height == q.Height
+	default:
+		return height >= q.Height
+	}
+}
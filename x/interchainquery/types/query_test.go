@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
+)
+
+func TestQueryAcceptsHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    types.Query
+		height   int64
+		expected bool
+	}{
+		{"latest, any height", types.Query{}, 10, true},
+		{"exact, matching height", types.Query{Height: 10, ExactHeight: true}, 10, true},
+		{"exact, later height", types.Query{Height: 10, ExactHeight: true}, 11, false},
+		{"exact, earlier height", types.Query{Height: 10, ExactHeight: true}, 9, false},
+		{"at or after, matching height", types.Query{Height: 10}, 10, true},
+		{"at or after, later height", types.Query{Height: 10}, 11, true},
+		{"at or after, earlier height", types.Query{Height: 10}, 9, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.query.AcceptsHeight(tt.height))
+		})
+	}
+}
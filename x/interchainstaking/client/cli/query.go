@@ -3,7 +3,9 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -40,6 +42,10 @@ func GetQueryCmd() *cobra.Command {
 		GetZoneRedelegationRecordsCmd(),
 		GetZoneValidatorsCmd(),
 		GetZoneCmd(),
+		GetRebalancePlanCmd(),
+		GetLiquidityBufferCmd(),
+		GetRedemptionRateHistoryCmd(),
+		GetRedemptionRateTWAPCmd(),
 	)
 
 	return cmd
@@ -501,3 +507,152 @@ func GetZoneCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRebalancePlanCmd returns the rebalancing that would be performed for the zone at the next epoch.
+func GetRebalancePlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plan [chain-id]",
+		Short: "Simulate rebalancing for a given chain against current state.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRebalancePlanRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.RebalancePlan(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetLiquidityBufferCmd returns the liquidity buffer of a zone.
+func GetLiquidityBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-buffer [chain-id]",
+		Short: "Query the instant redemption liquidity buffer for a given chain.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID := args[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryLiquidityBufferRequest{
+				ChainId: chainID,
+			}
+
+			res, err := queryClient.LiquidityBuffer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRedemptionRateHistoryCmd returns the redemption rate history of a zone.
+func GetRedemptionRateHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-history [chain-id] [from-epoch] [to-epoch]",
+		Short: "Query the recorded redemption rates for a given chain, optionally bounded by epoch.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking redemption-rate-history cosmoshub-4 100 200`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRedemptionRateHistoryRequest{
+				ChainId: args[0],
+			}
+			if len(args) > 1 {
+				if req.From, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid from-epoch %s: %w", args[1], err)
+				}
+			}
+			if len(args) > 2 {
+				if req.To, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+					return fmt.Errorf("invalid to-epoch %s: %w", args[2], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RedemptionRateHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRedemptionRateTWAPCmd returns the time weighted average redemption rate of a zone.
+func GetRedemptionRateTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-twap [chain-id] [window]",
+		Short: "Query the time weighted average redemption rate for a given chain over a window ending now.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query interchainstaking redemption-rate-twap cosmoshub-4 168h`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid window %s: %w", args[1], err)
+			}
+			if window < time.Second {
+				return errors.New("window must be at least one second")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRedemptionRateTWAPRequest{
+				ChainId: args[0],
+				Window:  uint64(window / time.Second),
+			}
+
+			res, err := queryClient.RedemptionRateTWAP(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -32,6 +32,7 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(GetSignalIntentTxCmd())
 	txCmd.AddCommand(GetRequestRedemptionTxCmd())
+	txCmd.AddCommand(GetInstantRedemptionTxCmd())
 	txCmd.AddCommand(GetReopenChannelTxCmd())
 	txCmd.AddCommand(GetCancelUnbondingTxCmd())
 	txCmd.AddCommand(GetRequeueUnbondingTxCmd())
@@ -148,6 +149,33 @@ func GetRequestRedemptionTxCmd() *cobra.Command {
 	return cmd
 }
 
+func GetInstantRedemptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instant-redeem [coin] [destination_address]",
+		Short: `Redeem tokens instantly from the zone liquidity buffer.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			destinationAddress := args[1]
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to parse coin %s", args[0])
+			}
+
+			msg := types.NewMsgInstantRedemption(coin, destinationAddress, clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetReopenChannelTxCmd returns a CLI command handler for creating a Reopen ICA port transaction.
 func GetReopenChannelTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
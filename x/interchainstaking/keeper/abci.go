@@ -73,6 +73,10 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) {
 			}
 		}
 
+		if err := k.HandleScheduledUnbondings(ctx, zone); err != nil {
+			k.Logger(ctx).Error("error in HandleScheduledUnbondings", "error", err.Error(), "chain_id", zone.ChainId)
+		}
+
 		connection, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, zone.ConnectionId)
 		if !found {
 			return false
@@ -26,7 +26,6 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	tmclienttypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 
-	lsmtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/utils/proofs"
@@ -38,16 +37,20 @@ import (
 
 type Callback func(*Keeper, sdk.Context, []byte, icqtypes.Query) error
 
+// TimeoutCallback is invoked when a query expires without a response.
+type TimeoutCallback func(*Keeper, sdk.Context, icqtypes.Query) error
+
 // Callbacks wrapper struct for interchainstaking keeper.
 type Callbacks struct {
 	k         *Keeper
 	callbacks map[string]Callback
+	timeouts  map[string]TimeoutCallback
 }
 
 var _ icqtypes.QueryCallbacks = Callbacks{}
 
 func (k *Keeper) CallbackHandler() Callbacks {
-	return Callbacks{k, make(map[string]Callback)}
+	return Callbacks{k, make(map[string]Callback), make(map[string]TimeoutCallback)}
 }
 
 // Call calls callback handler.
@@ -55,6 +58,8 @@ func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.
 	if !c.Has(id) {
 		return fmt.Errorf("callback %s not found", id)
 	}
+	// the query has been answered; a callback that re-requests it tags it again.
+	c.k.deleteWithdrawalWaitgroupQuery(ctx, query.Id)
 	return c.callbacks[id](c.k, ctx, args, query)
 }
 
@@ -68,6 +73,20 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+// CallTimeout calls the timeout handler for id, if one is registered.
+func (c Callbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	fn, found := c.timeouts[id]
+	if !found {
+		return nil
+	}
+	return fn(c.k, ctx, query)
+}
+
+func (c Callbacks) AddTimeoutCallback(id string, fn TimeoutCallback) Callbacks {
+	c.timeouts[id] = fn
+	return c
+}
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback("valset", Callback(ValsetCallback)).
@@ -88,7 +107,45 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback("signinginfo", Callback(SigningInfoCallback)).
 		AddCallback("lsminfo", Callback(LsmInfoCallback))
 
-	return a.(Callbacks)
+	// queries that hold a slot in the withdrawal waitgroup must release it on timeout.
+	return a.(Callbacks).
+		AddTimeoutCallback("rewards", WithdrawalWaitgroupTimeoutCallback).
+		AddTimeoutCallback("delegations_epoch", WithdrawalWaitgroupTimeoutCallback).
+		AddTimeoutCallback("delegation_epoch", WithdrawalWaitgroupTimeoutCallback).
+		AddTimeoutCallback("delegationaccountbalances", WithdrawalWaitgroupTimeoutCallback).
+		AddTimeoutCallback("delegationaccountbalance", WithdrawalWaitgroupTimeoutCallback)
+}
+
+// -----------------------------------
+// Timeout Handlers
+// -----------------------------------
+
+// WithdrawalWaitgroupTimeoutCallback decrements the withdrawal waitgroup for a query that expired
+// without a response, so the epoch flow is not blocked waiting for it indefinitely. Queries emitted
+// before the waitgroup was last reset no longer hold a slot, so their expiry is a no-op.
+func WithdrawalWaitgroupTimeoutCallback(k *Keeper, ctx sdk.Context, query icqtypes.Query) error {
+	zone, found := k.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	epoch, tagged := k.getWithdrawalWaitgroupQueryEpoch(ctx, query.Id)
+	k.deleteWithdrawalWaitgroupQuery(ctx, query.Id)
+	if tagged && epoch != k.GetWithdrawalWaitgroupEpoch(ctx, zone.ChainId) {
+		k.Logger(ctx).Info("Ignoring timeout of query from a previous epoch", "chain_id", zone.ChainId, "callback", query.CallbackId, "epoch", epoch)
+		return nil
+	}
+
+	if err := zone.DecrementWithdrawalWaitgroup(k.Logger(ctx), 1, fmt.Sprintf("%s query timeout", query.CallbackId)); err != nil {
+		return err
+	}
+	k.SetZone(ctx, &zone)
+
+	if zone.GetWithdrawalWaitgroup() == 0 {
+		k.Logger(ctx).Info("Triggering redemption rate update after query timeout", "chain_id", zone.ChainId, "callback", query.CallbackId)
+		return k.TriggerRedemptionRate(ctx, &zone)
+	}
+	return nil
 }
 
 // -----------------------------------
@@ -164,7 +221,7 @@ func delegationsCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes
 
 	k.Logger(ctx).Debug("Delegations callback triggered", "chain", zone.ChainId)
 
-	return k.UpdateDelegationRecordsForAddress(ctx, zone, delegationQuery.DelegatorAddr, args, isEpoch)
+	return k.UpdateDelegationRecordsForAddress(ctx, zone, delegationQuery.DelegatorAddr, args, isEpoch, query.Height)
 }
 
 func DelegationEpochCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
@@ -350,17 +407,16 @@ func LsmInfoCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Que
 
 	k.Logger(ctx).Debug("Validator liquid info callback", "zone", zone.ChainId)
 
-	lsmValInfo := lsmtypes.LiquidValidator{}
 	if len(args) == 0 {
 		k.Logger(ctx).Error("unable to find liquid info for validator", "query", query.Request)
 		return nil
 	}
-	err := k.cdc.Unmarshal(args, &lsmValInfo)
+	operatorAddress, liquidShares, err := k.LsmProviderForZone(&zone).ParseLiquidValidator(args)
 	if err != nil {
 		return err
 	}
 
-	validatorAddr, err := addressutils.ValAddressFromBech32(lsmValInfo.OperatorAddress, zone.GetValoperPrefix())
+	validatorAddr, err := addressutils.ValAddressFromBech32(operatorAddress, zone.GetValoperPrefix())
 	if err != nil {
 		return err
 	}
@@ -368,7 +424,7 @@ func LsmInfoCallback(k *Keeper, ctx sdk.Context, args []byte, query icqtypes.Que
 	if !found {
 		return fmt.Errorf("validator not found: %s", validatorAddr)
 	}
-	validator.LiquidShares = lsmValInfo.LiquidShares
+	validator.LiquidShares = liquidShares
 	if err := k.SetValidator(ctx, query.ChainId, validator); err != nil {
 		return err
 	}
@@ -700,7 +756,7 @@ func DelegationAccountBalanceCallback(k *Keeper, ctx sdk.Context, args []byte, q
 	k.SetZone(ctx, &zone)
 
 	// if token is not valid for staking, then send to withdrawal account.
-	if valid, _ := zone.ValidateCoinsForZone(sdk.NewCoins(coin), k.GetValidatorAddressesAsMap(ctx, zone.ChainId)); !valid {
+	if valid, _ := zone.ValidateCoinsForZone(sdk.NewCoins(coin), k.GetValidatorAddressesAsMap(ctx, zone.ChainId), k.LsmProviderForZone(&zone)); !valid {
 		k.Logger(ctx).Info("token is not a valid staking token, so sending to withdrawal account for disbursal", "chain", zone.ChainId, "assets", coin)
 		if zone.GetWithdrawalWaitgroup() == 0 {
 			k.Logger(ctx).Info("triggering redemption rate calc in lieu of delegation flush")
@@ -737,17 +793,7 @@ func DelegationAccountBalancesCallback(k *Keeper, ctx sdk.Context, args []byte,
 
 	for _, coin := range balances.Add(accountBalances...) { // we want to iterate over all denoms, including ones we currently have values for.
 
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			types.BankStoreKey,
-			append(banktypes.CreateAccountBalancesPrefix(addressBytes), coin.Denom...),
-			sdk.NewInt(-1),
-			types.ModuleName,
-			"delegationaccountbalance",
-			0,
-		)
+		k.makeWithdrawalWaitgroupRequest(ctx, &zone, types.BankStoreKey, append(banktypes.CreateAccountBalancesPrefix(addressBytes), coin.Denom...), "delegationaccountbalance", 0)
 
 		if err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, fmt.Sprintf("delegation account balance for %s", coin.Denom)); err != nil {
 			return err
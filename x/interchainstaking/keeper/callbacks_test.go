@@ -40,6 +40,7 @@ import (
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/utils/ica"
 	"github.com/quicksilver-zone/quicksilver/utils/proofs"
+	icqkeeper "github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
 	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
 	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
@@ -2777,6 +2778,94 @@ func (suite *KeeperTestSuite) TestDelegationAccountBalanceCallbackDenomMismatch(
 	})
 }
 
+func (suite *KeeperTestSuite) TestWithdrawalWaitgroupTimeoutCallback() {
+	suite.Run("waitgroup released on timeout", func() {
+		suite.SetupTest()
+		suite.setupTestZones()
+
+		app := suite.GetQuicksilverApp(suite.chainA)
+		handler := app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
+		ctx := suite.chainA.GetContext()
+
+		zone, _ := app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		zone.SetWithdrawalWaitgroup(app.Logger(), 2, "init")
+		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+		query := icqtypes.Query{ChainId: suite.chainB.ChainID, CallbackId: "rewards"}
+		suite.Require().NoError(handler.CallTimeout(ctx, "rewards", query))
+
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.Require().Equal(uint32(1), zone.GetWithdrawalWaitgroup())
+
+		// callbacks without a timeout handler are a no-op.
+		suite.Require().NoError(handler.CallTimeout(ctx, "valset", query))
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.Require().Equal(uint32(1), zone.GetWithdrawalWaitgroup())
+	})
+
+	suite.Run("query from a previous epoch", func() {
+		suite.SetupTest()
+		suite.setupTestZones()
+
+		app := suite.GetQuicksilverApp(suite.chainA)
+		handler := app.InterchainstakingKeeper.CallbackHandler().RegisterCallbacks()
+		ctx := suite.chainA.GetContext()
+
+		app.InterchainstakingKeeper.SetWithdrawalWaitgroupEpoch(ctx, suite.chainB.ChainID, 1)
+		zone, _ := app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		zone.SetWithdrawalWaitgroup(app.Logger(), 1, "init")
+		zone.DelegationAddress.Balance = sdk.NewCoins()
+		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+		// emit a delegationaccountbalance query holding a slot in the epoch 1 waitgroup.
+		reqbz, err := app.AppCodec().Marshal(&banktypes.QueryAllBalancesRequest{Address: zone.DelegationAddress.Address})
+		suite.Require().NoError(err)
+		respbz, err := app.AppCodec().Marshal(&banktypes.QueryAllBalancesResponse{Balances: sdk.NewCoins(sdk.NewCoin("uqck", sdk.OneInt()))})
+		suite.Require().NoError(err)
+		suite.Require().NoError(keeper.DelegationAccountBalancesCallback(app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: suite.chainB.ChainID, Request: reqbz}))
+
+		accAddr, err := sdk.AccAddressFromBech32(zone.DelegationAddress.Address)
+		suite.Require().NoError(err)
+		data := append(banktypes.CreateAccountBalancesPrefix(accAddr), "uqck"...)
+		id := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, icstypes.BankStoreKey, data, icstypes.ModuleName, "delegationaccountbalance")
+		query, found := app.InterchainQueryKeeper.GetQuery(ctx, id)
+		suite.Require().True(found)
+
+		// the waitgroup is reset at the next epoch and holds slots for the queries of that epoch.
+		app.InterchainstakingKeeper.SetWithdrawalWaitgroupEpoch(ctx, suite.chainB.ChainID, 2)
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		zone.SetWithdrawalWaitgroup(app.Logger(), 2, "next epoch")
+		app.InterchainstakingKeeper.SetZone(ctx, &zone)
+
+		suite.Require().NoError(handler.CallTimeout(ctx, query.CallbackId, query))
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.Require().Equal(uint32(2), zone.GetWithdrawalWaitgroup())
+
+		// a query of the current epoch releases its slot.
+		app.InterchainQueryKeeper.DeleteQuery(ctx, id)
+		suite.Require().NoError(keeper.DelegationAccountBalancesCallback(app.InterchainstakingKeeper, ctx, respbz, icqtypes.Query{ChainId: suite.chainB.ChainID, Request: reqbz}))
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.Require().Equal(uint32(2), zone.GetWithdrawalWaitgroup())
+
+		query, found = app.InterchainQueryKeeper.GetQuery(ctx, id)
+		suite.Require().True(found)
+		suite.Require().NoError(handler.CallTimeout(ctx, query.CallbackId, query))
+		zone, _ = app.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+		suite.Require().Equal(uint32(1), zone.GetWithdrawalWaitgroup())
+	})
+
+	suite.Run("bad chain", func() {
+		suite.SetupTest()
+		suite.setupTestZones()
+
+		app := suite.GetQuicksilverApp(suite.chainA)
+		ctx := suite.chainA.GetContext()
+
+		err := keeper.WithdrawalWaitgroupTimeoutCallback(app.InterchainstakingKeeper, ctx, icqtypes.Query{ChainId: "badchain"})
+		suite.Require().Error(err)
+	})
+}
+
 func (suite *KeeperTestSuite) TestDelegationAccountBalancesCallbackNoWg() {
 	tcs := []struct {
 		Name               string
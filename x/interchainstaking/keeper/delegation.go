@@ -13,7 +13,6 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	lsmstakingtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
@@ -204,12 +203,15 @@ func (*Keeper) PrepareDelegationMessagesForCoins(zone *types.Zone, allocations m
 	return msgs
 }
 
-func (*Keeper) PrepareDelegationMessagesForShares(zone *types.Zone, coins sdk.Coins) []sdk.Msg {
+func (k *Keeper) PrepareDelegationMessagesForShares(zone *types.Zone, coins sdk.Coins) []sdk.Msg {
 	var msgs []sdk.Msg
+	provider := k.LsmProviderForZone(zone)
 	for _, coin := range coins.Sort() {
 		if coin.IsPositive() {
 			// no min amount here.
-			msgs = append(msgs, &lsmstakingtypes.MsgRedeemTokensForShares{DelegatorAddress: zone.DelegationAddress.Address, Amount: coin})
+			if msg := provider.NewRedeemTokensMsg(zone.DelegationAddress.Address, coin); msg != nil {
+				msgs = append(msgs, msg)
+			}
 		}
 	}
 	return msgs
@@ -223,13 +225,11 @@ func (k Keeper) DetermineMaximumValidatorAllocations(ctx sdk.Context, zone *type
 		return out
 	}
 
+	provider := k.lsmCapsProvider(zone)
 	for _, val := range k.GetValidators(ctx, zone.ChainId) {
-		// validator bond max
-		maxBondShares := val.ValidatorBondShares.Mul(caps.ValidatorBondCap).Sub(val.LiquidShares)
-
-		// validator pc max
-		maxLiquidStakedShares := sdk.NewDecFromInt(val.VotingPower).Mul(caps.ValidatorCap).Sub(val.LiquidShares)
-		out[val.ValoperAddress] = sdkmath.MaxInt(sdk.ZeroInt(), sdkmath.MinInt(maxBondShares.TruncateInt(), maxLiquidStakedShares.TruncateInt()))
+		if maxShares, ok := provider.MaxLiquidAllocation(*caps, val); ok {
+			out[val.ValoperAddress] = maxShares
+		}
 	}
 
 	return out
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -441,3 +443,96 @@ func (k *Keeper) ClaimedPercentageByClaimType(c context.Context, req *types.Quer
 
 	return &types.QueryClaimedPercentageResponse{Percentage: percentage}, nil
 }
+
+func (k *Keeper) RebalancePlan(c context.Context, req *types.QueryRebalancePlanRequest) (*types.QueryRebalancePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	plan, err := k.SimulateRebalance(ctx, &zone)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return plan, nil
+}
+
+func (k *Keeper) LiquidityBuffer(c context.Context, req *types.QueryLiquidityBufferRequest) (*types.QueryLiquidityBufferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	zone, found := k.GetZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	buffer := types.NewLiquidityBuffer()
+	if zone.LiquidityBuffer != nil {
+		buffer = zone.LiquidityBuffer
+	}
+
+	return &types.QueryLiquidityBufferResponse{
+		Buffer:    *buffer,
+		Available: sdk.NewCoin(zone.BaseDenom, buffer.Available()),
+		Rate:      zone.InstantRedemptionRate(),
+	}, nil
+}
+
+func (k *Keeper) RedemptionRateHistory(c context.Context, req *types.QueryRedemptionRateHistoryRequest) (*types.QueryRedemptionRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.To != 0 && req.From > req.To {
+		return nil, status.Error(codes.InvalidArgument, "from must not be greater than to")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	samples := make([]types.RedemptionRateSample, 0)
+	k.IterateRedemptionRateSamples(ctx, req.ChainId, func(_ int64, sample types.RedemptionRateSample) (stop bool) {
+		if req.To != 0 && sample.Epoch > req.To {
+			return true
+		}
+		if sample.Epoch >= req.From {
+			samples = append(samples, sample)
+		}
+		return false
+	})
+
+	return &types.QueryRedemptionRateHistoryResponse{Samples: samples}, nil
+}
+
+func (k *Keeper) RedemptionRateTWAP(c context.Context, req *types.QueryRedemptionRateTWAPRequest) (*types.QueryRedemptionRateTWAPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Window == 0 || req.Window > math.MaxInt64/uint64(time.Second) {
+		return nil, status.Error(codes.InvalidArgument, "window must be a positive number of seconds")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no zone found matching %s", req.GetChainId()))
+	}
+
+	end := ctx.BlockTime()
+	start := end.Add(-time.Duration(req.Window) * time.Second) //nolint:gosec
+	twap, coveredFrom, count, ok := types.RedemptionRateTWAP(k.GetRedemptionRateHistory(ctx, req.ChainId), start, end)
+	if !ok {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no redemption rate history for %s", req.GetChainId()))
+	}
+
+	return &types.QueryRedemptionRateTWAPResponse{
+		Twap:      twap,
+		StartTime: coveredFrom,
+		EndTime:   end,
+		Samples:   count,
+	}, nil
+}
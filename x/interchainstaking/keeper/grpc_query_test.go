@@ -13,6 +13,7 @@ import (
 	"github.com/quicksilver-zone/quicksilver/utils/randomutils"
 	claimsmanagertypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
 
@@ -1068,6 +1069,7 @@ func (suite *KeeperTestSuite) TestKeeper_UnbondingRecords() {
 					types.UnbondingRecord{
 						ChainId:       zone.ChainId,
 						EpochNumber:   1,
+						BatchId:       1,
 						Validator:     icsKeeper.GetValidators(ctx, suite.chainB.ChainID)[0].ValoperAddress,
 						RelatedTxhash: []string{"ABC012"},
 					},
@@ -1889,3 +1891,368 @@ func (suite *KeeperTestSuite) TestKeeper_ClaimedPercentageByClaimType() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_RebalancePlan() {
+	tests := []struct {
+		name     string
+		malleate func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone *types.Zone, vals []types.Validator)
+		req      func(zone types.Zone) *types.QueryRebalancePlanRequest
+		wantErr  bool
+		check    func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone types.Zone, vals []types.Validator, resp *types.QueryRebalancePlanResponse)
+	}{
+		{
+			name:     "nil request",
+			malleate: func(sdk.Context, *keeper.Keeper, *types.Zone, []types.Validator) {},
+			req:      func(types.Zone) *types.QueryRebalancePlanRequest { return nil },
+			wantErr:  true,
+		},
+		{
+			name:     "unknown zone",
+			malleate: func(sdk.Context, *keeper.Keeper, *types.Zone, []types.Validator) {},
+			req: func(types.Zone) *types.QueryRebalancePlanRequest {
+				return &types.QueryRebalancePlanRequest{ChainId: "unknown-1"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "intent change redelegates from overallocated validator",
+			malleate: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone *types.Zone, vals []types.Validator) {
+				zone.AggregateIntent = types.ValidatorIntents{
+					{ValoperAddress: vals[0].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[1].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[2].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[3].ValoperAddress, Weight: sdk.NewDecWithPrec(1, 1)},
+				}
+			},
+			req: func(zone types.Zone) *types.QueryRebalancePlanRequest {
+				return &types.QueryRebalancePlanRequest{ChainId: zone.ChainId}
+			},
+			check: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone types.Zone, vals []types.Validator, resp *types.QueryRebalancePlanResponse) {
+				suite.Len(resp.Targets, 3)
+				for _, target := range resp.Targets {
+					suite.Equal(math.NewInt(200_000_000), target.Amount)
+				}
+				suite.Len(resp.Sources, 1)
+				suite.Equal(vals[3].ValoperAddress, resp.Sources[0].ValoperAddress)
+				suite.Equal(math.NewInt(600_000_000), resp.Sources[0].Amount)
+
+				suite.Len(resp.Redelegations, 3)
+				for _, msg := range resp.Redelegations {
+					suite.Equal(zone.DelegationAddress.Address, msg.DelegatorAddress)
+					suite.Equal(vals[3].ValoperAddress, msg.ValidatorSrcAddress)
+					suite.Equal(sdk.NewCoin(zone.BaseDenom, math.NewInt(200_000_000)), msg.Amount)
+				}
+
+				suite.Empty(resp.LockedValidators)
+				suite.Empty(resp.RedelegationRecords)
+				suite.Equal("0.173205", resp.DistanceToTarget)
+				suite.Equal("0.000000", resp.PlannedDistanceToTarget)
+
+				// the plan must not be persisted.
+				suite.Empty(icsKeeper.ZoneRedelegationRecords(ctx, zone.ChainId))
+			},
+		},
+		{
+			name: "capped turnover strategy limits redelegations",
+			malleate: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone *types.Zone, vals []types.Validator) {
+				zone.AggregateIntent = types.ValidatorIntents{
+					{ValoperAddress: vals[0].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[1].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[2].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[3].ValoperAddress, Weight: sdk.NewDecWithPrec(1, 1)},
+				}
+				zone.RebalanceStrategy = types.RebalanceStrategyCappedTurnover
+				zone.RebalanceMaxTurnover = sdk.NewDecWithPrec(5, 2)
+			},
+			req: func(zone types.Zone) *types.QueryRebalancePlanRequest {
+				return &types.QueryRebalancePlanRequest{ChainId: zone.ChainId}
+			},
+			check: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone types.Zone, vals []types.Validator, resp *types.QueryRebalancePlanResponse) {
+				suite.Len(resp.Targets, 3)
+				suite.Len(resp.Sources, 1)
+				suite.Len(resp.Redelegations, 1)
+				suite.Equal(vals[3].ValoperAddress, resp.Redelegations[0].ValidatorSrcAddress)
+				suite.Equal(sdk.NewCoin(zone.BaseDenom, math.NewInt(200_000_000)), resp.Redelegations[0].Amount)
+			},
+		},
+		{
+			name: "locked validator is not a source",
+			malleate: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone *types.Zone, vals []types.Validator) {
+				zone.AggregateIntent = types.ValidatorIntents{
+					{ValoperAddress: vals[0].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[1].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[2].ValoperAddress, Weight: sdk.NewDecWithPrec(3, 1)},
+					{ValoperAddress: vals[3].ValoperAddress, Weight: sdk.NewDecWithPrec(1, 1)},
+				}
+				delegation, found := icsKeeper.GetDelegation(ctx, zone.ChainId, zone.DelegationAddress.Address, vals[3].ValoperAddress)
+				suite.True(found)
+				delegation.RedelegationEnd = ctx.BlockTime().Add(time.Hour).Unix()
+				icsKeeper.SetDelegation(ctx, zone.ChainId, delegation)
+				icsKeeper.SetRedelegationRecord(ctx, types.RedelegationRecord{
+					ChainId:        zone.ChainId,
+					EpochNumber:    1,
+					Source:         vals[0].ValoperAddress,
+					Destination:    vals[3].ValoperAddress,
+					Amount:         math.NewInt(100_000_000),
+					CompletionTime: ctx.BlockTime().Add(time.Hour),
+				})
+			},
+			req: func(zone types.Zone) *types.QueryRebalancePlanRequest {
+				return &types.QueryRebalancePlanRequest{ChainId: zone.ChainId}
+			},
+			check: func(ctx sdk.Context, icsKeeper *keeper.Keeper, zone types.Zone, vals []types.Validator, resp *types.QueryRebalancePlanResponse) {
+				suite.Len(resp.Targets, 3)
+				suite.Empty(resp.Sources)
+				suite.Empty(resp.Redelegations)
+				suite.Equal([]string{vals[3].ValoperAddress}, resp.LockedValidators)
+				suite.Len(resp.RedelegationRecords, 1)
+				suite.Equal(resp.DistanceToTarget, resp.PlannedDistanceToTarget)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+			suite.True(found)
+
+			for _, delegation := range icsKeeper.GetAllDelegations(ctx, zone.ChainId) {
+				suite.NoError(icsKeeper.RemoveDelegation(ctx, zone.ChainId, delegation))
+			}
+			vals := icsKeeper.GetValidators(ctx, zone.ChainId)
+			suite.GreaterOrEqual(len(vals), 4)
+			for _, val := range vals[:4] {
+				icsKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, val.ValoperAddress, sdk.NewCoin(zone.BaseDenom, math.NewInt(1_000_000_000))))
+			}
+
+			tt.malleate(ctx, icsKeeper, &zone, vals)
+			icsKeeper.SetZone(ctx, &zone)
+
+			resp, err := icsKeeper.RebalancePlan(ctx, tt.req(zone))
+			if tt.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			tt.check(ctx, icsKeeper, zone, vals, resp)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_LiquidityBuffer() {
+	tests := []struct {
+		name            string
+		malleate        func(zone *types.Zone)
+		req             func(zone types.Zone) *types.QueryLiquidityBufferRequest
+		wantErr         bool
+		expectAvailable math.Int
+		expectRate      sdk.Dec
+	}{
+		{
+			name:     "nil request",
+			malleate: func(*types.Zone) {},
+			req:      func(types.Zone) *types.QueryLiquidityBufferRequest { return nil },
+			wantErr:  true,
+		},
+		{
+			name:     "unknown zone",
+			malleate: func(*types.Zone) {},
+			req: func(types.Zone) *types.QueryLiquidityBufferRequest {
+				return &types.QueryLiquidityBufferRequest{ChainId: "unknown-1"}
+			},
+			wantErr: true,
+		},
+		{
+			name:     "no liquidity buffer",
+			malleate: func(zone *types.Zone) { zone.LiquidityBuffer = nil },
+			req: func(zone types.Zone) *types.QueryLiquidityBufferRequest {
+				return &types.QueryLiquidityBufferRequest{ChainId: zone.ChainId}
+			},
+			expectAvailable: math.ZeroInt(),
+			expectRate:      sdk.OneDec(),
+		},
+		{
+			name: "bounded by balance",
+			malleate: func(zone *types.Zone) {
+				zone.LiquidityBuffer.Balance = math.NewInt(3000000)
+			},
+			req: func(zone types.Zone) *types.QueryLiquidityBufferRequest {
+				return &types.QueryLiquidityBufferRequest{ChainId: zone.ChainId}
+			},
+			expectAvailable: math.NewInt(3000000),
+			expectRate:      sdk.NewDecWithPrec(99, 2),
+		},
+		{
+			name: "bounded by epoch cap",
+			malleate: func(zone *types.Zone) {
+				zone.LiquidityBuffer.RedeemedThisEpoch = math.NewInt(6000000)
+			},
+			req: func(zone types.Zone) *types.QueryLiquidityBufferRequest {
+				return &types.QueryLiquidityBufferRequest{ChainId: zone.ChainId}
+			},
+			expectAvailable: math.NewInt(2000000),
+			expectRate:      sdk.NewDecWithPrec(99, 2),
+		},
+		{
+			name: "disabled",
+			malleate: func(zone *types.Zone) {
+				zone.LiquidityBuffer.Enabled = false
+			},
+			req: func(zone types.Zone) *types.QueryLiquidityBufferRequest {
+				return &types.QueryLiquidityBufferRequest{ChainId: zone.ChainId}
+			},
+			expectAvailable: math.ZeroInt(),
+			expectRate:      sdk.NewDecWithPrec(99, 2),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			zone := suite.setupLiquidityBuffer(ctx)
+			tt.malleate(&zone)
+			icsKeeper.SetZone(ctx, &zone)
+
+			resp, err := icsKeeper.LiquidityBuffer(ctx, tt.req(zone))
+			if tt.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(sdk.NewCoin(zone.BaseDenom, tt.expectAvailable), resp.Available)
+			suite.Equal(tt.expectRate, resp.Rate)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedemptionRateHistory() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	for epoch := int64(1); epoch <= 5; epoch++ {
+		icsKeeper.SetRedemptionRateSample(ctx, types.RedemptionRateSample{
+			ChainId: zone.ChainId,
+			Epoch:   epoch,
+			Height:  epoch * 100,
+			Time:    ctx.BlockTime().Add(time.Duration(epoch) * time.Hour),
+			Rate:    sdk.OneDec(),
+			Tvl:     math.NewInt(1000),
+		})
+	}
+
+	tests := []struct {
+		name         string
+		req          *types.QueryRedemptionRateHistoryRequest
+		wantErr      bool
+		expectEpochs []int64
+	}{
+		{
+			name:    "nil request",
+			req:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "unknown zone",
+			req:     &types.QueryRedemptionRateHistoryRequest{ChainId: "unknown-1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid range",
+			req:     &types.QueryRedemptionRateHistoryRequest{ChainId: zone.ChainId, From: 4, To: 2},
+			wantErr: true,
+		},
+		{
+			name:         "unbounded",
+			req:          &types.QueryRedemptionRateHistoryRequest{ChainId: zone.ChainId},
+			expectEpochs: []int64{1, 2, 3, 4, 5},
+		},
+		{
+			name:         "from",
+			req:          &types.QueryRedemptionRateHistoryRequest{ChainId: zone.ChainId, From: 3},
+			expectEpochs: []int64{3, 4, 5},
+		},
+		{
+			name:         "from and to",
+			req:          &types.QueryRedemptionRateHistoryRequest{ChainId: zone.ChainId, From: 2, To: 3},
+			expectEpochs: []int64{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			resp, err := icsKeeper.RedemptionRateHistory(ctx, tt.req)
+			if tt.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			epochs := make([]int64, 0, len(resp.Samples))
+			for _, sample := range resp.Samples {
+				epochs = append(epochs, sample.Epoch)
+			}
+			suite.Equal(tt.expectEpochs, epochs)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RedemptionRateTWAP() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	resp, err := icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: zone.ChainId, Window: 3600})
+	suite.Error(err, "no history recorded")
+	suite.Nil(resp)
+
+	now := ctx.BlockTime()
+	icsKeeper.SetRedemptionRateSample(ctx, types.RedemptionRateSample{ChainId: zone.ChainId, Height: 1, Time: now.Add(-20 * time.Hour), Rate: sdk.MustNewDecFromStr("1.0"), Tvl: math.NewInt(1000)})
+	icsKeeper.SetRedemptionRateSample(ctx, types.RedemptionRateSample{ChainId: zone.ChainId, Height: 2, Time: now.Add(-10 * time.Hour), Rate: sdk.MustNewDecFromStr("1.2"), Tvl: math.NewInt(1000)})
+
+	_, err = icsKeeper.RedemptionRateTWAP(ctx, nil)
+	suite.Error(err)
+	_, err = icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: zone.ChainId})
+	suite.Error(err)
+	_, err = icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: "unknown-1", Window: 3600})
+	suite.Error(err)
+
+	// 10h at 1.0 and 10h at 1.2.
+	resp, err = icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: zone.ChainId, Window: 20 * 3600})
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("1.1"), resp.Twap)
+	suite.Equal(uint32(2), resp.Samples)
+	suite.True(now.Add(-20 * time.Hour).Equal(resp.StartTime))
+	suite.True(now.Equal(resp.EndTime))
+
+	// the window is truncated to the recorded history.
+	resp, err = icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: zone.ChainId, Window: 40 * 3600})
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("1.1"), resp.Twap)
+	suite.True(now.Add(-20 * time.Hour).Equal(resp.StartTime))
+
+	resp, err = icsKeeper.RedemptionRateTWAP(ctx, &types.QueryRedemptionRateTWAPRequest{ChainId: zone.ChainId, Window: 5 * 3600})
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("1.2"), resp.Twap)
+	suite.Equal(uint32(1), resp.Samples)
+}
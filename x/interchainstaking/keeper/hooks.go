@@ -96,30 +96,41 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 			return false
 		}
 
-		k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusUnbond, func(idx int64, record types.WithdrawalRecord) bool {
-			if (record.Status == types.WithdrawStatusUnbond) && !record.Acknowledged && record.EpochNumber < epochNumber {
-				record.Requeued = true
-				k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusQueued)
-			}
-			return false
-		})
+		// zones with an unbonding interval requeue unacknowledged withdrawals when their next batch is due.
+		if !zone.HasUnbondingInterval() {
+			k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusUnbond, func(idx int64, record types.WithdrawalRecord) bool {
+				if (record.Status == types.WithdrawStatusUnbond) && !record.Acknowledged && record.EpochNumber < epochNumber {
+					record.Requeued = true
+					k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusQueued)
+				}
+				return false
+			})
+		}
 
 		if zone.GetWithdrawalWaitgroup() > 0 {
 			zone.SetWithdrawalWaitgroup(k.Logger(ctx), 0, "epoch waitgroup was unexpected > 0")
 		}
-
-		if err := k.HandleQueuedUnbondings(ctx, zone, epochNumber); err != nil {
-			// we can and need not panic here; logging the error is sufficient.
-			// an error here is not expected, but also not terminal.
-			// we don't return on failure here as we still want to attempt
-			// the unrelated tasks below.
-			k.Logger(ctx).Error(
-				"encountered a problem handling queued unbondings",
-				"error", err.Error(),
-				"chain_id", zone.ChainId,
-				"epoch_identifier", epochIdentifier,
-				"epoch_number", epochNumber,
-			)
+		// queries emitted before this point no longer hold a slot in the waitgroup.
+		k.SetWithdrawalWaitgroupEpoch(ctx, zone.ChainId, epochNumber)
+
+		// instant redemption caps apply per epoch.
+		zone.LiquidityBuffer.ResetEpoch()
+
+		// zones with an unbonding interval are batched in BeginBlocker.
+		if !zone.HasUnbondingInterval() {
+			if err := k.HandleQueuedUnbondings(ctx, zone, epochNumber); err != nil {
+				// we can and need not panic here; logging the error is sufficient.
+				// an error here is not expected, but also not terminal.
+				// we don't return on failure here as we still want to attempt
+				// the unrelated tasks below.
+				k.Logger(ctx).Error(
+					"encountered a problem handling queued unbondings",
+					"error", err.Error(),
+					"chain_id", zone.ChainId,
+					"epoch_identifier", epochIdentifier,
+					"epoch_number", epochNumber,
+				)
+			}
 		}
 
 		err = k.Rebalance(ctx, zone, epochNumber)
@@ -149,50 +160,20 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 		delegationQuery := stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: zone.DelegationAddress.Address, Pagination: &query.PageRequest{Limit: uint64(len(vals))}}
 		bz := k.cdc.MustMarshal(&delegationQuery)
 
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.staking.v1beta1.Query/DelegatorDelegations",
-			bz,
-			sdk.NewInt(-1),
-			types.ModuleName,
-			"delegations_epoch",
-			0,
-		)
+		k.makeWithdrawalWaitgroupRequest(ctx, zone, "cosmos.staking.v1beta1.Query/DelegatorDelegations", bz, "delegations_epoch", 0)
 
 		_ = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, "delegations trigger")
 
 		balancesQuery := banktypes.QueryAllBalancesRequest{Address: zone.DelegationAddress.Address}
 		bz = k.cdc.MustMarshal(&balancesQuery)
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.bank.v1beta1.Query/AllBalances",
-			bz,
-			sdk.NewInt(-1),
-			types.ModuleName,
-			"delegationaccountbalances",
-			0,
-		)
+		k.makeWithdrawalWaitgroupRequest(ctx, zone, "cosmos.bank.v1beta1.Query/AllBalances", bz, "delegationaccountbalances", 0)
 		// increment waitgroup; decremented in delegationaccountbalance callback
 		_ = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, "delegationaccountbalances trigger")
 
 		rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.DelegationAddress.Address}
 		bz = k.cdc.MustMarshal(&rewardsQuery)
 
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
-			bz,
-			sdk.NewInt(-1),
-			types.ModuleName,
-			"rewards",
-			0,
-		)
+		k.makeWithdrawalWaitgroupRequest(ctx, zone, "cosmos.distribution.v1beta1.Query/DelegationTotalRewards", bz, "rewards", 0)
 
 		// increment the WithdrawalWaitgroup
 		// this allows us to track the response for every protocol delegator
@@ -27,7 +27,6 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
-	lsmstakingtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
@@ -130,6 +129,48 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 			return fmt.Errorf("could not find msgresponse for index %d", msgIndex)
 		}
 
+		// lsm messages are routed by type to the provider that built them, rather than by the
+		// current provider of the zone, which may have changed while they were in flight.
+		if provider, found := k.lsmProviderForMsgType(msg.Type); found {
+			switch msg.Type {
+			case provider.RedeemTokensMsgType():
+				if !success {
+					if err := k.HandleFailedRedeemTokens(ctx, msg.Msg, packetData.Memo, connectionID); err != nil {
+						return err
+					}
+					continue
+				}
+				amount, err := provider.ParseRedeemTokensResponse(msgResponse)
+				if err != nil {
+					k.Logger(ctx).Error("unable to unmarshal redeem tokens response", "type", msg.Type, "error", err)
+					return err
+				}
+
+				k.Logger(ctx).Info("Tokens redeemed for shares", "amount", amount)
+				// we should update delegation records here.
+				if err := k.HandleRedeemTokens(ctx, msg.Msg, amount, packetData.Memo, connectionID); err != nil {
+					return err
+				}
+				continue
+			case provider.TokenizeSharesMsgType():
+				if !success {
+					// We can safely ignore this, as this can reasonably fail, and we cater for this in the flush logic.
+					return nil
+				}
+				amount, err := provider.ParseTokenizeSharesResponse(msgResponse)
+				if err != nil {
+					k.Logger(ctx).Error("unable to unpack tokenize shares response", "type", msg.Type, "error", err)
+					return err
+				}
+
+				k.Logger(ctx).Info("Shares tokenized", "amount", amount)
+				if err := k.HandleTokenizedShares(ctx, msg.Msg, amount, packetData.Memo, connectionID); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
 		switch msg.Type {
 		case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
 			if !success {
@@ -145,45 +186,6 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Pack
 				return err
 			}
 			continue
-		case "/gaia.liquid.v1beta1.MsgRedeemTokensForShares":
-			if !success {
-				if err := k.HandleFailedRedeemTokens(ctx, msg.Msg, packetData.Memo); err != nil {
-					return err
-				}
-				continue
-			}
-			response := lsmstakingtypes.MsgRedeemTokensForSharesResponse{}
-
-			err = proto.Unmarshal(msgResponse, &response)
-			if err != nil {
-				k.Logger(ctx).Error("unable to unmarshal MsgRedeemTokensForShares response", "error", err)
-				return err
-			}
-
-			k.Logger(ctx).Info("Tokens redeemed for shares", "response", response)
-			// we should update delegation records here.
-			if err := k.HandleRedeemTokens(ctx, msg.Msg, response.Amount, packetData.Memo, connectionID); err != nil {
-				return err
-			}
-			continue
-		case "/gaia.liquid.v1beta1.MsgTokenizeShares":
-			if !success {
-				// We can safely ignore this, as this can reasonably fail, and we cater for this in the flush logic.
-				return nil
-			}
-			response := lsmstakingtypes.MsgTokenizeSharesResponse{}
-
-			err = proto.Unmarshal(msgResponse, &response)
-			if err != nil {
-				k.Logger(ctx).Error("unable to unpack MsgTokenizeShares response", "error", err)
-				return err
-			}
-
-			k.Logger(ctx).Info("Shares tokenized", "response", response)
-			if err := k.HandleTokenizedShares(ctx, msg.Msg, response.Amount, packetData.Memo); err != nil {
-				return err
-			}
-			continue
 		case "/cosmos.staking.v1beta1.MsgDelegate":
 			if !success {
 				if err := k.HandleFailedDelegate(ctx, msg.Msg, packetData.Memo); err != nil {
@@ -405,6 +407,10 @@ func (k *Keeper) HandleCompleteSend(ctx sdk.Context, msg sdk.Msg, memo string, c
 		k.Logger(ctx).Info("delegate account send tokens; handling withdrawal", "amount", sMsg.Amount, "memo", memo)
 		return k.HandleWithdrawForUser(ctx, zone, sMsg, memo)
 
+	case zone.IsDepositAddress(sMsg.FromAddress) && strings.HasPrefix(memo, types.MsgTypeUnbondSend):
+		k.Logger(ctx).Info("deposit account send tokens; handling instant redemption", "amount", sMsg.Amount, "memo", memo)
+		return k.HandleCompleteInstantRedemption(ctx, zone, memo)
+
 	case zone.IsDepositAddress(sMsg.FromAddress) && memo == "refund":
 		k.Logger(ctx).Info("unable to process deposit, returning funds to sender", "recipient", sMsg.ToAddress, "amount", sMsg.Amount, "memo", memo)
 		return nil
@@ -567,8 +573,8 @@ func (k *Keeper) HandleMaturedWithdrawals(ctx sdk.Context, zone *types.Zone) err
 func (k *Keeper) HandleMaturedUnbondings(ctx sdk.Context) {
 	k.IterateUnbondingRecords(ctx, func(idx int64, record types.UnbondingRecord) bool {
 		if ctx.BlockTime().After(record.CompletionTime) && !record.CompletionTime.Equal(time.Time{}) {
-			k.Logger(ctx).Info("found matured unbonding", "chain", record.ChainId, "validator", record.Validator, "epoch", record.EpochNumber, "completion", record.CompletionTime)
-			k.DeleteUnbondingRecord(ctx, record.ChainId, record.Validator, record.EpochNumber)
+			k.Logger(ctx).Info("found matured unbonding", "chain", record.ChainId, "validator", record.Validator, "batch", record.BatchId, "completion", record.CompletionTime)
+			k.DeleteUnbondingRecord(ctx, record.ChainId, record.Validator, record.BatchId)
 		}
 		return false
 	})
@@ -586,19 +592,23 @@ func (k *Keeper) GetInflightUnbondingAmount(ctx sdk.Context, zone *types.Zone) s
 	return outCoin
 }
 
-func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, sharesAmount sdk.Coin, memo string) error {
+func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, sharesAmount sdk.Coin, memo string, connectionID string) error {
 	var err error
 	k.Logger(ctx).Info("received MsgTokenizeShares acknowledgement")
-	// first, type assertion. we should have stakingtypes.MsgTokenizeShares
-	tsMsg, ok := msg.(*lsmstakingtypes.MsgTokenizeShares)
-	if !ok {
-		k.Logger(ctx).Error("unable to cast source message to MsgTokenizeShares")
-		return errors.New("unable to cast source message to MsgTokenizeShares")
+	provider, found := k.lsmProviderForMsgType(sdk.MsgTypeURL(msg))
+	if !found {
+		k.Logger(ctx).Error("no lsm provider found for tokenize shares message", "type", sdk.MsgTypeURL(msg), "connection_id", connectionID)
+		return fmt.Errorf("no lsm provider found for message type %s", sdk.MsgTypeURL(msg))
+	}
+	delegatorAddress, err := provider.ParseTokenizeSharesMsg(msg)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return err
 	}
 
-	zone, found := k.GetZoneForDelegateAccount(ctx, tsMsg.DelegatorAddress)
+	zone, found := k.GetZoneForDelegateAccount(ctx, delegatorAddress)
 	if !found {
-		return fmt.Errorf("zone for delegate account %s not found", tsMsg.DelegatorAddress)
+		return fmt.Errorf("zone for delegate account %s not found", delegatorAddress)
 	}
 	withdrawalRecord, found := k.GetWithdrawalRecord(ctx, zone.ChainId, memo, types.WithdrawStatusTokenize)
 
@@ -610,7 +620,7 @@ func (k *Keeper) HandleTokenizedShares(ctx sdk.Context, msg sdk.Msg, sharesAmoun
 	// Try to find a matching distribution
 	matchFound := false
 	for _, dist := range withdrawalRecord.Distribution {
-		if equalLsmCoin(dist.Valoper, dist.Amount, sharesAmount) {
+		if equalLsmCoin(provider, dist.Valoper, dist.Amount, sharesAmount) {
 			withdrawalRecord.Amount = withdrawalRecord.Amount.Add(sharesAmount)
 			matchFound = true
 			break
@@ -805,34 +815,37 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 		return k.HandleOffboardingUndelegate(ctx, undelegateMsg, completion, zone)
 	}
 
-	epochNumber, err := types.ParseEpochMsgMemo(memo, types.MsgTypeWithdrawal)
+	batchID, err := types.ParseWithdrawalBatchMemo(memo)
 	if err != nil {
 		return err
 	}
 
-	if err := zone.DecrementWithdrawalWaitgroup(k.Logger(ctx), 1, "unbonding message ack"); err != nil {
+	ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, batchID)
+	if found && ubr.Scheduled {
+		k.decrementUnbondingWaitgroup(ctx, zone.ChainId, "unbonding message ack")
+	} else if err := zone.DecrementWithdrawalWaitgroup(k.Logger(ctx), 1, "unbonding message ack"); err != nil {
 		// given that there _could_ be a backlog of message, we don't want to bail here, else they will remain undeliverable.
 		k.Logger(ctx).Error(err.Error())
 	}
-	ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
 	if !found {
-		if epochNumber >= 258 && epochNumber < 261 {
+		if batchID >= 258 && batchID < 261 {
 			// this is a temporary fix for a bug that occurred in epoch 258-261
 			// where the unbonding record was not found.
 			// we acknowledge the unbonding, and create a stub ubr that has no related txhash.
 			// this means the unbond tx will get rescheduled on the next epoch.
-			k.Logger(ctx).Info("unbonding record for %s not found for epoch %d", undelegateMsg.ValidatorAddress, epochNumber)
+			k.Logger(ctx).Info("unbonding record for %s not found for batch %d", undelegateMsg.ValidatorAddress, batchID)
 			ubr := types.UnbondingRecord{
 				ChainId:        zone.ChainId,
 				Validator:      undelegateMsg.ValidatorAddress,
-				EpochNumber:    epochNumber,
+				EpochNumber:    batchID,
+				BatchId:        batchID,
 				CompletionTime: completion,
 			}
 			k.SetUnbondingRecord(ctx, ubr)
 			k.SetZone(ctx, zone)
 			return nil
 		}
-		return fmt.Errorf("unbonding record for %s not found for epoch %d", undelegateMsg.ValidatorAddress, epochNumber)
+		return fmt.Errorf("unbonding record for %s not found for batch %d", undelegateMsg.ValidatorAddress, batchID)
 	}
 
 	for _, hash := range ubr.RelatedTxhash {
@@ -840,7 +853,7 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 
 		record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusUnbond)
 		if !found {
-			k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "hash", hash, "status", types.WithdrawStatusUnbond, "validator", undelegateMsg.ValidatorAddress, "epoch", epochNumber)
+			k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "hash", hash, "status", types.WithdrawStatusUnbond, "validator", undelegateMsg.ValidatorAddress, "batch", batchID)
 			continue
 		}
 
@@ -867,21 +880,25 @@ func (k *Keeper) HandleUndelegate(ctx sdk.Context, msg sdk.Msg, completion time.
 
 	data := stakingtypes.GetDelegationKey(delAddr, valAddr)
 
-	// send request to update delegation record for undelegated del/val tuple.
-	k.ICQKeeper.MakeRequest(
-		ctx,
-		zone.ConnectionId,
-		zone.ChainId,
-		"store/staking/key",
-		data,
-		sdk.NewInt(-1),
-		types.ModuleName,
-		"delegation_epoch",
-		0,
-	)
-
-	if err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, "unbonding message ack emit delegation_epoch query"); err != nil {
-		return err
+	// send request to update delegation record for undelegated del/val tuple. scheduled batches are not
+	// part of the epoch, so their delegation queries do not hold a slot in the withdrawal waitgroup.
+	if ubr.Scheduled {
+		k.ICQKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"store/staking/key",
+			data,
+			sdk.NewInt(-1),
+			types.ModuleName,
+			"delegation",
+			0,
+		)
+	} else {
+		k.makeWithdrawalWaitgroupRequest(ctx, zone, "store/staking/key", data, "delegation_epoch", 0)
+		if err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, "unbonding message ack emit delegation_epoch query"); err != nil {
+			return err
+		}
 	}
 	k.SetZone(ctx, zone)
 
@@ -917,17 +934,7 @@ func (k *Keeper) HandleOffboardingUndelegate(ctx sdk.Context, undelegateMsg *sta
 	data := stakingtypes.GetDelegationKey(delAddr, valAddr)
 
 	// send request to update delegation record for undelegated del/val tuple.
-	k.ICQKeeper.MakeRequest(
-		ctx,
-		zone.ConnectionId,
-		zone.ChainId,
-		"store/staking/key",
-		data,
-		sdk.NewInt(-1),
-		types.ModuleName,
-		"delegation_epoch",
-		0,
-	)
+	k.makeWithdrawalWaitgroupRequest(ctx, zone, "store/staking/key", data, "delegation_epoch", 0)
 
 	if err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, "offboarding unbonding ack emit delegation_epoch query"); err != nil {
 		return err
@@ -976,6 +983,9 @@ func (k *Keeper) HandleFailedBankSend(ctx sdk.Context, msg sdk.Msg, memo string,
 	case zone.IsDelegateAddress(sMsg.FromAddress):
 		k.Logger(ctx).Info("MsgSend from delegate account failed; updating withdrawal", "amount", sMsg.Amount, "memo", memo)
 		return k.HandleFailedUnbondSend(ctx, sMsg, memo)
+	case zone.IsDepositAddress(sMsg.FromAddress) && strings.HasPrefix(memo, types.MsgTypeUnbondSend):
+		k.Logger(ctx).Info("MsgSend from deposit account for instant redemption failed; requeueing withdrawal", "amount", sMsg.Amount, "memo", memo)
+		return k.HandleFailedInstantRedemption(ctx, zone, sMsg, memo)
 	default:
 		err = fmt.Errorf("unexpected MsgSend from %s to %s (amount: %s)", sMsg.FromAddress, sMsg.ToAddress, sMsg.Amount)
 		k.Logger(ctx).Error(err.Error())
@@ -1012,7 +1022,7 @@ func (k *Keeper) HandleFailedUnbondSend(ctx sdk.Context, sendMsg *banktypes.MsgS
 }
 
 func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo string) error {
-	epochNumber, err := types.ParseEpochMsgMemo(memo, types.MsgTypeWithdrawal)
+	batchID, err := types.ParseWithdrawalBatchMemo(memo)
 	if err != nil {
 		return err
 	}
@@ -1028,15 +1038,15 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 	if !found {
 		return fmt.Errorf("zone for delegate account %s not found", undelegateMsg.DelegatorAddress)
 	}
-	ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
+	ubr, found := k.GetUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, batchID)
 	if !found {
-		return fmt.Errorf("cannot find unbonding record for %s/%s/%d", zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
+		return fmt.Errorf("cannot find unbonding record for %s/%s/%d", zone.ChainId, undelegateMsg.ValidatorAddress, batchID)
 	}
 
 	for _, hash := range ubr.RelatedTxhash {
 		wdr, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusUnbond)
 		if !found {
-			k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "hash", hash, "status", types.WithdrawStatusUnbond, "validator", undelegateMsg.ValidatorAddress, "epoch", epochNumber)
+			k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "hash", hash, "status", types.WithdrawStatusUnbond, "validator", undelegateMsg.ValidatorAddress, "batch", batchID)
 			continue
 		}
 		// if multi val then:
@@ -1168,38 +1178,42 @@ func (k *Keeper) HandleFailedUndelegate(ctx sdk.Context, msg sdk.Msg, memo strin
 			return err
 		}
 	}
-	k.DeleteUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, epochNumber)
+	k.DeleteUnbondingRecord(ctx, zone.ChainId, undelegateMsg.ValidatorAddress, batchID)
 	k.Logger(ctx).Info("cleaning up unbonding record")
 	return nil
 }
 
 func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coin, memo string, connectionID string) error {
 	k.Logger(ctx).Info("Received MsgRedeemTokensforShares acknowledgement")
-	// first, type assertion. we should have stakingtypes.MsgRedeemTokensforShares
-	redeemMsg, ok := msg.(*lsmstakingtypes.MsgRedeemTokensForShares)
-	if !ok {
-		k.Logger(ctx).Error("unable to cast source message to MsgRedeemTokensforShares")
-		return errors.New("unable to cast source message to MsgRedeemTokensforShares")
+	provider, found := k.lsmProviderForMsgType(sdk.MsgTypeURL(msg))
+	if !found {
+		k.Logger(ctx).Error("no lsm provider found for redeem tokens message", "type", sdk.MsgTypeURL(msg), "connection_id", connectionID)
+		return fmt.Errorf("no lsm provider found for message type %s", sdk.MsgTypeURL(msg))
+	}
+	delegatorAddress, redeemAmount, err := provider.ParseRedeemTokensMsg(msg)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return err
 	}
-	validatorAddress, err := k.GetValidatorForToken(ctx, redeemMsg.Amount, connectionID)
+	validatorAddress, err := k.GetValidatorForToken(ctx, provider, redeemAmount, connectionID)
 	if err != nil {
 		return err
 	}
-	zone, found := k.GetZoneForDelegateAccount(ctx, redeemMsg.DelegatorAddress)
+	zone, found := k.GetZoneForDelegateAccount(ctx, delegatorAddress)
 	if !found {
-		return fmt.Errorf("zone for delegate account %s not found", redeemMsg.DelegatorAddress)
+		return fmt.Errorf("zone for delegate account %s not found", delegatorAddress)
 	}
 
 	switch {
 	case strings.HasPrefix(memo, "batch"):
-		k.Logger(ctx).Debug("batch delegation", "memo", memo, "tx", redeemMsg)
+		k.Logger(ctx).Debug("batch delegation", "memo", memo, "amount", redeemAmount)
 		exclusionTimestampUnix, err := strconv.ParseInt(strings.Split(memo, "/")[1], 10, 64)
 		if err != nil {
 			return err
 		}
 		k.Logger(ctx).Debug("outstanding delegations ack-received")
-		k.SetReceiptsCompleted(ctx, zone.ChainId, time.Unix(exclusionTimestampUnix, 0), ctx.BlockTime(), redeemMsg.Amount.Denom)
-		balance, negative := zone.DelegationAddress.Balance.SafeSub(redeemMsg.Amount)
+		k.SetReceiptsCompleted(ctx, zone.ChainId, time.Unix(exclusionTimestampUnix, 0), ctx.BlockTime(), redeemAmount.Denom)
+		balance, negative := zone.DelegationAddress.Balance.SafeSub(redeemAmount)
 		if negative {
 			k.Logger(ctx).Error("unexpected negative balance; likely due to stale ack")
 			return nil
@@ -1222,29 +1236,33 @@ func (k *Keeper) HandleRedeemTokens(ctx sdk.Context, msg sdk.Msg, amount sdk.Coi
 		receipt.Completed = &t
 		k.SetReceipt(ctx, receipt)
 	}
-	return k.UpdateDelegationRecordForAddress(ctx, redeemMsg.DelegatorAddress, validatorAddress, amount, zone, false, false)
+	return k.UpdateDelegationRecordForAddress(ctx, delegatorAddress, validatorAddress, amount, zone, false, false)
 }
 
-func (k *Keeper) HandleFailedRedeemTokens(ctx sdk.Context, msg sdk.Msg, memo string) error {
+func (k *Keeper) HandleFailedRedeemTokens(ctx sdk.Context, msg sdk.Msg, memo string, connectionID string) error {
 	k.Logger(ctx).Info("Received MsgRedeemTokensForShares failure acknowledgement")
-	// first, type assertion. we should have lsmstakingtypes.MsgRedeemTokensForShares
-	redeemMsg, ok := msg.(*lsmstakingtypes.MsgRedeemTokensForShares)
-	if !ok {
-		k.Logger(ctx).Error("unable to cast source message to MsgRedeemTokensForShares")
-		return errors.New("unable to cast source message to MsgRedeemTokensForShares")
+	provider, found := k.lsmProviderForMsgType(sdk.MsgTypeURL(msg))
+	if !found {
+		k.Logger(ctx).Error("no lsm provider found for redeem tokens message", "type", sdk.MsgTypeURL(msg), "connection_id", connectionID)
+		return fmt.Errorf("no lsm provider found for message type %s", sdk.MsgTypeURL(msg))
+	}
+	delegatorAddress, redeemAmount, err := provider.ParseRedeemTokensMsg(msg)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return err
 	}
-	zone, found := k.GetZoneForDelegateAccount(ctx, redeemMsg.DelegatorAddress)
+	zone, found := k.GetZoneForDelegateAccount(ctx, delegatorAddress)
 	if !found {
 		// most likely a performance account...
-		if _, found := k.GetZoneForPerformanceAccount(ctx, redeemMsg.DelegatorAddress); !found {
+		if _, found := k.GetZoneForPerformanceAccount(ctx, delegatorAddress); !found {
 			return nil
 		}
-		return fmt.Errorf("unable to find zone for address %s", redeemMsg.DelegatorAddress)
+		return fmt.Errorf("unable to find zone for address %s", delegatorAddress)
 	}
 
 	switch {
 	case strings.HasPrefix(memo, "batch"):
-		k.Logger(ctx).Error("batch token redemption failed", "memo", memo, "tx", redeemMsg)
+		k.Logger(ctx).Error("batch token redemption failed", "memo", memo, "amount", redeemAmount)
 		if err := zone.DecrementWithdrawalWaitgroup(k.Logger(ctx), uint32(1), "batch token redemption failure ack"); err != nil {
 			k.Logger(ctx).Error(err.Error())
 			return nil
@@ -1395,7 +1413,11 @@ func (k *Keeper) HandleUpdatedWithdrawAddress(ctx sdk.Context, msg sdk.Msg) erro
 	return nil
 }
 
-func (k *Keeper) GetValidatorForToken(ctx sdk.Context, amount sdk.Coin, connectionID string) (string, error) {
+// GetValidatorForToken returns the validator whose tokenized shares are represented by the denom of
+// amount. The denom is parsed by the provider that built the redeem message, and must name a zone
+// validator exactly; matching by prefix would also attribute malformed denoms, such as
+// {valoper}/abc or {valoper}x/1, to the validator.
+func (k *Keeper) GetValidatorForToken(ctx sdk.Context, provider types.LsmProvider, amount sdk.Coin, connectionID string) (string, error) {
 	zone, err := k.GetZoneFromConnectionID(ctx, connectionID)
 	if err != nil {
 		err = fmt.Errorf("3: %w", err)
@@ -1403,10 +1425,13 @@ func (k *Keeper) GetValidatorForToken(ctx sdk.Context, amount sdk.Coin, connecti
 		return "", err
 	}
 
-	for _, val := range k.GetValidatorAddresses(ctx, zone.ChainId) {
-		if strings.HasPrefix(amount.Denom, val) {
-			// match!
-			return val, nil
+	valoper, ok := provider.ParseShareDenom(amount.Denom)
+	if ok {
+		for _, val := range k.GetValidatorAddresses(ctx, zone.ChainId) {
+			if valoper == val {
+				// match!
+				return val, nil
+			}
 		}
 	}
 
@@ -1415,8 +1440,9 @@ func (k *Keeper) GetValidatorForToken(ctx sdk.Context, amount sdk.Coin, connecti
 
 // UpdateDelegationRecordsForAddress accepts a QueryDelegatorDelegationsResponse and for new, or changed delegation records will
 // trigger an ICQ request for that record. If this was triggered by an epoch, the withdrawal waitgroup should be decremented once,
-// (for the incoming message) and incremented for each outgoing message.
-func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone types.Zone, delegatorAddress string, args []byte, isEpoch bool) error {
+// (for the incoming message) and incremented for each outgoing message, and the outgoing queries are pinned to the remote height
+// of the response so that the epoch snapshot is consistent.
+func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone types.Zone, delegatorAddress string, args []byte, isEpoch bool, height int64) error {
 	var response stakingtypes.QueryDelegatorDelegationsResponse
 	err := k.cdc.Unmarshal(args, &response)
 	if err != nil {
@@ -1435,12 +1461,14 @@ func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone types.Z
 	}
 
 	cb := "delegation"
+	queryHeight := int64(0)
 	if isEpoch {
 		if err := zone.DecrementWithdrawalWaitgroup(k.Logger(ctx), 1, "delegations_epoch callback succeeded"); err != nil {
 			k.Logger(ctx).Error(err.Error())
 			// don't return here, catch and squash err.
 		}
 		cb = "delegation_epoch"
+		queryHeight = height
 	}
 
 	for _, delegationRecord := range response.DelegationResponses {
@@ -1455,22 +1483,24 @@ func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone types.Z
 		if !ok || !delegation.Amount.Equal(delegationRecord.GetBalance()) { // new or updated delegation
 			k.Logger(ctx).Info("Outdated delegation record - fetching proof...", "valoper", delegationRecord.Delegation.ValidatorAddress)
 
-			k.ICQKeeper.MakeRequest(
-				ctx,
-				zone.ConnectionId,
-				zone.ChainId,
-				"store/staking/key",
-				data,
-				sdk.NewInt(-1),
-				types.ModuleName,
-				cb,
-				0,
-			)
 			if isEpoch {
+				k.makeWithdrawalWaitgroupRequest(ctx, &zone, "store/staking/key", data, cb, queryHeight)
 				err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, fmt.Sprintf("delegation callback emit %s query", cb))
 				if err != nil {
 					return err
 				}
+			} else {
+				k.ICQKeeper.MakeRequest(
+					ctx,
+					zone.ConnectionId,
+					zone.ChainId,
+					"store/staking/key",
+					data,
+					sdk.NewInt(-1),
+					types.ModuleName,
+					cb,
+					0,
+				)
 			}
 		}
 
@@ -1488,22 +1518,24 @@ func (k *Keeper) UpdateDelegationRecordsForAddress(ctx sdk.Context, zone types.Z
 
 		// send request to prove delegation no longer exists. If the response is nil (i.e. no delegation), then
 		// the delegation record is removed by the callback.
-		k.ICQKeeper.MakeRequest(
-			ctx,
-			zone.ConnectionId,
-			zone.ChainId,
-			"store/staking/key",
-			data,
-			sdk.NewInt(-1),
-			types.ModuleName,
-			cb,
-			0,
-		)
 		if isEpoch {
+			k.makeWithdrawalWaitgroupRequest(ctx, &zone, "store/staking/key", data, cb, queryHeight)
 			err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), 1, fmt.Sprintf("delegations callback emit %s query", cb))
 			if err != nil {
 				return err
 			}
+		} else {
+			k.ICQKeeper.MakeRequest(
+				ctx,
+				zone.ConnectionId,
+				zone.ChainId,
+				"store/staking/key",
+				data,
+				sdk.NewInt(-1),
+				types.ModuleName,
+				cb,
+				0,
+			)
 		}
 	}
 
@@ -1720,21 +1752,8 @@ func (*Keeper) prepareRewardsDistributionMsgs(zone types.Zone, rewards math.Int)
 	}
 }
 
-func isNumericString(in string) bool {
-	// It is okay to use strconv.ParseInt to test if a value is numeric
-	// because the total supply of QCK is:
-	//      400_000_000 (400 million) qck aka 400_000_000_000_000 uqck
-	// and to parse numeric values, say in the smallest unit of uqck
-	//      MaxInt64: (1<<63)-1 = 9_223_372_036_854_775_807 uqck aka
-	//                            9_223_372_036_854.775 (9.223 Trillion) qck
-	// so the function is appropriate as its range won't be exceeded.
-	_, err := strconv.ParseInt(in, 10, 64)
-	return err == nil
-}
-
-func equalLsmCoin(valoper string, amount math.Int, lsmAmount sdk.Coin) bool {
-	parts := strings.Split(lsmAmount.Denom, "/")
-	if len(parts) == 2 && strings.HasPrefix(parts[0], valoper) && isNumericString(parts[1]) {
+func equalLsmCoin(provider types.LsmProvider, valoper string, amount math.Int, lsmAmount sdk.Coin) bool {
+	if shareValoper, ok := provider.ParseShareDenom(lsmAmount.Denom); ok && strings.HasPrefix(shareValoper, valoper) {
 		return lsmAmount.Amount.Equal(amount)
 	}
 	return false
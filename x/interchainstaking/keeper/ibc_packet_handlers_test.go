@@ -1094,6 +1094,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   1,
+					BatchId:       1,
 					Validator:     vals[0],
 					RelatedTxhash: []string{hash},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(312000000)),
@@ -1108,7 +1109,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 						msgs: []sdk.Msg{
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(312000000))},
 						},
-						memo:    types.EpochWithdrawalMemo(1),
+						memo:    types.WithdrawalBatchMemo(1),
 						success: false,
 					},
 				}
@@ -1179,6 +1180,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   1,
+					BatchId:       1,
 					Validator:     vals[0],
 					RelatedTxhash: []string{hash, hash2},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(936000000)),
@@ -1193,7 +1195,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 						msgs: []sdk.Msg{
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(936000000))},
 						},
-						memo:    types.EpochWithdrawalMemo(1),
+						memo:    types.WithdrawalBatchMemo(1),
 						success: false,
 					},
 				}
@@ -1280,6 +1282,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   2,
+					BatchId:       2,
 					Validator:     vals[0],
 					RelatedTxhash: []string{hash, hash2},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(468000000)),
@@ -1287,6 +1290,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   2,
+					BatchId:       2,
 					Validator:     vals[1],
 					RelatedTxhash: []string{hash},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(156000000)),
@@ -1294,6 +1298,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   2,
+					BatchId:       2,
 					Validator:     vals[2],
 					RelatedTxhash: []string{hash2},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(312000000)),
@@ -1310,7 +1315,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[1], Amount: sdk.NewCoin("uatom", math.NewInt(156000000))},
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[2], Amount: sdk.NewCoin("uatom", math.NewInt(312000000))},
 						},
-						memo:    types.EpochWithdrawalMemo(2),
+						memo:    types.WithdrawalBatchMemo(2),
 						success: false,
 					},
 				}
@@ -1397,6 +1402,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   1,
+					BatchId:       1,
 					Validator:     vals[0],
 					RelatedTxhash: []string{hash, hash2},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(468000000)),
@@ -1404,6 +1410,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   1,
+					BatchId:       1,
 					Validator:     vals[1],
 					RelatedTxhash: []string{hash},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(156000000)),
@@ -1411,6 +1418,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 				quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 					ChainId:       suite.chainB.ChainID,
 					EpochNumber:   1,
+					BatchId:       1,
 					Validator:     vals[2],
 					RelatedTxhash: []string{hash2},
 					Amount:        sdk.NewCoin("uatom", math.NewInt(312000000)),
@@ -1425,7 +1433,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 						msgs: []sdk.Msg{
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[0], Amount: sdk.NewCoin("uatom", math.NewInt(468000000))},
 						},
-						memo:    types.EpochWithdrawalMemo(1),
+						memo:    types.WithdrawalBatchMemo(1),
 						success: true,
 					},
 					{
@@ -1433,7 +1441,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate() {
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[1], Amount: sdk.NewCoin("uatom", math.NewInt(156000000))},
 							&stakingtypes.MsgUndelegate{DelegatorAddress: z.DelegationAddress.Address, ValidatorAddress: vals[2], Amount: sdk.NewCoin("uatom", math.NewInt(312000000))},
 						},
-						memo:    types.EpochWithdrawalMemo(1),
+						memo:    types.WithdrawalBatchMemo(1),
 						success: false,
 					},
 				}
@@ -2335,6 +2343,7 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 					{
 						ChainId:       suite.chainB.ChainID,
 						EpochNumber:   1,
+						BatchId:       1,
 						Validator:     vals[0],
 						RelatedTxhash: []string{hash1},
 					},
@@ -2406,6 +2415,7 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 					{
 						ChainId:       suite.chainB.ChainID,
 						EpochNumber:   1,
+						BatchId:       1,
 						Validator:     vals[0],
 						RelatedTxhash: []string{hash1},
 					},
@@ -2515,6 +2525,7 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 					{
 						ChainId:       suite.chainB.ChainID,
 						EpochNumber:   2,
+						BatchId:       2,
 						Validator:     vals[1],
 						RelatedTxhash: []string{hash1, hash2, hash3},
 					},
@@ -2642,12 +2653,14 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 					{
 						ChainId:       suite.chainB.ChainID,
 						EpochNumber:   1,
+						BatchId:       1,
 						Validator:     vals[0],
 						RelatedTxhash: []string{hash1},
 					},
 					{
 						ChainId:       suite.chainB.ChainID,
 						EpochNumber:   1,
+						BatchId:       1,
 						Validator:     vals[1],
 						RelatedTxhash: []string{hash2},
 					},
@@ -2704,7 +2717,7 @@ func (suite *KeeperTestSuite) TestReceiveAckForBeginUndelegate() {
 			packetData := icatypes.InterchainAccountPacketData{
 				Type: icatypes.EXECUTE_TX,
 				Data: data,
-				Memo: types.EpochWithdrawalMemo(test.epoch),
+				Memo: types.WithdrawalBatchMemo(test.epoch),
 			}
 
 			packet := channeltypes.Packet{Data: quicksilver.InterchainstakingKeeper.GetCodec().MustMarshalJSON(&packetData)}
@@ -4226,7 +4239,7 @@ func (suite *KeeperTestSuite) TestHandleTokenizedShares() {
 			}
 
 			for index, msg := range test.msgs(ctx, quicksilver, zone) {
-				err := quicksilver.InterchainstakingKeeper.HandleTokenizedShares(ctx, msg, shareAmount[index], test.txHash)
+				err := quicksilver.InterchainstakingKeeper.HandleTokenizedShares(ctx, msg, shareAmount[index], test.txHash, zone.ConnectionId)
 				suite.NoError(err)
 			}
 
@@ -4304,6 +4317,32 @@ func (suite *KeeperTestSuite) TestGetValidatorForToken() {
 				return vals[0]
 			},
 		},
+		{
+			// denoms are parsed rather than matched by prefix, so malformed denoms beginning
+			// with a validator address are not attributed to it.
+			name:            "Non-numeric record id",
+			err:             true,
+			setupConnection: true,
+			amount: func(ctx sdk.Context, qs *app.Quicksilver, zone types.Zone) sdk.Coin {
+				vals := qs.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
+				return sdk.NewCoin(vals[0]+"/abc", sdk.NewInt(100))
+			},
+			expectVal: func(ctx sdk.Context, qs *app.Quicksilver, zone types.Zone) string {
+				return ""
+			},
+		},
+		{
+			name:            "Validator address prefix",
+			err:             true,
+			setupConnection: true,
+			amount: func(ctx sdk.Context, qs *app.Quicksilver, zone types.Zone) sdk.Coin {
+				vals := qs.InterchainstakingKeeper.GetValidatorAddresses(ctx, zone.ChainId)
+				return sdk.NewCoin(vals[0]+"x/1", sdk.NewInt(100))
+			},
+			expectVal: func(ctx sdk.Context, qs *app.Quicksilver, zone types.Zone) string {
+				return ""
+			},
+		},
 		{
 			name:            "Not found validator",
 			err:             true,
@@ -4342,7 +4381,7 @@ func (suite *KeeperTestSuite) TestGetValidatorForToken() {
 				suite.Fail("unable to retrieve zone for test")
 			}
 			amount := test.amount(ctx, quicksilver, zone)
-			resVal, err := quicksilver.InterchainstakingKeeper.GetValidatorForToken(ctx, amount, suite.path.EndpointA.ConnectionID)
+			resVal, err := quicksilver.InterchainstakingKeeper.GetValidatorForToken(ctx, types.GaiaLsmProvider{}, amount, suite.path.EndpointA.ConnectionID)
 
 			if test.err {
 				suite.Error(err)
@@ -5030,6 +5069,7 @@ func (suite *KeeperTestSuite) TestHandleMaturedUnbondings_NoDeleteFuture() {
 		ChainId:        zone.ChainId,
 		Validator:      testVal,
 		EpochNumber:    258,
+		BatchId:        258,
 		CompletionTime: ctx.BlockTime().Add(time.Hour * 24),
 	})
 
@@ -5056,6 +5096,7 @@ func (suite *KeeperTestSuite) TestHandleMaturedUnbondings_DeleteExpired() {
 		ChainId:        zone.ChainId,
 		Validator:      testVal,
 		EpochNumber:    258,
+		BatchId:        258,
 		CompletionTime: ctx.BlockTime().Add(-time.Hour * 25),
 	})
 
@@ -5082,6 +5123,7 @@ func (suite *KeeperTestSuite) TestHandleMaturedUnbondings_NoNilCompletionTime()
 		ChainId:        zone.ChainId,
 		Validator:      testVal,
 		EpochNumber:    258,
+		BatchId:        258,
 		CompletionTime: time.Time{},
 	})
 
@@ -5108,7 +5150,7 @@ func (suite *KeeperTestSuite) TestUndelegate_NoRecordEpoch259() {
 		DelegatorAddress: zone.DelegationAddress.Address,
 		ValidatorAddress: testVal,
 		Amount:           sdk.NewCoin(zone.BaseDenom, sdk.NewInt(100)),
-	}, ctx.BlockTime().Add(time.Hour*24), types.EpochWithdrawalMemo(259))
+	}, ctx.BlockTime().Add(time.Hour*24), types.WithdrawalBatchMemo(259))
 	suite.NoError(err)
 	ubr, found := app.InterchainstakingKeeper.GetUnbondingRecord(ctx, zone.ChainId, testVal, 259)
 	// record should be created
@@ -5171,7 +5213,7 @@ func (suite *KeeperTestSuite) TestHandleTokenizedShares_MissingRecord() {
 	memo := randomutils.GenerateRandomHashAsHex(32) // Non-existent record
 
 	// Should return nil (not error) when record not found
-	err := quicksilver.InterchainstakingKeeper.HandleTokenizedShares(ctx, msg, sharesAmount, memo)
+	err := quicksilver.InterchainstakingKeeper.HandleTokenizedShares(ctx, msg, sharesAmount, memo, zone.ConnectionId)
 	suite.NoError(err)
 }
 
@@ -5194,6 +5236,7 @@ func (suite *KeeperTestSuite) TestHandleUndelegate_MissingWithdrawalRecord() {
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       zone.ChainId,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash}, // This hash has no withdrawal record
 	})
@@ -5207,7 +5250,7 @@ func (suite *KeeperTestSuite) TestHandleUndelegate_MissingWithdrawalRecord() {
 	completion := time.Now().UTC().Add(21 * 24 * time.Hour)
 
 	// Should return nil (not error) when withdrawal record not found
-	err := quicksilver.InterchainstakingKeeper.HandleUndelegate(ctx, msg, completion, types.EpochWithdrawalMemo(1))
+	err := quicksilver.InterchainstakingKeeper.HandleUndelegate(ctx, msg, completion, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// Verify unbonding record was updated with completion time
@@ -5265,6 +5308,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_MissingWithdrawalRecord
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       zone.ChainId,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash}, // No corresponding withdrawal record
 	})
@@ -5276,7 +5320,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_MissingWithdrawalRecord
 	}
 
 	// Should return nil (not error) - just skips the missing record
-	err := quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err := quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// Verify unbonding record was still cleaned up
@@ -5333,6 +5377,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_ValidatorNotInDistribut
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   380,
+		BatchId:       380,
 		Validator:     vals[2],
 		RelatedTxhash: []string{hash},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(50000000)),
@@ -5346,7 +5391,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_ValidatorNotInDistribut
 
 	// Without hot-fix guard 3, this creates a requeued record with BurnAmount=0,
 	// which SetWithdrawalRecord rejects with "burnAmount cannot be negative or zero".
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(380))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(380))
 	suite.NoError(err)
 
 	// Original WDR should be unchanged — vals[2] was not in its distribution.
@@ -5408,6 +5453,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_TinyBurnAmountTruncates
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(500000)),
@@ -5419,7 +5465,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_TinyBurnAmountTruncates
 		Amount:           sdk.NewCoin("uatom", math.NewInt(500000)),
 	}
 
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// WDR should have vals[0] removed from distribution.
@@ -5504,6 +5550,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_MixedDistributionMultiW
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash, hash2},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(312000000)),
@@ -5515,7 +5562,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_MixedDistributionMultiW
 		Amount:           sdk.NewCoin("uatom", math.NewInt(312000000)),
 	}
 
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	allRecords := quicksilver.InterchainstakingKeeper.AllZoneWithdrawalRecords(ctx, suite.chainB.ChainID)
@@ -5592,6 +5639,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_DistributionAmountExcee
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(150)),
@@ -5603,7 +5651,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_DistributionAmountExcee
 		Amount:           sdk.NewCoin("uatom", math.NewInt(150)),
 	}
 
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// Original WDR should be deleted (single validator in distribution = delete path)
@@ -5663,6 +5711,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_AmountZeroGuard() {
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(100)),
@@ -5676,7 +5725,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_AmountZeroGuard() {
 
 	// Without the Amount=0 guard, this would panic with division by zero.
 	// With the guard, the WDR is deleted and the full BurnAmount is requeued for recovery.
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// WDR should be deleted — no longer orphaned in WithdrawStatusUnbond
@@ -5757,6 +5806,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_AmountZeroGuard_MultiWD
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash1, hash2},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(160)),
@@ -5770,7 +5820,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_AmountZeroGuard_MultiWD
 
 	// Without the fix both WDRs would be orphaned. With the fix, the first iteration
 	// creates a requeued record and the second accumulates into it.
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	// Both original WDRs must be gone.
@@ -5829,6 +5879,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_RelatedAmountExceedsAmo
 	quicksilver.InterchainstakingKeeper.SetUnbondingRecord(ctx, types.UnbondingRecord{
 		ChainId:       suite.chainB.ChainID,
 		EpochNumber:   1,
+		BatchId:       1,
 		Validator:     vals[0],
 		RelatedTxhash: []string{hash},
 		Amount:        sdk.NewCoin("uatom", math.NewInt(150)),
@@ -5840,7 +5891,7 @@ func (suite *KeeperTestSuite) TestHandleFailedUndelegate_RelatedAmountExceedsAmo
 		Amount:           sdk.NewCoin("uatom", math.NewInt(150)),
 	}
 
-	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.EpochWithdrawalMemo(1))
+	err = quicksilver.InterchainstakingKeeper.HandleFailedUndelegate(ctx, msg, types.WithdrawalBatchMemo(1))
 	suite.NoError(err)
 
 	_, found = quicksilver.InterchainstakingKeeper.GetWithdrawalRecord(ctx, suite.chainB.ChainID, hash, types.WithdrawStatusUnbond)
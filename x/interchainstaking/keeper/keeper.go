@@ -30,7 +30,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctmtypes "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 
-	lsmstakingtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
+	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	claimsmanagertypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	epochskeeper "github.com/quicksilver-zone/quicksilver/x/epochs/keeper"
@@ -59,6 +59,7 @@ type Keeper struct {
 	paramStore          paramtypes.Subspace
 	txSubmit            TxSubmitFn
 	govAuthority        string
+	lsmProviders        map[string]types.LsmProvider
 }
 
 // NewKeeper returns a new instance of zones Keeper.
@@ -108,6 +109,9 @@ func NewKeeper(
 		paramStore:          ps,
 		AuthzKeeper:         authzKeeper,
 		govAuthority:        govAuthority,
+		lsmProviders: map[string]types.LsmProvider{
+			types.LsmProviderGaia: types.GaiaLsmProvider{},
+		},
 	}
 }
 
@@ -311,7 +315,7 @@ func (k *Keeper) SetValidatorsForZone(ctx sdk.Context, data []byte, icqQuery icq
 			}
 			if zone.SupportLsm() {
 				// emit liquid validator query
-				k.EmitLiquidValidatorQuery(ctx, icqQuery.ConnectionId, icqQuery.ChainId, addr)
+				k.EmitLiquidValidatorQuery(ctx, &zone, addr)
 			}
 
 		}
@@ -478,7 +482,8 @@ func (k *Keeper) SetValidatorForZone(ctx sdk.Context, zone *types.Zone, data []b
 func (k *Keeper) depositInterval(ctx sdk.Context) zoneItrFn {
 	return func(index int64, zone *types.Zone) (stop bool) {
 		if zone.DepositAddress != nil {
-			if !zone.DepositAddress.Balance.Empty() {
+			// tokens held by the liquidity buffer remain on the deposit account.
+			if !zone.UnbufferedDepositBalance().Empty() {
 				k.Logger(ctx).Debug("balance is non zero", "balance", zone.DepositAddress.Balance)
 				k.EmitDepositIntervalQuery(ctx, zone)
 
@@ -645,13 +650,16 @@ func (k *Keeper) EmitDepositIntervalQuery(ctx sdk.Context, zone *types.Zone) {
 	)
 }
 
-func (k *Keeper) EmitLiquidValidatorQuery(ctx sdk.Context, connectionID, chainID string, addr sdk.ValAddress) {
-	data := lsmstakingtypes.GetLiquidValidatorKey(addr)
+func (k *Keeper) EmitLiquidValidatorQuery(ctx sdk.Context, zone *types.Zone, addr sdk.ValAddress) {
+	queryType, data, ok := k.LsmProviderForZone(zone).LiquidValidatorQuery(addr)
+	if !ok {
+		return
+	}
 	k.ICQKeeper.MakeRequest(
 		ctx,
-		connectionID,
-		chainID,
-		"store/liquid/key",
+		zone.ConnectionId,
+		zone.ChainId,
+		queryType,
 		data,
 		sdk.NewInt(-1),
 		types.ModuleName,
@@ -716,6 +724,7 @@ func (k *Keeper) UpdateRedemptionRate(ctx sdk.Context, zone *types.Zone, epochRe
 	zone.LastRedemptionRate = zone.RedemptionRate
 	zone.RedemptionRate = ratio
 	k.SetZone(ctx, zone)
+	k.recordRedemptionRate(ctx, zone, k.nativeAssetAmount(ctx, zone).Add(epochRewards).Add(delegationsInProcess))
 }
 
 func (k *Keeper) OverrideRedemptionRateNoCap(ctx sdk.Context, zone *types.Zone) {
@@ -726,11 +735,23 @@ func (k *Keeper) OverrideRedemptionRateNoCap(ctx sdk.Context, zone *types.Zone)
 	zone.LastRedemptionRate = zone.RedemptionRate
 	zone.RedemptionRate = ratio
 	k.SetZone(ctx, zone)
+	k.recordRedemptionRate(ctx, zone, k.nativeAssetAmount(ctx, zone).Add(delegationsInProcess))
+}
+
+// nativeAssetAmount returns the native assets backing the qAssets of a zone,
+// excluding pending rewards and delegations.
+func (k *Keeper) nativeAssetAmount(ctx sdk.Context, zone *types.Zone) sdkmath.Int {
+	amount := k.GetDelegatedAmount(ctx, zone).Amount
+	// tokens held by the liquidity buffer back qAssets, so fees accrue to holders.
+	if zone.LiquidityBuffer != nil {
+		amount = amount.Add(zone.LiquidityBuffer.Balance)
+	}
+	return amount
 }
 
 func (k *Keeper) GetRatio(ctx sdk.Context, zone *types.Zone, epochRewards sdkmath.Int) (sdk.Dec, bool) {
 	// native asset amount
-	nativeAssetAmount := k.GetDelegatedAmount(ctx, zone).Amount
+	nativeAssetAmount := k.nativeAssetAmount(ctx, zone)
 	// v1.7.0 - remove unbonding tokens from RR logic on both sides of the equation.
 
 	// nativeAssetUnbonding, _ := k.GetWithdrawnTokensAndCount(ctx, zone)
@@ -801,27 +822,41 @@ func (k *Keeper) GetAggregateIntentOrDefault(ctx sdk.Context, zone *types.Zone)
 	return filteredIntents, nil
 }
 
-func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+// DetermineRebalances returns the redelegations required to move the delegations of a zone toward its
+// aggregate intent using the zone rebalance strategy, omitting those below the zone dust threshold.
+func (k *Keeper) DetermineRebalances(ctx sdk.Context, zone *types.Zone) (types.RebalanceTargets, error) {
 	currentAllocations, currentSum, currentLocked, lockedSum := k.GetDelegationMap(ctx, zone.ChainId)
 	targetAllocations, err := k.GetAggregateIntentOrDefault(ctx, zone)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	maxCanAllocate := k.DetermineMaximumValidatorAllocations(ctx, zone)
-	rebalances := types.DetermineAllocationsForRebalancing(currentAllocations, currentLocked, currentSum, lockedSum, targetAllocations, maxCanAllocate, k.Logger(ctx)).RemoveDuplicates()
-	msgs := make([]sdk.Msg, 0)
+	rebalances := zone.Rebalancer().DetermineAllocationsForRebalancing(currentAllocations, currentLocked, currentSum, lockedSum, targetAllocations, maxCanAllocate, k.Logger(ctx)).RemoveDuplicates()
+	out := make(types.RebalanceTargets, 0, len(rebalances))
 	for _, rebalance := range rebalances {
 		if rebalance.Amount.GTE(zone.DustThreshold) {
-			msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorSrcAddress: rebalance.Source, ValidatorDstAddress: rebalance.Target, Amount: sdk.NewCoin(zone.BaseDenom, rebalance.Amount)})
-			k.SetRedelegationRecord(ctx, types.RedelegationRecord{
-				ChainId:     zone.ChainId,
-				EpochNumber: epochNumber,
-				Source:      rebalance.Source,
-				Destination: rebalance.Target,
-				Amount:      rebalance.Amount,
-			})
+			out = append(out, rebalance)
 		}
 	}
+	return out, nil
+}
+
+func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64) error {
+	rebalances, err := k.DetermineRebalances(ctx, zone)
+	if err != nil {
+		return err
+	}
+	msgs := make([]sdk.Msg, 0)
+	for _, rebalance := range rebalances {
+		msgs = append(msgs, rebalanceMsg(zone, rebalance))
+		k.SetRedelegationRecord(ctx, types.RedelegationRecord{
+			ChainId:     zone.ChainId,
+			EpochNumber: epochNumber,
+			Source:      rebalance.Source,
+			Destination: rebalance.Target,
+			Amount:      rebalance.Amount,
+		})
+	}
 	if len(msgs) == 0 {
 		k.Logger(ctx).Debug("No rebalancing required")
 		return nil
@@ -830,6 +865,66 @@ func (k *Keeper) Rebalance(ctx sdk.Context, zone *types.Zone, epochNumber int64)
 	return k.SubmitTx(ctx, msgs, zone.DelegationAddress, types.EpochRebalanceMemo(epochNumber), zone.MessagesPerTx)
 }
 
+func rebalanceMsg(zone *types.Zone, rebalance *types.RebalanceTarget) *stakingtypes.MsgBeginRedelegate {
+	return &stakingtypes.MsgBeginRedelegate{DelegatorAddress: zone.DelegationAddress.Address, ValidatorSrcAddress: rebalance.Source, ValidatorDstAddress: rebalance.Target, Amount: sdk.NewCoin(zone.BaseDenom, rebalance.Amount)}
+}
+
+// SimulateRebalance simulates Rebalance for a zone against current state, without submitting
+// any transactions or writing redelegation records.
+func (k *Keeper) SimulateRebalance(ctx sdk.Context, zone *types.Zone) (*types.QueryRebalancePlanResponse, error) {
+	currentAllocations, currentSum, currentLocked, _ := k.GetDelegationMap(ctx, zone.ChainId)
+	targetAllocations, err := k.GetAggregateIntentOrDefault(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	maxCanAllocate := k.DetermineMaximumValidatorAllocations(ctx, zone)
+	targets, sources := types.CalculateAllocationDeltas(currentAllocations, currentLocked, currentSum, targetAllocations, maxCanAllocate)
+
+	rebalances, err := k.DetermineRebalances(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &types.QueryRebalancePlanResponse{
+		Targets:             targets,
+		Sources:             sources,
+		Redelegations:       make([]stakingtypes.MsgBeginRedelegate, 0, len(rebalances)),
+		LockedValidators:    utils.Keys(currentLocked),
+		RedelegationRecords: make([]types.RedelegationRecord, 0),
+	}
+
+	// apply the planned redelegations to the current allocations.
+	plannedAllocations := make(map[string]sdkmath.Int, len(currentAllocations))
+	for valoper, amount := range currentAllocations {
+		plannedAllocations[valoper] = amount
+	}
+	for _, rebalance := range rebalances {
+		out.Redelegations = append(out.Redelegations, *rebalanceMsg(zone, rebalance))
+		if _, found := plannedAllocations[rebalance.Target]; !found {
+			plannedAllocations[rebalance.Target] = sdk.ZeroInt()
+		}
+		plannedAllocations[rebalance.Source] = plannedAllocations[rebalance.Source].Sub(rebalance.Amount)
+		plannedAllocations[rebalance.Target] = plannedAllocations[rebalance.Target].Add(rebalance.Amount)
+	}
+
+	for _, record := range k.ZoneRedelegationRecords(ctx, zone.ChainId) {
+		if currentLocked[record.Destination] {
+			out.RedelegationRecords = append(out.RedelegationRecords, record)
+		}
+	}
+
+	current := k.CurrentDelegationsAsIntent(ctx, zone)
+	out.DistanceToTarget = fmt.Sprintf("%f", types.DistanceBetweenIntents(current, targetAllocations))
+
+	planned := make(types.ValidatorIntents, 0, len(plannedAllocations))
+	for _, valoper := range utils.Keys(plannedAllocations) {
+		planned = append(planned, &types.ValidatorIntent{ValoperAddress: valoper, Weight: sdk.NewDecFromInt(plannedAllocations[valoper])})
+	}
+	out.PlannedDistanceToTarget = fmt.Sprintf("%f", types.DistanceBetweenIntents(planned.Normalize(), targetAllocations))
+
+	return out, nil
+}
+
 // UnmarshalValidatorsResponse attempts to umarshal a byte slice into a QueryValidatorsResponse.
 func (k *Keeper) UnmarshalValidatorsResponse(data []byte) (stakingtypes.QueryValidatorsResponse, error) {
 	validatorsRes := stakingtypes.QueryValidatorsResponse{}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// FillLiquidityBuffer retains up to the buffer shortfall of the base denom from
+// deposited assets on the deposit account, and returns the assets remaining to
+// be transferred to the delegate account.
+func (k *Keeper) FillLiquidityBuffer(ctx sdk.Context, zone *types.Zone, assets sdk.Coins) sdk.Coins {
+	if !zone.InstantRedemptionEnabled() {
+		return assets
+	}
+
+	retained := sdkmath.MinInt(assets.AmountOf(zone.BaseDenom), zone.LiquidityBuffer.Shortfall())
+	if !retained.IsPositive() {
+		return assets
+	}
+
+	zone.LiquidityBuffer.Balance = zone.LiquidityBuffer.Balance.Add(retained)
+	k.SetZone(ctx, zone)
+
+	k.Logger(ctx).Info("retained deposit in liquidity buffer", "chain_id", zone.ChainId, "amount", retained, "balance", zone.LiquidityBuffer.Balance)
+	return assets.Sub(sdk.NewCoin(zone.BaseDenom, retained))
+}
+
+// RedeemFromLiquidityBuffer pays out native tokens from the liquidity buffer of the zone
+// for the given qAssets, which must already be held in escrow. The qAssets are burned
+// as the buffer is reduced, such that the redemption rate is unaffected while the
+// payout is in flight. It returns the native tokens sent to the destination address.
+func (k *Keeper) RedeemFromLiquidityBuffer(ctx sdk.Context, zone *types.Zone, sender sdk.AccAddress, destination string, burnAmount sdk.Coin, hash string) (sdk.Coin, error) {
+	amount := sdk.NewCoin(zone.BaseDenom, sdk.NewDecFromInt(burnAmount.Amount).Mul(zone.InstantRedemptionRate()).TruncateInt())
+	if !amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("redemption of %s yields no native tokens", burnAmount)
+	}
+
+	buffer := zone.LiquidityBuffer
+	if amount.Amount.GT(buffer.Balance) {
+		return sdk.Coin{}, fmt.Errorf("insufficient liquidity buffer balance; requested %s, available %s%s", amount, buffer.Balance, zone.BaseDenom)
+	}
+	if buffer.RedeemedThisEpoch.Add(amount.Amount).GT(buffer.EpochCap) {
+		return sdk.Coin{}, fmt.Errorf("instant redemption cap exceeded for epoch; requested %s, available %s%s", amount, buffer.Available(), zone.BaseDenom)
+	}
+
+	record := types.WithdrawalRecord{
+		ChainId:        zone.ChainId,
+		Delegator:      sender.String(),
+		Recipient:      destination,
+		Amount:         sdk.NewCoins(amount),
+		BurnAmount:     burnAmount,
+		Txhash:         hash,
+		Status:         types.WithdrawStatusSend,
+		CompletionTime: ctx.BlockTime(),
+		EpochNumber:    k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch,
+	}
+	if err := k.SetWithdrawalRecord(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	buffer.Balance = buffer.Balance.Sub(amount.Amount)
+	buffer.RedeemedThisEpoch = buffer.RedeemedThisEpoch.Add(amount.Amount)
+	k.SetZone(ctx, zone)
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.EscrowModuleAccount, sdk.NewCoins(burnAmount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("unable to burn escrowed qassets: %w", err)
+	}
+
+	msg := &banktypes.MsgSend{FromAddress: zone.DepositAddress.GetAddress(), ToAddress: destination, Amount: sdk.NewCoins(amount)}
+	if err := k.SubmitTx(ctx, []sdk.Msg{msg}, zone.DepositAddress, types.TxUnbondSendMemo(hash), zone.MessagesPerTx); err != nil {
+		return sdk.Coin{}, fmt.Errorf("unable to submit instant redemption: %w", err)
+	}
+
+	return amount, nil
+}
+
+// HandleCompleteInstantRedemption marks the withdrawal record of an instant
+// redemption as completed. Its qAssets were burned when it was paid out.
+func (k *Keeper) HandleCompleteInstantRedemption(ctx sdk.Context, zone *types.Zone, memo string) error {
+	txHash, err := types.ParseTxMsgMemo(memo, types.MsgTypeUnbondSend)
+	if err != nil {
+		return err
+	}
+
+	wdr, found := k.GetWithdrawalRecord(ctx, zone.ChainId, txHash, types.WithdrawStatusSend)
+	if !found {
+		k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "tx_hash", txHash, "status", types.WithdrawStatusSend)
+		return nil
+	}
+
+	k.UpdateWithdrawalRecordStatus(ctx, &wdr, types.WithdrawStatusCompleted)
+	return nil
+}
+
+// HandleFailedInstantRedemption returns the native tokens of a failed instant
+// redemption to the liquidity buffer, restores its burned qAssets to escrow, and
+// requeues the withdrawal record to be processed as a regular unbonding.
+func (k *Keeper) HandleFailedInstantRedemption(ctx sdk.Context, zone *types.Zone, sendMsg *banktypes.MsgSend, memo string) error {
+	txHash, err := types.ParseTxMsgMemo(memo, types.MsgTypeUnbondSend)
+	if err != nil {
+		return err
+	}
+
+	wdr, found := k.GetWithdrawalRecord(ctx, zone.ChainId, txHash, types.WithdrawStatusSend)
+	if !found {
+		k.Logger(ctx).Info("withdrawal record not found; may have already been processed", "chain_id", zone.ChainId, "tx_hash", txHash, "to_address", sendMsg.ToAddress, "status", types.WithdrawStatusSend)
+		return nil
+	}
+
+	if zone.LiquidityBuffer == nil {
+		zone.LiquidityBuffer = types.NewLiquidityBuffer()
+	}
+	amount := sendMsg.Amount.AmountOf(zone.BaseDenom)
+	zone.LiquidityBuffer.Balance = zone.LiquidityBuffer.Balance.Add(amount)
+	// the epoch cap is only restored if the redemption was made this epoch.
+	if wdr.EpochNumber == k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch {
+		zone.LiquidityBuffer.RedeemedThisEpoch = sdkmath.MaxInt(zone.LiquidityBuffer.RedeemedThisEpoch.Sub(amount), sdkmath.ZeroInt())
+	}
+	k.SetZone(ctx, zone)
+
+	if err := k.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(wdr.BurnAmount)); err != nil {
+		return err
+	}
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowModuleAccount, sdk.NewCoins(wdr.BurnAmount)); err != nil {
+		return err
+	}
+
+	wdr.Amount = nil
+	wdr.Requeued = true
+	wdr.SendErrors++
+	k.UpdateWithdrawalRecordStatus(ctx, &wdr, types.WithdrawStatusQueued)
+
+	return nil
+}
@@ -0,0 +1,316 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/utils/ica"
+	icskeeper "github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// setupLiquidityBuffer enables instant redemption for the test zone and funds
+// testAddress with qAssets.
+func (suite *KeeperTestSuite) setupLiquidityBuffer(ctx sdk.Context) icstypes.Zone {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	params := icsKeeper.GetParams(ctx)
+	params.UnbondingEnabled = true
+	icsKeeper.SetParams(ctx, params)
+
+	testAccount, err := addressutils.AccAddressFromBech32(testAddress, "")
+	suite.NoError(err)
+	qAssets := sdk.NewCoins(sdk.NewCoin("uqatom", math.NewInt(10000000)))
+	suite.NoError(quicksilver.BankKeeper.MintCoins(ctx, icstypes.ModuleName, qAssets))
+	suite.NoError(quicksilver.BankKeeper.SendCoinsFromModuleToAccount(ctx, icstypes.ModuleName, testAccount, qAssets))
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.UnbondingEnabled = true
+	zone.RedemptionRate = sdk.OneDec()
+	zone.LastRedemptionRate = sdk.OneDec()
+	zone.LiquidityBuffer = &icstypes.LiquidityBuffer{
+		Enabled:           true,
+		Fee:               sdk.NewDecWithPrec(1, 2),
+		Target:            math.NewInt(20000000),
+		EpochCap:          math.NewInt(8000000),
+		Balance:           math.NewInt(10000000),
+		RedeemedThisEpoch: math.ZeroInt(),
+	}
+	icsKeeper.SetZone(ctx, &zone)
+
+	return zone
+}
+
+func (suite *KeeperTestSuite) TestInstantRedemption() {
+	tests := []struct {
+		name          string
+		malleate      func(zone *icstypes.Zone)
+		value         sdk.Coin
+		expectErr     string
+		expectAmount  math.Int
+		expectBalance math.Int
+	}{
+		{
+			name:          "valid - fee applied",
+			malleate:      func(zone *icstypes.Zone) {},
+			value:         sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectAmount:  math.NewInt(4950000),
+			expectBalance: math.NewInt(5050000),
+		},
+		{
+			name: "valid - lesser of redemption rates used",
+			malleate: func(zone *icstypes.Zone) {
+				zone.RedemptionRate = sdk.MustNewDecFromStr("1.1")
+				zone.LastRedemptionRate = sdk.MustNewDecFromStr("1.05")
+			},
+			value:         sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectAmount:  math.NewInt(5197500),
+			expectBalance: math.NewInt(4802500),
+		},
+		{
+			name:      "invalid - instant redemption disabled",
+			malleate:  func(zone *icstypes.Zone) { zone.LiquidityBuffer.Enabled = false },
+			value:     sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectErr: "instant redemption currently disabled",
+		},
+		{
+			name:      "invalid - no liquidity buffer",
+			malleate:  func(zone *icstypes.Zone) { zone.LiquidityBuffer = nil },
+			value:     sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectErr: "instant redemption currently disabled",
+		},
+		{
+			name:      "invalid - insufficient buffer balance",
+			malleate:  func(zone *icstypes.Zone) { zone.LiquidityBuffer.Balance = math.NewInt(1000000) },
+			value:     sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectErr: "insufficient liquidity buffer balance",
+		},
+		{
+			name:      "invalid - epoch cap exceeded",
+			malleate:  func(zone *icstypes.Zone) { zone.LiquidityBuffer.RedeemedThisEpoch = math.NewInt(5000000) },
+			value:     sdk.NewCoin("uqatom", math.NewInt(5000000)),
+			expectErr: "instant redemption cap exceeded for epoch",
+		},
+		{
+			name:      "invalid - insufficient qasset balance",
+			malleate:  func(zone *icstypes.Zone) {},
+			value:     sdk.NewCoin("uqatom", math.NewInt(50000000)),
+			expectErr: "account has insufficient balance of qasset to burn",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			quicksilver := suite.GetQuicksilverApp(suite.chainA)
+			icsKeeper := quicksilver.InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			txk := ica.TxKeeper{}
+			icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+
+			zone := suite.setupLiquidityBuffer(ctx)
+			tt.malleate(&zone)
+			icsKeeper.SetZone(ctx, &zone)
+			redeemedBefore := math.ZeroInt()
+			if zone.LiquidityBuffer != nil {
+				redeemedBefore = zone.LiquidityBuffer.RedeemedThisEpoch
+			}
+
+			destination := addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix)
+			msg := icstypes.MsgInstantRedemption{
+				Value:              tt.value,
+				DestinationAddress: destination,
+				FromAddress:        testAddress,
+			}
+
+			supplyBefore := quicksilver.BankKeeper.GetSupply(ctx, "uqatom")
+
+			msgSrv := icskeeper.NewMsgServerImpl(icsKeeper)
+			res, err := msgSrv.InstantRedemption(sdk.WrapSDKContext(ctx), &msg)
+			if tt.expectErr != "" {
+				suite.ErrorContains(err, tt.expectErr)
+				suite.Nil(res)
+				suite.Empty(txk.Txs)
+				return
+			}
+
+			suite.NoError(err)
+			expected := sdk.NewCoin(zone.BaseDenom, tt.expectAmount)
+			suite.Equal(expected, res.Amount)
+
+			zone, found := icsKeeper.GetZone(ctx, zone.ChainId)
+			suite.True(found)
+			suite.Equal(tt.expectBalance, zone.LiquidityBuffer.Balance)
+			suite.Equal(redeemedBefore.Add(tt.expectAmount), zone.LiquidityBuffer.RedeemedThisEpoch)
+
+			suite.Len(txk.Txs, 1)
+			suite.Len(txk.Txs[0].Msgs, 1)
+			sendMsg, ok := txk.Txs[0].Msgs[0].(*banktypes.MsgSend)
+			suite.True(ok)
+			suite.Equal(zone.DepositAddress.Address, sendMsg.FromAddress)
+			suite.Equal(destination, sendMsg.ToAddress)
+			suite.Equal(sdk.NewCoins(expected), sendMsg.Amount)
+
+			records := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+			suite.Len(records, 1)
+			suite.Equal(icstypes.WithdrawStatusSend, records[0].Status)
+			suite.Equal(tt.value, records[0].BurnAmount)
+			suite.Equal(sdk.NewCoins(expected), records[0].Amount)
+			suite.Equal(icstypes.TxUnbondSendMemo(records[0].Txhash), txk.Txs[0].Memo)
+
+			// the qAssets are burned as the buffer is reduced.
+			escrow := quicksilver.BankKeeper.GetBalance(ctx, quicksilver.AccountKeeper.GetModuleAddress(icstypes.EscrowModuleAccount), "uqatom")
+			suite.True(escrow.IsZero())
+			suite.Equal(supplyBefore.Sub(tt.value), quicksilver.BankKeeper.GetSupply(ctx, "uqatom"))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFillLiquidityBuffer() {
+	tests := []struct {
+		name           string
+		malleate       func(zone *icstypes.Zone)
+		assets         sdk.Coins
+		expectRemain   sdk.Coins
+		expectRetained math.Int
+	}{
+		{
+			name:           "buffer disabled",
+			malleate:       func(zone *icstypes.Zone) { zone.LiquidityBuffer.Enabled = false },
+			assets:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRemain:   sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRetained: math.ZeroInt(),
+		},
+		{
+			name:           "deposit retained in full",
+			malleate:       func(zone *icstypes.Zone) {},
+			assets:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRemain:   sdk.Coins{},
+			expectRetained: math.NewInt(5000000),
+		},
+		{
+			name:           "deposit retained up to target",
+			malleate:       func(zone *icstypes.Zone) {},
+			assets:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(15000000))),
+			expectRemain:   sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRetained: math.NewInt(10000000),
+		},
+		{
+			name:           "buffer at target",
+			malleate:       func(zone *icstypes.Zone) { zone.LiquidityBuffer.Balance = zone.LiquidityBuffer.Target },
+			assets:         sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRemain:   sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(5000000))),
+			expectRetained: math.ZeroInt(),
+		},
+		{
+			name:           "lsm shares are not retained",
+			malleate:       func(zone *icstypes.Zone) {},
+			assets:         sdk.NewCoins(sdk.NewCoin("cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0/1", math.NewInt(5000000))),
+			expectRemain:   sdk.NewCoins(sdk.NewCoin("cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0/1", math.NewInt(5000000))),
+			expectRetained: math.ZeroInt(),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			zone := suite.setupLiquidityBuffer(ctx)
+			tt.malleate(&zone)
+			icsKeeper.SetZone(ctx, &zone)
+			balanceBefore := zone.LiquidityBuffer.Balance
+
+			remain := icsKeeper.FillLiquidityBuffer(ctx, &zone, tt.assets)
+			suite.Equal(tt.expectRemain, remain)
+
+			zone, found := icsKeeper.GetZone(ctx, zone.ChainId)
+			suite.True(found)
+			suite.Equal(balanceBefore.Add(tt.expectRetained), zone.LiquidityBuffer.Balance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInstantRedemptionAcknowledgement() {
+	for _, success := range []bool{true, false} {
+		suite.Run(map[bool]string{true: "success", false: "failure"}[success], func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			quicksilver := suite.GetQuicksilverApp(suite.chainA)
+			icsKeeper := quicksilver.InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			txk := ica.TxKeeper{}
+			icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+
+			zone := suite.setupLiquidityBuffer(ctx)
+			ratioBefore, _ := icsKeeper.GetRatio(ctx, &zone, math.ZeroInt())
+			msg := icstypes.MsgInstantRedemption{
+				Value:              sdk.NewCoin("uqatom", math.NewInt(5000000)),
+				DestinationAddress: addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+				FromAddress:        testAddress,
+			}
+			_, err := icskeeper.NewMsgServerImpl(icsKeeper).InstantRedemption(sdk.WrapSDKContext(ctx), &msg)
+			suite.NoError(err)
+			suite.Len(txk.Txs, 1)
+
+			// the redemption rate does not dip while the payout is in flight, as the fee accrues to holders.
+			zone, found := icsKeeper.GetZone(ctx, zone.ChainId)
+			suite.True(found)
+			ratioInFlight, _ := icsKeeper.GetRatio(ctx, &zone, math.ZeroInt())
+			suite.True(ratioInFlight.GTE(ratioBefore), "ratio %s dipped below %s", ratioInFlight, ratioBefore)
+			supplyInFlight := quicksilver.BankKeeper.GetSupply(ctx, "uqatom")
+
+			sendMsg := txk.Txs[0].Msgs[0]
+			memo := txk.Txs[0].Memo
+			escrowAddress := quicksilver.AccountKeeper.GetModuleAddress(icstypes.EscrowModuleAccount)
+
+			if success {
+				suite.NoError(icsKeeper.HandleCompleteSend(ctx, sendMsg, memo, zone.ConnectionId))
+
+				records := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+				suite.Len(records, 1)
+				suite.Equal(icstypes.WithdrawStatusCompleted, records[0].Status)
+				suite.True(quicksilver.BankKeeper.GetBalance(ctx, escrowAddress, "uqatom").IsZero())
+				suite.Equal(supplyInFlight, quicksilver.BankKeeper.GetSupply(ctx, "uqatom"))
+
+				zone, found := icsKeeper.GetZone(ctx, zone.ChainId)
+				suite.True(found)
+				suite.Equal(math.NewInt(5050000), zone.LiquidityBuffer.Balance)
+				ratio, _ := icsKeeper.GetRatio(ctx, &zone, math.ZeroInt())
+				suite.Equal(ratioInFlight, ratio)
+				return
+			}
+
+			suite.NoError(icsKeeper.HandleFailedBankSend(ctx, sendMsg, memo, zone.ConnectionId))
+
+			records := icsKeeper.AllZoneWithdrawalRecords(ctx, zone.ChainId)
+			suite.Len(records, 1)
+			suite.Equal(icstypes.WithdrawStatusQueued, records[0].Status)
+			suite.True(records[0].Requeued)
+			suite.Empty(records[0].Amount)
+			// the burned qAssets are restored to escrow for the requeued unbonding.
+			suite.Equal(msg.Value, quicksilver.BankKeeper.GetBalance(ctx, escrowAddress, "uqatom"))
+			suite.Equal(supplyInFlight.Add(msg.Value), quicksilver.BankKeeper.GetSupply(ctx, "uqatom"))
+
+			zone, found = icsKeeper.GetZone(ctx, zone.ChainId)
+			suite.True(found)
+			suite.Equal(math.NewInt(10000000), zone.LiquidityBuffer.Balance)
+			suite.True(zone.LiquidityBuffer.RedeemedThisEpoch.IsZero())
+			ratio, _ := icsKeeper.GetRatio(ctx, &zone, math.ZeroInt())
+			suite.Equal(ratioBefore, ratio)
+		})
+	}
+}
@@ -9,10 +9,68 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/quicksilver-zone/quicksilver/utils"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
 
+// RegisterLsmProvider registers an LsmProvider, making it selectable by zones.
+func (k *Keeper) RegisterLsmProvider(provider types.LsmProvider) {
+	k.lsmProviders[provider.Name()] = provider
+}
+
+// HasLsmProvider returns true if an LsmProvider with the given name is registered.
+func (k Keeper) HasLsmProvider(name string) bool {
+	if name == types.LsmProviderNone {
+		return true
+	}
+	_, found := k.lsmProviders[name]
+	return found
+}
+
+// LsmProviderForZone returns the LsmProvider selected by the zone. Zones without
+// liquid staking module support, or selecting an unregistered provider, are
+// served by types.NoLsmProvider.
+func (k Keeper) LsmProviderForZone(zone *types.Zone) types.LsmProvider {
+	if !zone.SupportLsm() {
+		return types.NoLsmProvider{}
+	}
+	return k.lsmProviderByName(zone.LsmProvider)
+}
+
+// lsmCapsProvider returns the LsmProvider whose caps bound the delegations of
+// the zone. Unlike LsmProviderForZone, it does not depend on the zone accepting
+// tokenized shares, as the caps of the host chain apply to the delegations of
+// the zone regardless.
+func (k Keeper) lsmCapsProvider(zone *types.Zone) types.LsmProvider {
+	return k.lsmProviderByName(zone.LsmProvider)
+}
+
+func (k Keeper) lsmProviderByName(name string) types.LsmProvider {
+	if name == "" {
+		name = types.LsmProviderGaia
+	}
+	provider, found := k.lsmProviders[name]
+	if !found {
+		return types.NoLsmProvider{}
+	}
+	return provider
+}
+
+// lsmProviderForMsgType returns the registered LsmProvider whose redeem or
+// tokenize messages have the given type url. Acknowledgements are dispatched by
+// message type rather than by the current provider of the zone, so that those
+// of messages sent before the zone changed provider are still handled.
+func (k Keeper) lsmProviderForMsgType(typeURL string) (types.LsmProvider, bool) {
+	for _, name := range utils.Keys(k.lsmProviders) {
+		provider := k.lsmProviders[name]
+		if typeURL == provider.RedeemTokensMsgType() || typeURL == provider.TokenizeSharesMsgType() {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
 // GetCap returns Cap info by zone and delegator
 func (k Keeper) GetLsmCaps(ctx sdk.Context, chainID string) (*types.LsmCaps, bool) {
 	caps := types.LsmCaps{}
@@ -99,8 +157,7 @@ func (k Keeper) CheckExceedsGlobalCap(ctx sdk.Context, zone *types.Zone, amount
 
 	liquidSupply := k.GetLiquidStakedSupply(ctx, zone)
 	totalSupply := sdk.NewDecFromInt(k.GetTotalStakedSupply(ctx, zone))
-	amountDec := sdk.NewDecFromInt(amount)
-	return liquidSupply.Add(amountDec).Quo(totalSupply).GT(caps.GlobalCap)
+	return k.lsmCapsProvider(zone).ExceedsGlobalCap(*caps, liquidSupply, totalSupply, sdk.NewDecFromInt(amount))
 }
 
 func (k Keeper) CheckExceedsValidatorCap(ctx sdk.Context, zone *types.Zone, validator string, amount math.Int) error {
@@ -111,28 +168,12 @@ func (k Keeper) CheckExceedsValidatorCap(ctx sdk.Context, zone *types.Zone, vali
 		return nil
 	}
 
-	// Retrieve the validator's information
-	valAddrBytes, err := addressutils.ValAddressFromBech32(validator, zone.GetValoperPrefix())
+	val, err := k.validatorForCap(ctx, zone, validator)
 	if err != nil {
 		return err
 	}
 
-	val, found := k.GetValidator(ctx, zone.ChainId, valAddrBytes)
-	if !found {
-		// Validator not found, throw an error
-		return errors.New("validator not found")
-	}
-
-	// Calculate the liquid shares and tokens
-	amountDec := sdk.NewDecFromInt(amount)
-	liquidShares := val.LiquidShares.Add(amountDec)
-	tokens := sdk.NewDecFromInt(val.VotingPower).Add(amountDec)
-
-	if liquidShares.Quo(tokens).GT(caps.ValidatorCap) {
-		return errors.New("exceeds validator cap")
-	}
-
-	return nil
+	return k.lsmCapsProvider(zone).CheckValidatorCap(*caps, val, sdk.NewDecFromInt(amount))
 }
 
 func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, zone *types.Zone, validator string, amount math.Int) error {
@@ -142,26 +183,24 @@ func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, zone *types.Zone,
 		return nil
 	}
 
-	// Retrieve the validator's information
-	valAddrBytes, err := addressutils.ValAddressFromBech32(validator, zone.GetValoperPrefix())
+	val, err := k.validatorForCap(ctx, zone, validator)
 	if err != nil {
 		return err
 	}
 
+	return k.lsmCapsProvider(zone).CheckValidatorBondCap(*caps, val, sdk.NewDecFromInt(amount))
+}
+
+func (k Keeper) validatorForCap(ctx sdk.Context, zone *types.Zone, validator string) (types.Validator, error) {
+	valAddrBytes, err := addressutils.ValAddressFromBech32(validator, zone.GetValoperPrefix())
+	if err != nil {
+		return types.Validator{}, err
+	}
+
 	val, found := k.GetValidator(ctx, zone.ChainId, valAddrBytes)
 	if !found {
 		// cannot find validator, do not allow to proceed.
-		return errors.New("validator not found")
+		return types.Validator{}, errors.New("validator not found")
 	}
-
-	maxShares := val.ValidatorBondShares.Mul(caps.ValidatorBondCap)
-
-	amountDec := sdk.NewDecFromInt(amount)
-	liquidShares := val.LiquidShares.Add(amountDec)
-
-	if liquidShares.GT(maxShares) {
-		return errors.New("exceeds validator bond cap")
-	}
-
-	return nil
+	return val, nil
 }
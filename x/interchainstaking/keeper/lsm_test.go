@@ -1,10 +1,16 @@
 package keeper_test
 
 import (
+	"errors"
+	"strings"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/quicksilver-zone/quicksilver/utils/ica"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
@@ -462,3 +468,166 @@ func (suite *KeeperTestSuite) TestCheckExceedsValidatorBondCap() {
 		})
 	}
 }
+
+// testLsmProvider is an LsmProvider for a host chain using {valoper}-{record_id}
+// share denoms and MsgCancelUnbondingDelegation to redeem shares; a message no
+// other provider, nor the rest of the keeper, handles.
+type testLsmProvider struct {
+	types.NoLsmProvider
+}
+
+func (testLsmProvider) Name() string { return "test" }
+
+func (testLsmProvider) ParseShareDenom(denom string) (string, bool) {
+	parts := strings.Split(denom, "-")
+	if len(parts) != 2 {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func (testLsmProvider) RedeemTokensMsgType() string {
+	return sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{})
+}
+
+func (testLsmProvider) NewRedeemTokensMsg(delegator string, amount sdk.Coin) sdk.Msg {
+	valoper, _ := testLsmProvider{}.ParseShareDenom(amount.Denom)
+	return &stakingtypes.MsgCancelUnbondingDelegation{DelegatorAddress: delegator, ValidatorAddress: valoper, Amount: amount}
+}
+
+func (testLsmProvider) ParseRedeemTokensMsg(msg sdk.Msg) (string, sdk.Coin, error) {
+	redeemMsg, ok := msg.(*stakingtypes.MsgCancelUnbondingDelegation)
+	if !ok {
+		return "", sdk.Coin{}, errors.New("unable to cast source message to MsgCancelUnbondingDelegation")
+	}
+	return redeemMsg.DelegatorAddress, redeemMsg.Amount, nil
+}
+
+func (suite *KeeperTestSuite) TestLsmProviderForZone() {
+	tcs := []struct {
+		Name            string
+		LiquidityModule bool
+		LsmProvider     string
+		Expected        string
+	}{
+		{Name: "lsm disabled", LiquidityModule: false, LsmProvider: types.LsmProviderGaia, Expected: types.LsmProviderNone},
+		{Name: "default provider", LiquidityModule: true, LsmProvider: "", Expected: types.LsmProviderGaia},
+		{Name: "gaia provider", LiquidityModule: true, LsmProvider: types.LsmProviderGaia, Expected: types.LsmProviderGaia},
+		{Name: "none provider", LiquidityModule: true, LsmProvider: types.LsmProviderNone, Expected: types.LsmProviderNone},
+		{Name: "registered provider", LiquidityModule: true, LsmProvider: "test", Expected: "test"},
+		{Name: "unknown provider", LiquidityModule: true, LsmProvider: "unknown", Expected: types.LsmProviderNone},
+	}
+	for _, t := range tcs {
+		suite.Run(t.Name, func() {
+			suite.SetupTest()
+			icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+			icsKeeper.RegisterLsmProvider(testLsmProvider{})
+
+			zone := types.Zone{LiquidityModule: t.LiquidityModule, LsmProvider: t.LsmProvider}
+			suite.Equal(t.Expected, icsKeeper.LsmProviderForZone(&zone).Name())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleCompleteSendWithLsmProvider() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	txk := ica.TxKeeper{}
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+	icsKeeper.RegisterLsmProvider(testLsmProvider{})
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.LsmProvider = "test"
+	icsKeeper.SetZone(ctx, &zone)
+
+	valoper := icsKeeper.GetValidators(ctx, zone.ChainId)[0].ValoperAddress
+	shares := sdk.NewCoin(valoper+"-1", math.NewInt(1000))
+	_, ok := icsKeeper.LsmProviderForZone(&zone).ParseShareDenom(shares.Denom)
+	suite.True(ok)
+
+	msg := &banktypes.MsgSend{FromAddress: zone.DepositAddress.Address, ToAddress: zone.DelegationAddress.Address, Amount: sdk.NewCoins(shares)}
+	suite.NoError(icsKeeper.HandleCompleteSend(ctx, msg, "", suite.path.EndpointA.ConnectionID))
+
+	suite.Len(txk.Txs, 1)
+	suite.Len(txk.Txs[0].Msgs, 1)
+	redeemMsg, ok := txk.Txs[0].Msgs[0].(*stakingtypes.MsgCancelUnbondingDelegation)
+	suite.True(ok)
+	suite.Equal(zone.DelegationAddress.Address, redeemMsg.DelegatorAddress)
+	suite.Equal(valoper, redeemMsg.ValidatorAddress)
+	suite.Equal(shares, redeemMsg.Amount)
+}
+
+func (suite *KeeperTestSuite) TestHandleFailedRedeemTokensWithLsmProvider() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.WithdrawalWaitgroup = 2
+	zone.LsmProvider = "test"
+	icsKeeper.SetZone(ctx, &zone)
+
+	valoper := icsKeeper.GetValidators(ctx, zone.ChainId)[0].ValoperAddress
+	msg := testLsmProvider{}.NewRedeemTokensMsg(zone.DelegationAddress.Address, sdk.NewCoin(valoper+"-1", math.NewInt(1000)))
+
+	// messages of no registered provider are not handled as redeem messages.
+	err := icsKeeper.HandleFailedRedeemTokens(ctx, msg, "batch/1000", zone.ConnectionId)
+	suite.ErrorContains(err, "no lsm provider found for message type")
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.Equal(uint32(2), zone.WithdrawalWaitgroup)
+
+	icsKeeper.RegisterLsmProvider(testLsmProvider{})
+	suite.NoError(icsKeeper.HandleFailedRedeemTokens(ctx, msg, "batch/1000", zone.ConnectionId))
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.Equal(uint32(1), zone.WithdrawalWaitgroup)
+
+	// acknowledgements of messages sent before the zone changed provider are handled by the
+	// provider that built them.
+	zone.LsmProvider = types.LsmProviderGaia
+	icsKeeper.SetZone(ctx, &zone)
+	suite.NoError(icsKeeper.HandleFailedRedeemTokens(ctx, msg, "batch/1000", zone.ConnectionId))
+	zone, _ = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.Equal(uint32(0), zone.WithdrawalWaitgroup)
+}
+
+func (suite *KeeperTestSuite) TestLsmCapsProvider() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	validators := icsKeeper.GetValidators(ctx, suite.chainB.ChainID)
+	validators[0].VotingPower = math.NewInt(1000)
+	validators[0].LiquidShares = sdk.NewDec(400)
+	validators[0].ValidatorBondShares = sdk.NewDec(5)
+	suite.NoError(icsKeeper.SetValidator(ctx, suite.chainB.ChainID, validators[0]))
+	icsKeeper.SetLsmCaps(ctx, suite.chainB.ChainID, types.LsmCaps{
+		ValidatorCap:     sdk.NewDecWithPrec(50, 2),
+		ValidatorBondCap: sdk.NewDec(100),
+		GlobalCap:        sdk.NewDecWithPrec(5, 2),
+	})
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	// the caps of the host chain bound delegations whether or not tokenized shares are accepted.
+	zone.LiquidityModule = false
+	suite.Error(icsKeeper.CheckExceedsValidatorBondCap(ctx, &zone, validators[0].ValoperAddress, math.NewInt(101)))
+	suite.Equal(math.NewInt(100), icsKeeper.DetermineMaximumValidatorAllocations(ctx, &zone)[validators[0].ValoperAddress])
+
+	// zones without a liquid staking module have no caps.
+	zone.LsmProvider = types.LsmProviderNone
+	suite.NoError(icsKeeper.CheckExceedsValidatorBondCap(ctx, &zone, validators[0].ValoperAddress, math.NewInt(101)))
+	suite.NoError(icsKeeper.CheckExceedsValidatorCap(ctx, &zone, validators[0].ValoperAddress, math.NewInt(1000)))
+	suite.Empty(icsKeeper.DetermineMaximumValidatorAllocations(ctx, &zone))
+}
@@ -97,6 +97,78 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 	return &types.MsgRequestRedemptionResponse{}, nil
 }
 
+// InstantRedemption redeems qAssets for native assets paid immediately from the
+// liquidity buffer of the zone, less the buffer fee.
+func (k msgServer) InstantRedemption(goCtx context.Context, msg *types.MsgInstantRedemption) (*types.MsgInstantRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetUnbondingEnabled(ctx) {
+		return nil, errors.New("unbonding is currently disabled")
+	}
+
+	zone := k.GetZoneByLocalDenom(ctx, msg.Value.Denom)
+
+	// does zone exist?
+	if zone == nil {
+		return nil, fmt.Errorf("unable to find matching zone for denom %s", msg.Value.GetDenom())
+	}
+
+	if !zone.UnbondingEnabled {
+		return nil, fmt.Errorf("unbonding currently disabled for zone %s", zone.ChainId)
+	}
+
+	if !zone.InstantRedemptionEnabled() {
+		return nil, fmt.Errorf("instant redemption currently disabled for zone %s", zone.ChainId)
+	}
+
+	// does destination address match the prefix registered against the zone?
+	if _, err := addressutils.AccAddressFromBech32(msg.DestinationAddress, zone.AccountPrefix); err != nil {
+		return nil, fmt.Errorf("destination address %s does not match expected prefix %s [%w]", msg.DestinationAddress, zone.AccountPrefix, err)
+	}
+
+	sender, _ := sdk.AccAddressFromBech32(msg.FromAddress) // already validated
+
+	// does the user have sufficient assets to burn
+	if !k.BankKeeper.HasBalance(ctx, sender, msg.Value) {
+		return nil, errors.New("account has insufficient balance of qasset to burn")
+	}
+
+	heightBytes := make([]byte, 8)
+	blockHeight := ctx.BlockHeight()
+	if blockHeight < 0 {
+		return nil, fmt.Errorf("block height is negative: %d", blockHeight)
+	}
+
+	binary.BigEndian.PutUint64(heightBytes, uint64(blockHeight))
+	hash := sha256.Sum256(append(msg.GetSignBytes(), heightBytes...))
+	hashString := hex.EncodeToString(hash[:])
+
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.EscrowModuleAccount, sdk.NewCoins(msg.Value)); err != nil {
+		return nil, fmt.Errorf("unable to send coins to escrow account: %w", err)
+	}
+
+	amount, err := k.RedeemFromLiquidityBuffer(ctx, zone, sender, msg.DestinationAddress, msg.Value, hashString)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeInstantRedemption,
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, msg.Value.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, msg.DestinationAddress),
+			sdk.NewAttribute(types.AttributeKeyChainID, zone.ChainId),
+		),
+	})
+
+	return &types.MsgInstantRedemptionResponse{Amount: amount}, nil
+}
+
 func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -202,6 +202,12 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 			}
 			zone.LiquidityModule = boolValue
 
+		case "lsm_provider":
+			if !k.HasLsmProvider(change.Value) {
+				return fmt.Errorf("unknown lsm provider: %s", change.Value)
+			}
+			zone.LsmProvider = change.Value
+
 		case "unbonding_enabled":
 			boolValue, err := strconv.ParseBool(change.Value)
 			if err != nil {
@@ -267,6 +273,95 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 			}
 			zone.DustThreshold = intVal
 
+		case "rebalance_strategy":
+			if err := types.ValidateRebalanceStrategy(change.Value); err != nil {
+				return err
+			}
+			zone.RebalanceStrategy = change.Value
+
+		case "rebalance_threshold":
+			decVal, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decVal.IsNegative() || decVal.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid value for rebalance_threshold: %s", change.Value)
+			}
+			zone.RebalanceThreshold = decVal
+
+		case "rebalance_max_turnover":
+			decVal, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if !decVal.IsPositive() || decVal.GT(sdk.OneDec()) {
+				return fmt.Errorf("invalid value for rebalance_max_turnover: %s", change.Value)
+			}
+			zone.RebalanceMaxTurnover = decVal
+
+		case "unbonding_interval_blocks":
+			intVal, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if intVal < 0 || (intVal > 0 && intVal < MinUnbondingIntervalBlocks) {
+				return fmt.Errorf("invalid value for unbonding_interval_blocks: %d; must be zero or at least %d", intVal, MinUnbondingIntervalBlocks)
+			}
+			zone.UnbondingIntervalBlocks = intVal
+
+		case "unbonding_interval_hours":
+			intVal, err := strconv.ParseInt(change.Value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if intVal < 0 || (intVal > 0 && intVal < MinUnbondingIntervalHours) {
+				return fmt.Errorf("invalid value for unbonding_interval_hours: %d; must be zero or at least %d", intVal, MinUnbondingIntervalHours)
+			}
+			zone.UnbondingIntervalHours = intVal
+
+		case "instant_redemption_enabled":
+			boolValue, err := strconv.ParseBool(change.Value)
+			if err != nil {
+				return err
+			}
+			if zone.LiquidityBuffer == nil {
+				zone.LiquidityBuffer = types.NewLiquidityBuffer()
+			}
+			zone.LiquidityBuffer.Enabled = boolValue
+
+		case "instant_redemption_fee":
+			decVal, err := sdk.NewDecFromStr(change.Value)
+			if err != nil {
+				return err
+			}
+			if decVal.IsNegative() || decVal.GTE(sdk.OneDec()) {
+				return fmt.Errorf("invalid value for instant_redemption_fee: %s", change.Value)
+			}
+			if zone.LiquidityBuffer == nil {
+				zone.LiquidityBuffer = types.NewLiquidityBuffer()
+			}
+			zone.LiquidityBuffer.Fee = decVal
+
+		case "liquidity_buffer_target":
+			intVal, ok := sdkmath.NewIntFromString(change.Value)
+			if !ok || intVal.IsNegative() {
+				return fmt.Errorf("invalid value for liquidity_buffer_target: %s", change.Value)
+			}
+			if zone.LiquidityBuffer == nil {
+				zone.LiquidityBuffer = types.NewLiquidityBuffer()
+			}
+			zone.LiquidityBuffer.Target = intVal
+
+		case "instant_redemption_epoch_cap":
+			intVal, ok := sdkmath.NewIntFromString(change.Value)
+			if !ok || intVal.IsNegative() {
+				return fmt.Errorf("invalid value for instant_redemption_epoch_cap: %s", change.Value)
+			}
+			if zone.LiquidityBuffer == nil {
+				zone.LiquidityBuffer = types.NewLiquidityBuffer()
+			}
+			zone.LiquidityBuffer.EpochCap = intVal
+
 		case "connection_id":
 			if !strings.HasPrefix(change.Value, "connection-") {
 				return errors.New("unexpected connection format")
@@ -336,6 +431,15 @@ func (k *Keeper) HandleUpdateZoneProposal(ctx sdk.Context, p *types.UpdateZonePr
 			return fmt.Errorf("unexpected key '%s'", change.Key)
 		}
 	}
+
+	if zone.RebalanceStrategy == types.RebalanceStrategyCappedTurnover && (zone.RebalanceMaxTurnover.IsNil() || !zone.RebalanceMaxTurnover.IsPositive()) {
+		return errors.New("rebalance_max_turnover must be set to use the capped_turnover rebalance strategy")
+	}
+
+	if zone.InstantRedemptionEnabled() && !zone.LiquidityBuffer.EpochCap.IsPositive() {
+		return errors.New("instant_redemption_epoch_cap must be set to enable instant redemption")
+	}
+
 	k.SetZone(ctx, &zone)
 
 	k.Logger(ctx).Info("applied changes to zone", "changes", p.Changes, "zone", zone.ChainId)
@@ -72,6 +72,26 @@ func (suite *KeeperTestSuite) TestHandleUpdateZoneProposal() {
 								Key:   "transfer_channel",
 								Value: "channel-101",
 							},
+							{
+								Key:   "lsm_provider",
+								Value: "gaia",
+							},
+							{
+								Key:   "rebalance_strategy",
+								Value: "threshold",
+							},
+							{
+								Key:   "rebalance_threshold",
+								Value: "0.05",
+							},
+							{
+								Key:   "unbonding_interval_blocks",
+								Value: "3600",
+							},
+							{
+								Key:   "unbonding_interval_hours",
+								Value: "6",
+							},
 						},
 					},
 				}
@@ -86,6 +106,9 @@ func (suite *KeeperTestSuite) TestHandleUpdateZoneProposal() {
 				suite.False(newZone.ReturnToSender)
 				suite.Equal(newZone.MessagesPerTx, int64(2))
 				suite.Equal(newZone.AccountPrefix, "osmo")
+				suite.Equal(newZone.LsmProvider, "gaia")
+				suite.Equal(newZone.RebalanceStrategy, "threshold")
+				suite.Equal(newZone.RebalanceThreshold, sdk.NewDecWithPrec(5, 2))
 			},
 		},
 		{
@@ -334,6 +357,309 @@ func (suite *KeeperTestSuite) TestHandleUpdateZoneProposal() {
 				}
 			},
 		},
+		{
+			name:      "invalid - lsm_provider",
+			expectErr: "unknown lsm provider",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "lsm_provider",
+								Value: "stride",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - rebalance_strategy",
+			expectErr: "unknown rebalance strategy",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "rebalance_strategy",
+								Value: "random",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - rebalance_threshold",
+			expectErr: "invalid value for rebalance_threshold",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "rebalance_threshold",
+								Value: "1.5",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - rebalance_max_turnover",
+			expectErr: "invalid value for rebalance_max_turnover",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "rebalance_max_turnover",
+								Value: "0",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - unbonding_interval_blocks",
+			expectErr: "invalid value for unbonding_interval_blocks",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "unbonding_interval_blocks",
+								Value: "-1",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - unbonding_interval_blocks shorter than ica timeout",
+			expectErr: "invalid value for unbonding_interval_blocks",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "unbonding_interval_blocks",
+								Value: "1",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - unbonding_interval_hours shorter than ica timeout",
+			expectErr: "invalid value for unbonding_interval_hours",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "unbonding_interval_hours",
+								Value: "5",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - unbonding_interval_hours",
+			expectErr: "invalid value for unbonding_interval_hours",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "unbonding_interval_hours",
+								Value: "-6",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "valid - instant redemption",
+			expectErr: "",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "instant_redemption_enabled",
+								Value: "true",
+							},
+							{
+								Key:   "instant_redemption_fee",
+								Value: "0.005",
+							},
+							{
+								Key:   "liquidity_buffer_target",
+								Value: "1000000000",
+							},
+							{
+								Key:   "instant_redemption_epoch_cap",
+								Value: "250000000",
+							},
+						},
+					},
+				}
+			},
+			check: func(ctx sdk.Context, quicksilver *app.Quicksilver, prevZone icstypes.Zone) {
+				newZone, found := quicksilver.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+				suite.True(found)
+
+				suite.NotNil(newZone.LiquidityBuffer)
+				suite.True(newZone.LiquidityBuffer.Enabled)
+				suite.Equal(sdk.NewDecWithPrec(5, 3), newZone.LiquidityBuffer.Fee)
+				suite.Equal(math.NewInt(1000000000), newZone.LiquidityBuffer.Target)
+				suite.Equal(math.NewInt(250000000), newZone.LiquidityBuffer.EpochCap)
+				suite.True(newZone.LiquidityBuffer.Balance.IsZero())
+			},
+		},
+		{
+			name:      "invalid - instant_redemption_fee",
+			expectErr: "invalid value for instant_redemption_fee",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "instant_redemption_fee",
+								Value: "1",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - liquidity_buffer_target",
+			expectErr: "invalid value for liquidity_buffer_target",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "liquidity_buffer_target",
+								Value: "-1",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - instant_redemption_epoch_cap",
+			expectErr: "invalid value for instant_redemption_epoch_cap",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "instant_redemption_epoch_cap",
+								Value: "abc",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - instant redemption without epoch cap",
+			expectErr: "instant_redemption_epoch_cap must be set",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "instant_redemption_enabled",
+								Value: "true",
+							},
+						},
+					},
+				}
+			},
+		},
+		{
+			name:      "invalid - capped_turnover without rebalance_max_turnover",
+			expectErr: "rebalance_max_turnover must be set",
+			setup: func(ctx sdk.Context, quicksilver *app.Quicksilver) {
+				suite.setupTestZones()
+			},
+			proposals: func(zone icstypes.Zone) []icstypes.UpdateZoneProposal {
+				return []icstypes.UpdateZoneProposal{
+					{
+						ChainId: zone.ChainId,
+						Changes: []*icstypes.UpdateZoneValue{
+							{
+								Key:   "rebalance_strategy",
+								Value: "capped_turnover",
+							},
+						},
+					},
+				}
+			},
+		},
 		{
 			name:      "invalid - messages_per_tx",
 			expectErr: "invalid value for messages_per_tx",
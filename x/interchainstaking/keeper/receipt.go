@@ -82,7 +82,7 @@ func (k *Keeper) HandleReceiptTransaction(ctx sdk.Context, txn *tx.Tx, hash stri
 
 	}
 
-	valid, matchesVals := zone.ValidateCoinsForZone(assets, k.GetValidatorAddressesAsMap(ctx, zone.ChainId))
+	valid, matchesVals := zone.ValidateCoinsForZone(assets, k.GetValidatorAddressesAsMap(ctx, zone.ChainId), k.LsmProviderForZone(&zone))
 
 	if !valid {
 		k.Logger(ctx).Error("unable to validate coins. Ignoring.", "senderAddress", senderAddress)
@@ -132,9 +132,12 @@ func (k *Keeper) HandleReceiptTransaction(ctx sdk.Context, txn *tx.Tx, hash stri
 	}
 
 	if success {
-		if err := k.TransferToDelegate(ctx, &zone, assets, hash); err != nil {
-			k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
-			return fmt.Errorf("unable to transfer to delegate. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+		// top up the liquidity buffer before delegating the remainder.
+		if toDelegate := k.FillLiquidityBuffer(ctx, &zone, assets); !toDelegate.IsZero() {
+			if err := k.TransferToDelegate(ctx, &zone, toDelegate, hash); err != nil {
+				k.Logger(ctx).Error("unable to transfer to delegate. Ignoring.", "senderAddress", senderAddress, "zone", zone.ChainId, "err", err)
+				return fmt.Errorf("unable to transfer to delegate. Ignoring. senderAddress=%q zone=%q err: %w", senderAddress, zone.ChainId, err)
+			}
 		}
 		if memoAutoClaim {
 			if err := k.HandleAutoClaim(ctx, senderAccAddress); err != nil {
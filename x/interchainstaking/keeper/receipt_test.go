@@ -15,6 +15,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/utils/ica"
 	"github.com/quicksilver-zone/quicksilver/utils/randomutils"
 	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 )
@@ -57,6 +58,55 @@ func (suite *KeeperTestSuite) TestHandleReceiptTransactionGood() {
 	suite.Equal(sdk.NewCoin(zone.LocalDenom, math.NewInt(1833333)), after2)
 }
 
+func (suite *KeeperTestSuite) TestHandleReceiptTransactionFillsLiquidityBuffer() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	txk := ica.TxKeeper{}
+	icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.LiquidityBuffer = types.NewLiquidityBuffer()
+	zone.LiquidityBuffer.Enabled = true
+	zone.LiquidityBuffer.EpochCap = math.NewInt(1000000)
+	zone.LiquidityBuffer.Target = math.NewInt(1500000)
+	icsKeeper.SetZone(ctx, &zone)
+
+	fromAddress := addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix)
+	msg := banktypes.MsgSend{FromAddress: fromAddress, ToAddress: zone.DepositAddress.Address, Amount: sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, math.NewInt(1000000)))}
+	anymsg, err := codectypes.NewAnyWithValue(&msg)
+	suite.NoError(err)
+	transaction := &tx.Tx{Body: &tx.TxBody{Messages: []*codectypes.Any{anymsg}}}
+
+	// the first deposit is retained in full; nothing is sent to the delegate account.
+	suite.NoError(icsKeeper.HandleReceiptTransaction(ctx, transaction, randomutils.GenerateRandomHashAsHex(64), zone))
+	suite.Empty(txk.Txs)
+
+	zone, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(math.NewInt(1000000), zone.LiquidityBuffer.Balance)
+
+	// the second deposit tops the buffer up to target, and the remainder is delegated.
+	suite.NoError(icsKeeper.HandleReceiptTransaction(ctx, transaction, randomutils.GenerateRandomHashAsHex(64), zone))
+	suite.Len(txk.Txs, 1)
+	sendMsg, ok := txk.Txs[0].Msgs[0].(*banktypes.MsgSend)
+	suite.True(ok)
+	suite.Equal(zone.DelegationAddress.Address, sendMsg.ToAddress)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(zone.BaseDenom, math.NewInt(500000))), sendMsg.Amount)
+
+	zone, found = icsKeeper.GetZone(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(math.NewInt(1500000), zone.LiquidityBuffer.Balance)
+
+	// qAssets are minted for the full deposits.
+	supply := suite.GetQuicksilverApp(suite.chainA).BankKeeper.GetSupply(ctx, zone.LocalDenom)
+	suite.Equal(sdk.NewCoin(zone.LocalDenom, math.NewInt(2000000)), supply)
+}
+
 func (suite *KeeperTestSuite) TestHandleReceiptTransactionBadRecipient() {
 	suite.SetupTest()
 	suite.setupTestZones()
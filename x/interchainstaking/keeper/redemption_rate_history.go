@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// SetRedemptionRateSample stores a redemption rate sample, keyed by chain and height.
+func (k *Keeper) SetRedemptionRateSample(ctx sdk.Context, sample types.RedemptionRateSample) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&sample)
+	store.Set(types.GetRedemptionRateSampleKey(sample.ChainId, sample.Height), bz)
+}
+
+// IterateRedemptionRateSamples iterates through the redemption rate samples of a zone, oldest first.
+func (k *Keeper) IterateRedemptionRateSamples(ctx sdk.Context, chainID string, fn func(index int64, sample types.RedemptionRateSample) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRedemptionRateHistoryPrefix(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		sample := types.RedemptionRateSample{}
+		k.cdc.MustUnmarshal(iterator.Value(), &sample)
+		if stop := fn(i, sample); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetRedemptionRateHistory returns the redemption rate samples of a zone, oldest first.
+func (k *Keeper) GetRedemptionRateHistory(ctx sdk.Context, chainID string) []types.RedemptionRateSample {
+	samples := make([]types.RedemptionRateSample, 0)
+	k.IterateRedemptionRateSamples(ctx, chainID, func(_ int64, sample types.RedemptionRateSample) (stop bool) {
+		samples = append(samples, sample)
+		return false
+	})
+	return samples
+}
+
+// DeleteRedemptionRateHistory deletes the redemption rate samples of a zone.
+func (k *Keeper) DeleteRedemptionRateHistory(ctx sdk.Context, chainID string) {
+	k.pruneRedemptionRateHistory(ctx, chainID, 0)
+}
+
+// pruneRedemptionRateHistory deletes the oldest redemption rate samples of a
+// zone, such that at most retain samples remain.
+func (k *Keeper) pruneRedemptionRateHistory(ctx sdk.Context, chainID string, retain int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetRedemptionRateHistoryPrefix(chainID))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) <= retain {
+		return
+	}
+	for _, key := range keys[:len(keys)-retain] {
+		store.Delete(key)
+	}
+}
+
+// recordRedemptionRate records the current redemption rate of a zone in its
+// redemption rate history, pruning samples beyond RedemptionRateHistoryLength.
+func (k *Keeper) recordRedemptionRate(ctx sdk.Context, zone *types.Zone, tvl sdkmath.Int) {
+	k.SetRedemptionRateSample(ctx, types.RedemptionRateSample{
+		ChainId: zone.ChainId,
+		Epoch:   k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+		Rate:    zone.RedemptionRate,
+		Tvl:     tvl,
+	})
+	k.pruneRedemptionRateHistory(ctx, zone.ChainId, types.RedemptionRateHistoryLength)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// setupRedemptionRateZone delegates 3000 native tokens and mints 3000 qAssets
+// for the test zone.
+func (suite *KeeperTestSuite) setupRedemptionRateZone(ctx sdk.Context) icstypes.Zone {
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+
+	vals := suite.GetQuicksilverApp(suite.chainB).StakingKeeper.GetAllValidators(suite.chainB.GetContext())
+	for _, val := range vals[:3] {
+		icsKeeper.SetDelegation(ctx, zone.ChainId, icstypes.Delegation{DelegationAddress: zone.DelegationAddress.Address, ValidatorAddress: val.OperatorAddress, Amount: sdk.NewCoin(zone.BaseDenom, sdk.NewInt(1000))})
+	}
+
+	suite.NoError(quicksilver.MintKeeper.MintCoins(ctx, sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, sdk.NewInt(3000)))))
+	return zone
+}
+
+func (suite *KeeperTestSuite) TestUpdateRedemptionRateRecordsHistory() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	zone := suite.setupRedemptionRateZone(ctx)
+
+	suite.Empty(icsKeeper.GetRedemptionRateHistory(ctx, zone.ChainId))
+
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+
+	ctx2 := ctx.WithBlockHeight(ctx.BlockHeight() + 100).WithBlockTime(ctx.BlockTime().Add(6 * time.Hour))
+	icsKeeper.UpdateRedemptionRate(ctx2, &zone, sdk.NewInt(30))
+
+	history := icsKeeper.GetRedemptionRateHistory(ctx2, zone.ChainId)
+	suite.Len(history, 2)
+
+	suite.Equal(zone.ChainId, history[0].ChainId)
+	suite.Equal(ctx.BlockHeight(), history[0].Height)
+	suite.True(ctx.BlockTime().Equal(history[0].Time))
+	suite.Equal(sdk.OneDec(), history[0].Rate)
+	suite.Equal(sdk.NewInt(3000), history[0].Tvl)
+
+	suite.Equal(ctx2.BlockHeight(), history[1].Height)
+	suite.True(ctx2.BlockTime().Equal(history[1].Time))
+	suite.Equal(sdk.NewDecWithPrec(101, 2), history[1].Rate)
+	suite.Equal(sdk.NewInt(3030), history[1].Tvl)
+
+	// forced updates are recorded too.
+	ctx3 := ctx2.WithBlockHeight(ctx2.BlockHeight() + 100)
+	icsKeeper.OverrideRedemptionRateNoCap(ctx3, &zone)
+	suite.Len(icsKeeper.GetRedemptionRateHistory(ctx3, zone.ChainId), 3)
+}
+
+func (suite *KeeperTestSuite) TestRedemptionRateHistoryPruned() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+	zone := suite.setupRedemptionRateZone(ctx)
+
+	for i := int64(1); i <= icstypes.RedemptionRateHistoryLength; i++ {
+		icsKeeper.SetRedemptionRateSample(ctx, icstypes.RedemptionRateSample{
+			ChainId: zone.ChainId,
+			Height:  i,
+			Time:    ctx.BlockTime(),
+			Rate:    sdk.OneDec(),
+			Tvl:     sdk.NewInt(3000),
+		})
+	}
+	// samples of other zones are unaffected.
+	icsKeeper.SetRedemptionRateSample(ctx, icstypes.RedemptionRateSample{ChainId: zone.ChainId + "0", Height: 1, Time: ctx.BlockTime(), Rate: sdk.OneDec(), Tvl: sdk.NewInt(1)})
+
+	ctx = ctx.WithBlockHeight(10000)
+	icsKeeper.UpdateRedemptionRate(ctx, &zone, sdk.ZeroInt())
+
+	history := icsKeeper.GetRedemptionRateHistory(ctx, zone.ChainId)
+	suite.Len(history, icstypes.RedemptionRateHistoryLength)
+	suite.Equal(int64(2), history[0].Height)
+	suite.Equal(int64(10000), history[len(history)-1].Height)
+	suite.Len(icsKeeper.GetRedemptionRateHistory(ctx, zone.ChainId+"0"), 1)
+
+	icsKeeper.DeleteRedemptionRateHistory(ctx, zone.ChainId)
+	suite.Empty(icsKeeper.GetRedemptionRateHistory(ctx, zone.ChainId))
+	suite.Len(icsKeeper.GetRedemptionRateHistory(ctx, zone.ChainId+"0"), 1)
+}
@@ -144,9 +144,18 @@ func (k *Keeper) GetUnlockedTokensForZone(ctx sdk.Context, zone *types.Zone) (ma
 	return availablePerValidator, total, nil
 }
 
-// HandleQueuedUnbondings is called once per epoch to aggregate all queued unbondings into
-// a single unbond transaction per delegation.
+// HandleQueuedUnbondings is called once per unbonding batch to aggregate all queued unbondings into
+// a single unbond transaction per delegation. Batches are triggered at the end of each epoch, or
+// on the zone's unbonding interval if one is set; each batch is assigned the next id from the
+// zone's unbonding schedule.
 func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch int64) error {
+	return k.handleQueuedUnbondings(ctx, zone, epoch, false)
+}
+
+// handleQueuedUnbondings submits an unbonding batch. The undelegations of scheduled batches are
+// tracked by the unbonding schedule's waitgroup, such that they neither hold up nor are reset by
+// the epoch's withdrawal waitgroup.
+func (k *Keeper) handleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch int64, scheduled bool) error {
 	// total amount coins to withdraw
 	totalToWithdraw := sdk.NewCoin(zone.BaseDenom, sdk.ZeroInt())
 
@@ -219,12 +228,17 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 		return err
 	}
 
+	schedule := k.unbondingSchedule(ctx, zone.ChainId)
+	batchID := schedule.Sequence + 1
+
 	for _, hash := range utils.Keys(distributionsPerWithdrawal) {
 		record, found := k.GetWithdrawalRecord(ctx, zone.ChainId, hash, types.WithdrawStatusQueued)
 		if !found {
 			return errors.New("unable to find withdrawal record")
 		}
 		record.Distribution = distributionsPerWithdrawal[hash]
+		record.BatchId = batchID
+		record.BatchTime = ctx.BlockTime()
 		k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusUnbond)
 	}
 
@@ -242,7 +256,7 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 
 	k.Logger(ctx).Info("unbonding messages to send", "msg", msgs)
 
-	err = k.SubmitTx(ctx, msgs, zone.DelegationAddress, types.EpochWithdrawalMemo(epoch), zone.MessagesPerTx)
+	err = k.SubmitTx(ctx, msgs, zone.DelegationAddress, types.WithdrawalBatchMemo(batchID), zone.MessagesPerTx)
 	if err != nil {
 		return err
 	}
@@ -250,10 +264,18 @@ func (k *Keeper) HandleQueuedUnbondings(ctx sdk.Context, zone *types.Zone, epoch
 	for _, valoper := range utils.Keys(coinsOutPerValidator) {
 		if !coinsOutPerValidator[valoper].Amount.IsZero() {
 			sort.Strings(txHashesPerValidator[valoper])
-			k.SetUnbondingRecord(ctx, types.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: epoch, Validator: valoper, RelatedTxhash: txHashesPerValidator[valoper], Amount: coinsOutPerValidator[valoper]})
+			k.SetUnbondingRecord(ctx, types.UnbondingRecord{ChainId: zone.ChainId, EpochNumber: epoch, BatchId: batchID, Scheduled: scheduled, Validator: valoper, RelatedTxhash: txHashesPerValidator[valoper], Amount: coinsOutPerValidator[valoper]})
 		}
 	}
 
+	schedule.Sequence = batchID
+	if scheduled {
+		schedule.Waitgroup += uint32(len(msgs)) //nolint:gosec
+		k.SetUnbondingSchedule(ctx, schedule)
+		return nil
+	}
+	k.SetUnbondingSchedule(ctx, schedule)
+
 	if err = zone.IncrementWithdrawalWaitgroup(k.Logger(ctx), uint32(len(msgs)), "trigger unbonding messages"); err != nil { //nolint:gosec
 		return err
 	}
@@ -318,7 +318,7 @@ func (suite *KeeperTestSuite) TestStargazeHandleQueuedUnbondingsUnderflow() {
 
 	suite.Equal(len(txk.Txs), 1)
 	suite.Equal(len(txk.Txs[0].Msgs), 3)
-	suite.Equal(txk.Txs[0].Memo, "withdrawal/129")
+	suite.Equal(txk.Txs[0].Memo, "withdrawal/1")
 
 	expectedMsgs := map[string]sdk.Coin{
 		"starsvaloper15rvwy3m0jmsc2ecgqmnfahvapppaqw3lh6a0x4": sdk.NewCoin("ustars", sdkmath.NewInt(29930436932)),
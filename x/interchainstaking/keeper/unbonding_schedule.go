@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+const (
+	// MinUnbondingIntervalHours is the minimum non-zero unbonding_interval_hours,
+	// such that the undelegations of a scheduled batch time out before the next
+	// batch is due.
+	MinUnbondingIntervalHours = int64(ICATimeout / time.Hour)
+	// MinUnbondingIntervalBlocks is the minimum non-zero unbonding_interval_blocks;
+	// the ICA timeout at a block time of six seconds.
+	MinUnbondingIntervalBlocks = int64(ICATimeout / (6 * time.Second))
+)
+
+// GetUnbondingSchedule returns the unbonding schedule of a zone.
+func (k *Keeper) GetUnbondingSchedule(ctx sdk.Context, chainID string) (types.UnbondingSchedule, bool) {
+	schedule := types.UnbondingSchedule{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := store.Get(types.GetUnbondingScheduleKey(chainID))
+	if bz == nil {
+		return schedule, false
+	}
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// SetUnbondingSchedule stores the unbonding schedule of a zone.
+func (k *Keeper) SetUnbondingSchedule(ctx sdk.Context, schedule types.UnbondingSchedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	bz := k.cdc.MustMarshal(&schedule)
+	store.Set(types.GetUnbondingScheduleKey(schedule.ChainId), bz)
+}
+
+// DeleteUnbondingSchedule deletes the unbonding schedule of a zone.
+func (k *Keeper) DeleteUnbondingSchedule(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
+	store.Delete(types.GetUnbondingScheduleKey(chainID))
+}
+
+// unbondingSchedule returns the unbonding schedule of a zone, initialising a
+// new schedule if none exists.
+func (k *Keeper) unbondingSchedule(ctx sdk.Context, chainID string) types.UnbondingSchedule {
+	schedule, found := k.GetUnbondingSchedule(ctx, chainID)
+	if !found {
+		schedule = types.UnbondingSchedule{ChainId: chainID}
+	}
+	return schedule
+}
+
+// decrementUnbondingWaitgroup releases a slot in the unbonding schedule's
+// waitgroup, on acknowledgement of an undelegation of a scheduled batch.
+func (k *Keeper) decrementUnbondingWaitgroup(ctx sdk.Context, chainID string, reason string) {
+	schedule := k.unbondingSchedule(ctx, chainID)
+	if schedule.Waitgroup == 0 {
+		// the waitgroup is reset when the next batch is due, so late acknowledgements are expected.
+		k.Logger(ctx).Info("unbonding waitgroup already zero", "zone", chainID, "reason", reason)
+		return
+	}
+	schedule.Waitgroup--
+	k.Logger(ctx).Info("decrementing unbonding waitgroup", "zone", chainID, "new", schedule.Waitgroup, "reason", reason)
+	k.SetUnbondingSchedule(ctx, schedule)
+}
+
+// HandleScheduledUnbondings submits an unbonding batch for a zone with an
+// unbonding interval if one is due. It is called from BeginBlocker; zones
+// without an unbonding interval are batched by the epoch hook instead.
+//
+// Undelegations of earlier scheduled batches that remain unacknowledged when
+// the next batch is due are requeued, as the epoch hook does for epoch batches,
+// but only once their ICA packets have timed out; undelegations still in flight
+// are left to be acknowledged, or failed, by their own acknowledgement.
+func (k *Keeper) HandleScheduledUnbondings(ctx sdk.Context, zone *types.Zone) error {
+	if !zone.HasUnbondingInterval() || zone.DelegationAddress == nil {
+		return nil
+	}
+
+	schedule := k.unbondingSchedule(ctx, zone.ChainId)
+	if !zone.UnbondingBatchDue(schedule, ctx.BlockHeight(), ctx.BlockTime()) {
+		return nil
+	}
+
+	k.IterateZoneStatusWithdrawalRecords(ctx, zone.ChainId, types.WithdrawStatusUnbond, func(_ int64, record types.WithdrawalRecord) bool {
+		if !record.Acknowledged && !ctx.BlockTime().Before(record.BatchTime.Add(ICATimeout)) {
+			record.Requeued = true
+			k.UpdateWithdrawalRecordStatus(ctx, &record, types.WithdrawStatusQueued)
+		}
+		return false
+	})
+	if schedule.Waitgroup > 0 {
+		k.Logger(ctx).Info("unbonding waitgroup was unexpected > 0", "zone", zone.ChainId, "waitgroup", schedule.Waitgroup)
+		schedule.Waitgroup = 0
+		k.SetUnbondingSchedule(ctx, schedule)
+	}
+
+	// state changes are written only if the batch is submitted, so a failing
+	// batch leaves the queued withdrawal records untouched.
+	epoch := k.EpochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+	cacheCtx, write := ctx.CacheContext()
+	err := k.handleQueuedUnbondings(cacheCtx, zone, epoch, true)
+	if err == nil {
+		write()
+	}
+
+	// the batch is considered attempted regardless of outcome, so a failing
+	// batch is retried on the next interval rather than every block.
+	schedule = k.unbondingSchedule(ctx, zone.ChainId)
+	schedule.LastBatchHeight = ctx.BlockHeight()
+	schedule.LastBatchTime = ctx.BlockTime()
+	k.SetUnbondingSchedule(ctx, schedule)
+
+	return err
+}
@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/utils/ica"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func (suite *KeeperTestSuite) TestUnbondingScheduleSetGetDelete() {
+	suite.SetupTest()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := icsKeeper.GetUnbondingSchedule(ctx, "cosmoshub-4")
+	suite.False(found)
+
+	schedule := types.UnbondingSchedule{
+		ChainId:         "cosmoshub-4",
+		Sequence:        12,
+		LastBatchHeight: 100,
+		LastBatchTime:   time.Unix(1700000000, 0).UTC(),
+	}
+	icsKeeper.SetUnbondingSchedule(ctx, schedule)
+
+	actual, found := icsKeeper.GetUnbondingSchedule(ctx, "cosmoshub-4")
+	suite.True(found)
+	suite.Equal(schedule, actual)
+
+	icsKeeper.DeleteUnbondingSchedule(ctx, "cosmoshub-4")
+	_, found = icsKeeper.GetUnbondingSchedule(ctx, "cosmoshub-4")
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestHandleScheduledUnbondings() {
+	tests := []struct {
+		name          string
+		malleate      func(zone *types.Zone)
+		advanceBlocks int64
+		advanceTime   time.Duration
+		expectBatch   bool
+	}{
+		{
+			name:          "epoch zone - never batched in begin blocker",
+			malleate:      func(zone *types.Zone) {},
+			advanceBlocks: 100,
+			expectBatch:   false,
+		},
+		{
+			name:          "block interval - not due",
+			malleate:      func(zone *types.Zone) { zone.UnbondingIntervalBlocks = 10 },
+			advanceBlocks: 9,
+			expectBatch:   false,
+		},
+		{
+			name:          "block interval - due",
+			malleate:      func(zone *types.Zone) { zone.UnbondingIntervalBlocks = 10 },
+			advanceBlocks: 10,
+			expectBatch:   true,
+		},
+		{
+			name:        "hour interval - not due",
+			malleate:    func(zone *types.Zone) { zone.UnbondingIntervalHours = 6 },
+			advanceTime: 5 * time.Hour,
+			expectBatch: false,
+		},
+		{
+			name:        "hour interval - due",
+			malleate:    func(zone *types.Zone) { zone.UnbondingIntervalHours = 6 },
+			advanceTime: 6 * time.Hour,
+			expectBatch: true,
+		},
+		{
+			name: "block interval takes precedence over hour interval",
+			malleate: func(zone *types.Zone) {
+				zone.UnbondingIntervalBlocks = 10
+				zone.UnbondingIntervalHours = 1
+			},
+			advanceBlocks: 1,
+			advanceTime:   2 * time.Hour,
+			expectBatch:   false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			quicksilver := suite.GetQuicksilverApp(suite.chainA)
+			icsKeeper := quicksilver.InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			txk := ica.TxKeeper{}
+			icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+
+			zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+			suite.True(found)
+			test.malleate(&zone)
+			icsKeeper.SetZone(ctx, &zone)
+
+			for _, valoper := range icsKeeper.GetValidatorAddresses(ctx, zone.ChainId) {
+				icsKeeper.SetDelegation(ctx, zone.ChainId, types.Delegation{
+					DelegationAddress: zone.DelegationAddress.Address,
+					ValidatorAddress:  valoper,
+					Amount:            sdk.NewCoin("uatom", sdk.NewInt(1000000)),
+				})
+			}
+
+			record := types.WithdrawalRecord{
+				ChainId:     zone.ChainId,
+				Delegator:   addressutils.GenerateAccAddressForTest().String(),
+				Recipient:   addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+				BurnAmount:  sdk.NewCoin("uqatom", sdk.NewInt(2000000)),
+				Txhash:      "7C8B95EEE82CB63771E02EBEB05E6A80076D70B2E0A1C457F1FD1A0EF2EA961D",
+				Status:      types.WithdrawStatusQueued,
+				EpochNumber: 3,
+			}
+			suite.NoError(icsKeeper.SetWithdrawalRecord(ctx, record))
+
+			icsKeeper.SetUnbondingSchedule(ctx, types.UnbondingSchedule{
+				ChainId:         zone.ChainId,
+				Sequence:        5,
+				LastBatchHeight: ctx.BlockHeight(),
+				LastBatchTime:   ctx.BlockTime(),
+			})
+			waitgroup := zone.GetWithdrawalWaitgroup()
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + test.advanceBlocks).WithBlockTime(ctx.BlockTime().Add(test.advanceTime))
+			suite.NoError(icsKeeper.HandleScheduledUnbondings(ctx, &zone))
+
+			schedule, found := icsKeeper.GetUnbondingSchedule(ctx, zone.ChainId)
+			suite.True(found)
+
+			if !test.expectBatch {
+				suite.Empty(txk.Txs)
+				_, found := icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusQueued)
+				suite.True(found)
+				suite.Equal(int64(5), schedule.Sequence)
+				return
+			}
+
+			suite.Len(txk.Txs, 1)
+			suite.Equal(types.WithdrawalBatchMemo(6), txk.Txs[0].Memo)
+			suite.Equal(int64(6), schedule.Sequence)
+			suite.Equal(ctx.BlockHeight(), schedule.LastBatchHeight)
+			suite.True(ctx.BlockTime().Equal(schedule.LastBatchTime))
+
+			// scheduled undelegations are tracked by the schedule's waitgroup, not the zone's.
+			suite.Equal(uint32(len(txk.Txs[0].Msgs)), schedule.Waitgroup) //nolint:gosec
+			actualZone, found := icsKeeper.GetZone(ctx, zone.ChainId)
+			suite.True(found)
+			suite.Equal(waitgroup, actualZone.GetWithdrawalWaitgroup())
+
+			actualRecord, found := icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusUnbond)
+			suite.True(found)
+			suite.NotEmpty(actualRecord.Distribution)
+			suite.Equal(int64(6), actualRecord.BatchId)
+			suite.True(ctx.BlockTime().Equal(actualRecord.BatchTime))
+			suite.Equal(int64(3), actualRecord.EpochNumber)
+			for _, distribution := range actualRecord.Distribution {
+				ubr, found := icsKeeper.GetUnbondingRecord(ctx, zone.ChainId, distribution.Valoper, 6)
+				suite.True(found)
+				suite.Equal(int64(6), ubr.BatchId)
+				suite.True(ubr.Scheduled)
+				suite.Contains(ubr.RelatedTxhash, record.Txhash)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleScheduledUnbondingsInFlight() {
+	tests := []struct {
+		name          string
+		advanceTime   time.Duration
+		expectRequeue bool
+	}{
+		{
+			name:          "ack after the next batch - not requeued",
+			advanceTime:   time.Minute,
+			expectRequeue: false,
+		},
+		{
+			name:          "unacknowledged after the ica timeout - requeued",
+			advanceTime:   keeper.ICATimeout,
+			expectRequeue: true,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			suite.setupTestZones()
+
+			quicksilver := suite.GetQuicksilverApp(suite.chainA)
+			icsKeeper := quicksilver.InterchainstakingKeeper
+			ctx := suite.chainA.GetContext()
+
+			txk := ica.TxKeeper{}
+			icsKeeper.OverrideTxSubmit(ica.GetTestSubmitTxFn(&txk))
+
+			zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+			suite.True(found)
+			zone.UnbondingIntervalBlocks = 10
+			icsKeeper.SetZone(ctx, &zone)
+
+			for _, valoper := range icsKeeper.GetValidatorAddresses(ctx, zone.ChainId) {
+				icsKeeper.SetDelegation(ctx, zone.ChainId, types.Delegation{
+					DelegationAddress: zone.DelegationAddress.Address,
+					ValidatorAddress:  valoper,
+					Amount:            sdk.NewCoin("uatom", sdk.NewInt(1000000)),
+				})
+			}
+
+			record := types.WithdrawalRecord{
+				ChainId:     zone.ChainId,
+				Delegator:   addressutils.GenerateAccAddressForTest().String(),
+				Recipient:   addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+				BurnAmount:  sdk.NewCoin("uqatom", sdk.NewInt(2000000)),
+				Txhash:      "7C8B95EEE82CB63771E02EBEB05E6A80076D70B2E0A1C457F1FD1A0EF2EA961D",
+				Status:      types.WithdrawStatusQueued,
+				EpochNumber: 3,
+			}
+			suite.NoError(icsKeeper.SetWithdrawalRecord(ctx, record))
+
+			icsKeeper.SetUnbondingSchedule(ctx, types.UnbondingSchedule{
+				ChainId:         zone.ChainId,
+				Sequence:        5,
+				LastBatchHeight: ctx.BlockHeight(),
+				LastBatchTime:   ctx.BlockTime(),
+			})
+
+			// trigger batch 6.
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+			suite.NoError(icsKeeper.HandleScheduledUnbondings(ctx, &zone))
+			suite.Len(txk.Txs, 1)
+			suite.Equal(types.WithdrawalBatchMemo(6), txk.Txs[0].Memo)
+
+			// the next batch is due before batch 6 is acknowledged.
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithBlockTime(ctx.BlockTime().Add(test.advanceTime))
+			suite.NoError(icsKeeper.HandleScheduledUnbondings(ctx, &zone))
+
+			actualRecord, found := icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusUnbond)
+			suite.True(found)
+
+			if test.expectRequeue {
+				// the timed out undelegation is submitted again in batch 7.
+				suite.Len(txk.Txs, 2)
+				suite.Equal(types.WithdrawalBatchMemo(7), txk.Txs[1].Memo)
+				suite.True(actualRecord.Requeued)
+				suite.Equal(int64(7), actualRecord.BatchId)
+				return
+			}
+
+			// the in flight undelegation is left alone, so is not submitted twice.
+			suite.Len(txk.Txs, 1)
+			suite.False(actualRecord.Requeued)
+			suite.Equal(int64(6), actualRecord.BatchId)
+
+			// the late acknowledgement of batch 6 is applied to the record.
+			completion := ctx.BlockTime().Add(21 * 24 * time.Hour)
+			for _, msg := range txk.Txs[0].Msgs {
+				suite.NoError(icsKeeper.HandleUndelegate(ctx, msg, completion, types.WithdrawalBatchMemo(6)))
+			}
+
+			actualRecord, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusUnbond)
+			suite.True(found)
+			suite.True(actualRecord.Acknowledged)
+			suite.True(completion.Equal(actualRecord.CompletionTime))
+			_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusQueued)
+			suite.False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleScheduledUnbondingsSubmitFailure() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	quicksilver := suite.GetQuicksilverApp(suite.chainA)
+	icsKeeper := quicksilver.InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	icsKeeper.OverrideTxSubmit(func(_ sdk.Context, _ *keeper.Keeper, _ []sdk.Msg, _ *types.ICAAccount, _ string, _ int64) error {
+		return errors.New("channel closed")
+	})
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	zone.UnbondingIntervalBlocks = 10
+	icsKeeper.SetZone(ctx, &zone)
+
+	for _, valoper := range icsKeeper.GetValidatorAddresses(ctx, zone.ChainId) {
+		icsKeeper.SetDelegation(ctx, zone.ChainId, types.Delegation{
+			DelegationAddress: zone.DelegationAddress.Address,
+			ValidatorAddress:  valoper,
+			Amount:            sdk.NewCoin("uatom", sdk.NewInt(1000000)),
+		})
+	}
+
+	record := types.WithdrawalRecord{
+		ChainId:     zone.ChainId,
+		Delegator:   addressutils.GenerateAccAddressForTest().String(),
+		Recipient:   addressutils.GenerateAddressForTestWithPrefix(zone.AccountPrefix),
+		BurnAmount:  sdk.NewCoin("uqatom", sdk.NewInt(2000000)),
+		Txhash:      "7C8B95EEE82CB63771E02EBEB05E6A80076D70B2E0A1C457F1FD1A0EF2EA961D",
+		Status:      types.WithdrawStatusQueued,
+		EpochNumber: 3,
+	}
+	suite.NoError(icsKeeper.SetWithdrawalRecord(ctx, record))
+
+	icsKeeper.SetUnbondingSchedule(ctx, types.UnbondingSchedule{
+		ChainId:         zone.ChainId,
+		Sequence:        5,
+		LastBatchHeight: ctx.BlockHeight(),
+		LastBatchTime:   ctx.BlockTime(),
+	})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	suite.Error(icsKeeper.HandleScheduledUnbondings(ctx, &zone))
+
+	// the failed batch leaves the withdrawal record queued and unallocated.
+	actualRecord, found := icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusQueued)
+	suite.True(found)
+	suite.Equal(record, actualRecord)
+	_, found = icsKeeper.GetWithdrawalRecord(ctx, zone.ChainId, record.Txhash, types.WithdrawStatusUnbond)
+	suite.False(found)
+
+	for _, valoper := range icsKeeper.GetValidatorAddresses(ctx, zone.ChainId) {
+		_, found := icsKeeper.GetUnbondingRecord(ctx, zone.ChainId, valoper, 6)
+		suite.False(found)
+	}
+
+	// the batch is considered attempted, so is not retried until the next interval.
+	schedule, found := icsKeeper.GetUnbondingSchedule(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(int64(5), schedule.Sequence)
+	suite.Zero(schedule.Waitgroup)
+	suite.Equal(ctx.BlockHeight(), schedule.LastBatchHeight)
+}
@@ -203,12 +203,12 @@ func (k *Keeper) AllZoneWithdrawalRecords(ctx sdk.Context, chainID string) []typ
 	return records
 }
 
-// GetUnbondingRecord returns unbonding record info by zone, validator and epoch.
-func (k *Keeper) GetUnbondingRecord(ctx sdk.Context, chainID, validator string, epochNumber int64) (types.UnbondingRecord, bool) {
+// GetUnbondingRecord returns unbonding record info by zone, validator and batch id.
+func (k *Keeper) GetUnbondingRecord(ctx sdk.Context, chainID, validator string, batchID int64) (types.UnbondingRecord, bool) {
 	record := types.UnbondingRecord{}
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
-	bz := store.Get(types.GetUnbondingKey(chainID, validator, epochNumber))
+	bz := store.Get(types.GetUnbondingKey(chainID, validator, batchID))
 	if bz == nil {
 		return record, false
 	}
@@ -220,13 +220,13 @@ func (k *Keeper) GetUnbondingRecord(ctx sdk.Context, chainID, validator string,
 func (k *Keeper) SetUnbondingRecord(ctx sdk.Context, record types.UnbondingRecord) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
 	bz := k.cdc.MustMarshal(&record)
-	store.Set(types.GetUnbondingKey(record.ChainId, record.Validator, record.EpochNumber), bz)
+	store.Set(types.GetUnbondingKey(record.ChainId, record.Validator, record.BatchId), bz)
 }
 
 // DeleteUnbondingRecord deletes unbonding record.
-func (k *Keeper) DeleteUnbondingRecord(ctx sdk.Context, chainID, validator string, epochNumber int64) {
+func (k *Keeper) DeleteUnbondingRecord(ctx sdk.Context, chainID, validator string, batchID int64) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), nil)
-	store.Delete(types.GetUnbondingKey(chainID, validator, epochNumber))
+	store.Delete(types.GetUnbondingKey(chainID, validator, batchID))
 }
 
 // IteratePrefixedUnbondingRecords iterate through all records with given prefix.
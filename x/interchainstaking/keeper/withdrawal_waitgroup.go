@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqkeeper "github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+// SetWithdrawalWaitgroupEpoch sets the epoch the withdrawal waitgroup of a zone was last reset in.
+func (k *Keeper) SetWithdrawalWaitgroupEpoch(ctx sdk.Context, chainID string, epoch int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawalWaitgroupEpochKey(chainID), sdk.Uint64ToBigEndian(uint64(epoch))) //nolint:gosec
+}
+
+// GetWithdrawalWaitgroupEpoch returns the epoch the withdrawal waitgroup of a zone was last reset in.
+func (k *Keeper) GetWithdrawalWaitgroupEpoch(ctx sdk.Context, chainID string) int64 {
+	store := ctx.KVStore(k.storeKey)
+	return int64(sdk.BigEndianToUint64(store.Get(types.GetWithdrawalWaitgroupEpochKey(chainID)))) //nolint:gosec
+}
+
+// DeleteWithdrawalWaitgroupEpoch deletes the withdrawal waitgroup epoch of a zone.
+func (k *Keeper) DeleteWithdrawalWaitgroupEpoch(ctx sdk.Context, chainID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWithdrawalWaitgroupEpochKey(chainID))
+}
+
+// makeWithdrawalWaitgroupRequest makes an interchain query that holds a slot in the
+// withdrawal waitgroup of zone, tagging it with the current waitgroup epoch so that
+// its timeout does not release a slot of a later epoch.
+func (k *Keeper) makeWithdrawalWaitgroupRequest(ctx sdk.Context, zone *types.Zone, queryType string, request []byte, callbackID string, height int64) {
+	k.ICQKeeper.MakeRequestAtHeight(ctx, zone.ConnectionId, zone.ChainId, queryType, request, sdk.NewInt(-1), types.ModuleName, callbackID, 0, height, height > 0)
+
+	id := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, queryType, request, types.ModuleName, callbackID)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawalWaitgroupQueryKey(id), sdk.Uint64ToBigEndian(uint64(k.GetWithdrawalWaitgroupEpoch(ctx, zone.ChainId)))) //nolint:gosec
+}
+
+// getWithdrawalWaitgroupQueryEpoch returns the waitgroup epoch a query was emitted in, if it was tagged.
+func (k *Keeper) getWithdrawalWaitgroupQueryEpoch(ctx sdk.Context, queryID string) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawalWaitgroupQueryKey(queryID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true //nolint:gosec
+}
+
+// deleteWithdrawalWaitgroupQuery removes the waitgroup epoch tag of a query.
+func (k *Keeper) deleteWithdrawalWaitgroupQuery(ctx sdk.Context, queryID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWithdrawalWaitgroupQueryKey(queryID))
+}
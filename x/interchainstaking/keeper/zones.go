@@ -528,9 +528,12 @@ func (k *Keeper) RemoveZoneAndAssociatedRecords(ctx sdk.Context, chainID string)
 
 	// clear unbondings
 	k.IteratePrefixedUnbondingRecords(ctx, []byte(chainID), func(_ int64, record types.UnbondingRecord) (stop bool) {
-		k.DeleteUnbondingRecord(ctx, record.ChainId, record.Validator, record.EpochNumber)
+		k.DeleteUnbondingRecord(ctx, record.ChainId, record.Validator, record.BatchId)
 		return false
 	})
+	k.DeleteUnbondingSchedule(ctx, chainID)
+	k.DeleteRedemptionRateHistory(ctx, chainID)
+	k.DeleteWithdrawalWaitgroupEpoch(ctx, chainID)
 
 	// clear redelegations
 	k.IteratePrefixedRedelegationRecords(ctx, []byte(chainID), func(_ int64, _ []byte, record types.RedelegationRecord) (stop bool) {
@@ -57,13 +57,15 @@ func TestKeeperWithZonesRoundTrip(t *testing.T) {
 
 	// 2. Now set a zone and ensure it is retrieved.
 	zone = types.Zone{
-		ConnectionId:       "conn-test",
-		ChainId:            chainID,
-		LocalDenom:         "uqck",
-		BaseDenom:          "qck",
-		RedemptionRate:     sdk.ZeroDec(),
-		LastRedemptionRate: sdk.ZeroDec(),
-		Tvl:                sdk.ZeroDec(),
+		ConnectionId:         "conn-test",
+		ChainId:              chainID,
+		LocalDenom:           "uqck",
+		BaseDenom:            "qck",
+		RedemptionRate:       sdk.ZeroDec(),
+		LastRedemptionRate:   sdk.ZeroDec(),
+		Tvl:                  sdk.ZeroDec(),
+		RebalanceThreshold:   sdk.ZeroDec(),
+		RebalanceMaxTurnover: sdk.ZeroDec(),
 	}
 	kpr.SetZone(ctx, &zone)
 	gotZone, ok := kpr.GetZone(ctx, chainID)
@@ -96,10 +98,12 @@ func TestKeeperWithZonesRoundTrip(t *testing.T) {
 					sdk.NewCoin("uqck", sdk.NewInt(700000)),
 				),
 			},
-			Is_118:             true,
-			RedemptionRate:     sdk.ZeroDec(),
-			LastRedemptionRate: sdk.ZeroDec(),
-			Tvl:                sdk.ZeroDec(),
+			Is_118:               true,
+			RedemptionRate:       sdk.ZeroDec(),
+			LastRedemptionRate:   sdk.ZeroDec(),
+			Tvl:                  sdk.ZeroDec(),
+			RebalanceThreshold:   sdk.ZeroDec(),
+			RebalanceMaxTurnover: sdk.ZeroDec(),
 		}
 		kpr.SetAddressZoneMapping(ctx, delegationAddr, zone.ChainId)
 		kpr.SetZone(ctx, &zone)
@@ -247,6 +251,7 @@ func (suite *KeeperTestSuite) TestRemoveZoneAndAssociatedRecords() {
 		types.UnbondingRecord{
 			ChainId:       chainID,
 			EpochNumber:   1,
+			BatchId:       1,
 			Validator:     vals[0].ValoperAddress,
 			RelatedTxhash: []string{"ABC012"},
 		})
@@ -424,6 +429,44 @@ func (suite *KeeperTestSuite) TestZone_GetAggregateIntentOrDefault() {
 	require.Equal(suite.T(), expected, actual)
 }
 
+func (suite *KeeperTestSuite) TestZone_DistanceToTarget() {
+	suite.SetupTest()
+	suite.setupTestZones()
+
+	icsKeeper := suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper
+	ctx := suite.chainA.GetContext()
+
+	zone, found := icsKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	vals := icsKeeper.GetValidators(ctx, zone.ChainId)
+	suite.Require().NotEmpty(vals)
+
+	// delegate everything to a single validator, such that the zone is off target.
+	icsKeeper.IterateAllDelegations(ctx, zone.ChainId, func(delegation types.Delegation) (stop bool) {
+		suite.Require().NoError(icsKeeper.RemoveDelegation(ctx, zone.ChainId, delegation))
+		return false
+	})
+	icsKeeper.SetDelegation(ctx, zone.ChainId, types.NewDelegation(zone.DelegationAddress.Address, vals[0].ValoperAddress, sdk.NewCoin(zone.BaseDenom, math.NewInt(1000))))
+
+	current := icsKeeper.CurrentDelegationsAsIntent(ctx, &zone)
+	target, err := icsKeeper.GetAggregateIntentOrDefault(ctx, &zone)
+	suite.Require().NoError(err)
+	suite.Require().Positive(types.DistanceBetweenIntents(current, target))
+
+	// the distance is summed over the validators of the zone.
+	zone.Validators = nil
+	distance, err := icsKeeper.DistanceToTarget(ctx, &zone)
+	suite.Require().NoError(err)
+	suite.Require().Zero(distance)
+
+	for i := range vals {
+		zone.Validators = append(zone.Validators, &vals[i])
+	}
+	distance, err = icsKeeper.DistanceToTarget(ctx, &zone)
+	suite.Require().NoError(err)
+	suite.Require().InDelta(types.DistanceBetweenIntents(current, target), distance, 0.000001)
+}
+
 func (suite *KeeperTestSuite) TestZone_GetZoneForAccount() {
 	suite.SetupTest()
 	suite.setupTestZones()
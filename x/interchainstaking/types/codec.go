@@ -20,6 +20,7 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSignalIntent{}, "quicksilver/MsgSignalIntent", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "quicksilver/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgInstantRedemption{}, "quicksilver/MsgInstantRedemption", nil)
 	cdc.RegisterConcrete(&MsgCancelRedemption{}, "quicksilver/MsgCancelRedemption", nil)
 	cdc.RegisterConcrete(&MsgRequeueRedemption{}, "quicksilver/MsgRequeueRedemption", nil)
 	cdc.RegisterConcrete(&RegisterZoneProposal{}, "quicksilver/RegisterZoneProposal", nil)
@@ -37,6 +38,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSignalIntent{},
 		&MsgRequestRedemption{},
+		&MsgInstantRedemption{},
 		&MsgCancelRedemption{},
 		&MsgRequeueRedemption{},
 		&MsgGovCloseChannel{},
@@ -3,6 +3,7 @@ package types
 const (
 	EventTypeRegisterZone                = "register_zone"
 	EventTypeRedemptionRequest           = "request_redemption"
+	EventTypeInstantRedemption           = "instant_redemption"
 	EventTypeRedemptionCancellation      = "cancel_redemption"
 	EventTypeRedemptionRequeue           = "requeue_redemption"
 	EventTypeUpdateRedemption            = "update_redemption"
@@ -54,8 +54,15 @@ func EpochRebalanceMemo(epoch int64) string {
 	return EpochMsgMemo(MsgTypeRebalance, epoch)
 }
 
-func EpochWithdrawalMemo(epoch int64) string {
-	return EpochMsgMemo(MsgTypeWithdrawal, epoch)
+// WithdrawalBatchMemo returns the memo of undelegations submitted for the
+// given unbonding batch.
+func WithdrawalBatchMemo(batchID int64) string {
+	return fmt.Sprintf("%s/%d", MsgTypeWithdrawal, batchID)
+}
+
+// ParseWithdrawalBatchMemo returns the unbonding batch id of a withdrawal memo.
+func ParseWithdrawalBatchMemo(memo string) (batchID int64, err error) {
+	return ParseEpochMsgMemo(memo, MsgTypeWithdrawal)
 }
 
 func TxUnbondSendMemo(hash string) string {
@@ -25,7 +25,7 @@ func TestParseMsgMemo(t *testing.T) {
 		},
 		{
 			name:                "valid withdrawal",
-			memo:                types.EpochWithdrawalMemo(10),
+			memo:                types.WithdrawalBatchMemo(10),
 			msgType:             types.MsgTypeWithdrawal,
 			wantErr:             false,
 			expectedEpochNumber: 10,
@@ -56,3 +56,12 @@ func TestParseMsgMemo(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWithdrawalBatchMemo(t *testing.T) {
+	batchID, err := types.ParseWithdrawalBatchMemo(types.WithdrawalBatchMemo(42))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), batchID)
+
+	_, err = types.ParseWithdrawalBatchMemo(types.EpochRebalanceMemo(42))
+	require.Error(t, err)
+}
@@ -64,6 +64,29 @@ type Zone struct {
 	DustThreshold                cosmossdk_io_math.Int                  `protobuf:"bytes,30,opt,name=dust_threshold,json=dustThreshold,proto3,customtype=cosmossdk.io/math.Int" json:"dust_threshold"`
 	TransferChannel              string                                 `protobuf:"bytes,31,opt,name=transfer_channel,json=transferChannel,proto3" json:"transfer_channel,omitempty"`
 	IsOffboarding                bool                                   `protobuf:"varint,32,opt,name=is_offboarding,json=isOffboarding,proto3" json:"is_offboarding,omitempty"`
+	// lsm_provider selects the liquid staking module implementation of the host
+	// chain; defaults to "gaia" when liquidity_module is enabled.
+	LsmProvider string `protobuf:"bytes,33,opt,name=lsm_provider,json=lsmProvider,proto3" json:"lsm_provider,omitempty"`
+	// rebalance_strategy selects the rebalancing strategy of the zone; one of
+	// "greedy" (default), "threshold" or "capped_turnover".
+	RebalanceStrategy string `protobuf:"bytes,34,opt,name=rebalance_strategy,json=rebalanceStrategy,proto3" json:"rebalance_strategy,omitempty"`
+	// rebalance_threshold is the deviation from target allocation, as a
+	// fraction of total delegations, beyond which the threshold strategy moves
+	// a validator.
+	RebalanceThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,35,opt,name=rebalance_threshold,json=rebalanceThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalance_threshold"`
+	// rebalance_max_turnover is the fraction of total delegations the
+	// capped_turnover strategy may redelegate per epoch.
+	RebalanceMaxTurnover github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,36,opt,name=rebalance_max_turnover,json=rebalanceMaxTurnover,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalance_max_turnover"`
+	// unbonding_interval_blocks is the number of blocks between unbonding
+	// batches; takes precedence over unbonding_interval_hours. If neither is
+	// set, unbondings are batched at the end of each epoch.
+	UnbondingIntervalBlocks int64 `protobuf:"varint,37,opt,name=unbonding_interval_blocks,json=unbondingIntervalBlocks,proto3" json:"unbonding_interval_blocks,omitempty"`
+	// unbonding_interval_hours is the number of hours between unbonding batches.
+	UnbondingIntervalHours int64 `protobuf:"varint,38,opt,name=unbonding_interval_hours,json=unbondingIntervalHours,proto3" json:"unbonding_interval_hours,omitempty"`
+	// liquidity_buffer holds native tokens on the deposit account against which
+	// qAssets may be redeemed instantly; nil if instant redemption has never
+	// been configured for the zone.
+	LiquidityBuffer *LiquidityBuffer `protobuf:"bytes,39,opt,name=liquidity_buffer,json=liquidityBuffer,proto3" json:"liquidity_buffer,omitempty"`
 }
 
 func (m *Zone) Reset()         { *m = Zone{} }
@@ -295,6 +318,99 @@ func (m *Zone) GetIsOffboarding() bool {
 	return false
 }
 
+func (m *Zone) GetLsmProvider() string {
+	if m != nil {
+		return m.LsmProvider
+	}
+	return ""
+}
+
+func (m *Zone) GetRebalanceStrategy() string {
+	if m != nil {
+		return m.RebalanceStrategy
+	}
+	return ""
+}
+
+func (m *Zone) GetUnbondingIntervalBlocks() int64 {
+	if m != nil {
+		return m.UnbondingIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Zone) GetUnbondingIntervalHours() int64 {
+	if m != nil {
+		return m.UnbondingIntervalHours
+	}
+	return 0
+}
+
+func (m *Zone) GetLiquidityBuffer() *LiquidityBuffer {
+	if m != nil {
+		return m.LiquidityBuffer
+	}
+	return nil
+}
+
+// LiquidityBuffer tracks the native tokens retained from deposits on the
+// deposit account, and the instant redemptions paid from them.
+type LiquidityBuffer struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// fee is the fraction of the redeemed value retained by the buffer.
+	Fee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+	// target is the balance deposits top the buffer up to.
+	Target cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=target,proto3,customtype=cosmossdk.io/math.Int" json:"target"`
+	// epoch_cap is the maximum amount of native tokens paid out by instant
+	// redemptions per epoch.
+	EpochCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=epoch_cap,json=epochCap,proto3,customtype=cosmossdk.io/math.Int" json:"epoch_cap"`
+	// balance is the amount of native tokens held by the buffer.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// redeemed_this_epoch is the amount of native tokens paid out by instant
+	// redemptions in the current epoch.
+	RedeemedThisEpoch cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=redeemed_this_epoch,json=redeemedThisEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"redeemed_this_epoch"`
+}
+
+func (m *LiquidityBuffer) Reset()         { *m = LiquidityBuffer{} }
+func (m *LiquidityBuffer) String() string { return proto.CompactTextString(m) }
+func (*LiquidityBuffer) ProtoMessage()    {}
+func (*LiquidityBuffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{1}
+}
+func (m *LiquidityBuffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityBuffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityBuffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityBuffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityBuffer.Merge(m, src)
+}
+func (m *LiquidityBuffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityBuffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityBuffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityBuffer proto.InternalMessageInfo
+
+func (m *LiquidityBuffer) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 type SubzoneInfo struct {
 	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	BaseChainID string `protobuf:"bytes,2,opt,name=base_chainID,json=baseChainID,proto3" json:"base_chainID,omitempty"`
@@ -304,7 +420,7 @@ func (m *SubzoneInfo) Reset()         { *m = SubzoneInfo{} }
 func (m *SubzoneInfo) String() string { return proto.CompactTextString(m) }
 func (*SubzoneInfo) ProtoMessage()    {}
 func (*SubzoneInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{1}
+	return fileDescriptor_0d755cfd37ef9fee, []int{2}
 }
 func (m *SubzoneInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +473,7 @@ func (m *LsmCaps) Reset()         { *m = LsmCaps{} }
 func (m *LsmCaps) String() string { return proto.CompactTextString(m) }
 func (*LsmCaps) ProtoMessage()    {}
 func (*LsmCaps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{2}
+	return fileDescriptor_0d755cfd37ef9fee, []int{3}
 }
 func (m *LsmCaps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +515,7 @@ func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
 func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
 func (*ICAAccount) ProtoMessage()    {}
 func (*ICAAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{3}
+	return fileDescriptor_0d755cfd37ef9fee, []int{4}
 }
 func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +589,7 @@ func (m *Distribution) Reset()         { *m = Distribution{} }
 func (m *Distribution) String() string { return proto.CompactTextString(m) }
 func (*Distribution) ProtoMessage()    {}
 func (*Distribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{4}
+	return fileDescriptor_0d755cfd37ef9fee, []int{5}
 }
 func (m *Distribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -531,13 +647,18 @@ type WithdrawalRecord struct {
 	Acknowledged   bool                                     `protobuf:"varint,11,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
 	EpochNumber    int64                                    `protobuf:"varint,12,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	SendErrors     int64                                    `protobuf:"varint,13,opt,name=send_errors,json=sendErrors,proto3" json:"send_errors,omitempty"`
+	// batch_id is the id of the unbonding batch that submitted this withdrawal.
+	BatchId int64 `protobuf:"varint,14,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// batch_time is the time at which the unbonding batch that submitted this
+	// withdrawal was sent.
+	BatchTime time.Time `protobuf:"bytes,15,opt,name=batch_time,json=batchTime,proto3,stdtime" json:"batch_time"`
 }
 
 func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
 func (m *WithdrawalRecord) String() string { return proto.CompactTextString(m) }
 func (*WithdrawalRecord) ProtoMessage()    {}
 func (*WithdrawalRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{5}
+	return fileDescriptor_0d755cfd37ef9fee, []int{6}
 }
 func (m *WithdrawalRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,6 +771,20 @@ func (m *WithdrawalRecord) GetSendErrors() int64 {
 	return 0
 }
 
+func (m *WithdrawalRecord) GetBatchId() int64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *WithdrawalRecord) GetBatchTime() time.Time {
+	if m != nil {
+		return m.BatchTime
+	}
+	return time.Time{}
+}
+
 type UnbondingRecord struct {
 	ChainId        string                                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	EpochNumber    int64                                   `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
@@ -657,13 +792,20 @@ type UnbondingRecord struct {
 	RelatedTxhash  []string                                `protobuf:"bytes,4,rep,name=related_txhash,json=relatedTxhash,proto3" json:"related_txhash,omitempty"`
 	Amount         github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 	CompletionTime time.Time                               `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// batch_id is the sequence id of the unbonding batch that created this
+	// record; epoch_number is retained for information only.
+	BatchId int64 `protobuf:"varint,7,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// scheduled is true if the batch was submitted on the zone's unbonding
+	// interval, and so is tracked by the unbonding schedule's waitgroup rather
+	// than the zone's withdrawal waitgroup.
+	Scheduled bool `protobuf:"varint,8,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
 }
 
 func (m *UnbondingRecord) Reset()         { *m = UnbondingRecord{} }
 func (m *UnbondingRecord) String() string { return proto.CompactTextString(m) }
 func (*UnbondingRecord) ProtoMessage()    {}
 func (*UnbondingRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{6}
+	return fileDescriptor_0d755cfd37ef9fee, []int{7}
 }
 func (m *UnbondingRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,6 +869,172 @@ func (m *UnbondingRecord) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *UnbondingRecord) GetBatchId() int64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *UnbondingRecord) GetScheduled() bool {
+	if m != nil {
+		return m.Scheduled
+	}
+	return false
+}
+
+// UnbondingSchedule tracks the unbonding batches of a zone.
+type UnbondingSchedule struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// sequence is the id of the most recently submitted unbonding batch.
+	Sequence        int64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	LastBatchHeight int64     `protobuf:"varint,3,opt,name=last_batch_height,json=lastBatchHeight,proto3" json:"last_batch_height,omitempty"`
+	LastBatchTime   time.Time `protobuf:"bytes,4,opt,name=last_batch_time,json=lastBatchTime,proto3,stdtime" json:"last_batch_time"`
+	// waitgroup is the number of undelegations of scheduled batches awaiting
+	// acknowledgement.
+	Waitgroup uint32 `protobuf:"varint,5,opt,name=waitgroup,proto3" json:"waitgroup,omitempty"`
+}
+
+func (m *UnbondingSchedule) Reset()         { *m = UnbondingSchedule{} }
+func (m *UnbondingSchedule) String() string { return proto.CompactTextString(m) }
+func (*UnbondingSchedule) ProtoMessage()    {}
+func (*UnbondingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{8}
+}
+func (m *UnbondingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingSchedule.Merge(m, src)
+}
+func (m *UnbondingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingSchedule proto.InternalMessageInfo
+
+func (m *UnbondingSchedule) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *UnbondingSchedule) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *UnbondingSchedule) GetLastBatchHeight() int64 {
+	if m != nil {
+		return m.LastBatchHeight
+	}
+	return 0
+}
+
+func (m *UnbondingSchedule) GetLastBatchTime() time.Time {
+	if m != nil {
+		return m.LastBatchTime
+	}
+	return time.Time{}
+}
+
+func (m *UnbondingSchedule) GetWaitgroup() uint32 {
+	if m != nil {
+		return m.Waitgroup
+	}
+	return 0
+}
+
+// RedemptionRateSample is a point in the redemption rate history of a zone.
+type RedemptionRateSample struct {
+	ChainId string                                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   int64                                  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height  int64                                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time                              `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	Rate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// tvl is the amount of native assets backing the zone qAssets.
+	Tvl cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=tvl,proto3,customtype=cosmossdk.io/math.Int" json:"tvl"`
+}
+
+func (m *RedemptionRateSample) Reset()         { *m = RedemptionRateSample{} }
+func (m *RedemptionRateSample) String() string { return proto.CompactTextString(m) }
+func (*RedemptionRateSample) ProtoMessage()    {}
+func (*RedemptionRateSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d755cfd37ef9fee, []int{9}
+}
+func (m *RedemptionRateSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionRateSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionRateSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionRateSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionRateSample.Merge(m, src)
+}
+func (m *RedemptionRateSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionRateSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionRateSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionRateSample proto.InternalMessageInfo
+
+func (m *RedemptionRateSample) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *RedemptionRateSample) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RedemptionRateSample) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RedemptionRateSample) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 type RedelegationRecord struct {
 	ChainId        string                `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	EpochNumber    int64                 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
@@ -741,7 +1049,7 @@ func (m *RedelegationRecord) Reset()         { *m = RedelegationRecord{} }
 func (m *RedelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*RedelegationRecord) ProtoMessage()    {}
 func (*RedelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{7}
+	return fileDescriptor_0d755cfd37ef9fee, []int{10}
 }
 func (m *RedelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +1141,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{8}
+	return fileDescriptor_0d755cfd37ef9fee, []int{11}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1214,7 @@ func (m *DelegatorIntent) Reset()         { *m = DelegatorIntent{} }
 func (m *DelegatorIntent) String() string { return proto.CompactTextString(m) }
 func (*DelegatorIntent) ProtoMessage()    {}
 func (*DelegatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{9}
+	return fileDescriptor_0d755cfd37ef9fee, []int{12}
 }
 func (m *DelegatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +1266,7 @@ func (m *ValidatorIntent) Reset()         { *m = ValidatorIntent{} }
 func (m *ValidatorIntent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIntent) ProtoMessage()    {}
 func (*ValidatorIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{10}
+	return fileDescriptor_0d755cfd37ef9fee, []int{13}
 }
 func (m *ValidatorIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1006,7 +1314,7 @@ func (m *Delegation) Reset()         { *m = Delegation{} }
 func (m *Delegation) String() string { return proto.CompactTextString(m) }
 func (*Delegation) ProtoMessage()    {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{11}
+	return fileDescriptor_0d755cfd37ef9fee, []int{14}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,7 +1380,7 @@ func (m *PortConnectionTuple) Reset()         { *m = PortConnectionTuple{} }
 func (m *PortConnectionTuple) String() string { return proto.CompactTextString(m) }
 func (*PortConnectionTuple) ProtoMessage()    {}
 func (*PortConnectionTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{12}
+	return fileDescriptor_0d755cfd37ef9fee, []int{15}
 }
 func (m *PortConnectionTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,7 +1436,7 @@ func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d755cfd37ef9fee, []int{13}
+	return fileDescriptor_0d755cfd37ef9fee, []int{16}
 }
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1201,12 +1509,15 @@ func (m *Receipt) GetCompleted() *time.Time {
 
 func init() {
 	proto.RegisterType((*Zone)(nil), "quicksilver.interchainstaking.v1.Zone")
+	proto.RegisterType((*LiquidityBuffer)(nil), "quicksilver.interchainstaking.v1.LiquidityBuffer")
 	proto.RegisterType((*SubzoneInfo)(nil), "quicksilver.interchainstaking.v1.SubzoneInfo")
 	proto.RegisterType((*LsmCaps)(nil), "quicksilver.interchainstaking.v1.LsmCaps")
 	proto.RegisterType((*ICAAccount)(nil), "quicksilver.interchainstaking.v1.ICAAccount")
 	proto.RegisterType((*Distribution)(nil), "quicksilver.interchainstaking.v1.Distribution")
 	proto.RegisterType((*WithdrawalRecord)(nil), "quicksilver.interchainstaking.v1.WithdrawalRecord")
 	proto.RegisterType((*UnbondingRecord)(nil), "quicksilver.interchainstaking.v1.UnbondingRecord")
+	proto.RegisterType((*UnbondingSchedule)(nil), "quicksilver.interchainstaking.v1.UnbondingSchedule")
+	proto.RegisterType((*RedemptionRateSample)(nil), "quicksilver.interchainstaking.v1.RedemptionRateSample")
 	proto.RegisterType((*RedelegationRecord)(nil), "quicksilver.interchainstaking.v1.RedelegationRecord")
 	proto.RegisterType((*Validator)(nil), "quicksilver.interchainstaking.v1.Validator")
 	proto.RegisterType((*DelegatorIntent)(nil), "quicksilver.interchainstaking.v1.DelegatorIntent")
@@ -1221,143 +1532,168 @@ func init() {
 }
 
 var fileDescriptor_0d755cfd37ef9fee = []byte{
-	// 2164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0x36, 0x29, 0x89, 0x14, 0x0f, 0x29, 0x51, 0x1a, 0xc9, 0xce, 0xfa, 0x26, 0x32, 0x6c, 0x92,
-	0xb2, 0xb0, 0x45, 0x46, 0x0e, 0x90, 0xba, 0x41, 0x51, 0x40, 0x94, 0xdc, 0x44, 0x68, 0xac, 0x08,
-	0x2b, 0xa5, 0x41, 0x63, 0x14, 0x8b, 0xe1, 0xee, 0x88, 0x9c, 0x68, 0x77, 0x87, 0xde, 0x19, 0xea,
-	0xe2, 0xc7, 0x3e, 0xf6, 0x29, 0x3f, 0xa1, 0xcf, 0x41, 0x1f, 0xdd, 0xb7, 0xfe, 0x80, 0xbc, 0x14,
-	0x30, 0x0c, 0x14, 0x28, 0x8a, 0x42, 0x2e, 0xec, 0x37, 0x3d, 0xb5, 0xfd, 0x05, 0xc5, 0x5c, 0xf6,
-	0xa2, 0x4b, 0x4c, 0xc9, 0x51, 0xf2, 0x24, 0xce, 0xb9, 0x7c, 0x67, 0x2e, 0x67, 0xcf, 0xf9, 0x66,
-	0x04, 0xf7, 0x1f, 0x0f, 0xa9, 0xbb, 0xc3, 0xa9, 0xbf, 0x4b, 0xa2, 0x36, 0x0d, 0x05, 0x89, 0xdc,
-	0x3e, 0xa6, 0x21, 0x17, 0x78, 0x87, 0x86, 0xbd, 0xf6, 0xee, 0xd2, 0x69, 0x61, 0x6b, 0x10, 0x31,
-	0xc1, 0x50, 0x3d, 0xe3, 0xd9, 0x3a, 0x6d, 0xb4, 0xbb, 0x74, 0x63, 0xc1, 0x65, 0x3c, 0x60, 0xbc,
-	0xdd, 0xc5, 0x9c, 0xb4, 0x77, 0x97, 0xba, 0x44, 0xe0, 0xa5, 0xb6, 0xcb, 0x68, 0xa8, 0x11, 0x6e,
-	0x5c, 0xd7, 0x7a, 0x47, 0x8d, 0xda, 0x7a, 0x60, 0x54, 0xf3, 0x3d, 0xd6, 0x63, 0x5a, 0x2e, 0x7f,
-	0x19, 0x69, 0xad, 0xc7, 0x58, 0xcf, 0x27, 0x6d, 0x35, 0xea, 0x0e, 0xb7, 0xdb, 0x82, 0x06, 0x84,
-	0x0b, 0x1c, 0x0c, 0xb4, 0x41, 0xe3, 0xd9, 0x0c, 0x8c, 0x7f, 0xc9, 0x42, 0x82, 0x7e, 0x02, 0x53,
-	0x2e, 0x0b, 0x43, 0xe2, 0x0a, 0xca, 0x42, 0x87, 0x7a, 0x56, 0xae, 0x9e, 0x6b, 0x96, 0xec, 0x4a,
-	0x2a, 0x5c, 0xf3, 0xd0, 0x75, 0x98, 0x54, 0x53, 0x96, 0xfa, 0xbc, 0xd2, 0x17, 0xd5, 0x78, 0xcd,
-	0x43, 0x9f, 0x43, 0xd5, 0x23, 0x03, 0xc6, 0xa9, 0x70, 0xb0, 0xe7, 0x45, 0x84, 0x73, 0x6b, 0xac,
-	0x9e, 0x6b, 0x96, 0xef, 0xdd, 0x6d, 0x8d, 0x5a, 0x76, 0x6b, 0x6d, 0x65, 0x79, 0xd9, 0x75, 0xd9,
-	0x30, 0x14, 0xf6, 0xb4, 0x01, 0x59, 0xd6, 0x18, 0xe8, 0x11, 0xa0, 0x3d, 0x2a, 0xfa, 0x5e, 0x84,
-	0xf7, 0xb0, 0x9f, 0x20, 0x8f, 0xbf, 0x01, 0xf2, 0x6c, 0x8a, 0x13, 0x83, 0xff, 0x1e, 0xe6, 0x06,
-	0x24, 0xda, 0x66, 0x51, 0x80, 0x43, 0x97, 0x24, 0xe8, 0x13, 0x6f, 0x80, 0x8e, 0x32, 0x40, 0x99,
-	0xb9, 0x7b, 0xc4, 0x27, 0x3d, 0xac, 0xb6, 0x34, 0x46, 0x2f, 0xbc, 0xc9, 0xdc, 0x53, 0x9c, 0x18,
-	0xfc, 0x5d, 0x98, 0xc6, 0x5a, 0xeb, 0x0c, 0x22, 0xb2, 0x4d, 0xf7, 0xad, 0xa2, 0x3a, 0x90, 0x29,
-	0x23, 0xdd, 0x50, 0x42, 0x54, 0x83, 0xb2, 0xcf, 0x5c, 0xec, 0x3b, 0x1e, 0x09, 0x59, 0x60, 0x4d,
-	0x2a, 0x1b, 0x50, 0xa2, 0x55, 0x29, 0x41, 0xb7, 0x01, 0x64, 0xb6, 0x19, 0x7d, 0x49, 0xe9, 0x4b,
-	0x52, 0xa2, 0xd5, 0x04, 0xaa, 0x11, 0xf1, 0x48, 0x30, 0x50, 0x6b, 0x88, 0xb0, 0x20, 0x16, 0x48,
-	0x9b, 0xce, 0x2f, 0xbf, 0x3d, 0xac, 0x5d, 0xf9, 0xe7, 0x61, 0xed, 0xbd, 0x1e, 0x15, 0xfd, 0x61,
-	0xb7, 0xe5, 0xb2, 0xc0, 0x24, 0xa4, 0xf9, 0xb3, 0xc8, 0xbd, 0x9d, 0xb6, 0x38, 0x18, 0x10, 0xde,
-	0x5a, 0x25, 0xee, 0xf3, 0xa7, 0x8b, 0x60, 0xf2, 0x75, 0x95, 0xb8, 0xf6, 0x74, 0x0a, 0x6a, 0x63,
-	0x41, 0x50, 0x08, 0xf3, 0x3e, 0xe6, 0xc2, 0x39, 0x19, 0xab, 0x7c, 0x09, 0xb1, 0x90, 0x44, 0xb6,
-	0x8f, 0xc7, 0xfb, 0x0d, 0xc0, 0x2e, 0xf6, 0xa9, 0x87, 0x05, 0x8b, 0xb8, 0x55, 0xa9, 0x8f, 0x35,
-	0xcb, 0xf7, 0xee, 0x8c, 0x3e, 0x92, 0xdf, 0xc6, 0x3e, 0x76, 0xc6, 0x1d, 0x45, 0x30, 0x83, 0x7b,
-	0xbd, 0x48, 0x1e, 0x10, 0x71, 0xa4, 0x5f, 0x28, 0xac, 0x29, 0x05, 0xb9, 0x74, 0x01, 0xc8, 0x35,
-	0xe5, 0xd8, 0x99, 0xff, 0xe6, 0x45, 0x6d, 0xe6, 0x84, 0x90, 0xdb, 0xd5, 0x24, 0x80, 0x96, 0xc8,
-	0x63, 0x0b, 0x86, 0xbe, 0xa0, 0x0e, 0x27, 0xa1, 0x67, 0x4d, 0xd7, 0x73, 0xcd, 0x49, 0xbb, 0xa4,
-	0x24, 0x9b, 0x24, 0xf4, 0xd0, 0xcf, 0x60, 0xc6, 0xa7, 0x8f, 0x87, 0xd4, 0xa3, 0xe2, 0xc0, 0x09,
-	0x98, 0x37, 0xf4, 0x89, 0x55, 0x55, 0x46, 0xd5, 0x44, 0xfe, 0x50, 0x89, 0xd1, 0x12, 0xcc, 0x67,
-	0xbe, 0xb0, 0x3d, 0x4c, 0x45, 0x2f, 0x62, 0xc3, 0x81, 0x35, 0x53, 0xcf, 0x35, 0xa7, 0xec, 0xb9,
-	0x54, 0xf7, 0x45, 0xac, 0x42, 0x3f, 0x07, 0x8b, 0x76, 0x5d, 0x27, 0x24, 0xfb, 0xc2, 0x49, 0xf7,
-	0xc1, 0xe9, 0x63, 0xde, 0xb7, 0x66, 0xeb, 0xb9, 0x66, 0xc5, 0xbe, 0x4a, 0xbb, 0xee, 0x3a, 0xd9,
-	0x17, 0xc9, 0x42, 0xf8, 0x27, 0x98, 0xf7, 0xd1, 0x01, 0x2c, 0x24, 0xf6, 0x0e, 0x27, 0xbe, 0xa9,
-	0x36, 0xd8, 0x97, 0x09, 0x29, 0x7f, 0x5a, 0xa8, 0x9e, 0x6b, 0x8e, 0x77, 0x3e, 0x38, 0x3a, 0xac,
-	0xb5, 0x5f, 0x6f, 0x79, 0x97, 0x8b, 0x88, 0x86, 0xbd, 0xbb, 0x2c, 0xa0, 0x42, 0x9e, 0xec, 0x81,
-	0x7d, 0x2b, 0x71, 0xd8, 0x8c, 0xed, 0x97, 0x13, 0x73, 0xf4, 0x3b, 0x98, 0xeb, 0x33, 0xdf, 0xa3,
-	0x61, 0x8f, 0x67, 0xe3, 0xcd, 0xa9, 0x78, 0xcd, 0xa3, 0xc3, 0xda, 0x3b, 0x67, 0xa8, 0x4f, 0x07,
-	0x41, 0xb1, 0x55, 0x06, 0xda, 0x86, 0x59, 0x95, 0xbc, 0x64, 0xc0, 0xdc, 0xbe, 0xd3, 0x27, 0xb4,
-	0xd7, 0x17, 0xd6, 0x7c, 0x3d, 0xd7, 0x1c, 0xeb, 0xbc, 0x77, 0x74, 0x58, 0x6b, 0x9c, 0x52, 0x9e,
-	0x86, 0xad, 0x4a, 0x9b, 0x07, 0xd2, 0xe4, 0x13, 0x65, 0x81, 0xd6, 0x61, 0x4c, 0xec, 0xfa, 0xd6,
-	0xd5, 0x4b, 0xc8, 0x7f, 0x09, 0x84, 0x36, 0x60, 0x66, 0x18, 0x76, 0x59, 0x28, 0xe7, 0xee, 0x0c,
-	0x48, 0x44, 0x99, 0x67, 0x5d, 0x53, 0x53, 0x7c, 0xf7, 0xe8, 0xb0, 0xf6, 0xf6, 0x49, 0xdd, 0x19,
-	0x33, 0x4c, 0x4c, 0x36, 0x94, 0x05, 0xfa, 0x14, 0xaa, 0x01, 0xe1, 0x1c, 0xf7, 0x08, 0x97, 0x4e,
-	0x8e, 0xd8, 0xb7, 0xde, 0x52, 0x80, 0xef, 0x1c, 0x1d, 0xd6, 0xea, 0x27, 0x54, 0xa7, 0xf1, 0xa6,
-	0x62, 0x8b, 0x0d, 0x12, 0x6d, 0xed, 0xa3, 0x5f, 0xc0, 0xa4, 0x47, 0x5c, 0x1a, 0x60, 0x9f, 0x5b,
-	0x96, 0x82, 0xb9, 0x7d, 0x74, 0x58, 0xbb, 0x1e, 0xcb, 0x4e, 0xfb, 0x27, 0xe6, 0xe8, 0x0e, 0xcc,
-	0xa6, 0xd3, 0x27, 0x21, 0xee, 0xfa, 0xc4, 0xb3, 0xae, 0xab, 0x64, 0x4f, 0xd7, 0xfc, 0x40, 0xcb,
-	0xe5, 0x87, 0x61, 0x3a, 0x0c, 0x4f, 0x6c, 0x6f, 0xe8, 0x0f, 0x23, 0x96, 0xc7, 0xa6, 0x4d, 0x98,
-	0x89, 0x88, 0x18, 0x46, 0xa1, 0x23, 0x98, 0xfa, 0xcc, 0x48, 0x64, 0xdd, 0x54, 0xa6, 0xd3, 0x5a,
-	0xbe, 0xc5, 0x36, 0x95, 0x14, 0x5d, 0x85, 0x02, 0xe5, 0xce, 0xd2, 0xd2, 0x7d, 0xeb, 0x96, 0xd2,
-	0x4f, 0x50, 0xbe, 0xb4, 0x74, 0x1f, 0x7d, 0x06, 0x65, 0x3e, 0xec, 0x3e, 0x61, 0x21, 0x59, 0x0b,
-	0xb7, 0x99, 0x75, 0x5b, 0x15, 0xfe, 0xc5, 0xd1, 0x25, 0x61, 0x33, 0x75, 0xb2, 0xb3, 0x08, 0xc8,
-	0x86, 0x69, 0x6f, 0xc8, 0x85, 0x23, 0xfa, 0x11, 0xe1, 0x32, 0x11, 0xad, 0x05, 0x95, 0x1f, 0x77,
-	0x4c, 0x7e, 0x5c, 0xd5, 0xa7, 0xce, 0xbd, 0x9d, 0x16, 0x65, 0xed, 0x00, 0x8b, 0x7e, 0x6b, 0x2d,
-	0x14, 0x99, 0x74, 0x58, 0x0b, 0x85, 0x3d, 0x25, 0x21, 0xb6, 0x62, 0x04, 0xb9, 0x21, 0x22, 0xc2,
-	0x21, 0xdf, 0x26, 0x91, 0xe3, 0xf6, 0x71, 0x18, 0x12, 0xdf, 0xaa, 0xa9, 0x2e, 0x50, 0x8d, 0xe5,
-	0x2b, 0x5a, 0x2c, 0x5b, 0x0e, 0xe5, 0x0e, 0xdb, 0xde, 0xee, 0x32, 0x1c, 0xc9, 0x4d, 0xb5, 0xea,
-	0x6a, 0xb9, 0x53, 0x94, 0x7f, 0x96, 0x0a, 0x1b, 0xeb, 0x50, 0xce, 0xac, 0x00, 0xdd, 0x82, 0x12,
-	0x1e, 0x8a, 0x3e, 0x8b, 0xa8, 0x38, 0x30, 0xa4, 0x22, 0x15, 0xa0, 0xb7, 0xa1, 0xa2, 0xda, 0x8f,
-	0xa6, 0x11, 0xab, 0x86, 0x55, 0x94, 0xa5, 0x6c, 0x45, 0x8b, 0x1a, 0x7f, 0xc9, 0x43, 0xf1, 0x53,
-	0x1e, 0xac, 0xe0, 0x01, 0x47, 0x18, 0xa6, 0xd2, 0xb2, 0xe0, 0xe2, 0x81, 0x06, 0xfc, 0x9e, 0x1f,
-	0x48, 0x25, 0x81, 0x5c, 0xc1, 0x03, 0xf4, 0x15, 0xa0, 0x34, 0x84, 0xcc, 0x1e, 0x15, 0x27, 0x7f,
-	0x09, 0x71, 0x66, 0x12, 0xdc, 0x0e, 0x0b, 0x3d, 0x19, 0xeb, 0x11, 0x40, 0xcf, 0x67, 0x5d, 0xec,
-	0xab, 0x18, 0x63, 0x97, 0x10, 0xa3, 0xa4, 0xf1, 0x56, 0xf0, 0xa0, 0xf1, 0xa7, 0x3c, 0x40, 0xca,
-	0x21, 0xd0, 0x3d, 0x28, 0xc6, 0x14, 0x44, 0x6f, 0x9a, 0xf5, 0xfc, 0xe9, 0xe2, 0xbc, 0x71, 0x35,
-	0xac, 0x62, 0x53, 0x7d, 0x65, 0x76, 0x6c, 0x88, 0x08, 0x14, 0xbb, 0xd8, 0x97, 0x9c, 0xc6, 0xca,
-	0xab, 0x86, 0x76, 0xbd, 0x65, 0x1c, 0xe4, 0x01, 0xb5, 0x0c, 0x43, 0x6d, 0xad, 0x30, 0x1a, 0x76,
-	0xde, 0x97, 0xf3, 0xfe, 0xe6, 0x45, 0xad, 0x79, 0x8e, 0x79, 0x4b, 0x07, 0x6e, 0xc7, 0xd8, 0xe8,
-	0x26, 0x94, 0x06, 0x2c, 0x12, 0x4e, 0x88, 0x03, 0xa2, 0x77, 0xc1, 0x9e, 0x94, 0x82, 0x75, 0x1c,
-	0x10, 0xb4, 0xf8, 0x9d, 0x0c, 0xb0, 0x74, 0x16, 0xa7, 0xbb, 0x03, 0xb3, 0x06, 0x36, 0xd3, 0xcb,
-	0x26, 0x54, 0x2f, 0x9b, 0x31, 0x8a, 0xa4, 0x91, 0x35, 0xfe, 0x98, 0x83, 0xca, 0x2a, 0x95, 0xb5,
-	0xa5, 0x3b, 0x54, 0xa5, 0xdc, 0x82, 0xe2, 0x2e, 0xf6, 0xd9, 0x80, 0x44, 0x26, 0x55, 0xe3, 0x21,
-	0xba, 0x09, 0x45, 0x07, 0x07, 0x72, 0x27, 0x55, 0x2e, 0x8c, 0x77, 0xf2, 0x56, 0xce, 0x2e, 0x2c,
-	0x2b, 0x09, 0x5a, 0x81, 0x82, 0xd1, 0x8d, 0x5d, 0xfc, 0x83, 0x34, 0xae, 0x8d, 0xbf, 0x4f, 0xc0,
-	0xcc, 0x17, 0xc9, 0x7a, 0x6c, 0xe2, 0xb2, 0xe8, 0x38, 0xe3, 0xce, 0x1d, 0x67, 0xdc, 0x1f, 0x42,
-	0xc9, 0xd0, 0x42, 0x16, 0x99, 0xfc, 0xfc, 0xee, 0x23, 0x4d, 0x4d, 0x91, 0x0d, 0x15, 0x2f, 0xb3,
-	0x66, 0x6b, 0x4c, 0x9d, 0x6c, 0x6b, 0x74, 0x5d, 0xca, 0xee, 0x94, 0x7d, 0x0c, 0x43, 0xce, 0x25,
-	0x22, 0x2e, 0x1d, 0x50, 0xc9, 0x7d, 0xc6, 0x47, 0xcd, 0x25, 0x31, 0x45, 0x6e, 0xb2, 0x71, 0x13,
-	0x97, 0x9f, 0x5f, 0x06, 0x1a, 0x3d, 0x81, 0x72, 0x57, 0x96, 0x71, 0x13, 0x49, 0x13, 0xf0, 0xd7,
-	0x44, 0xfa, 0x95, 0x39, 0xbd, 0x9f, 0x9e, 0x33, 0xd2, 0xf3, 0xa7, 0x8b, 0x65, 0x03, 0x26, 0x87,
-	0x36, 0xc8, 0x68, 0x26, 0x33, 0xae, 0x41, 0x41, 0xec, 0x2b, 0x62, 0xa4, 0xe9, 0xb9, 0x19, 0x49,
-	0x39, 0x17, 0x58, 0x0c, 0xb9, 0xa2, 0xe4, 0x13, 0xb6, 0x19, 0xa1, 0x87, 0x50, 0x75, 0x59, 0x30,
-	0xf0, 0x89, 0xa2, 0x3b, 0xf2, 0xb6, 0xa6, 0x38, 0x79, 0xf9, 0xde, 0x8d, 0x96, 0xbe, 0xca, 0xb5,
-	0xe2, 0xab, 0x5c, 0x6b, 0x2b, 0xbe, 0xca, 0x75, 0x26, 0xe5, 0x84, 0xbf, 0x7e, 0x51, 0xcb, 0xd9,
-	0xd3, 0xa9, 0xb3, 0x54, 0xa3, 0x1b, 0x30, 0x19, 0x91, 0xc7, 0x43, 0x32, 0x24, 0x9e, 0xe2, 0xed,
-	0x93, 0x76, 0x32, 0x46, 0x0d, 0xa8, 0x60, 0x77, 0x27, 0x64, 0x7b, 0x3e, 0xf1, 0x7a, 0xc4, 0x53,
-	0x5c, 0x7b, 0xd2, 0x3e, 0x26, 0x93, 0xe5, 0x59, 0x13, 0x97, 0x70, 0x18, 0x74, 0x49, 0x64, 0x55,
-	0x64, 0x6b, 0xb6, 0xcb, 0x4a, 0xb6, 0xae, 0x44, 0xf2, 0x86, 0x21, 0x9b, 0xa3, 0x43, 0xa2, 0x48,
-	0x72, 0xe9, 0x29, 0x65, 0x01, 0x52, 0xf4, 0x40, 0x49, 0x1a, 0xff, 0xc9, 0x43, 0xf5, 0xf3, 0xb8,
-	0x0f, 0x8f, 0x4e, 0xeb, 0x93, 0x21, 0xf3, 0xa7, 0x43, 0x7e, 0x08, 0xa5, 0xa4, 0x94, 0x9a, 0x2f,
-	0xee, 0x35, 0xd9, 0x96, 0x98, 0xca, 0x06, 0x16, 0x11, 0x1f, 0x0b, 0xe2, 0x39, 0xe6, 0x50, 0xc6,
-	0xeb, 0x63, 0xf2, 0xce, 0x64, 0xa4, 0x5b, 0xfa, 0x6c, 0x1e, 0x67, 0x92, 0xf2, 0x07, 0x4e, 0x95,
-	0x38, 0x45, 0xcf, 0x38, 0xf6, 0xc2, 0x9b, 0x1f, 0x7b, 0xe3, 0xbf, 0x79, 0x40, 0xf2, 0xc6, 0x13,
-	0x5f, 0x1a, 0x2f, 0x65, 0xd7, 0xdf, 0x87, 0x02, 0x67, 0xc3, 0xc8, 0x25, 0x23, 0xb7, 0xdc, 0xd8,
-	0xa1, 0x8f, 0xa0, 0xec, 0x11, 0x2e, 0x68, 0xa8, 0xb9, 0xf6, 0xa8, 0xba, 0x90, 0x35, 0xce, 0xd6,
-	0xdb, 0x09, 0xc5, 0x07, 0xb3, 0xf5, 0xf6, 0x72, 0xb7, 0x2b, 0x53, 0xbe, 0x8b, 0x6f, 0x5e, 0xbe,
-	0xff, 0x56, 0x80, 0x52, 0x72, 0xdd, 0x41, 0xcb, 0x50, 0x35, 0x9d, 0xc3, 0x39, 0x6f, 0xd7, 0x9d,
-	0x36, 0x0e, 0xcb, 0x49, 0xf3, 0x95, 0x8b, 0x0c, 0x28, 0xe7, 0xc9, 0x75, 0xf8, 0x32, 0x58, 0xc8,
-	0x74, 0x0a, 0xaa, 0xae, 0xc2, 0x3d, 0xc9, 0x88, 0x4d, 0x6f, 0x70, 0x78, 0x1f, 0x47, 0x84, 0x5f,
-	0x0a, 0x13, 0xa9, 0x26, 0xa8, 0x9b, 0x0a, 0x14, 0xad, 0x43, 0x65, 0x97, 0x09, 0x75, 0xc7, 0x60,
-	0x7b, 0x24, 0x32, 0xe9, 0x70, 0xa1, 0xbd, 0x2e, 0x6b, 0x80, 0x0d, 0xe9, 0x8f, 0x6c, 0x98, 0xe0,
-	0x2e, 0x8b, 0x88, 0xca, 0x8f, 0xef, 0x3b, 0x5b, 0x0d, 0x95, 0x29, 0xcb, 0x05, 0x5d, 0xae, 0x4d,
-	0x59, 0xbe, 0x06, 0x85, 0xaf, 0x30, 0x95, 0x97, 0x85, 0xa2, 0xaa, 0x92, 0x66, 0x84, 0x16, 0x00,
-	0x04, 0x0b, 0xba, 0x5c, 0xb0, 0x90, 0x78, 0xaa, 0x94, 0x4f, 0xda, 0x19, 0x09, 0xfa, 0x18, 0x2a,
-	0xda, 0xd2, 0xe1, 0x54, 0xb2, 0xa8, 0x8b, 0xd4, 0xf2, 0xb2, 0xf6, 0xdc, 0x94, 0x8e, 0xe8, 0x0f,
-	0x39, 0xb8, 0x7a, 0x82, 0x96, 0x9a, 0xb3, 0xd2, 0xcf, 0x31, 0xeb, 0x17, 0x5b, 0xfd, 0xff, 0x0e,
-	0x6b, 0xb7, 0x0e, 0x70, 0xe0, 0x7f, 0xd4, 0x38, 0x13, 0xb4, 0x61, 0xcf, 0x1d, 0xe3, 0xaa, 0xe6,
-	0x04, 0x77, 0x60, 0x4a, 0xbf, 0x1e, 0xc4, 0xb1, 0xf5, 0xf3, 0xcc, 0xaf, 0x2f, 0x1c, 0x7b, 0x5e,
-	0xc7, 0x3e, 0x06, 0xd6, 0xb0, 0x2b, 0x7a, 0xac, 0x83, 0x35, 0xfe, 0x9c, 0x83, 0xea, 0x6a, 0x9c,
-	0x42, 0xe6, 0xd5, 0xe3, 0x18, 0xe5, 0xc9, 0x9d, 0x9f, 0xf2, 0x60, 0x28, 0xea, 0x77, 0x19, 0x6e,
-	0x78, 0xec, 0xa5, 0x3d, 0xcc, 0xc4, 0xb8, 0x8d, 0xbf, 0xe6, 0xa0, 0x7a, 0x42, 0x8b, 0x3a, 0x17,
-	0x2f, 0x02, 0x27, 0x1d, 0x10, 0x81, 0xc2, 0x9e, 0x7e, 0x51, 0xd0, 0x1f, 0xff, 0xc3, 0x0b, 0x6f,
-	0xf6, 0x94, 0xde, 0x6c, 0x8d, 0xd2, 0x38, 0x91, 0xf7, 0x85, 0x58, 0x9c, 0x07, 0x58, 0x4d, 0xfa,
-	0x05, 0xfa, 0xf8, 0xcc, 0xa7, 0xcb, 0x51, 0x93, 0x3f, 0xe3, 0x99, 0xf2, 0x01, 0xcc, 0xa6, 0x19,
-	0x16, 0xe3, 0x8c, 0x22, 0xab, 0xe9, 0x45, 0x29, 0x86, 0x79, 0x7c, 0x8c, 0x60, 0xff, 0x28, 0x2d,
-	0xf9, 0x1a, 0x14, 0xcc, 0x53, 0xce, 0xb8, 0xea, 0x85, 0x66, 0x24, 0x2f, 0xcc, 0x51, 0xa6, 0xb5,
-	0x3a, 0x24, 0xf4, 0x74, 0x87, 0xb2, 0xab, 0x59, 0xf9, 0x83, 0xd0, 0x6b, 0x6c, 0xc2, 0xdc, 0x06,
-	0x8b, 0xc4, 0x4a, 0xf2, 0x84, 0xbe, 0x35, 0x1c, 0xf8, 0xe7, 0x7c, 0x6a, 0x7f, 0x0b, 0x8a, 0xea,
-	0x4e, 0x94, 0xbc, 0xb4, 0x17, 0xe4, 0x70, 0xcd, 0x6b, 0xfc, 0x2b, 0x0f, 0x45, 0x9b, 0xb8, 0x84,
-	0x0e, 0xc4, 0xeb, 0x1a, 0xba, 0xec, 0xd6, 0xfa, 0xcd, 0x22, 0x3f, 0xb2, 0x5b, 0xeb, 0x57, 0x8c,
-	0x94, 0xaa, 0x8e, 0x1d, 0xa3, 0xaa, 0x29, 0x47, 0x1f, 0xff, 0xe1, 0x38, 0xfa, 0x0a, 0xc0, 0x36,
-	0x8d, 0xb8, 0x70, 0x38, 0x21, 0xa1, 0xe1, 0x5d, 0xa3, 0xca, 0x64, 0x4e, 0x95, 0xc9, 0x92, 0xf2,
-	0xdb, 0x24, 0x24, 0x44, 0x1d, 0x28, 0x99, 0xce, 0x4e, 0xbc, 0x73, 0x12, 0x02, 0x83, 0x91, 0xb8,
-	0x75, 0x1e, 0x7d, 0xfb, 0x72, 0x21, 0xf7, 0xec, 0xe5, 0x42, 0xee, 0xdf, 0x2f, 0x17, 0x72, 0x5f,
-	0xbf, 0x5a, 0xb8, 0xf2, 0xec, 0xd5, 0xc2, 0x95, 0x7f, 0xbc, 0x5a, 0xb8, 0xf2, 0xe5, 0x72, 0x66,
-	0x51, 0x99, 0xea, 0xb1, 0xf8, 0x84, 0x85, 0x24, 0x2b, 0x68, 0xef, 0x9f, 0xf1, 0x6f, 0x21, 0xb5,
-	0xe6, 0x6e, 0x41, 0xcd, 0xe2, 0x83, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xc6, 0x68, 0x71,
-	0x44, 0x1a, 0x00, 0x00,
+	// 2564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6f, 0x1b, 0xc7,
+	0xf9, 0x37, 0x49, 0x89, 0x14, 0x1f, 0x4a, 0xa2, 0x34, 0x92, 0x9d, 0xb5, 0xe3, 0x88, 0x0c, 0xf3,
+	0xa6, 0xfc, 0x1d, 0x51, 0x91, 0x03, 0xe4, 0xef, 0x06, 0x6d, 0x01, 0x51, 0x76, 0x63, 0xa1, 0xb6,
+	0x22, 0x2c, 0x95, 0x06, 0x8d, 0x5b, 0x2c, 0x86, 0xbb, 0x23, 0x72, 0xa2, 0xdd, 0x1d, 0x7a, 0x67,
+	0x28, 0x4b, 0x39, 0xf6, 0xd8, 0x53, 0x3e, 0x42, 0xaf, 0x0d, 0x8a, 0x9e, 0x92, 0x5b, 0x3f, 0x40,
+	0x2e, 0x05, 0x82, 0x9c, 0xda, 0xa2, 0x50, 0x0a, 0xfb, 0xe6, 0x5b, 0xfb, 0x09, 0x8a, 0x79, 0xd9,
+	0x17, 0x4a, 0xaa, 0x29, 0xaa, 0x4c, 0x4f, 0xe2, 0x3c, 0x2f, 0xbf, 0x67, 0x5e, 0x9e, 0x99, 0xe7,
+	0x65, 0x05, 0x77, 0x1e, 0x0f, 0xa8, 0x7b, 0xc0, 0xa9, 0x7f, 0x48, 0xa2, 0x75, 0x1a, 0x0a, 0x12,
+	0xb9, 0x3d, 0x4c, 0x43, 0x2e, 0xf0, 0x01, 0x0d, 0xbb, 0xeb, 0x87, 0x1b, 0x67, 0x89, 0xcd, 0x7e,
+	0xc4, 0x04, 0x43, 0xf5, 0x8c, 0x66, 0xf3, 0xac, 0xd0, 0xe1, 0xc6, 0x8d, 0x15, 0x97, 0xf1, 0x80,
+	0xf1, 0xf5, 0x0e, 0xe6, 0x64, 0xfd, 0x70, 0xa3, 0x43, 0x04, 0xde, 0x58, 0x77, 0x19, 0x0d, 0x35,
+	0xc2, 0x8d, 0xeb, 0x9a, 0xef, 0xa8, 0xd1, 0xba, 0x1e, 0x18, 0xd6, 0x72, 0x97, 0x75, 0x99, 0xa6,
+	0xcb, 0x5f, 0x86, 0x5a, 0xeb, 0x32, 0xd6, 0xf5, 0xc9, 0xba, 0x1a, 0x75, 0x06, 0xfb, 0xeb, 0x82,
+	0x06, 0x84, 0x0b, 0x1c, 0xf4, 0xb5, 0x40, 0xe3, 0xaf, 0xcb, 0x30, 0xf5, 0x29, 0x0b, 0x09, 0x7a,
+	0x0d, 0xe6, 0x5c, 0x16, 0x86, 0xc4, 0x15, 0x94, 0x85, 0x0e, 0xf5, 0xac, 0x5c, 0x3d, 0xb7, 0x5a,
+	0xb6, 0x67, 0x53, 0xe2, 0xb6, 0x87, 0xae, 0xc3, 0x8c, 0x9a, 0xb2, 0xe4, 0xe7, 0x15, 0xbf, 0xa4,
+	0xc6, 0xdb, 0x1e, 0xfa, 0x18, 0xaa, 0x1e, 0xe9, 0x33, 0x4e, 0x85, 0x83, 0x3d, 0x2f, 0x22, 0x9c,
+	0x5b, 0x85, 0x7a, 0x6e, 0xb5, 0x72, 0xfb, 0x9d, 0xe6, 0xa8, 0x65, 0x37, 0xb7, 0xb7, 0x36, 0x37,
+	0x5d, 0x97, 0x0d, 0x42, 0x61, 0xcf, 0x1b, 0x90, 0x4d, 0x8d, 0x81, 0x1e, 0x01, 0x7a, 0x42, 0x45,
+	0xcf, 0x8b, 0xf0, 0x13, 0xec, 0x27, 0xc8, 0x53, 0x97, 0x40, 0x5e, 0x4c, 0x71, 0x62, 0xf0, 0x5f,
+	0xc3, 0x52, 0x9f, 0x44, 0xfb, 0x2c, 0x0a, 0x70, 0xe8, 0x92, 0x04, 0x7d, 0xfa, 0x12, 0xe8, 0x28,
+	0x03, 0x94, 0x99, 0xbb, 0x47, 0x7c, 0xd2, 0xc5, 0x6a, 0x4b, 0x63, 0xf4, 0xe2, 0x65, 0xe6, 0x9e,
+	0xe2, 0xc4, 0xe0, 0x6f, 0xc0, 0x3c, 0xd6, 0x5c, 0xa7, 0x1f, 0x91, 0x7d, 0x7a, 0x64, 0x95, 0xd4,
+	0x81, 0xcc, 0x19, 0xea, 0xae, 0x22, 0xa2, 0x1a, 0x54, 0x7c, 0xe6, 0x62, 0xdf, 0xf1, 0x48, 0xc8,
+	0x02, 0x6b, 0x46, 0xc9, 0x80, 0x22, 0xdd, 0x95, 0x14, 0xf4, 0x0a, 0x80, 0xf4, 0x36, 0xc3, 0x2f,
+	0x2b, 0x7e, 0x59, 0x52, 0x34, 0x9b, 0x40, 0x35, 0x22, 0x1e, 0x09, 0xfa, 0x6a, 0x0d, 0x11, 0x16,
+	0xc4, 0x02, 0x29, 0xd3, 0xfa, 0xf1, 0x37, 0x27, 0xb5, 0x2b, 0x7f, 0x3b, 0xa9, 0xbd, 0xd9, 0xa5,
+	0xa2, 0x37, 0xe8, 0x34, 0x5d, 0x16, 0x18, 0x87, 0x34, 0x7f, 0xd6, 0xb8, 0x77, 0xb0, 0x2e, 0x8e,
+	0xfb, 0x84, 0x37, 0xef, 0x12, 0xf7, 0xbb, 0xaf, 0xd6, 0x40, 0xd3, 0xe5, 0xc8, 0x9e, 0x4f, 0x41,
+	0x6d, 0x2c, 0x08, 0x0a, 0x61, 0xd9, 0xc7, 0x5c, 0x38, 0xa7, 0x6d, 0x55, 0x26, 0x60, 0x0b, 0x49,
+	0x64, 0x7b, 0xd8, 0xde, 0xcf, 0x01, 0x0e, 0xb1, 0x4f, 0x3d, 0x2c, 0x58, 0xc4, 0xad, 0xd9, 0x7a,
+	0x61, 0xb5, 0x72, 0xfb, 0xd6, 0xe8, 0x23, 0xf9, 0x45, 0xac, 0x63, 0x67, 0xd4, 0x51, 0x04, 0x0b,
+	0xb8, 0xdb, 0x8d, 0xe4, 0x01, 0x11, 0x47, 0xea, 0x85, 0xc2, 0x9a, 0x53, 0x90, 0x1b, 0x63, 0x40,
+	0x6e, 0x2b, 0xc5, 0xd6, 0xf2, 0x97, 0xdf, 0xd7, 0x16, 0x4e, 0x11, 0xb9, 0x5d, 0x4d, 0x0c, 0x68,
+	0x8a, 0x3c, 0xb6, 0x60, 0xe0, 0x0b, 0xea, 0x70, 0x12, 0x7a, 0xd6, 0x7c, 0x3d, 0xb7, 0x3a, 0x63,
+	0x97, 0x15, 0xa5, 0x4d, 0x42, 0x0f, 0xbd, 0x0d, 0x0b, 0x3e, 0x7d, 0x3c, 0xa0, 0x1e, 0x15, 0xc7,
+	0x4e, 0xc0, 0xbc, 0x81, 0x4f, 0xac, 0xaa, 0x12, 0xaa, 0x26, 0xf4, 0x87, 0x8a, 0x8c, 0x36, 0x60,
+	0x39, 0x73, 0xc3, 0x9e, 0x60, 0x2a, 0xba, 0x11, 0x1b, 0xf4, 0xad, 0x85, 0x7a, 0x6e, 0x75, 0xce,
+	0x5e, 0x4a, 0x79, 0x9f, 0xc4, 0x2c, 0xf4, 0xff, 0x60, 0xd1, 0x8e, 0xeb, 0x84, 0xe4, 0x48, 0x38,
+	0xe9, 0x3e, 0x38, 0x3d, 0xcc, 0x7b, 0xd6, 0x62, 0x3d, 0xb7, 0x3a, 0x6b, 0x5f, 0xa5, 0x1d, 0x77,
+	0x87, 0x1c, 0x89, 0x64, 0x21, 0xfc, 0x3e, 0xe6, 0x3d, 0x74, 0x0c, 0x2b, 0x89, 0xbc, 0xc3, 0x89,
+	0x6f, 0x5e, 0x1b, 0xec, 0x4b, 0x87, 0x94, 0x3f, 0x2d, 0x54, 0xcf, 0xad, 0x4e, 0xb5, 0xde, 0x7b,
+	0x7e, 0x52, 0x5b, 0x7f, 0xb1, 0xe4, 0x3b, 0x5c, 0x44, 0x34, 0xec, 0xbe, 0xc3, 0x02, 0x2a, 0xe4,
+	0xc9, 0x1e, 0xdb, 0x37, 0x13, 0x85, 0x76, 0x2c, 0xbf, 0x99, 0x88, 0xa3, 0x5f, 0xc2, 0x52, 0x8f,
+	0xf9, 0x1e, 0x0d, 0xbb, 0x3c, 0x6b, 0x6f, 0x49, 0xd9, 0x5b, 0x7d, 0x7e, 0x52, 0x7b, 0xfd, 0x1c,
+	0xf6, 0x59, 0x23, 0x28, 0x96, 0xca, 0x40, 0xdb, 0xb0, 0xa8, 0x9c, 0x97, 0xf4, 0x99, 0xdb, 0x73,
+	0x7a, 0x84, 0x76, 0x7b, 0xc2, 0x5a, 0xae, 0xe7, 0x56, 0x0b, 0xad, 0x37, 0x9f, 0x9f, 0xd4, 0x1a,
+	0x67, 0x98, 0x67, 0x61, 0xab, 0x52, 0xe6, 0x9e, 0x14, 0xb9, 0xaf, 0x24, 0xd0, 0x0e, 0x14, 0xc4,
+	0xa1, 0x6f, 0x5d, 0x9d, 0x80, 0xff, 0x4b, 0x20, 0xb4, 0x0b, 0x0b, 0x83, 0xb0, 0xc3, 0x42, 0x39,
+	0x77, 0xa7, 0x4f, 0x22, 0xca, 0x3c, 0xeb, 0x9a, 0x9a, 0xe2, 0x1b, 0xcf, 0x4f, 0x6a, 0xaf, 0x9e,
+	0xe6, 0x9d, 0x33, 0xc3, 0x44, 0x64, 0x57, 0x49, 0xa0, 0x07, 0x50, 0x0d, 0x08, 0xe7, 0xb8, 0x4b,
+	0xb8, 0x54, 0x72, 0xc4, 0x91, 0xf5, 0x92, 0x02, 0x7c, 0xfd, 0xf9, 0x49, 0xad, 0x7e, 0x8a, 0x75,
+	0x16, 0x6f, 0x2e, 0x96, 0xd8, 0x25, 0xd1, 0xde, 0x11, 0xfa, 0x11, 0xcc, 0x78, 0xc4, 0xa5, 0x01,
+	0xf6, 0xb9, 0x65, 0x29, 0x98, 0x57, 0x9e, 0x9f, 0xd4, 0xae, 0xc7, 0xb4, 0xb3, 0xfa, 0x89, 0x38,
+	0xba, 0x05, 0x8b, 0xe9, 0xf4, 0x49, 0x88, 0x3b, 0x3e, 0xf1, 0xac, 0xeb, 0xca, 0xd9, 0xd3, 0x35,
+	0xdf, 0xd3, 0x74, 0x79, 0x31, 0x4c, 0x84, 0xe1, 0x89, 0xec, 0x0d, 0x7d, 0x31, 0x62, 0x7a, 0x2c,
+	0xba, 0x0a, 0x0b, 0x11, 0x11, 0x83, 0x28, 0x74, 0x04, 0x53, 0xd7, 0x8c, 0x44, 0xd6, 0xcb, 0x4a,
+	0x74, 0x5e, 0xd3, 0xf7, 0x58, 0x5b, 0x51, 0xd1, 0x55, 0x28, 0x52, 0xee, 0x6c, 0x6c, 0xdc, 0xb1,
+	0x6e, 0x2a, 0xfe, 0x34, 0xe5, 0x1b, 0x1b, 0x77, 0xd0, 0x47, 0x50, 0xe1, 0x83, 0xce, 0xe7, 0x2c,
+	0x24, 0xdb, 0xe1, 0x3e, 0xb3, 0x5e, 0x51, 0x0f, 0xff, 0xda, 0xe8, 0x27, 0xa1, 0x9d, 0x2a, 0xd9,
+	0x59, 0x04, 0x64, 0xc3, 0xbc, 0x37, 0xe0, 0xc2, 0x11, 0xbd, 0x88, 0x70, 0xe9, 0x88, 0xd6, 0x8a,
+	0xf2, 0x8f, 0x5b, 0xc6, 0x3f, 0xae, 0xea, 0x53, 0xe7, 0xde, 0x41, 0x93, 0xb2, 0xf5, 0x00, 0x8b,
+	0x5e, 0x73, 0x3b, 0x14, 0x19, 0x77, 0xd8, 0x0e, 0x85, 0x3d, 0x27, 0x21, 0xf6, 0x62, 0x04, 0xb9,
+	0x21, 0x22, 0xc2, 0x21, 0xdf, 0x27, 0x91, 0xe3, 0xf6, 0x70, 0x18, 0x12, 0xdf, 0xaa, 0xa9, 0x28,
+	0x50, 0x8d, 0xe9, 0x5b, 0x9a, 0x2c, 0x43, 0x0e, 0xe5, 0x0e, 0xdb, 0xdf, 0xef, 0x30, 0x1c, 0xc9,
+	0x4d, 0xb5, 0xea, 0x6a, 0xb9, 0x73, 0x94, 0x7f, 0x94, 0x12, 0xd1, 0xab, 0x30, 0xeb, 0xf3, 0x40,
+	0xe6, 0x28, 0x87, 0x54, 0xee, 0xd9, 0xab, 0x0a, 0xad, 0xe2, 0xf3, 0x60, 0xd7, 0x90, 0xd0, 0x1a,
+	0xa0, 0x88, 0x74, 0xb0, 0xaf, 0xc2, 0x2e, 0x17, 0xf2, 0xa9, 0xef, 0x1e, 0x5b, 0x0d, 0x25, 0xb8,
+	0x98, 0x70, 0xda, 0x86, 0x81, 0x02, 0x58, 0x4a, 0xc5, 0xd3, 0xc5, 0xbf, 0x36, 0x89, 0xe0, 0x90,
+	0x00, 0xa7, 0x5b, 0x12, 0xc1, 0xb5, 0xd4, 0x5c, 0x80, 0x8f, 0x1c, 0x79, 0xd8, 0xec, 0x90, 0x44,
+	0xd6, 0xeb, 0x13, 0xb0, 0xb8, 0x9c, 0x60, 0x3f, 0xc4, 0x47, 0x7b, 0x06, 0x19, 0x7d, 0x00, 0xd7,
+	0x53, 0x27, 0x56, 0x5e, 0x71, 0x88, 0x7d, 0xa7, 0xe3, 0x33, 0xf7, 0x80, 0x5b, 0x6f, 0xc8, 0x0b,
+	0x61, 0xbf, 0x94, 0x08, 0x6c, 0x1b, 0x7e, 0x4b, 0xb1, 0xd1, 0x1d, 0xb0, 0xce, 0xd1, 0xed, 0xb1,
+	0x41, 0xc4, 0xad, 0x37, 0x95, 0xea, 0xb5, 0x33, 0xaa, 0xf7, 0x25, 0x17, 0xfd, 0x2a, 0x1b, 0x26,
+	0x3a, 0x83, 0xfd, 0x7d, 0x12, 0x59, 0x6f, 0xd5, 0x73, 0x17, 0x8b, 0x5c, 0x0f, 0x62, 0xcd, 0x96,
+	0x52, 0xcc, 0x44, 0x16, 0x4d, 0x68, 0xfc, 0xb1, 0x00, 0xd5, 0x53, 0x42, 0xc8, 0x82, 0x52, 0x7c,
+	0xed, 0x72, 0xca, 0x79, 0xe2, 0xa1, 0x7c, 0xf1, 0xf6, 0x09, 0xb1, 0xf2, 0x13, 0xd8, 0x62, 0x09,
+	0x84, 0xb6, 0xa0, 0x28, 0x70, 0xd4, 0x25, 0xc2, 0x2a, 0x8c, 0x7f, 0x49, 0x8c, 0x2a, 0xba, 0x0f,
+	0x65, 0xfd, 0x70, 0xbb, 0xb8, 0x6f, 0x4d, 0x8d, 0x8f, 0x33, 0xa3, 0xb4, 0xb7, 0x70, 0x1f, 0xdd,
+	0x83, 0x92, 0x39, 0x76, 0x6b, 0x7a, 0x7c, 0x9c, 0x58, 0x17, 0x3d, 0x92, 0x57, 0xc1, 0x23, 0x24,
+	0x20, 0x9e, 0x23, 0x7a, 0x94, 0xeb, 0xb8, 0x62, 0x15, 0xc7, 0x87, 0x5c, 0x8c, 0x71, 0xf6, 0x7a,
+	0x94, 0xab, 0xd0, 0xd3, 0xd8, 0x81, 0x4a, 0xe6, 0xed, 0x41, 0x37, 0xa1, 0x8c, 0x07, 0xa2, 0xc7,
+	0x22, 0x2a, 0x8e, 0x4d, 0x39, 0x90, 0x12, 0xe4, 0x35, 0x57, 0x89, 0xa3, 0x2e, 0x00, 0xee, 0x9a,
+	0x7a, 0xa0, 0x22, 0x69, 0x5b, 0x9a, 0xd4, 0xf8, 0x3a, 0x0f, 0xa5, 0x07, 0x3c, 0xd8, 0xc2, 0x7d,
+	0x8e, 0x30, 0xcc, 0xa5, 0x01, 0x5d, 0xee, 0x66, 0x6e, 0x02, 0x07, 0x3d, 0x9b, 0x40, 0xca, 0x2d,
+	0xfe, 0x0c, 0x50, 0x6a, 0x42, 0xfa, 0xbb, 0xb2, 0x33, 0x09, 0x87, 0x5a, 0x48, 0x70, 0x5b, 0x2c,
+	0xf4, 0xa4, 0xad, 0x47, 0x00, 0x5d, 0x9f, 0x75, 0xb0, 0xaf, 0x6c, 0x14, 0x26, 0x60, 0xa3, 0xac,
+	0xf1, 0xb6, 0x70, 0xbf, 0xf1, 0xbb, 0x3c, 0x40, 0x9a, 0xfd, 0xa3, 0xdb, 0x50, 0x8a, 0x8b, 0x07,
+	0xbd, 0x69, 0xd6, 0x77, 0x5f, 0xad, 0x2d, 0x1b, 0x55, 0x53, 0x0f, 0xb4, 0x55, 0x7c, 0xb4, 0x63,
+	0x41, 0x44, 0x52, 0x77, 0xcb, 0xab, 0x54, 0xf4, 0x7a, 0xd3, 0x28, 0xc8, 0x03, 0x6a, 0x9a, 0xda,
+	0xb2, 0xb9, 0xc5, 0x68, 0xd8, 0x7a, 0x57, 0xce, 0xfb, 0xcb, 0xef, 0x6b, 0xab, 0x17, 0x98, 0xb7,
+	0x54, 0xe0, 0xa9, 0x3b, 0xbe, 0x0c, 0xe5, 0x3e, 0x8b, 0x84, 0x13, 0xe2, 0x80, 0xe8, 0x5d, 0xb0,
+	0x67, 0x24, 0x61, 0x07, 0x07, 0x44, 0xbe, 0xf2, 0xff, 0xa1, 0x76, 0x2b, 0x9f, 0x57, 0x8d, 0xdd,
+	0x82, 0xc5, 0xf8, 0xd1, 0x4d, 0xb3, 0xd0, 0x69, 0x95, 0x85, 0x2e, 0x18, 0x46, 0x92, 0x82, 0x36,
+	0x7e, 0x9b, 0x83, 0xd9, 0xbb, 0x94, 0x8b, 0x88, 0x76, 0x06, 0x2a, 0x09, 0xb3, 0xa0, 0x74, 0x88,
+	0x7d, 0xd6, 0x27, 0x91, 0x71, 0xd5, 0x78, 0x88, 0x5e, 0x86, 0x92, 0x83, 0x03, 0xb9, 0x93, 0xca,
+	0x17, 0xa6, 0x5a, 0x79, 0x2b, 0x67, 0x17, 0x37, 0x15, 0x45, 0xbe, 0x12, 0x86, 0x77, 0x99, 0x57,
+	0x42, 0xab, 0x36, 0xbe, 0x2e, 0xc2, 0xc2, 0x27, 0xc9, 0x7a, 0x6c, 0xe2, 0xb2, 0x68, 0xb8, 0x56,
+	0xce, 0x0d, 0xd7, 0xca, 0xef, 0x43, 0xd9, 0x14, 0x74, 0x2c, 0xb2, 0xf2, 0x23, 0x8e, 0x34, 0x15,
+	0x45, 0x36, 0xcc, 0x7a, 0x99, 0x35, 0x5b, 0x05, 0x75, 0xb2, 0xcd, 0xd1, 0x4f, 0x75, 0x76, 0xa7,
+	0xec, 0x21, 0x0c, 0x39, 0x97, 0x88, 0xb8, 0xb4, 0x4f, 0x65, 0xd5, 0x32, 0x35, 0x6a, 0x2e, 0x89,
+	0x28, 0x72, 0x93, 0x8d, 0x9b, 0x9e, 0xbc, 0x7f, 0x19, 0x68, 0xf4, 0x39, 0x54, 0x3a, 0x32, 0x01,
+	0x33, 0x96, 0x74, 0xe9, 0xfc, 0x02, 0x4b, 0x3f, 0x35, 0xa7, 0xf7, 0xd6, 0x05, 0x2d, 0x7d, 0xf7,
+	0xd5, 0x5a, 0xc5, 0x80, 0xc9, 0xa1, 0x0d, 0xd2, 0x9a, 0xf1, 0x8c, 0x6b, 0x50, 0x14, 0x47, 0xaa,
+	0xa4, 0xd1, 0x85, 0xb5, 0x19, 0x49, 0x3a, 0x17, 0x58, 0x0c, 0xb8, 0x2a, 0xa6, 0xa7, 0x6d, 0x33,
+	0x42, 0x0f, 0xa1, 0xea, 0xb2, 0xa0, 0xef, 0x13, 0x55, 0xa8, 0x08, 0x1a, 0x10, 0x55, 0x4d, 0x57,
+	0x6e, 0xdf, 0x68, 0xea, 0x26, 0x4c, 0x33, 0x6e, 0xc2, 0x34, 0xf7, 0xe2, 0x26, 0x4c, 0x6b, 0x46,
+	0x4e, 0xf8, 0x8b, 0xef, 0x6b, 0x39, 0x7b, 0x3e, 0x55, 0x96, 0x6c, 0x74, 0x03, 0x66, 0x22, 0xf2,
+	0x78, 0x40, 0x06, 0xc4, 0x53, 0x15, 0xf7, 0x8c, 0x9d, 0x8c, 0x51, 0x03, 0x66, 0xb1, 0x7b, 0x10,
+	0xb2, 0x27, 0x3e, 0xf1, 0xba, 0xc4, 0x53, 0x55, 0xf2, 0x8c, 0x3d, 0x44, 0x93, 0xcf, 0xb3, 0x8e,
+	0x5c, 0xe1, 0x20, 0xe8, 0x90, 0xc8, 0x9a, 0x55, 0x89, 0x40, 0x45, 0xd1, 0x76, 0x14, 0x49, 0xf6,
+	0x06, 0x64, 0x5a, 0xeb, 0x90, 0x28, 0x92, 0x55, 0xf0, 0x9c, 0x92, 0x00, 0x49, 0xba, 0xa7, 0x28,
+	0xd2, 0x85, 0x3b, 0x58, 0xb8, 0x3d, 0x87, 0xea, 0x12, 0xb3, 0x20, 0x2f, 0xbe, 0x70, 0x7b, 0xdb,
+	0x1e, 0xda, 0x02, 0xd0, 0x2c, 0xb5, 0xd0, 0xea, 0x18, 0x0b, 0x2d, 0x2b, 0x3d, 0xc9, 0x69, 0xfc,
+	0xbe, 0x00, 0xd5, 0x8f, 0xe3, 0xcc, 0x64, 0xf4, 0xb5, 0x39, 0xbd, 0xa4, 0xfc, 0xd9, 0x25, 0xbd,
+	0x0f, 0xe5, 0xe4, 0xa9, 0xb6, 0x0a, 0xa3, 0xbc, 0x39, 0x11, 0x95, 0xa9, 0x6d, 0x44, 0x7c, 0x2c,
+	0x64, 0x54, 0xd5, 0x87, 0x3e, 0x55, 0x2f, 0xc8, 0x6e, 0x8a, 0xa1, 0xee, 0xe9, 0xb3, 0x7f, 0x9c,
+	0x71, 0xfa, 0x1f, 0xd8, 0x15, 0xe3, 0x2b, 0x70, 0x8e, 0x5b, 0x15, 0xff, 0x0b, 0xb7, 0xca, 0x1e,
+	0x69, 0x69, 0xf8, 0x48, 0x6f, 0x42, 0x99, 0xbb, 0x3d, 0x22, 0x9b, 0x02, 0x9e, 0xf2, 0xed, 0x19,
+	0x3b, 0x25, 0x34, 0x9e, 0xe6, 0x60, 0x31, 0x39, 0xab, 0xb6, 0x21, 0xbf, 0xe8, 0xb4, 0x6e, 0xc0,
+	0x0c, 0x97, 0x0e, 0xab, 0x43, 0x90, 0xb4, 0x94, 0x8c, 0xd1, 0xff, 0x99, 0x8a, 0x59, 0x4f, 0xc5,
+	0x54, 0xcc, 0x05, 0x25, 0xa4, 0x2a, 0xe1, 0x16, 0x16, 0x49, 0x25, 0xfc, 0x00, 0xaa, 0x19, 0x59,
+	0xb5, 0x01, 0x53, 0x63, 0x6c, 0xc0, 0x5c, 0x82, 0xa7, 0xd6, 0x7f, 0x13, 0xca, 0xa7, 0x83, 0x4b,
+	0x4a, 0x68, 0x7c, 0x99, 0x87, 0xe5, 0xe1, 0x4e, 0x51, 0x1b, 0x07, 0xfd, 0x17, 0xaf, 0x73, 0x19,
+	0xa6, 0x75, 0x0e, 0xa6, 0x17, 0xa9, 0x07, 0xf2, 0x95, 0x18, 0x5a, 0x96, 0x19, 0xa1, 0x3b, 0x30,
+	0x35, 0xf6, 0x12, 0x94, 0x06, 0xda, 0x85, 0x29, 0xd5, 0x12, 0x9b, 0x9e, 0x40, 0xae, 0xa1, 0x90,
+	0xd0, 0x4f, 0x74, 0x8f, 0xe1, 0x12, 0xb9, 0xa3, 0xd4, 0x6b, 0xfc, 0x33, 0x0f, 0xc8, 0x26, 0x26,
+	0x3a, 0xc9, 0xed, 0x9a, 0xc4, 0x05, 0x7e, 0x17, 0x8a, 0x9c, 0x0d, 0x22, 0x97, 0x8c, 0xbc, 0xbd,
+	0x46, 0x0e, 0x7d, 0x00, 0x15, 0x8f, 0x70, 0x41, 0x43, 0xdd, 0xd0, 0x19, 0x15, 0xc2, 0xb2, 0xc2,
+	0xd9, 0xd4, 0x60, 0x5a, 0x35, 0x1d, 0xb2, 0xa9, 0xc1, 0x84, 0x6f, 0x5e, 0x9a, 0x69, 0x94, 0x2e,
+	0x9f, 0x69, 0xfc, 0xb9, 0x08, 0xe5, 0xa4, 0xa7, 0x86, 0x36, 0xa1, 0x6a, 0x92, 0x1c, 0xe7, 0xa2,
+	0x09, 0xe2, 0xbc, 0x51, 0xd8, 0x4c, 0xf2, 0x44, 0xb9, 0xc8, 0x80, 0x72, 0x9e, 0xf4, 0x5c, 0x27,
+	0x91, 0x30, 0xcf, 0xa7, 0xa0, 0xaa, 0xdf, 0xda, 0x85, 0x05, 0xe3, 0x28, 0xb2, 0x9d, 0xd7, 0xc3,
+	0x11, 0xe1, 0x13, 0x49, 0x9a, 0xab, 0x09, 0x6a, 0x5b, 0x81, 0xa2, 0x1d, 0x98, 0x3d, 0x64, 0x42,
+	0x35, 0xb2, 0xd8, 0x13, 0x12, 0x5d, 0xa6, 0x66, 0xab, 0x68, 0x80, 0x5d, 0xa9, 0x8f, 0x6c, 0x98,
+	0xe6, 0x2e, 0x8b, 0x26, 0x73, 0xed, 0x34, 0x54, 0x26, 0x83, 0x28, 0xea, 0xcc, 0x42, 0x8f, 0x24,
+	0xfd, 0x33, 0x4c, 0xe5, 0xeb, 0x5b, 0x52, 0xaf, 0xaf, 0x19, 0xa1, 0x15, 0x00, 0xc1, 0x82, 0x0e,
+	0x17, 0x2c, 0x4c, 0x5e, 0xe6, 0x0c, 0x05, 0x7d, 0x08, 0xb3, 0x5a, 0xd2, 0xe1, 0x34, 0x74, 0xc7,
+	0x4b, 0x3b, 0x2a, 0x5a, 0xb3, 0x2d, 0x15, 0xd1, 0x6f, 0x72, 0x70, 0xf5, 0x54, 0x05, 0x65, 0xce,
+	0x4a, 0xf7, 0xfc, 0x77, 0xc6, 0x5b, 0xfd, 0xbf, 0x4e, 0x6a, 0x37, 0x8f, 0x71, 0xe0, 0x7f, 0xd0,
+	0x38, 0x17, 0xb4, 0x61, 0x2f, 0x0d, 0x95, 0x55, 0xe6, 0x04, 0x0f, 0x60, 0x4e, 0x37, 0x12, 0x62,
+	0xdb, 0xfa, 0x1b, 0xc0, 0xcf, 0xc6, 0xb6, 0xbd, 0xac, 0x6d, 0x0f, 0x81, 0x35, 0xec, 0x59, 0x3d,
+	0xd6, 0xc6, 0x1a, 0x7f, 0xc8, 0x41, 0xf5, 0x6e, 0xec, 0x42, 0xa6, 0xb5, 0x3e, 0x94, 0x9d, 0xe7,
+	0x2e, 0x9e, 0x9d, 0x63, 0x28, 0xe9, 0xe6, 0x3f, 0x37, 0x25, 0xd7, 0xc4, 0xba, 0xff, 0x31, 0x6e,
+	0xe3, 0x4f, 0x39, 0xa8, 0x9e, 0xe2, 0xa2, 0xd6, 0xf8, 0x8f, 0xc0, 0x69, 0x05, 0x44, 0xa0, 0xf8,
+	0x44, 0x47, 0x2b, 0x7d, 0xf9, 0x1f, 0x8e, 0xbd, 0xd9, 0x73, 0x7a, 0xb3, 0x35, 0x4a, 0xe3, 0x94,
+	0xdf, 0x17, 0x63, 0x72, 0x1e, 0xe0, 0x6e, 0x12, 0x2f, 0xd0, 0x87, 0xe7, 0x7e, 0x1f, 0x1b, 0x35,
+	0xf9, 0x73, 0xbe, 0x85, 0xdd, 0x83, 0xc5, 0xd4, 0xc3, 0x62, 0x9c, 0x51, 0x75, 0x55, 0x5a, 0xd3,
+	0xc7, 0x30, 0x8f, 0x87, 0x6a, 0xc1, 0xff, 0x49, 0x76, 0x97, 0xa6, 0x09, 0x53, 0x43, 0x69, 0xc2,
+	0xdb, 0xb2, 0xf7, 0x9c, 0xd9, 0x1c, 0xf9, 0x91, 0x67, 0x5a, 0xe7, 0x47, 0x59, 0xfa, 0xbd, 0xd0,
+	0x6b, 0xb4, 0x61, 0x69, 0x97, 0x45, 0x62, 0x2b, 0xf9, 0x4e, 0xbb, 0x37, 0xe8, 0xfb, 0x17, 0xfc,
+	0x9e, 0xfb, 0x12, 0x94, 0x54, 0xf9, 0x9e, 0x7c, 0xce, 0x2d, 0xca, 0xe1, 0xb6, 0xd7, 0xf8, 0x7b,
+	0x1e, 0x4a, 0x36, 0x71, 0x09, 0xed, 0x8b, 0x17, 0x05, 0x74, 0x19, 0xad, 0x75, 0x63, 0x3c, 0x3f,
+	0x32, 0x5a, 0x2b, 0xb9, 0x4c, 0x55, 0x55, 0x18, 0xaa, 0xaa, 0xd2, 0x72, 0x72, 0xea, 0x87, 0x2b,
+	0x27, 0xb7, 0x00, 0xf6, 0x69, 0xc4, 0x85, 0xc3, 0x09, 0x09, 0xad, 0xe9, 0x0b, 0x3d, 0x93, 0x39,
+	0x5d, 0xb4, 0x28, 0xbd, 0x36, 0x21, 0x21, 0x6a, 0x41, 0xd9, 0x44, 0x76, 0xe2, 0x59, 0xc5, 0x71,
+	0x30, 0x12, 0xb5, 0xd6, 0xa3, 0x6f, 0x9e, 0xae, 0xe4, 0xbe, 0x7d, 0xba, 0x92, 0xfb, 0xc7, 0xd3,
+	0x95, 0xdc, 0x17, 0xcf, 0x56, 0xae, 0x7c, 0xfb, 0x6c, 0xe5, 0xca, 0x5f, 0x9e, 0xad, 0x5c, 0xf9,
+	0x74, 0x33, 0xb3, 0xa8, 0xcc, 0xeb, 0xb1, 0x26, 0x9b, 0x72, 0x59, 0xc2, 0xfa, 0xd1, 0x39, 0xff,
+	0x7b, 0xa0, 0xd6, 0xdc, 0x29, 0xaa, 0x59, 0xbc, 0xf7, 0xef, 0x01, 0x00, 0xf6, 0x7f, 0xec, 0xfc,
+	0xa9, 0x20, 0x00, 0x00,
 }
 
 func (m *Zone) Marshal() (dAtA []byte, err error) {
@@ -1380,6 +1716,76 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LiquidityBuffer != nil {
+		{
+			size, err := m.LiquidityBuffer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.UnbondingIntervalHours != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingIntervalHours))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.UnbondingIntervalBlocks != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.UnbondingIntervalBlocks))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.RebalanceMaxTurnover.Size()
+		i -= size
+		if _, err := m.RebalanceMaxTurnover.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.RebalanceThreshold.Size()
+		i -= size
+		if _, err := m.RebalanceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x9a
+	if len(m.RebalanceStrategy) > 0 {
+		i -= len(m.RebalanceStrategy)
+		copy(dAtA[i:], m.RebalanceStrategy)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.RebalanceStrategy)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.LsmProvider) > 0 {
+		i -= len(m.LsmProvider)
+		copy(dAtA[i:], m.LsmProvider)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.LsmProvider)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.IsOffboarding {
 		i--
 		if m.IsOffboarding {
@@ -1699,7 +2105,7 @@ func (m *Zone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubzoneInfo) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityBuffer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1709,35 +2115,118 @@ func (m *SubzoneInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubzoneInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityBuffer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubzoneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityBuffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BaseChainID) > 0 {
-		i -= len(m.BaseChainID)
-		copy(dAtA[i:], m.BaseChainID)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.RedeemedThisEpoch.Size()
+		i -= size
+		if _, err := m.RedeemedThisEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LsmCaps) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EpochCap.Size()
+		i -= size
+		if _, err := m.EpochCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Target.Size()
+		i -= size
+		if _, err := m.Target.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubzoneInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubzoneInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubzoneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseChainID) > 0 {
+		i -= len(m.BaseChainID)
+		copy(dAtA[i:], m.BaseChainID)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.BaseChainID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LsmCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -1917,6 +2406,19 @@ func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BatchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BatchTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x7a
+	if m.BatchId != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.SendErrors != 0 {
 		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.SendErrors))
 		i--
@@ -1947,12 +2449,12 @@ func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x50
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x4a
 	if m.Status != 0 {
@@ -2049,12 +2551,27 @@ func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	if m.Scheduled {
+		i--
+		if m.Scheduled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	i -= n8
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n8))
+	if m.BatchId != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2098,6 +2615,127 @@ func (m *UnbondingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnbondingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Waitgroup != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Waitgroup))
+		i--
+		dAtA[i] = 0x28
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBatchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBatchTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.LastBatchHeight != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.LastBatchHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedemptionRateSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedemptionRateSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRateSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RedelegationRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2128,12 +2766,12 @@ func (m *RedelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n10
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n10))
+	i -= n14
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x32
 	if m.XAmount != 0 {
@@ -2210,12 +2848,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedSince):])
-	if err11 != nil {
-		return 0, err11
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedSince, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedSince):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n11
-	i = encodeVarintInterchainstaking(dAtA, i, uint64(n11))
+	i -= n15
+	i = encodeVarintInterchainstaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x4a
 	if m.Tombstoned {
@@ -2494,22 +3132,22 @@ func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Completed != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Completed):])
-		if err13 != nil {
-			return 0, err13
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Completed, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Completed):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n13
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n13))
+		i -= n17
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.FirstSeen != nil {
-		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FirstSeen):])
-		if err14 != nil {
-			return 0, err14
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.FirstSeen, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.FirstSeen):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n14
-		i = encodeVarintInterchainstaking(dAtA, i, uint64(n14))
+		i -= n18
+		i = encodeVarintInterchainstaking(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -2678,6 +3316,50 @@ func (m *Zone) Size() (n int) {
 	if m.IsOffboarding {
 		n += 3
 	}
+	l = len(m.LsmProvider)
+	if l > 0 {
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.RebalanceStrategy)
+	if l > 0 {
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	l = m.RebalanceThreshold.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	l = m.RebalanceMaxTurnover.Size()
+	n += 2 + l + sovInterchainstaking(uint64(l))
+	if m.UnbondingIntervalBlocks != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.UnbondingIntervalBlocks))
+	}
+	if m.UnbondingIntervalHours != 0 {
+		n += 2 + sovInterchainstaking(uint64(m.UnbondingIntervalHours))
+	}
+	if m.LiquidityBuffer != nil {
+		l = m.LiquidityBuffer.Size()
+		n += 2 + l + sovInterchainstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityBuffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Target.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.EpochCap.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.RedeemedThisEpoch.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
 	return n
 }
 
@@ -2814,6 +3496,11 @@ func (m *WithdrawalRecord) Size() (n int) {
 	if m.SendErrors != 0 {
 		n += 1 + sovInterchainstaking(uint64(m.SendErrors))
 	}
+	if m.BatchId != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.BatchId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BatchTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
 	return n
 }
 
@@ -2844,10 +3531,16 @@ func (m *UnbondingRecord) Size() (n int) {
 	n += 1 + l + sovInterchainstaking(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.BatchId != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.BatchId))
+	}
+	if m.Scheduled {
+		n += 2
+	}
 	return n
 }
 
-func (m *RedelegationRecord) Size() (n int) {
+func (m *UnbondingSchedule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2857,19 +3550,68 @@ func (m *RedelegationRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovInterchainstaking(uint64(l))
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	if m.Sequence != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Sequence))
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.LastBatchHeight != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.LastBatchHeight))
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovInterchainstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBatchTime)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	if m.Waitgroup != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Waitgroup))
 	}
-	if m.XAmount != 0 {
-		n += 1 + sovInterchainstaking(uint64(m.XAmount))
+	return n
+}
+
+func (m *RedemptionRateSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	l = m.Tvl.Size()
+	n += 1 + l + sovInterchainstaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.EpochNumber))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovInterchainstaking(uint64(l))
+	}
+	if m.XAmount != 0 {
+		n += 1 + sovInterchainstaking(uint64(m.XAmount))
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
 	n += 1 + l + sovInterchainstaking(uint64(l))
@@ -3944,59 +4686,9 @@ func (m *Zone) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsOffboarding = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SubzoneInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubzoneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmProvider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4024,11 +4716,11 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.LsmProvider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4056,61 +4748,11 @@ func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseChainID = string(dAtA[iNdEx:postIndex])
+			m.RebalanceStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LsmCaps) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowInterchainstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LsmCaps: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4138,13 +4780,13 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RebalanceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceMaxTurnover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4172,15 +4814,53 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorBondCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RebalanceMaxTurnover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIntervalBlocks", wireType)
+			}
+			m.UnbondingIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingIntervalBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIntervalHours", wireType)
+			}
+			m.UnbondingIntervalHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingIntervalHours |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBuffer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4190,23 +4870,25 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LiquidityBuffer == nil {
+				m.LiquidityBuffer = &LiquidityBuffer{}
+			}
+			if err := m.LiquidityBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4231,7 +4913,7 @@ func (m *LsmCaps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+func (m *LiquidityBuffer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4254,17 +4936,17 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityBuffer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4274,29 +4956,17 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4306,29 +4976,29 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4356,11 +5026,13 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortName = string(dAtA[iNdEx:postIndex])
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4388,13 +5060,15 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.EpochCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			m.BalanceWaitgroup = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4404,15 +5078,64 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemedThisEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemedThisEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -4430,7 +5153,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Distribution) Unmarshal(dAtA []byte) error {
+func (m *SubzoneInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4453,15 +5176,15 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubzoneInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubzoneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4489,13 +5212,13 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valoper = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XAmount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseChainID", wireType)
 			}
-			m.XAmount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4505,16 +5228,943 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.XAmount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LsmCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LsmCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LsmCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceWaitgroup", wireType)
+			}
+			m.BalanceWaitgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceWaitgroup |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valoper", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valoper = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XAmount", wireType)
+			}
+			m.XAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.XAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distribution = append(m.Distribution, &Distribution{})
+			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txhash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Requeued = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acknowledged = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendErrors", wireType)
+			}
+			m.SendErrors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendErrors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4524,23 +6174,22 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BatchTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4565,7 +6214,7 @@ func (m *Distribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4588,10 +6237,10 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4627,10 +6276,10 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			var stringLen uint64
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4640,29 +6289,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4672,29 +6308,27 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Distribution = append(m.Distribution, &Distribution{})
-			if err := m.Distribution[len(m.Distribution)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4722,7 +6356,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -4753,14 +6387,13 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4787,15 +6420,15 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txhash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
-			var stringLen uint64
+			m.BatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4805,29 +6438,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BatchId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txhash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4837,16 +6457,67 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.Scheduled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbondingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4856,30 +6527,29 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requeued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var v int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4889,17 +6559,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Sequence |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Requeued = bool(v != 0)
-		case 11:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledged", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchHeight", wireType)
 			}
-			var v int
+			m.LastBatchHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4909,17 +6578,16 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LastBatchHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Acknowledged = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchTime", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4929,16 +6597,30 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastBatchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waitgroup", wireType)
 			}
-			m.SendErrors = 0
+			m.Waitgroup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -4948,7 +6630,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SendErrors |= int64(b&0x7F) << shift
+				m.Waitgroup |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4974,7 +6656,7 @@ func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
+func (m *RedemptionRateSample) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4997,10 +6679,10 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedemptionRateSample: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedemptionRateSample: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5037,9 +6719,9 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.EpochNumber = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5049,16 +6731,16 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5068,29 +6750,16 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInterchainstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelatedTxhash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5100,29 +6769,30 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelatedTxhash = append(m.RelatedTxhash, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5132,30 +6802,31 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInterchainstaking
@@ -5165,22 +6836,23 @@ func (m *UnbondingRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthInterchainstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -66,6 +66,10 @@ var (
 	KeyPrefixLsmCaps                     = []byte{0x11}
 	KeyPrefixLocalDenomZoneMapping       = []byte{0x12}
 	KeyPrefixDeniedValidator             = []byte{0x13}
+	KeyPrefixUnbondingSchedule           = []byte{0x14}
+	KeyPrefixRedemptionRateHistory       = []byte{0x15}
+	KeyPrefixWithdrawalWaitgroupEpoch    = []byte{0x16}
+	KeyPrefixWithdrawalWaitgroupQuery    = []byte{0x17}
 )
 
 // ParseStakingDelegationKey parses the KV store key for a delegation from Cosmos x/staking module,
@@ -152,11 +156,43 @@ func GetWithdrawalKey(chainID string, status int32) []byte {
 }
 
 // GetUnbondingKey gets the unbonding key.
-// unbonding records are keyed by chainId, validator and epoch, as they must be unique with regard to this triple.
-func GetUnbondingKey(chainID, validator string, epochNumber int64) []byte {
-	epochBytes := make([]byte, 8)
-	binary.BigEndian.PutUint64(epochBytes, uint64(epochNumber)) //nolint:gosec
-	return append(append(KeyPrefixUnbondingRecord, chainID+validator...), epochBytes...)
+// unbonding records are keyed by chainId, validator and batch id, as they must be unique with regard to this triple.
+func GetUnbondingKey(chainID, validator string, batchID int64) []byte {
+	batchBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(batchBytes, uint64(batchID)) //nolint:gosec
+	return append(append(KeyPrefixUnbondingRecord, chainID+validator...), batchBytes...)
+}
+
+// GetUnbondingScheduleKey gets the unbonding schedule key for a given chain.
+func GetUnbondingScheduleKey(chainID string) []byte {
+	return append(KeyPrefixUnbondingSchedule, chainID...)
+}
+
+// GetWithdrawalWaitgroupEpochKey gets the key of the epoch the withdrawal
+// waitgroup of a given chain was last reset in.
+func GetWithdrawalWaitgroupEpochKey(chainID string) []byte {
+	return append(KeyPrefixWithdrawalWaitgroupEpoch, chainID...)
+}
+
+// GetWithdrawalWaitgroupQueryKey gets the key of the withdrawal waitgroup epoch
+// a given interchain query was emitted in.
+func GetWithdrawalWaitgroupQueryKey(queryID string) []byte {
+	return append(KeyPrefixWithdrawalWaitgroupQuery, queryID...)
+}
+
+// GetRedemptionRateHistoryPrefix gets the redemption rate history key prefix for
+// a given chain. The chain id is terminated so that it is not a prefix of
+// another chain id.
+func GetRedemptionRateHistoryPrefix(chainID string) []byte {
+	return append(append(KeyPrefixRedemptionRateHistory, chainID...), '/')
+}
+
+// GetRedemptionRateSampleKey gets the redemption rate sample key for a given
+// chain and height.
+func GetRedemptionRateSampleKey(chainID string, height int64) []byte {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(height)) //nolint:gosec
+	return append(GetRedemptionRateHistoryPrefix(chainID), heightBytes...)
 }
 
 // GetZoneValidatorsKey gets the validators key prefix for a given chain.
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLiquidityBuffer returns a disabled LiquidityBuffer with zero values.
+func NewLiquidityBuffer() *LiquidityBuffer {
+	return &LiquidityBuffer{
+		Fee:               sdk.ZeroDec(),
+		Target:            sdkmath.ZeroInt(),
+		EpochCap:          sdkmath.ZeroInt(),
+		Balance:           sdkmath.ZeroInt(),
+		RedeemedThisEpoch: sdkmath.ZeroInt(),
+	}
+}
+
+// Shortfall returns the amount required to top the buffer up to its target.
+func (b *LiquidityBuffer) Shortfall() sdkmath.Int {
+	if b == nil || b.Target.IsNil() || b.Balance.IsNil() || b.Balance.GTE(b.Target) {
+		return sdkmath.ZeroInt()
+	}
+	return b.Target.Sub(b.Balance)
+}
+
+// Available returns the amount of native tokens that may currently be paid
+// out by instant redemptions; the lesser of the buffer balance and the
+// remaining epoch cap.
+func (b *LiquidityBuffer) Available() sdkmath.Int {
+	if b == nil || !b.Enabled {
+		return sdkmath.ZeroInt()
+	}
+	remaining := b.EpochCap.Sub(b.RedeemedThisEpoch)
+	if remaining.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return sdkmath.MinInt(b.Balance, remaining)
+}
+
+// ResetEpoch resets the instant redemptions paid out in the current epoch.
+func (b *LiquidityBuffer) ResetEpoch() {
+	if b != nil {
+		b.RedeemedThisEpoch = sdkmath.ZeroInt()
+	}
+}
+
+// InstantRedemptionEnabled returns true if the zone accepts instant redemptions.
+func (z *Zone) InstantRedemptionEnabled() bool {
+	return z.LiquidityBuffer != nil && z.LiquidityBuffer.Enabled
+}
+
+// InstantRedemptionRate returns the native tokens paid per qAsset by an
+// instant redemption; the lesser of the current and last redemption rates,
+// less the buffer fee.
+func (z *Zone) InstantRedemptionRate() sdk.Dec {
+	rate := sdk.MinDec(z.RedemptionRate, z.LastRedemptionRate)
+	if z.LiquidityBuffer == nil {
+		return rate
+	}
+	return rate.Mul(sdk.OneDec().Sub(z.LiquidityBuffer.Fee))
+}
+
+// UnbufferedDepositBalance returns the deposit account balance excluding
+// tokens held by the liquidity buffer.
+func (z *Zone) UnbufferedDepositBalance() sdk.Coins {
+	if z.DepositAddress == nil {
+		return sdk.Coins{}
+	}
+	if z.LiquidityBuffer == nil || !z.LiquidityBuffer.Balance.IsPositive() {
+		return z.DepositAddress.Balance
+	}
+	buffered := sdkmath.MinInt(z.DepositAddress.Balance.AmountOf(z.BaseDenom), z.LiquidityBuffer.Balance)
+	return z.DepositAddress.Balance.Sub(sdk.NewCoin(z.BaseDenom, buffered))
+}
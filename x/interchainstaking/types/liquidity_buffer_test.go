@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestLiquidityBufferShortfall(t *testing.T) {
+	var nilBuffer *types.LiquidityBuffer
+	require.True(t, nilBuffer.Shortfall().IsZero())
+
+	buffer := types.NewLiquidityBuffer()
+	require.True(t, buffer.Shortfall().IsZero())
+
+	buffer.Target = sdkmath.NewInt(1000)
+	buffer.Balance = sdkmath.NewInt(400)
+	require.Equal(t, sdkmath.NewInt(600), buffer.Shortfall())
+
+	buffer.Balance = sdkmath.NewInt(1200)
+	require.True(t, buffer.Shortfall().IsZero())
+}
+
+func TestLiquidityBufferAvailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		buffer   *types.LiquidityBuffer
+		expected sdkmath.Int
+	}{
+		{
+			name:     "nil",
+			buffer:   nil,
+			expected: sdkmath.ZeroInt(),
+		},
+		{
+			name:     "disabled",
+			buffer:   &types.LiquidityBuffer{Balance: sdkmath.NewInt(1000), EpochCap: sdkmath.NewInt(1000), RedeemedThisEpoch: sdkmath.ZeroInt()},
+			expected: sdkmath.ZeroInt(),
+		},
+		{
+			name:     "bounded by balance",
+			buffer:   &types.LiquidityBuffer{Enabled: true, Balance: sdkmath.NewInt(500), EpochCap: sdkmath.NewInt(1000), RedeemedThisEpoch: sdkmath.ZeroInt()},
+			expected: sdkmath.NewInt(500),
+		},
+		{
+			name:     "bounded by epoch cap",
+			buffer:   &types.LiquidityBuffer{Enabled: true, Balance: sdkmath.NewInt(1000), EpochCap: sdkmath.NewInt(1000), RedeemedThisEpoch: sdkmath.NewInt(700)},
+			expected: sdkmath.NewInt(300),
+		},
+		{
+			name:     "epoch cap lowered below redeemed",
+			buffer:   &types.LiquidityBuffer{Enabled: true, Balance: sdkmath.NewInt(1000), EpochCap: sdkmath.NewInt(500), RedeemedThisEpoch: sdkmath.NewInt(700)},
+			expected: sdkmath.ZeroInt(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.buffer.Available())
+		})
+	}
+}
+
+func TestZoneInstantRedemptionRate(t *testing.T) {
+	zone := types.Zone{RedemptionRate: sdk.MustNewDecFromStr("1.1"), LastRedemptionRate: sdk.MustNewDecFromStr("1.05")}
+	require.Equal(t, sdk.MustNewDecFromStr("1.05"), zone.InstantRedemptionRate())
+	require.False(t, zone.InstantRedemptionEnabled())
+
+	zone.LiquidityBuffer = types.NewLiquidityBuffer()
+	zone.LiquidityBuffer.Fee = sdk.NewDecWithPrec(2, 2)
+	require.Equal(t, sdk.MustNewDecFromStr("1.029"), zone.InstantRedemptionRate())
+	require.False(t, zone.InstantRedemptionEnabled())
+
+	zone.LiquidityBuffer.Enabled = true
+	require.True(t, zone.InstantRedemptionEnabled())
+}
+
+func TestZoneUnbufferedDepositBalance(t *testing.T) {
+	zone := types.Zone{BaseDenom: "uatom"}
+	require.True(t, zone.UnbufferedDepositBalance().Empty())
+
+	zone.DepositAddress = &types.ICAAccount{Balance: sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000)), sdk.NewCoin("ibc/ABC", sdkmath.NewInt(5)))}
+	require.Equal(t, zone.DepositAddress.Balance, zone.UnbufferedDepositBalance())
+
+	zone.LiquidityBuffer = types.NewLiquidityBuffer()
+	zone.LiquidityBuffer.Balance = sdkmath.NewInt(400)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(600)), sdk.NewCoin("ibc/ABC", sdkmath.NewInt(5))), zone.UnbufferedDepositBalance())
+
+	zone.LiquidityBuffer.Balance = sdkmath.NewInt(1000)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("ibc/ABC", sdkmath.NewInt(5))), zone.UnbufferedDepositBalance())
+}
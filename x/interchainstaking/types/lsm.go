@@ -0,0 +1,223 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lsmtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
+)
+
+const (
+	// LsmProviderGaia is the liquid staking module shipped by gaia (x/liquid).
+	LsmProviderGaia = "gaia"
+	// LsmProviderNone is used for zones without liquid staking module support.
+	LsmProviderNone = "none"
+)
+
+// LsmProvider abstracts the liquid staking module of a host chain, so that
+// zones running different LSM implementations (or none at all) can be
+// supported. A provider is selected per zone by Zone.LsmProvider.
+type LsmProvider interface {
+	// Name returns the identifier used to select the provider for a zone.
+	Name() string
+	// ParseShareDenom returns the operator address of the validator whose
+	// tokenized shares are represented by denom.
+	ParseShareDenom(denom string) (valoper string, ok bool)
+	// LiquidValidatorQuery returns the interchain query type and request for the
+	// liquid staking state of a validator; ok is false if unsupported.
+	LiquidValidatorQuery(valoper sdk.ValAddress) (queryType string, request []byte, ok bool)
+	// ParseLiquidValidator decodes the response to LiquidValidatorQuery.
+	ParseLiquidValidator(data []byte) (valoper string, liquidShares sdk.Dec, err error)
+	// ExceedsGlobalCap returns true if liquid staking amount more tokens would
+	// take the liquid staked share of the total staked supply above the global
+	// cap.
+	ExceedsGlobalCap(caps LsmCaps, liquidSupply sdk.Dec, totalSupply sdk.Dec, amount sdk.Dec) bool
+	// CheckValidatorCap returns an error if liquid staking amount more tokens
+	// with the validator would exceed the validator cap.
+	CheckValidatorCap(caps LsmCaps, validator Validator, amount sdk.Dec) error
+	// CheckValidatorBondCap returns an error if liquid staking amount more
+	// tokens with the validator would exceed the validator bond cap.
+	CheckValidatorBondCap(caps LsmCaps, validator Validator, amount sdk.Dec) error
+	// MaxLiquidAllocation returns the most tokens that may be liquid staked with
+	// the validator within the caps; ok is false if there is no limit.
+	MaxLiquidAllocation(caps LsmCaps, validator Validator) (max math.Int, ok bool)
+	// NewRedeemTokensMsg returns a message redeeming tokenized shares for a
+	// native delegation.
+	NewRedeemTokensMsg(delegator string, amount sdk.Coin) sdk.Msg
+	// RedeemTokensMsgType returns the type url of redeem messages.
+	RedeemTokensMsgType() string
+	// ParseRedeemTokensMsg returns the delegator and shares of a redeem message.
+	ParseRedeemTokensMsg(msg sdk.Msg) (delegator string, amount sdk.Coin, err error)
+	// ParseRedeemTokensResponse returns the amount delegated by a redeem message response.
+	ParseRedeemTokensResponse(bz []byte) (sdk.Coin, error)
+	// TokenizeSharesMsgType returns the type url of tokenize messages.
+	TokenizeSharesMsgType() string
+	// ParseTokenizeSharesMsg returns the delegator of a tokenize message.
+	ParseTokenizeSharesMsg(msg sdk.Msg) (delegator string, err error)
+	// ParseTokenizeSharesResponse returns the shares minted by a tokenize message response.
+	ParseTokenizeSharesResponse(bz []byte) (sdk.Coin, error)
+}
+
+// GaiaLsmProvider implements LsmProvider for the gaia x/liquid module.
+type GaiaLsmProvider struct{}
+
+var _ LsmProvider = GaiaLsmProvider{}
+
+func (GaiaLsmProvider) Name() string { return LsmProviderGaia }
+
+// ParseShareDenom parses share denoms of the form {valoper}/{record_id}.
+func (GaiaLsmProvider) ParseShareDenom(denom string) (string, bool) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 {
+		return "", false
+	}
+	if _, err := strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return "", false
+	}
+	return parts[0], true
+}
+
+func (GaiaLsmProvider) LiquidValidatorQuery(valoper sdk.ValAddress) (string, []byte, bool) {
+	return "store/liquid/key", lsmtypes.GetLiquidValidatorKey(valoper), true
+}
+
+func (GaiaLsmProvider) ParseLiquidValidator(data []byte) (string, sdk.Dec, error) {
+	info := lsmtypes.LiquidValidator{}
+	if err := proto.Unmarshal(data, &info); err != nil {
+		return "", sdk.Dec{}, err
+	}
+	return info.OperatorAddress, info.LiquidShares, nil
+}
+
+func (GaiaLsmProvider) ExceedsGlobalCap(caps LsmCaps, liquidSupply sdk.Dec, totalSupply sdk.Dec, amount sdk.Dec) bool {
+	return liquidSupply.Add(amount).Quo(totalSupply).GT(caps.GlobalCap)
+}
+
+func (GaiaLsmProvider) CheckValidatorCap(caps LsmCaps, validator Validator, amount sdk.Dec) error {
+	liquidShares := validator.LiquidShares.Add(amount)
+	tokens := sdk.NewDecFromInt(validator.VotingPower).Add(amount)
+	if liquidShares.Quo(tokens).GT(caps.ValidatorCap) {
+		return errors.New("exceeds validator cap")
+	}
+	return nil
+}
+
+// CheckValidatorBondCap bounds the liquid shares of a validator by a multiple
+// of its validator bond shares.
+func (GaiaLsmProvider) CheckValidatorBondCap(caps LsmCaps, validator Validator, amount sdk.Dec) error {
+	maxShares := validator.ValidatorBondShares.Mul(caps.ValidatorBondCap)
+	if validator.LiquidShares.Add(amount).GT(maxShares) {
+		return errors.New("exceeds validator bond cap")
+	}
+	return nil
+}
+
+// MaxLiquidAllocation returns the lesser of the headroom under the validator
+// cap and the validator bond cap.
+func (GaiaLsmProvider) MaxLiquidAllocation(caps LsmCaps, validator Validator) (math.Int, bool) {
+	maxLiquidStakedShares := sdk.NewDecFromInt(validator.VotingPower).Mul(caps.ValidatorCap).Sub(validator.LiquidShares)
+	maxBondShares := validator.ValidatorBondShares.Mul(caps.ValidatorBondCap).Sub(validator.LiquidShares)
+	return math.MaxInt(sdk.ZeroInt(), math.MinInt(maxBondShares.TruncateInt(), maxLiquidStakedShares.TruncateInt())), true
+}
+
+func (GaiaLsmProvider) NewRedeemTokensMsg(delegator string, amount sdk.Coin) sdk.Msg {
+	return &lsmtypes.MsgRedeemTokensForShares{DelegatorAddress: delegator, Amount: amount}
+}
+
+func (GaiaLsmProvider) RedeemTokensMsgType() string {
+	return sdk.MsgTypeURL(&lsmtypes.MsgRedeemTokensForShares{})
+}
+
+func (GaiaLsmProvider) ParseRedeemTokensMsg(msg sdk.Msg) (string, sdk.Coin, error) {
+	redeemMsg, ok := msg.(*lsmtypes.MsgRedeemTokensForShares)
+	if !ok {
+		return "", sdk.Coin{}, errors.New("unable to cast source message to MsgRedeemTokensForShares")
+	}
+	return redeemMsg.DelegatorAddress, redeemMsg.Amount, nil
+}
+
+func (GaiaLsmProvider) ParseRedeemTokensResponse(bz []byte) (sdk.Coin, error) {
+	response := lsmtypes.MsgRedeemTokensForSharesResponse{}
+	if err := proto.Unmarshal(bz, &response); err != nil {
+		return sdk.Coin{}, err
+	}
+	return response.Amount, nil
+}
+
+func (GaiaLsmProvider) TokenizeSharesMsgType() string {
+	return sdk.MsgTypeURL(&lsmtypes.MsgTokenizeShares{})
+}
+
+func (GaiaLsmProvider) ParseTokenizeSharesMsg(msg sdk.Msg) (string, error) {
+	tsMsg, ok := msg.(*lsmtypes.MsgTokenizeShares)
+	if !ok {
+		return "", errors.New("unable to cast source message to MsgTokenizeShares")
+	}
+	return tsMsg.DelegatorAddress, nil
+}
+
+func (GaiaLsmProvider) ParseTokenizeSharesResponse(bz []byte) (sdk.Coin, error) {
+	response := lsmtypes.MsgTokenizeSharesResponse{}
+	if err := proto.Unmarshal(bz, &response); err != nil {
+		return sdk.Coin{}, err
+	}
+	return response.Amount, nil
+}
+
+// NoLsmProvider implements LsmProvider for zones without a liquid staking
+// module; no denoms are recognised as tokenized shares.
+type NoLsmProvider struct{}
+
+var _ LsmProvider = NoLsmProvider{}
+
+func (NoLsmProvider) Name() string { return LsmProviderNone }
+
+func (NoLsmProvider) ParseShareDenom(string) (string, bool) { return "", false }
+
+func (NoLsmProvider) LiquidValidatorQuery(sdk.ValAddress) (string, []byte, bool) {
+	return "", nil, false
+}
+
+func (NoLsmProvider) ParseLiquidValidator([]byte) (string, sdk.Dec, error) {
+	return "", sdk.Dec{}, errors.New("liquid staking module not supported")
+}
+
+func (NoLsmProvider) ExceedsGlobalCap(LsmCaps, sdk.Dec, sdk.Dec, sdk.Dec) bool { return false }
+
+func (NoLsmProvider) CheckValidatorCap(LsmCaps, Validator, sdk.Dec) error { return nil }
+
+func (NoLsmProvider) CheckValidatorBondCap(LsmCaps, Validator, sdk.Dec) error { return nil }
+
+func (NoLsmProvider) MaxLiquidAllocation(LsmCaps, Validator) (math.Int, bool) {
+	return math.Int{}, false
+}
+
+func (NoLsmProvider) NewRedeemTokensMsg(string, sdk.Coin) sdk.Msg { return nil }
+
+func (NoLsmProvider) RedeemTokensMsgType() string { return "" }
+
+func (NoLsmProvider) ParseRedeemTokensMsg(msg sdk.Msg) (string, sdk.Coin, error) {
+	return "", sdk.Coin{}, fmt.Errorf("unexpected redeem message %s; liquid staking module not supported", sdk.MsgTypeURL(msg))
+}
+
+func (NoLsmProvider) ParseRedeemTokensResponse([]byte) (sdk.Coin, error) {
+	return sdk.Coin{}, errors.New("liquid staking module not supported")
+}
+
+func (NoLsmProvider) TokenizeSharesMsgType() string { return "" }
+
+func (NoLsmProvider) ParseTokenizeSharesMsg(msg sdk.Msg) (string, error) {
+	return "", fmt.Errorf("unexpected tokenize message %s; liquid staking module not supported", sdk.MsgTypeURL(msg))
+}
+
+func (NoLsmProvider) ParseTokenizeSharesResponse([]byte) (sdk.Coin, error) {
+	return sdk.Coin{}, errors.New("liquid staking module not supported")
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lsmtypes "github.com/quicksilver-zone/quicksilver/third-party-chains/gaia-types/liquid/types"
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestGaiaLsmProviderParseShareDenom(t *testing.T) {
+	valoper := "cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy"
+	tests := []struct {
+		name    string
+		denom   string
+		valoper string
+		ok      bool
+	}{
+		{name: "valid", denom: valoper + "/1", valoper: valoper, ok: true},
+		{name: "non-numeric record id", denom: valoper + "/one", ok: false},
+		{name: "ibc denom", denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", ok: false},
+		{name: "base denom", denom: "uatom", ok: false},
+		{name: "too many parts", denom: valoper + "/1/2", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, ok := types.GaiaLsmProvider{}.ParseShareDenom(tt.denom)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.valoper, out)
+		})
+	}
+}
+
+func TestGaiaLsmProviderRedeemTokensMsg(t *testing.T) {
+	provider := types.GaiaLsmProvider{}
+	amount := sdk.NewCoin("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1", sdk.NewInt(100))
+
+	msg := provider.NewRedeemTokensMsg("cosmos1delegator", amount)
+	require.Equal(t, provider.RedeemTokensMsgType(), sdk.MsgTypeURL(msg))
+
+	delegator, out, err := provider.ParseRedeemTokensMsg(msg)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1delegator", delegator)
+	require.Equal(t, amount, out)
+
+	_, _, err = provider.ParseRedeemTokensMsg(&lsmtypes.MsgTokenizeShares{})
+	require.Error(t, err)
+}
+
+func TestGaiaLsmProviderCaps(t *testing.T) {
+	provider := types.GaiaLsmProvider{}
+	caps := types.LsmCaps{ValidatorCap: sdk.NewDecWithPrec(50, 2), ValidatorBondCap: sdk.NewDec(100), GlobalCap: sdk.NewDecWithPrec(5, 2)}
+
+	require.False(t, provider.ExceedsGlobalCap(caps, sdk.NewDec(40), sdk.NewDec(1000), sdk.NewDec(10)))
+	require.True(t, provider.ExceedsGlobalCap(caps, sdk.NewDec(50), sdk.NewDec(1000), sdk.NewDec(1)))
+
+	validator := types.Validator{VotingPower: sdk.NewInt(1000), LiquidShares: sdk.NewDec(400), ValidatorBondShares: sdk.NewDec(5)}
+	require.NoError(t, provider.CheckValidatorCap(caps, validator, sdk.NewDec(100)))
+	require.Error(t, provider.CheckValidatorCap(caps, validator, sdk.NewDec(300)))
+	require.NoError(t, provider.CheckValidatorBondCap(caps, validator, sdk.NewDec(100)))
+	require.Error(t, provider.CheckValidatorBondCap(caps, validator, sdk.NewDec(101)))
+
+	// the lesser of 1000 * 0.5 - 400 and 5 * 100 - 400.
+	maxShares, ok := provider.MaxLiquidAllocation(caps, validator)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(100), maxShares)
+
+	// validators over their caps may not be allocated more.
+	validator.LiquidShares = sdk.NewDec(600)
+	maxShares, ok = provider.MaxLiquidAllocation(caps, validator)
+	require.True(t, ok)
+	require.Equal(t, sdk.ZeroInt(), maxShares)
+}
+
+func TestNoLsmProvider(t *testing.T) {
+	provider := types.NoLsmProvider{}
+	_, ok := provider.ParseShareDenom("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1")
+	require.False(t, ok)
+	_, _, ok = provider.LiquidValidatorQuery(sdk.ValAddress{})
+	require.False(t, ok)
+	caps := types.LsmCaps{ValidatorCap: sdk.ZeroDec(), ValidatorBondCap: sdk.ZeroDec(), GlobalCap: sdk.ZeroDec()}
+	validator := types.Validator{VotingPower: sdk.NewInt(1000), LiquidShares: sdk.NewDec(1000), ValidatorBondShares: sdk.ZeroDec()}
+	require.False(t, provider.ExceedsGlobalCap(caps, sdk.NewDec(1000), sdk.NewDec(1000), sdk.OneDec()))
+	require.NoError(t, provider.CheckValidatorCap(caps, validator, sdk.OneDec()))
+	require.NoError(t, provider.CheckValidatorBondCap(caps, validator, sdk.OneDec()))
+	_, ok = provider.MaxLiquidAllocation(caps, validator)
+	require.False(t, ok)
+	require.Nil(t, provider.NewRedeemTokensMsg("cosmos1delegator", sdk.NewCoin("uatom", sdk.NewInt(1))))
+}
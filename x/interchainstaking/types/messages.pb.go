@@ -110,6 +110,93 @@ func (m *MsgRequestRedemptionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestRedemptionResponse proto.InternalMessageInfo
 
+// MsgInstantRedemption represents a message type to burn qAssets for native
+// assets paid immediately from the zone liquidity buffer.
+type MsgInstantRedemption struct {
+	Value              types.Coin `protobuf:"bytes,1,opt,name=value,proto3" json:"value" yaml:"coin"`
+	DestinationAddress string     `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	FromAddress        string     `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+}
+
+func (m *MsgInstantRedemption) Reset()         { *m = MsgInstantRedemption{} }
+func (m *MsgInstantRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedemption) ProtoMessage()    {}
+func (*MsgInstantRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{2}
+}
+func (m *MsgInstantRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedemption.Merge(m, src)
+}
+func (m *MsgInstantRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedemption proto.InternalMessageInfo
+
+// MsgInstantRedemptionResponse defines the MsgInstantRedemption response type.
+type MsgInstantRedemptionResponse struct {
+	// amount is the amount of native assets sent to the destination address.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount" yaml:"coin"`
+}
+
+func (m *MsgInstantRedemptionResponse) Reset()         { *m = MsgInstantRedemptionResponse{} }
+func (m *MsgInstantRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInstantRedemptionResponse) ProtoMessage()    {}
+func (*MsgInstantRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee484030fa140a82, []int{3}
+}
+func (m *MsgInstantRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInstantRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInstantRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInstantRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInstantRedemptionResponse.Merge(m, src)
+}
+func (m *MsgInstantRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInstantRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInstantRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInstantRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgInstantRedemptionResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 // MsgCancelRedemption represents a message type to cancel .
 type MsgCancelRedemption struct {
 	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -121,7 +208,7 @@ func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
 func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRedemption) ProtoMessage()    {}
 func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{2}
+	return fileDescriptor_ee484030fa140a82, []int{4}
 }
 func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +246,7 @@ func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemption
 func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
 func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{3}
+	return fileDescriptor_ee484030fa140a82, []int{5}
 }
 func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +294,7 @@ func (m *MsgUpdateRedemption) Reset()         { *m = MsgUpdateRedemption{} }
 func (m *MsgUpdateRedemption) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRedemption) ProtoMessage()    {}
 func (*MsgUpdateRedemption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{4}
+	return fileDescriptor_ee484030fa140a82, []int{6}
 }
 func (m *MsgUpdateRedemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +331,7 @@ func (m *MsgUpdateRedemptionResponse) Reset()         { *m = MsgUpdateRedemption
 func (m *MsgUpdateRedemptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRedemptionResponse) ProtoMessage()    {}
 func (*MsgUpdateRedemptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{5}
+	return fileDescriptor_ee484030fa140a82, []int{7}
 }
 func (m *MsgUpdateRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +371,7 @@ func (m *MsgRequeueRedemption) Reset()         { *m = MsgRequeueRedemption{} }
 func (m *MsgRequeueRedemption) String() string { return proto.CompactTextString(m) }
 func (*MsgRequeueRedemption) ProtoMessage()    {}
 func (*MsgRequeueRedemption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{6}
+	return fileDescriptor_ee484030fa140a82, []int{8}
 }
 func (m *MsgRequeueRedemption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +408,7 @@ func (m *MsgRequeueRedemptionResponse) Reset()         { *m = MsgRequeueRedempti
 func (m *MsgRequeueRedemptionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequeueRedemptionResponse) ProtoMessage()    {}
 func (*MsgRequeueRedemptionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{7}
+	return fileDescriptor_ee484030fa140a82, []int{9}
 }
 func (m *MsgRequeueRedemptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +449,7 @@ func (m *MsgSignalIntent) Reset()         { *m = MsgSignalIntent{} }
 func (m *MsgSignalIntent) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalIntent) ProtoMessage()    {}
 func (*MsgSignalIntent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{8}
+	return fileDescriptor_ee484030fa140a82, []int{10}
 }
 func (m *MsgSignalIntent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -399,7 +486,7 @@ func (m *MsgSignalIntentResponse) Reset()         { *m = MsgSignalIntentResponse
 func (m *MsgSignalIntentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSignalIntentResponse) ProtoMessage()    {}
 func (*MsgSignalIntentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{9}
+	return fileDescriptor_ee484030fa140a82, []int{11}
 }
 func (m *MsgSignalIntentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +526,7 @@ func (m *MsgGovExecuteICATx) Reset()         { *m = MsgGovExecuteICATx{} }
 func (m *MsgGovExecuteICATx) String() string { return proto.CompactTextString(m) }
 func (*MsgGovExecuteICATx) ProtoMessage()    {}
 func (*MsgGovExecuteICATx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{10}
+	return fileDescriptor_ee484030fa140a82, []int{12}
 }
 func (m *MsgGovExecuteICATx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,7 +590,7 @@ func (m *MsgGovExecuteICATxResponse) Reset()         { *m = MsgGovExecuteICATxRe
 func (m *MsgGovExecuteICATxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovExecuteICATxResponse) ProtoMessage()    {}
 func (*MsgGovExecuteICATxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee484030fa140a82, []int{11}
+	return fileDescriptor_ee484030fa140a82, []int{13}
 }
 func (m *MsgGovExecuteICATxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,6 +622,8 @@ var xxx_messageInfo_MsgGovExecuteICATxResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgRequestRedemption)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemption")
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgRequestRedemptionResponse")
+	proto.RegisterType((*MsgInstantRedemption)(nil), "quicksilver.interchainstaking.v1.MsgInstantRedemption")
+	proto.RegisterType((*MsgInstantRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgInstantRedemptionResponse")
 	proto.RegisterType((*MsgCancelRedemption)(nil), "quicksilver.interchainstaking.v1.MsgCancelRedemption")
 	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "quicksilver.interchainstaking.v1.MsgCancelRedemptionResponse")
 	proto.RegisterType((*MsgUpdateRedemption)(nil), "quicksilver.interchainstaking.v1.MsgUpdateRedemption")
@@ -552,84 +641,87 @@ func init() {
 }
 
 var fileDescriptor_ee484030fa140a82 = []byte{
-	// 1228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xbd, 0x7d, 0xf9, 0xb5, 0x99, 0xf4, 0x47, 0xcb, 0x24, 0x28, 0xc9, 0x92, 0xda, 0x91,
-	0x4f, 0x11, 0xd0, 0x75, 0xe3, 0xd2, 0x94, 0x26, 0x6d, 0xc0, 0x76, 0x88, 0x31, 0x24, 0x80, 0x1c,
-	0xca, 0xa1, 0x1c, 0x56, 0x63, 0xef, 0x64, 0xbd, 0xea, 0x7a, 0xc6, 0xdd, 0x99, 0xdd, 0xc6, 0x1c,
-	0x7b, 0x42, 0x82, 0x03, 0x12, 0xff, 0x40, 0xcf, 0x9c, 0x2b, 0xae, 0x1c, 0xe0, 0x10, 0xc4, 0x81,
-	0x0a, 0x2e, 0x08, 0x89, 0x08, 0x25, 0x20, 0x71, 0x42, 0x22, 0x47, 0x4e, 0x68, 0x66, 0xd7, 0x9b,
-	0xf5, 0x4b, 0x94, 0xb1, 0x13, 0x89, 0x9b, 0x77, 0x66, 0xbe, 0xdf, 0x79, 0x3e, 0xf3, 0x3c, 0xfb,
-	0xcc, 0x26, 0x20, 0xf7, 0xd0, 0x77, 0xea, 0x0f, 0x98, 0xe3, 0x06, 0xd8, 0xcb, 0x39, 0x84, 0x63,
-	0xaf, 0xde, 0x40, 0x0e, 0x61, 0x1c, 0x3d, 0x70, 0x88, 0x9d, 0x0b, 0x16, 0x72, 0x4d, 0xcc, 0x18,
-	0xb2, 0x31, 0x33, 0x5a, 0x1e, 0xe5, 0x14, 0xce, 0x25, 0x04, 0x46, 0x9f, 0xc0, 0x08, 0x16, 0xf4,
-	0x74, 0x9d, 0xb2, 0x26, 0x65, 0xb9, 0x1a, 0x62, 0x38, 0x17, 0x2c, 0xd4, 0x30, 0x47, 0x0b, 0xb9,
-	0x3a, 0x75, 0x48, 0xe8, 0xa0, 0xcf, 0x84, 0xf3, 0xa6, 0x7c, 0xca, 0x85, 0x0f, 0xd1, 0xd4, 0xa4,
-	0x4d, 0x6d, 0x1a, 0x8e, 0x8b, 0x5f, 0xd1, 0xe8, 0xac, 0x4d, 0xa9, 0xed, 0xe2, 0x1c, 0x6a, 0x39,
-	0x39, 0x44, 0x08, 0xe5, 0x88, 0x3b, 0x94, 0x74, 0x34, 0x33, 0xd1, 0xac, 0x7c, 0xaa, 0xf9, 0x5b,
-	0x39, 0x44, 0xda, 0xd1, 0xd4, 0xf5, 0x63, 0xe1, 0x5a, 0x1e, 0x6d, 0x51, 0x86, 0xdc, 0xc8, 0x2c,
-	0xfb, 0x97, 0x06, 0x26, 0x37, 0x98, 0x5d, 0xc5, 0x0f, 0x7d, 0xcc, 0x78, 0x15, 0x5b, 0xb8, 0xd9,
-	0x12, 0x9b, 0xc1, 0x55, 0x70, 0x3e, 0x40, 0xae, 0x8f, 0xa7, 0xb5, 0x39, 0x6d, 0x7e, 0x3c, 0x3f,
-	0x63, 0x44, 0x71, 0x0b, 0x48, 0x23, 0x82, 0x34, 0x4a, 0xd4, 0x21, 0xc5, 0x89, 0x9d, 0xdd, 0x4c,
-	0xea, 0x60, 0x37, 0x33, 0xde, 0x46, 0x4d, 0x77, 0x29, 0x2b, 0xc0, 0xb3, 0xd5, 0x50, 0x0c, 0x2b,
-	0x60, 0xc2, 0xc2, 0x8c, 0x3b, 0x44, 0x12, 0x98, 0xc8, 0xb2, 0x3c, 0xcc, 0xd8, 0xf4, 0x99, 0x39,
-	0x6d, 0x7e, 0xac, 0x38, 0xfd, 0xe3, 0xd3, 0x6b, 0x93, 0x91, 0x6d, 0x21, 0x9c, 0xd9, 0xe4, 0x9e,
-	0x43, 0xec, 0x2a, 0x4c, 0x88, 0xa2, 0x19, 0xb8, 0x0c, 0x2e, 0x6d, 0x79, 0xb4, 0x19, 0x7b, 0x9c,
-	0x3d, 0xc6, 0x63, 0x5c, 0xac, 0x8e, 0x86, 0x96, 0x2e, 0x7e, 0xf2, 0x24, 0x93, 0xfa, 0xf3, 0x49,
-	0x26, 0x95, 0x4d, 0x83, 0xd9, 0x41, 0xbc, 0x55, 0xcc, 0x5a, 0x94, 0x30, 0x9c, 0xfd, 0x54, 0x03,
-	0x13, 0x1b, 0xcc, 0x2e, 0x21, 0x52, 0xc7, 0x6e, 0xe2, 0x3c, 0x66, 0xc0, 0x45, 0x79, 0x96, 0xa6,
-	0x63, 0xc9, 0x23, 0x19, 0xab, 0x5e, 0x90, 0xcf, 0x15, 0x0b, 0x42, 0x70, 0xae, 0x81, 0x58, 0x23,
-	0xa4, 0xaa, 0xca, 0xdf, 0xa7, 0x15, 0xad, 0x03, 0x5e, 0x1c, 0x10, 0x4c, 0x27, 0x58, 0xf8, 0x36,
-	0xb8, 0xe8, 0x61, 0xee, 0x7b, 0x04, 0x5b, 0x23, 0xe6, 0x29, 0xd6, 0x67, 0xbf, 0x0c, 0xc1, 0xef,
-	0xb5, 0x2c, 0xc4, 0xf1, 0xe8, 0xe0, 0x57, 0x01, 0x20, 0xf8, 0x91, 0xc9, 0x38, 0xe2, 0x7e, 0x88,
-	0x7d, 0xbe, 0x3a, 0x46, 0xf0, 0xa3, 0x4d, 0x39, 0xd0, 0x77, 0x2e, 0xe7, 0x46, 0x3b, 0x97, 0xab,
-	0xf2, 0x5c, 0x7a, 0x63, 0x8d, 0x93, 0xf8, 0x59, 0xa2, 0xaa, 0x7d, 0xfc, 0x9f, 0x67, 0x31, 0x51,
-	0x73, 0xfe, 0xa0, 0x70, 0xbf, 0xd2, 0xc0, 0xe5, 0x0d, 0x66, 0x6f, 0x3a, 0x36, 0x41, 0x6e, 0x85,
-	0x70, 0x4c, 0x38, 0x34, 0x7a, 0x23, 0x2d, 0x4e, 0x1c, 0xec, 0x66, 0x2e, 0x47, 0xb9, 0x8b, 0x66,
-	0xb2, 0x87, 0xe1, 0xbf, 0x02, 0x2e, 0x38, 0x52, 0xd9, 0x79, 0xbb, 0xe0, 0xc1, 0x6e, 0xe6, 0xb9,
-	0x70, 0x79, 0x34, 0x91, 0xad, 0x76, 0x96, 0x9c, 0x16, 0xd8, 0x0c, 0x98, 0xea, 0x89, 0x3b, 0x66,
-	0xfa, 0x45, 0x03, 0x70, 0x83, 0xd9, 0x65, 0x1a, 0xbc, 0xb9, 0x8d, 0xeb, 0x3e, 0xc7, 0x95, 0x52,
-	0xe1, 0x83, 0xed, 0xa1, 0xb1, 0xf2, 0xe0, 0x82, 0x6a, 0x8c, 0x9d, 0x85, 0x70, 0x1e, 0x9c, 0x6b,
-	0x32, 0x5b, 0x9c, 0xc3, 0xd9, 0xf9, 0xf1, 0xfc, 0xa4, 0x11, 0xf6, 0x4b, 0xa3, 0xd3, 0x2f, 0x8d,
-	0x02, 0x69, 0x57, 0xe5, 0x0a, 0xb8, 0x08, 0xc6, 0x90, 0xcf, 0x1b, 0xd4, 0x73, 0x78, 0xfb, 0xd8,
-	0x52, 0x3c, 0x5c, 0x9a, 0x9d, 0x05, 0x7a, 0x3f, 0x5b, 0x07, 0x3d, 0xff, 0xdd, 0x14, 0x38, 0xbb,
-	0xc1, 0x6c, 0xf8, 0x8d, 0x06, 0x9e, 0xef, 0x6f, 0xac, 0x8b, 0xc6, 0x71, 0x17, 0x8a, 0x31, 0xa8,
-	0x41, 0xe9, 0x2b, 0xa3, 0xe9, 0xe2, 0x84, 0x2c, 0x3e, 0xfe, 0xe9, 0xf7, 0x2f, 0xce, 0x5c, 0x5f,
-	0xd2, 0x5e, 0xca, 0xbe, 0xdc, 0x75, 0x09, 0xf2, 0x6d, 0x71, 0x31, 0xf4, 0xdf, 0x16, 0x1e, 0xb6,
-	0x30, 0x6e, 0xc2, 0xa7, 0x1a, 0xb8, 0xd4, 0x55, 0x99, 0x0b, 0x4a, 0x81, 0x24, 0x25, 0xfa, 0xed,
-	0xa1, 0x25, 0xa3, 0x87, 0x1d, 0x96, 0x38, 0xfc, 0x5e, 0x03, 0x57, 0xfa, 0x9a, 0xf8, 0x4d, 0xa5,
-	0x38, 0x7a, 0x65, 0xfa, 0xdd, 0x91, 0x64, 0x31, 0x42, 0x41, 0x22, 0x2c, 0x0b, 0x84, 0x45, 0x25,
-	0x84, 0xba, 0x74, 0x32, 0xbd, 0xc3, 0xc0, 0x7f, 0xe8, 0x94, 0x52, 0x57, 0x37, 0x1b, 0xa2, 0x94,
-	0x92, 0xba, 0x61, 0x4a, 0x69, 0x60, 0xbf, 0x2a, 0x4a, 0xa0, 0x3b, 0x02, 0xe8, 0x96, 0x62, 0x29,
-	0x49, 0xab, 0x24, 0x91, 0xc8, 0x4f, 0xdf, 0x5d, 0xa3, 0x96, 0x9f, 0x5e, 0x99, 0x62, 0x7e, 0x8e,
-	0xbc, 0x2d, 0x86, 0xce, 0x8f, 0x2f, 0x9d, 0x92, 0x34, 0xdf, 0x6a, 0xe0, 0x72, 0x99, 0x06, 0x25,
-	0x97, 0x32, 0x5c, 0x6a, 0x20, 0x42, 0xb0, 0x0b, 0x5f, 0x55, 0x8a, 0xaa, 0x47, 0xa5, 0xdf, 0x19,
-	0x45, 0x15, 0xa3, 0xdc, 0x95, 0x28, 0xb7, 0x04, 0x4a, 0x5e, 0xad, 0xd4, 0x84, 0x8b, 0x59, 0x8f,
-	0x42, 0xde, 0xd1, 0xc0, 0x95, 0x32, 0x0d, 0xaa, 0x98, 0xb6, 0x30, 0xe9, 0x70, 0xdc, 0x54, 0x8d,
-	0xa8, 0x4b, 0xa6, 0x98, 0x94, 0x5e, 0x59, 0x4c, 0xb2, 0x22, 0x49, 0x5e, 0x13, 0x24, 0x37, 0x14,
-	0x6b, 0x4c, 0xd8, 0xc4, 0x28, 0x5f, 0x6b, 0xe0, 0xff, 0x65, 0x1a, 0x6c, 0x62, 0xbe, 0xce, 0x9a,
-	0x25, 0xd4, 0x62, 0x30, 0xaf, 0x1a, 0xd0, 0xa1, 0x46, 0x5f, 0x1a, 0x5e, 0x73, 0x6a, 0x04, 0x7f,
-	0x68, 0x60, 0xaa, 0x4c, 0x83, 0x82, 0x65, 0x7d, 0x88, 0x5c, 0xc7, 0x42, 0x9c, 0x7a, 0xab, 0x98,
-	0xb4, 0xd7, 0x1d, 0xc6, 0xa1, 0x72, 0x95, 0x0c, 0x52, 0xeb, 0xab, 0x27, 0x51, 0xc7, 0x7c, 0x6b,
-	0x92, 0xef, 0x0d, 0xc1, 0xb7, 0xac, 0xc4, 0x87, 0x2c, 0xcb, 0x0c, 0x3a, 0x76, 0xa6, 0x85, 0x49,
-	0xdb, 0x15, 0x2c, 0x7f, 0x6b, 0x40, 0x97, 0x65, 0xd0, 0xa4, 0x01, 0xee, 0x47, 0x7d, 0x5d, 0xbd,
-	0x8e, 0x06, 0x1a, 0xe8, 0xe5, 0x13, 0x1a, 0xc4, 0xc0, 0x15, 0x09, 0x5c, 0x12, 0xc0, 0x2b, 0x8a,
-	0x09, 0x15, 0x86, 0x83, 0x98, 0xa3, 0x7e, 0xd1, 0xf5, 0x69, 0xa4, 0xdc, 0x2f, 0x92, 0x2a, 0xf5,
-	0x7e, 0x31, 0xe8, 0x53, 0x65, 0xf8, 0x7e, 0x81, 0x43, 0x17, 0xd3, 0xa9, 0x23, 0xbe, 0x0d, 0x7f,
-	0xd5, 0xc0, 0x0b, 0x61, 0xf1, 0xdf, 0xa7, 0x04, 0xbf, 0xb7, 0xb5, 0x55, 0xa3, 0xc8, 0xb3, 0x1c,
-	0x62, 0xc3, 0x61, 0x5e, 0x9c, 0x1e, 0xad, 0x5e, 0x1c, 0x5d, 0x1b, 0x83, 0xad, 0x4a, 0xb0, 0x15,
-	0x01, 0x76, 0x5b, 0x09, 0x8c, 0x61, 0x6e, 0x7e, 0x4c, 0x09, 0x36, 0x69, 0x82, 0xe2, 0xf1, 0x19,
-	0x90, 0x16, 0xad, 0x56, 0xde, 0xc7, 0x05, 0xd7, 0x7d, 0x1f, 0x93, 0x70, 0x97, 0x4e, 0xe3, 0x67,
-	0xb0, 0xa4, 0xdc, 0xaf, 0x8f, 0x36, 0xd1, 0xdf, 0x39, 0x05, 0x93, 0x18, 0xfd, 0x5d, 0x89, 0xfe,
-	0x96, 0x40, 0x2f, 0x0d, 0xf3, 0xb9, 0x81, 0x5c, 0xd7, 0x6c, 0x85, 0xb6, 0x89, 0xab, 0x8d, 0xc1,
-	0x7f, 0x34, 0x30, 0x5b, 0xa6, 0xc1, 0x1a, 0xf5, 0xea, 0xf8, 0x1e, 0xa9, 0x51, 0x62, 0x15, 0x5c,
-	0x77, 0x15, 0xbb, 0xd8, 0x0e, 0xff, 0x2d, 0x01, 0x0b, 0xaa, 0xd1, 0x1f, 0x69, 0xa1, 0x57, 0x4e,
-	0x6c, 0x11, 0xe3, 0xaf, 0x4b, 0xfc, 0x35, 0x81, 0x5f, 0x50, 0xc2, 0xdf, 0x12, 0x96, 0xa6, 0x2f,
-	0x3d, 0xe5, 0x21, 0x58, 0x87, 0xae, 0xc5, 0x8f, 0x76, 0xf6, 0xd2, 0xda, 0xb3, 0xbd, 0xb4, 0xf6,
-	0xdb, 0x5e, 0x5a, 0xfb, 0x7c, 0x3f, 0x9d, 0x7a, 0xb6, 0x9f, 0x4e, 0xfd, 0xbc, 0x9f, 0x4e, 0xdd,
-	0x2f, 0xd8, 0x0e, 0x6f, 0xf8, 0x35, 0xa3, 0x4e, 0x9b, 0xc9, 0x6d, 0xae, 0x89, 0x22, 0xea, 0xda,
-	0x77, 0x7b, 0xc0, 0x9e, 0xbc, 0xdd, 0xc2, 0xac, 0xf6, 0x3f, 0xf9, 0x27, 0xc9, 0x8d, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x8d, 0xa4, 0x2b, 0xff, 0x94, 0x12, 0x00, 0x00,
+	// 1269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x98, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xc7, 0xbd, 0xfd, 0x9f, 0x49, 0x7f, 0xbf, 0xb6, 0x93, 0xa0, 0xd6, 0xdb, 0xd4, 0xae, 0x7c,
+	0x8a, 0x80, 0xae, 0x1b, 0x97, 0xa6, 0x34, 0x6d, 0x0c, 0xb6, 0x43, 0x8c, 0xa1, 0x01, 0xe4, 0x50,
+	0x0e, 0xe5, 0xb0, 0x1a, 0x7b, 0xc7, 0xeb, 0x55, 0xd7, 0x33, 0xee, 0xce, 0xec, 0x36, 0xe6, 0xd8,
+	0x13, 0x12, 0x1c, 0x90, 0x38, 0x70, 0xed, 0x99, 0x73, 0xc5, 0x95, 0x03, 0x1c, 0x2a, 0x71, 0xa0,
+	0x82, 0x0b, 0x42, 0x22, 0x42, 0x09, 0x48, 0x9c, 0x90, 0xc8, 0x91, 0x13, 0x9a, 0xd9, 0x3f, 0xd9,
+	0xd8, 0xae, 0xb2, 0xde, 0x44, 0xe2, 0xc0, 0xcd, 0xbb, 0x33, 0xdf, 0xef, 0xbc, 0xcf, 0x9b, 0x99,
+	0xf7, 0x36, 0x01, 0xc5, 0x07, 0xae, 0xd5, 0xbe, 0xcf, 0x2c, 0xdb, 0xc3, 0x4e, 0xd1, 0x22, 0x1c,
+	0x3b, 0xed, 0x2e, 0xb2, 0x08, 0xe3, 0xe8, 0xbe, 0x45, 0xcc, 0xa2, 0xb7, 0x50, 0xec, 0x61, 0xc6,
+	0x90, 0x89, 0x99, 0xd6, 0x77, 0x28, 0xa7, 0xf0, 0x72, 0x4c, 0xa0, 0x8d, 0x08, 0x34, 0x6f, 0x41,
+	0xcd, 0xb5, 0x29, 0xeb, 0x51, 0x56, 0x6c, 0x21, 0x86, 0x8b, 0xde, 0x42, 0x0b, 0x73, 0xb4, 0x50,
+	0x6c, 0x53, 0x8b, 0xf8, 0x0e, 0x6a, 0xd6, 0x1f, 0xd7, 0xe5, 0x53, 0xd1, 0x7f, 0x08, 0x86, 0x66,
+	0x4d, 0x6a, 0x52, 0xff, 0xbd, 0xf8, 0x15, 0xbc, 0x9d, 0x33, 0x29, 0x35, 0x6d, 0x5c, 0x44, 0x7d,
+	0xab, 0x88, 0x08, 0xa1, 0x1c, 0x71, 0x8b, 0x92, 0x50, 0x93, 0x0d, 0x46, 0xe5, 0x53, 0xcb, 0xed,
+	0x14, 0x11, 0x19, 0x04, 0x43, 0x57, 0xf7, 0x85, 0xeb, 0x3b, 0xb4, 0x4f, 0x19, 0xb2, 0x03, 0xb3,
+	0xc2, 0x9f, 0x0a, 0x98, 0x5d, 0x63, 0x66, 0x13, 0x3f, 0x70, 0x31, 0xe3, 0x4d, 0x6c, 0xe0, 0x5e,
+	0x5f, 0x2c, 0x06, 0x57, 0xc0, 0x71, 0x0f, 0xd9, 0x2e, 0xbe, 0xa0, 0x5c, 0x56, 0xe6, 0xa7, 0x4b,
+	0x59, 0x2d, 0x88, 0x5b, 0x40, 0x6a, 0x01, 0xa4, 0x56, 0xa3, 0x16, 0xa9, 0xce, 0x3c, 0xdd, 0xcc,
+	0x67, 0x76, 0x36, 0xf3, 0xd3, 0x03, 0xd4, 0xb3, 0x97, 0x0a, 0x02, 0xbc, 0xd0, 0xf4, 0xc5, 0xb0,
+	0x01, 0x66, 0x0c, 0xcc, 0xb8, 0x45, 0x24, 0x81, 0x8e, 0x0c, 0xc3, 0xc1, 0x8c, 0x5d, 0x38, 0x72,
+	0x59, 0x99, 0x9f, 0xaa, 0x5e, 0xf8, 0xe1, 0xc9, 0x95, 0xd9, 0xc0, 0xb6, 0xe2, 0x8f, 0xac, 0x73,
+	0xc7, 0x22, 0x66, 0x13, 0xc6, 0x44, 0xc1, 0x08, 0xbc, 0x05, 0x4e, 0x77, 0x1c, 0xda, 0x8b, 0x3c,
+	0x8e, 0xee, 0xe3, 0x31, 0x2d, 0x66, 0x07, 0xaf, 0x96, 0x4e, 0x7d, 0xfc, 0x38, 0x9f, 0xf9, 0xe3,
+	0x71, 0x3e, 0x53, 0xc8, 0x81, 0xb9, 0x71, 0xbc, 0x4d, 0xcc, 0xfa, 0x94, 0x30, 0x1c, 0x26, 0xa4,
+	0x21, 0x92, 0x46, 0xfe, 0x0b, 0x09, 0xe9, 0x80, 0xb9, 0x71, 0xbc, 0x61, 0x42, 0xe0, 0x2a, 0x38,
+	0x81, 0x7a, 0xd4, 0x25, 0x3c, 0x25, 0x78, 0xa0, 0x2e, 0x7c, 0xa2, 0x80, 0x99, 0x35, 0x66, 0xd6,
+	0x10, 0x69, 0x63, 0x3b, 0x96, 0xd7, 0x2c, 0x38, 0x25, 0x0f, 0xa9, 0x6e, 0x19, 0x72, 0x85, 0xa9,
+	0xe6, 0x49, 0xf9, 0xdc, 0x30, 0x20, 0x04, 0xc7, 0xba, 0x88, 0x75, 0xfd, 0xec, 0x34, 0xe5, 0xef,
+	0xc3, 0xa2, 0xb6, 0xc0, 0xc5, 0x31, 0xc1, 0x44, 0xd0, 0x6f, 0x81, 0x53, 0x0e, 0xe6, 0xae, 0x43,
+	0xb0, 0x91, 0x12, 0x3b, 0xd2, 0x17, 0xbe, 0xf4, 0xc1, 0xef, 0xf6, 0x0d, 0xc4, 0x71, 0x7a, 0xf0,
+	0x4b, 0x00, 0x10, 0xfc, 0x50, 0x67, 0x1c, 0x71, 0xd7, 0xc7, 0x3e, 0xde, 0x9c, 0x22, 0xf8, 0xe1,
+	0xba, 0x7c, 0x31, 0x92, 0x97, 0x63, 0xe9, 0xf2, 0x72, 0x09, 0x5c, 0x1c, 0x13, 0x6b, 0x74, 0x3b,
+	0x3e, 0x8d, 0x95, 0x0b, 0x17, 0xff, 0xeb, 0xbb, 0x18, 0xbb, 0xcc, 0xee, 0xb8, 0x70, 0xbf, 0x52,
+	0xc0, 0x99, 0x35, 0x66, 0xae, 0x5b, 0x26, 0x41, 0x76, 0x83, 0x70, 0x4c, 0x38, 0xd4, 0x86, 0x23,
+	0xad, 0xce, 0xec, 0x6c, 0xe6, 0xcf, 0x04, 0x7b, 0x17, 0x8c, 0x14, 0x76, 0xc3, 0x7f, 0x19, 0x9c,
+	0xb4, 0xa4, 0x32, 0xbc, 0xa5, 0x70, 0x67, 0x33, 0xff, 0x7f, 0x7f, 0x7a, 0x30, 0x50, 0x68, 0x86,
+	0x53, 0x0e, 0x0b, 0x2c, 0x0b, 0xce, 0x0f, 0xc5, 0x1d, 0x31, 0xfd, 0xac, 0x00, 0xb8, 0xc6, 0xcc,
+	0x3a, 0xf5, 0xde, 0xd8, 0xc0, 0x6d, 0x97, 0xe3, 0x46, 0xad, 0xf2, 0xfe, 0xc6, 0xc4, 0x58, 0x25,
+	0x70, 0x32, 0x69, 0x8c, 0xe1, 0x44, 0x38, 0x0f, 0x8e, 0xf5, 0x98, 0x29, 0xf2, 0x70, 0x74, 0x7e,
+	0xba, 0x34, 0xab, 0xf9, 0x8d, 0x48, 0x0b, 0x1b, 0x91, 0x56, 0x21, 0x83, 0xa6, 0x9c, 0x01, 0x17,
+	0xc1, 0x14, 0x72, 0x79, 0x97, 0x3a, 0x16, 0x1f, 0xec, 0x7b, 0x14, 0x77, 0xa7, 0x16, 0xe6, 0x80,
+	0x3a, 0xca, 0x16, 0xa2, 0x97, 0xbe, 0xc8, 0x82, 0xa3, 0x6b, 0xcc, 0x84, 0xdf, 0x28, 0xe0, 0xdc,
+	0x68, 0xc7, 0x5a, 0xd4, 0xf6, 0xeb, 0xd4, 0xda, 0xb8, 0xca, 0xaf, 0x96, 0xd3, 0xe9, 0xa2, 0x0d,
+	0x59, 0x7c, 0xf4, 0xe3, 0x6f, 0x9f, 0x1f, 0xb9, 0xba, 0xa4, 0xbc, 0x58, 0x78, 0x69, 0xcf, 0xd7,
+	0x05, 0xdf, 0x10, 0x1d, 0x77, 0xb4, 0x0d, 0x3b, 0xd8, 0xc0, 0xb8, 0x07, 0xbf, 0x53, 0xc0, 0xb9,
+	0xd1, 0x36, 0x93, 0x8c, 0x62, 0x44, 0xa7, 0x96, 0xd3, 0xe9, 0x22, 0x8a, 0xb2, 0xa4, 0x78, 0x55,
+	0x50, 0x5c, 0x4b, 0x44, 0x61, 0xf9, 0x56, 0x7a, 0x40, 0xf3, 0x44, 0x01, 0xa7, 0xf7, 0xdc, 0xb3,
+	0x85, 0x44, 0x01, 0xc5, 0x25, 0xea, 0xcd, 0x89, 0x25, 0xe9, 0x37, 0xc1, 0xbf, 0xb0, 0x62, 0x13,
+	0xce, 0x8e, 0xb4, 0xa4, 0xeb, 0x89, 0xe2, 0x18, 0x96, 0xa9, 0xcb, 0xa9, 0x64, 0x11, 0x42, 0x45,
+	0x22, 0xdc, 0x12, 0x08, 0x8b, 0x89, 0x10, 0xda, 0xd2, 0x49, 0x77, 0x22, 0x2b, 0xf8, 0x7d, 0x78,
+	0x31, 0x5c, 0x3c, 0xf1, 0x91, 0x1a, 0xd1, 0xa9, 0xe5, 0x74, 0xba, 0x08, 0xa8, 0x2a, 0x81, 0x6e,
+	0x0b, 0xa0, 0x1b, 0x09, 0x2f, 0x86, 0xb4, 0x8a, 0x13, 0x89, 0xfd, 0x19, 0xe9, 0x9c, 0xc9, 0xf6,
+	0x67, 0x58, 0xa6, 0x2e, 0xa7, 0x92, 0xa5, 0xdf, 0x1f, 0x57, 0x3a, 0xc5, 0x69, 0xbe, 0x55, 0xc0,
+	0x99, 0x3a, 0xf5, 0x6a, 0x36, 0x65, 0xb8, 0xd6, 0x45, 0x84, 0x60, 0x1b, 0xbe, 0x92, 0x28, 0xaa,
+	0x21, 0x95, 0x7a, 0x3b, 0x8d, 0x2a, 0x42, 0x59, 0x96, 0x28, 0x37, 0x04, 0x4a, 0x29, 0xd9, 0x51,
+	0x13, 0x2e, 0x7a, 0x3b, 0x08, 0xf9, 0xa9, 0x02, 0xce, 0xd6, 0xa9, 0xd7, 0xc4, 0xb4, 0x8f, 0x49,
+	0xc8, 0x71, 0x3d, 0x69, 0x44, 0x7b, 0x64, 0xea, 0x72, 0x2a, 0x59, 0xfa, 0xb2, 0xe5, 0x48, 0x9b,
+	0x08, 0xe5, 0x6b, 0x05, 0xfc, 0xaf, 0x4e, 0xbd, 0x75, 0xcc, 0xef, 0xb0, 0x5e, 0x0d, 0xf5, 0x19,
+	0x2c, 0x25, 0x0d, 0x68, 0x57, 0xa3, 0x2e, 0x4d, 0xae, 0x39, 0x34, 0x82, 0xdf, 0x15, 0x70, 0xbe,
+	0x4e, 0xbd, 0x8a, 0x61, 0x7c, 0x80, 0x6c, 0xcb, 0x40, 0x9c, 0x3a, 0x2b, 0x98, 0x0c, 0xee, 0x58,
+	0x8c, 0xc3, 0xc4, 0xa7, 0x64, 0x9c, 0x5a, 0x5d, 0x39, 0x88, 0x3a, 0xe2, 0x5b, 0x95, 0x7c, 0xaf,
+	0x0b, 0xbe, 0x5b, 0x89, 0xf8, 0x90, 0x61, 0xe8, 0x5e, 0x68, 0xa7, 0x1b, 0x98, 0x0c, 0x6c, 0xc1,
+	0xf2, 0x97, 0x02, 0x54, 0x79, 0x0c, 0x7a, 0xd4, 0xc3, 0xa3, 0xa8, 0xaf, 0x25, 0x3f, 0x47, 0x63,
+	0x0d, 0xd4, 0xfa, 0x01, 0x0d, 0x22, 0xe0, 0x86, 0x04, 0xae, 0x09, 0xe0, 0x72, 0xc2, 0x0d, 0x15,
+	0x86, 0xe3, 0x98, 0x83, 0x7a, 0xb1, 0xe7, 0x43, 0x2f, 0x71, 0xbd, 0x88, 0xab, 0xd4, 0xdb, 0x69,
+	0x54, 0xe9, 0xeb, 0x05, 0xf6, 0x5d, 0x74, 0xab, 0x8d, 0xf8, 0x06, 0xfc, 0x45, 0x01, 0x2f, 0xf8,
+	0x87, 0xff, 0x1e, 0x25, 0xf8, 0xdd, 0x4e, 0xa7, 0x45, 0x91, 0x63, 0x58, 0xc4, 0x84, 0x93, 0x5c,
+	0x9c, 0x21, 0xad, 0x5a, 0x4d, 0xaf, 0x8d, 0xc0, 0x56, 0x24, 0x58, 0x59, 0x80, 0xdd, 0x4c, 0x04,
+	0xc6, 0x30, 0xd7, 0x3f, 0xa2, 0x04, 0xeb, 0x34, 0x46, 0xf1, 0xe8, 0x08, 0xc8, 0x89, 0x52, 0x2b,
+	0xfb, 0x71, 0xc5, 0xb6, 0xdf, 0xc3, 0xc4, 0x5f, 0x25, 0x2c, 0xfc, 0x0c, 0xd6, 0x12, 0xd7, 0xeb,
+	0xe7, 0x9b, 0xa8, 0x6f, 0x1f, 0x82, 0x49, 0x84, 0xfe, 0x8e, 0x44, 0x7f, 0x53, 0xa0, 0xd7, 0x26,
+	0xf9, 0xdc, 0x40, 0xb6, 0xad, 0xf7, 0x7d, 0xdb, 0x58, 0x6b, 0x63, 0xf0, 0x6f, 0x05, 0xcc, 0xd5,
+	0xa9, 0xb7, 0x4a, 0x9d, 0x36, 0xbe, 0x4b, 0x5a, 0x94, 0x18, 0x15, 0xdb, 0x5e, 0xc1, 0x36, 0x36,
+	0xfd, 0xff, 0x5e, 0xc1, 0x4a, 0xd2, 0xe8, 0x9f, 0x6b, 0xa1, 0x36, 0x0e, 0x6c, 0x11, 0xe1, 0xdf,
+	0x91, 0xf8, 0xab, 0x02, 0xbf, 0x92, 0x08, 0xbf, 0x23, 0x2c, 0x75, 0x57, 0x7a, 0xca, 0x24, 0x18,
+	0xbb, 0xae, 0xd5, 0x0f, 0x9f, 0x6e, 0xe5, 0x94, 0x67, 0x5b, 0x39, 0xe5, 0xd7, 0xad, 0x9c, 0xf2,
+	0xd9, 0x76, 0x2e, 0xf3, 0x6c, 0x3b, 0x97, 0xf9, 0x69, 0x3b, 0x97, 0xb9, 0x57, 0x31, 0x2d, 0xde,
+	0x75, 0x5b, 0x5a, 0x9b, 0xf6, 0xe2, 0xcb, 0x5c, 0x11, 0x87, 0x68, 0xcf, 0xba, 0x1b, 0x63, 0xd6,
+	0xe4, 0x83, 0x3e, 0x66, 0xad, 0x13, 0xf2, 0x0f, 0xac, 0x6b, 0xff, 0x0c, 0x00, 0x34, 0x17, 0x21,
+	0xf9, 0xbb, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -647,6 +739,9 @@ type MsgClient interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
 	// native assets.
 	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	// InstantRedemption defines a method for redeeming qAssets immediately
+	// against the zone liquidity buffer.
+	InstantRedemption(ctx context.Context, in *MsgInstantRedemption, opts ...grpc.CallOption) (*MsgInstantRedemptionResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(ctx context.Context, in *MsgSignalIntent, opts ...grpc.CallOption) (*MsgSignalIntentResponse, error)
@@ -690,6 +785,15 @@ func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedempt
 	return out, nil
 }
 
+func (c *msgClient) InstantRedemption(ctx context.Context, in *MsgInstantRedemption, opts ...grpc.CallOption) (*MsgInstantRedemptionResponse, error) {
+	out := new(MsgInstantRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/InstantRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SignalIntent(ctx context.Context, in *MsgSignalIntent, opts ...grpc.CallOption) (*MsgSignalIntentResponse, error) {
 	out := new(MsgSignalIntentResponse)
 	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Msg/SignalIntent", in, out, opts...)
@@ -812,6 +916,9 @@ type MsgServer interface {
 	// RequestRedemption defines a method for requesting burning of qAssets for
 	// native assets.
 	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	// InstantRedemption defines a method for redeeming qAssets immediately
+	// against the zone liquidity buffer.
+	InstantRedemption(context.Context, *MsgInstantRedemption) (*MsgInstantRedemptionResponse, error)
 	// SignalIntent defines a method for signalling voting intent for one or more
 	// validators.
 	SignalIntent(context.Context, *MsgSignalIntent) (*MsgSignalIntentResponse, error)
@@ -845,6 +952,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
 }
+func (*UnimplementedMsgServer) InstantRedemption(ctx context.Context, req *MsgInstantRedemption) (*MsgInstantRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantRedemption not implemented")
+}
 func (*UnimplementedMsgServer) SignalIntent(ctx context.Context, req *MsgSignalIntent) (*MsgSignalIntentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalIntent not implemented")
 }
@@ -907,6 +1017,24 @@ func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InstantRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInstantRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InstantRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Msg/InstantRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InstantRedemption(ctx, req.(*MsgInstantRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SignalIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSignalIntent)
 	if err := dec(in); err != nil {
@@ -1150,6 +1278,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestRedemption",
 			Handler:    _Msg_RequestRedemption_Handler,
 		},
+		{
+			MethodName: "InstantRedemption",
+			Handler:    _Msg_InstantRedemption_Handler,
+		},
 		{
 			MethodName: "SignalIntent",
 			Handler:    _Msg_SignalIntent_Handler,
@@ -1277,6 +1409,86 @@ func (m *MsgRequestRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInstantRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInstantRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInstantRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInstantRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1680,6 +1892,36 @@ func (m *MsgRequestRedemptionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInstantRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInstantRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
+
 func (m *MsgCancelRedemption) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2044,6 +2286,236 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInstantRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInstantRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInstantRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInstantRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,7 @@ import (
 // interchainstaking message types.
 const (
 	TypeMsgRequestRedemption              = "requestredemption"
+	TypeMsgInstantRedemption              = "instantredemption"
 	TypeMsgCancelRedemption               = "cancelredemption"
 	TypeMsgRequeueRedemption              = "requeueredemption"
 	TypeMsgUpdateRedemption               = "updateredemption"
@@ -39,6 +40,7 @@ const (
 
 var (
 	_ sdk.Msg = &MsgRequestRedemption{}
+	_ sdk.Msg = &MsgInstantRedemption{}
 	_ sdk.Msg = &MsgCancelRedemption{}
 	_ sdk.Msg = &MsgRequeueRedemption{}
 	_ sdk.Msg = &MsgSignalIntent{}
@@ -53,6 +55,7 @@ var (
 	_ sdk.Msg = &MsgGovForceUnbondAllDelegations{}
 
 	_ legacytx.LegacyMsg = &MsgRequestRedemption{}
+	_ legacytx.LegacyMsg = &MsgInstantRedemption{}
 	_ legacytx.LegacyMsg = &MsgCancelRedemption{}
 	_ legacytx.LegacyMsg = &MsgRequeueRedemption{}
 	_ legacytx.LegacyMsg = &MsgUpdateRedemption{}
@@ -119,6 +122,29 @@ func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
 
 // ----------------------------------------------------------------
 
+// NewMsgInstantRedemption - construct a msg to redeem instantly against the liquidity buffer.
+func NewMsgInstantRedemption(value sdk.Coin, destinationAddress string, fromAddress sdk.Address) *MsgInstantRedemption {
+	return &MsgInstantRedemption{Value: value, DestinationAddress: destinationAddress, FromAddress: fromAddress.String()}
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgInstantRedemption) ValidateBasic() error {
+	return MsgRequestRedemption(msg).ValidateBasic()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgInstantRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgInstantRedemption) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ----------------------------------------------------------------
+
 var hexpr = regexp.MustCompile("^[A-Fa-f0-9]{64}$")
 
 // NewMsgCancelQueuedRedemption - construct a msg to cancel a requested redemption.
@@ -678,6 +704,16 @@ func (msg MsgRequestRedemption) Type() string {
 	return TypeMsgRequestRedemption
 }
 
+// MsgInstantRedemption
+
+func (msg MsgInstantRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg MsgInstantRedemption) Type() string {
+	return TypeMsgInstantRedemption
+}
+
 // MsgCancelRedemption
 
 func (msg MsgCancelRedemption) Route() string {
@@ -1157,3 +1157,18 @@ func TestMsgGovExecuteICATx(t *testing.T) {
 
 	}
 }
+
+func TestMsgInstantRedemption(t *testing.T) {
+	fromAddr := addressutils.GenerateAccAddressForTest()
+	msg := types.NewMsgInstantRedemption(sdk.NewCoin("uqatom", sdkmath.NewInt(500)), addressutils.GenerateAddressForTestWithPrefix("cosmos"), fromAddr)
+	require.NoError(t, msg.ValidateBasic())
+
+	// Check the signBytes.
+	signBytes := msg.GetSignBytes()
+	require.True(t, len(signBytes) != 0, "expecting signBytes to be produced")
+
+	// Signers should return the from address.
+	gotSigners := msg.GetSigners()
+	wantSigners := []sdk.AccAddress{fromAddr}
+	require.Equal(t, wantSigners, gotSigners, "mismatch in signers")
+}
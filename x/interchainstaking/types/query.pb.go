@@ -11,23 +11,28 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1837,1682 +1842,1788 @@ func (m *QueryClaimedPercentageResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryClaimedPercentageResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Statistics)(nil), "quicksilver.interchainstaking.v1.Statistics")
-	proto.RegisterType((*QueryZonesRequest)(nil), "quicksilver.interchainstaking.v1.QueryZonesRequest")
-	proto.RegisterType((*QueryZonesResponse)(nil), "quicksilver.interchainstaking.v1.QueryZonesResponse")
-	proto.RegisterType((*QueryZoneRequest)(nil), "quicksilver.interchainstaking.v1.QueryZoneRequest")
-	proto.RegisterType((*QueryZoneResponse)(nil), "quicksilver.interchainstaking.v1.QueryZoneResponse")
-	proto.RegisterType((*QueryZoneValidatorsRequest)(nil), "quicksilver.interchainstaking.v1.QueryZoneValidatorsRequest")
-	proto.RegisterType((*QueryZoneValidatorsResponse)(nil), "quicksilver.interchainstaking.v1.QueryZoneValidatorsResponse")
-	proto.RegisterType((*QueryDepositAccountForChainRequest)(nil), "quicksilver.interchainstaking.v1.QueryDepositAccountForChainRequest")
-	proto.RegisterType((*QueryDepositAccountForChainResponse)(nil), "quicksilver.interchainstaking.v1.QueryDepositAccountForChainResponse")
-	proto.RegisterType((*QueryDelegatorIntentRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentRequest")
-	proto.RegisterType((*QueryDelegatorIntentResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentResponse")
-	proto.RegisterType((*QueryDelegatorIntentsRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentsRequest")
-	proto.RegisterType((*DelegatorIntentsResponse)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsResponse")
-	proto.RegisterType((*QueryDelegatorIntentsResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentsResponse")
-	proto.RegisterType((*QueryDelegationsRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegationsRequest")
-	proto.RegisterType((*QueryDelegationsResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegationsResponse")
-	proto.RegisterType((*QueryReceiptsRequest)(nil), "quicksilver.interchainstaking.v1.QueryReceiptsRequest")
-	proto.RegisterType((*QueryReceiptsResponse)(nil), "quicksilver.interchainstaking.v1.QueryReceiptsResponse")
-	proto.RegisterType((*QueryTxStatusRequest)(nil), "quicksilver.interchainstaking.v1.QueryTxStatusRequest")
-	proto.RegisterType((*QueryTxStatusResponse)(nil), "quicksilver.interchainstaking.v1.QueryTxStatusResponse")
-	proto.RegisterType((*QueryWithdrawalRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryWithdrawalRecordsRequest")
-	proto.RegisterType((*QueryWithdrawalRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryWithdrawalRecordsResponse")
-	proto.RegisterType((*QueryUserWithdrawalRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryUserWithdrawalRecordsRequest")
-	proto.RegisterType((*QueryUnbondingRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryUnbondingRecordsRequest")
-	proto.RegisterType((*QueryUnbondingRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryUnbondingRecordsResponse")
-	proto.RegisterType((*QueryRedelegationRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryRedelegationRecordsRequest")
-	proto.RegisterType((*QueryRedelegationRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryRedelegationRecordsResponse")
-	proto.RegisterType((*QueryMappedAccountsRequest)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsRequest")
-	proto.RegisterType((*QueryMappedAccountsResponse)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsResponse.RemoteAddressMapEntry")
-	proto.RegisterType((*QueryInverseMappedAccountsRequest)(nil), "quicksilver.interchainstaking.v1.QueryInverseMappedAccountsRequest")
-	proto.RegisterType((*QueryInverseMappedAccountsResponse)(nil), "quicksilver.interchainstaking.v1.QueryInverseMappedAccountsResponse")
-	proto.RegisterType((*QueryDenyListRequest)(nil), "quicksilver.interchainstaking.v1.QueryDenyListRequest")
-	proto.RegisterType((*QueryDenyListResponse)(nil), "quicksilver.interchainstaking.v1.QueryDenyListResponse")
-	proto.RegisterType((*QueryClaimedPercentageRequest)(nil), "quicksilver.interchainstaking.v1.QueryClaimedPercentageRequest")
-	proto.RegisterType((*QueryClaimedPercentageResponse)(nil), "quicksilver.interchainstaking.v1.QueryClaimedPercentageResponse")
+type QueryRebalancePlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/interchainstaking/v1/query.proto", fileDescriptor_c8e4d79429548821)
+func (m *QueryRebalancePlanRequest) Reset()         { *m = QueryRebalancePlanRequest{} }
+func (m *QueryRebalancePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanRequest) ProtoMessage()    {}
+func (*QueryRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{35}
 }
-
-var fileDescriptor_c8e4d79429548821 = []byte{
-	// 2168 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6c, 0x1b, 0x59,
-	0x19, 0xef, 0xcb, 0xff, 0x7c, 0x69, 0xd2, 0xe4, 0xb5, 0xa1, 0xae, 0x29, 0x4e, 0x3a, 0x2b, 0x6d,
-	0xbb, 0x6c, 0xeb, 0x21, 0xe9, 0x0a, 0xba, 0xd9, 0x66, 0xdb, 0x38, 0x4e, 0x8a, 0x77, 0x5b, 0x68,
-	0xa7, 0x29, 0xd5, 0xb6, 0x8b, 0xcc, 0xc4, 0x7e, 0x72, 0x46, 0xb1, 0x67, 0xdc, 0x79, 0xe3, 0x6c,
-	0x43, 0x14, 0xa0, 0x7b, 0xe1, 0x86, 0x40, 0x20, 0xd0, 0x72, 0xe5, 0xb2, 0x42, 0xe2, 0xb6, 0x17,
-	0x6e, 0x70, 0x40, 0x5a, 0x21, 0x90, 0x56, 0x0b, 0x07, 0xd8, 0x43, 0x80, 0x76, 0x39, 0x20, 0xc1,
-	0x61, 0x7b, 0x01, 0x71, 0x42, 0xf3, 0xe6, 0x7b, 0xe3, 0xb1, 0x3d, 0xb6, 0xc7, 0x13, 0xa3, 0xed,
-	0xa9, 0x9e, 0xf7, 0xde, 0xf7, 0x7b, 0xdf, 0xef, 0xf7, 0xbe, 0xf7, 0xe7, 0xfb, 0x52, 0x38, 0xff,
-	0xa0, 0x66, 0x14, 0xb6, 0xb9, 0x51, 0xde, 0x61, 0xb6, 0x6a, 0x98, 0x0e, 0xb3, 0x0b, 0x5b, 0xba,
-	0x61, 0x72, 0x47, 0xdf, 0x36, 0xcc, 0x92, 0xba, 0xb3, 0xa0, 0x3e, 0xa8, 0x31, 0x7b, 0x37, 0x5d,
-	0xb5, 0x2d, 0xc7, 0xa2, 0xf3, 0x81, 0xd1, 0xe9, 0x96, 0xd1, 0xe9, 0x9d, 0x85, 0xe4, 0xe7, 0x0b,
-	0x16, 0xaf, 0x58, 0x5c, 0xdd, 0xd4, 0x39, 0xf3, 0x4c, 0xd5, 0x9d, 0x85, 0x4d, 0xe6, 0xe8, 0x0b,
-	0x6a, 0x55, 0x2f, 0x19, 0xa6, 0xee, 0x18, 0x96, 0xe9, 0xa1, 0x25, 0x53, 0xc1, 0xb1, 0x72, 0x54,
-	0xc1, 0x32, 0x64, 0xff, 0x29, 0xaf, 0x3f, 0x2f, 0xbe, 0x54, 0xef, 0x03, 0xbb, 0x4e, 0x94, 0xac,
-	0x92, 0xe5, 0xb5, 0xbb, 0xbf, 0xb0, 0xf5, 0x74, 0xc9, 0xb2, 0x4a, 0x65, 0xa6, 0xea, 0x55, 0x43,
-	0xd5, 0x4d, 0xd3, 0x72, 0xc4, 0x6c, 0xd2, 0xe6, 0x0b, 0x41, 0xaa, 0x85, 0xb2, 0x6e, 0x54, 0x78,
-	0x45, 0x37, 0xf5, 0x12, 0xb3, 0x5d, 0x9a, 0x0d, 0x0d, 0x68, 0x71, 0xa9, 0xab, 0x38, 0xad, 0x1a,
-	0x08, 0x4b, 0xe5, 0xbf, 0x43, 0x00, 0xb7, 0xdd, 0xe9, 0xb9, 0x63, 0x14, 0x38, 0x3d, 0x05, 0x63,
-	0x62, 0x50, 0xde, 0x28, 0x26, 0xc8, 0x3c, 0x39, 0x37, 0xae, 0x8d, 0x8a, 0xef, 0x5c, 0x91, 0xe6,
-	0x60, 0xbc, 0xc8, 0xaa, 0x16, 0x37, 0x1c, 0x56, 0x4c, 0x0c, 0xb8, 0x7d, 0x99, 0x17, 0xdf, 0x3f,
-	0x98, 0x3b, 0xf2, 0xd1, 0xc1, 0xdc, 0xac, 0x47, 0x99, 0x17, 0xb7, 0xd3, 0x86, 0xa5, 0x56, 0x74,
-	0x67, 0x2b, 0x9d, 0x33, 0x9d, 0x0f, 0xdf, 0xbb, 0x00, 0xa8, 0x45, 0xce, 0x74, 0xb4, 0xba, 0x35,
-	0x4d, 0xc2, 0x18, 0x7e, 0xf0, 0xc4, 0xe0, 0x3c, 0x39, 0x37, 0xa8, 0xf9, 0xdf, 0x34, 0x05, 0x80,
-	0xbf, 0x2d, 0x9b, 0x27, 0x86, 0x44, 0x6f, 0xa0, 0xc5, 0x73, 0xa3, 0xcc, 0x4a, 0xba, 0xeb, 0xc6,
-	0x70, 0x2c, 0x37, 0xd0, 0x9a, 0xae, 0xc2, 0x08, 0xaf, 0x55, 0xab, 0xe5, 0xdd, 0xc4, 0x48, 0xef,
-	0x38, 0x68, 0x4a, 0xcf, 0x03, 0x2d, 0x1a, 0xdc, 0xd1, 0xcd, 0x02, 0xcb, 0x3b, 0x56, 0xde, 0xd1,
-	0xed, 0x12, 0x73, 0x12, 0xa3, 0x42, 0xbb, 0x69, 0xd9, 0xb3, 0x61, 0x6d, 0x88, 0x76, 0xfa, 0x1a,
-	0x4c, 0xd7, 0xcc, 0x4d, 0xcb, 0x2c, 0x1a, 0x66, 0x29, 0xaf, 0x57, 0xac, 0x9a, 0xe9, 0x24, 0xc6,
-	0xe6, 0xc9, 0xb9, 0x89, 0xc5, 0x53, 0x69, 0x04, 0x77, 0x83, 0x2c, 0x8d, 0x41, 0x96, 0x5e, 0xb5,
-	0x0c, 0x33, 0x33, 0xe4, 0xfa, 0xa5, 0x1d, 0xf3, 0x0d, 0x57, 0x84, 0x1d, 0xcd, 0xc2, 0xe4, 0x83,
-	0x1a, 0xab, 0xb1, 0xa2, 0x04, 0x1a, 0x8f, 0x06, 0x74, 0xd4, 0xb3, 0x42, 0x94, 0xb3, 0x50, 0x07,
-	0xce, 0x17, 0x04, 0x0e, 0xcc, 0x93, 0x73, 0x93, 0xda, 0x94, 0xdf, 0xbc, 0x2a, 0x06, 0x9e, 0x01,
-	0x34, 0xc4, 0x51, 0x13, 0x62, 0xd4, 0x84, 0xd7, 0xe6, 0x0d, 0x49, 0xc3, 0x71, 0xcf, 0x28, 0x6f,
-	0xb3, 0x82, 0x65, 0xcb, 0x91, 0x47, 0xc5, 0xc8, 0x19, 0xaf, 0x4b, 0x13, 0x3d, 0x62, 0xbc, 0x72,
-	0x1f, 0x66, 0x6e, 0xb9, 0x3b, 0xef, 0x9e, 0x65, 0x32, 0xae, 0xb1, 0x07, 0x35, 0xc6, 0x1d, 0xba,
-	0x0e, 0x50, 0xdf, 0x80, 0x22, 0x08, 0x27, 0x16, 0x9f, 0x6f, 0xe0, 0xe4, 0x6d, 0x74, 0xc9, 0xec,
-	0xa6, 0x5e, 0x62, 0x68, 0xab, 0x05, 0x2c, 0x95, 0xbf, 0x13, 0xa0, 0x41, 0x74, 0x5e, 0xb5, 0x4c,
-	0xce, 0x68, 0x06, 0x86, 0xbf, 0xe9, 0x36, 0x24, 0xc8, 0xfc, 0xa0, 0x40, 0xee, 0x76, 0x52, 0xa4,
-	0x5d, 0x7b, 0x94, 0xce, 0x33, 0x75, 0x31, 0xb8, 0xa3, 0x3b, 0x3c, 0x31, 0x20, 0x30, 0xce, 0x77,
-	0xc7, 0xa8, 0x6f, 0x31, 0xcd, 0x33, 0xa5, 0xd7, 0x1a, 0x68, 0x0e, 0x0a, 0x9a, 0x67, 0xbb, 0xd2,
-	0xf4, 0x48, 0x34, 0xf0, 0xcc, 0xc0, 0xb4, 0x4f, 0x53, 0x6a, 0x98, 0x6e, 0xde, 0xc6, 0x99, 0xe3,
-	0x4f, 0x0f, 0xe6, 0x8e, 0xed, 0xea, 0x95, 0xf2, 0x92, 0x22, 0x7b, 0x14, 0x7f, 0x6f, 0x2b, 0xef,
-	0x90, 0xc0, 0x4a, 0xf8, 0x52, 0x5d, 0x85, 0x21, 0x97, 0xaf, 0xbf, 0x06, 0xbd, 0x28, 0x25, 0x2c,
-	0x83, 0x42, 0x91, 0x98, 0x42, 0x29, 0x3f, 0x21, 0x90, 0xf4, 0x7d, 0xfb, 0x9a, 0x5e, 0x36, 0x8a,
-	0xba, 0x7b, 0x10, 0x48, 0xaa, 0x1d, 0x4e, 0xac, 0xcf, 0xc0, 0x88, 0x0b, 0x51, 0xf3, 0xa6, 0x1f,
-	0xd7, 0xf0, 0xab, 0x29, 0xc2, 0x06, 0x63, 0x47, 0xd8, 0x2f, 0x09, 0x7c, 0x36, 0xd4, 0x33, 0xd4,
-	0xef, 0x16, 0xc0, 0x8e, 0xdf, 0x8a, 0xf1, 0xf6, 0x62, 0x77, 0x09, 0x7c, 0x24, 0x94, 0x32, 0x00,
-	0xd2, 0x14, 0x35, 0x03, 0xf1, 0xa3, 0x66, 0x03, 0x14, 0xe1, 0x7a, 0xd6, 0x3b, 0x59, 0x57, 0x0a,
-	0x62, 0xab, 0xae, 0x5b, 0xf6, 0xaa, 0xeb, 0x4d, 0xdc, 0x38, 0x7a, 0x44, 0xe0, 0xb9, 0x8e, 0xb0,
-	0xa8, 0xcc, 0x3d, 0x38, 0x89, 0x47, 0x7a, 0x5e, 0xf7, 0x86, 0xe4, 0xf5, 0x62, 0xd1, 0x66, 0x9c,
-	0xe3, 0x34, 0xca, 0xd3, 0x83, 0xb9, 0x94, 0x37, 0x4d, 0x9b, 0x81, 0x8a, 0x36, 0x5b, 0x6c, 0x98,
-	0x64, 0x05, 0xdb, 0x7f, 0x24, 0x57, 0x25, 0xeb, 0x1d, 0xf4, 0x96, 0x9d, 0x33, 0x1d, 0x66, 0x3a,
-	0x31, 0x39, 0xd1, 0x35, 0x98, 0x29, 0x4a, 0x24, 0xdf, 0x4b, 0xef, 0xfe, 0x4b, 0x7c, 0xf8, 0xde,
-	0x85, 0x13, 0x28, 0x3e, 0x4e, 0x7f, 0xdb, 0xb1, 0x0d, 0xb3, 0xa4, 0x4d, 0xfb, 0x26, 0xd2, 0x2d,
-	0x03, 0x4e, 0x87, 0x7b, 0x85, 0x92, 0xe4, 0x60, 0xc4, 0x10, 0x2d, 0xb8, 0xdd, 0x16, 0xba, 0x07,
-	0x4a, 0x33, 0x14, 0x02, 0x28, 0x2c, 0x7c, 0x2a, 0x7f, 0xcb, 0x84, 0x32, 0x22, 0x3d, 0x33, 0xfa,
-	0x0e, 0x81, 0x44, 0xeb, 0x14, 0x48, 0xa7, 0xe3, 0x43, 0x42, 0x32, 0x1d, 0x38, 0x2c, 0xd3, 0x1a,
-	0x7c, 0xae, 0x0d, 0x53, 0x74, 0x63, 0x03, 0x46, 0xbd, 0xa1, 0x72, 0xff, 0x2d, 0xf5, 0x3c, 0x99,
-	0x0f, 0xa6, 0x49, 0x28, 0xe5, 0x07, 0x04, 0x4e, 0x06, 0xe7, 0x75, 0xdf, 0x6e, 0x71, 0xc3, 0x6b,
-	0x3d, 0x64, 0x47, 0xc7, 0x39, 0x8c, 0xfe, 0x43, 0x20, 0xd1, 0xea, 0x93, 0x2f, 0xc3, 0x44, 0xb1,
-	0xde, 0x8c, 0x52, 0x9c, 0x8f, 0x2c, 0x85, 0x61, 0xc9, 0xb7, 0x43, 0x10, 0x86, 0x2e, 0xc3, 0xa0,
-	0xb3, 0x53, 0x8e, 0xf3, 0x16, 0x74, 0xed, 0xfa, 0x77, 0x03, 0x7e, 0x8f, 0xc0, 0x09, 0x41, 0x5d,
-	0x63, 0x05, 0x66, 0x54, 0x9d, 0x4f, 0x7d, 0x2d, 0x7e, 0x41, 0x60, 0xb6, 0xc9, 0x21, 0x5c, 0x88,
-	0xd7, 0x61, 0xcc, 0xc6, 0x36, 0x5c, 0x85, 0x17, 0xba, 0xaf, 0x02, 0xa2, 0xe0, 0x12, 0xf8, 0x00,
-	0xfd, 0xbb, 0x0c, 0xf2, 0xa8, 0xdf, 0xc6, 0xc3, 0xdb, 0xe2, 0x86, 0x8c, 0xab, 0xdf, 0x49, 0x18,
-	0x75, 0x1e, 0xe6, 0xb7, 0x74, 0xbe, 0x25, 0x6f, 0x5c, 0xe7, 0xe1, 0x97, 0x75, 0xbe, 0xa5, 0xbc,
-	0x89, 0x7a, 0xd4, 0x27, 0x40, 0x3d, 0x56, 0x61, 0x14, 0xe9, 0xe0, 0xb1, 0x17, 0x5d, 0x0e, 0x4d,
-	0x5a, 0x2a, 0x07, 0x04, 0x8f, 0x81, 0xbb, 0x86, 0xb3, 0x55, 0xb4, 0xf5, 0xb7, 0xf4, 0xb2, 0xf7,
-	0xca, 0xe4, 0x9f, 0xee, 0x99, 0xdf, 0xb7, 0x87, 0xc6, 0x6f, 0x08, 0xa4, 0xda, 0x11, 0xf4, 0x6f,
-	0xd4, 0x89, 0xb7, 0xfc, 0x4e, 0x19, 0x5b, 0x8b, 0xdd, 0xc5, 0x6c, 0x46, 0x94, 0xfb, 0x3c, 0x00,
-	0xd6, 0xbf, 0x38, 0x7b, 0x97, 0xc0, 0x19, 0xc1, 0xe3, 0x0e, 0x67, 0x76, 0xdb, 0xc5, 0x7a, 0x05,
-	0x8e, 0xd6, 0x38, 0x6b, 0xb9, 0x99, 0x9e, 0x1e, 0xcc, 0x85, 0xeb, 0x3e, 0xe1, 0x8e, 0x0e, 0x97,
-	0x3c, 0xfe, 0x16, 0xfe, 0x31, 0xc1, 0x4b, 0xf4, 0x8e, 0xcc, 0x82, 0x0e, 0x19, 0x52, 0xfd, 0x72,
-	0xec, 0xd7, 0x32, 0xd8, 0x5b, 0x1d, 0xc3, 0x50, 0xb8, 0x0b, 0xe0, 0xa7, 0x6e, 0x32, 0x12, 0x22,
-	0xdc, 0xb1, 0x4d, 0x78, 0xf2, 0xf1, 0x59, 0x87, 0xea, 0x5f, 0x1c, 0xbc, 0x43, 0x60, 0x0e, 0xcf,
-	0xc7, 0xfa, 0x7d, 0xf2, 0x8c, 0xe8, 0xfb, 0x7b, 0x02, 0xf3, 0xed, 0x7d, 0x43, 0x89, 0xbf, 0x01,
-	0x93, 0x36, 0x6b, 0xbd, 0x51, 0x5f, 0x8a, 0x72, 0x78, 0x35, 0xa3, 0xa2, 0xd0, 0x8d, 0x80, 0xfd,
-	0xd3, 0xfa, 0x26, 0x66, 0x4f, 0x37, 0xf4, 0x6a, 0x95, 0x15, 0xf1, 0xad, 0xec, 0xab, 0xbc, 0x08,
-	0xa3, 0x51, 0x1f, 0x80, 0x72, 0xa0, 0xf2, 0x4f, 0xf9, 0xc0, 0x6e, 0x86, 0x44, 0x71, 0xbe, 0x4b,
-	0x80, 0xda, 0xac, 0x62, 0x39, 0x4c, 0x6e, 0xe1, 0x7c, 0x45, 0xaf, 0xa2, 0x44, 0xb7, 0xbb, 0x4b,
-	0xd4, 0x01, 0x3b, 0xad, 0x09, 0x5c, 0x74, 0xec, 0x86, 0x5e, 0x5d, 0x33, 0x1d, 0x7b, 0x17, 0x15,
-	0x9c, 0xb6, 0x9b, 0x3a, 0x93, 0xab, 0x30, 0x1b, 0x6a, 0x40, 0xa7, 0x61, 0x70, 0x9b, 0xed, 0xe2,
-	0xc3, 0xd4, 0xfd, 0x49, 0x4f, 0xc0, 0xf0, 0x8e, 0x5e, 0xae, 0x31, 0xbc, 0xb8, 0xbc, 0x8f, 0xa5,
-	0x81, 0x4b, 0x44, 0xf9, 0x36, 0x9e, 0x59, 0x39, 0x73, 0x87, 0xd9, 0x9c, 0x85, 0xeb, 0x78, 0x05,
-	0xa6, 0x1a, 0x29, 0x77, 0x95, 0x73, 0xb2, 0xc1, 0xdb, 0x86, 0xf7, 0xf2, 0x40, 0xc3, 0x7b, 0x59,
-	0xc9, 0x61, 0xaa, 0xd6, 0xc6, 0x01, 0x54, 0xfd, 0x39, 0x98, 0x2c, 0x5b, 0x05, 0xbd, 0xdc, 0xe8,
-	0x80, 0x76, 0x54, 0x34, 0xca, 0x27, 0xfb, 0x3a, 0x5e, 0xf4, 0x59, 0x66, 0xee, 0x5e, 0x37, 0x78,
-	0xdc, 0x9c, 0x48, 0xf9, 0xa9, 0x7c, 0xe0, 0xd4, 0x81, 0xd0, 0x8d, 0x4b, 0x2d, 0x39, 0x6f, 0x27,
-	0x11, 0xfe, 0x2f, 0xa9, 0xed, 0xdb, 0xf2, 0x84, 0x5c, 0x2d, 0xeb, 0x46, 0x85, 0x15, 0x6f, 0x32,
-	0xbb, 0xc0, 0x4c, 0xa7, 0xbe, 0xdf, 0x3b, 0x25, 0x27, 0xeb, 0x00, 0xa2, 0xc0, 0x9a, 0x77, 0x76,
-	0xab, 0x5e, 0x30, 0x4c, 0x2d, 0x9e, 0x6d, 0x88, 0xd9, 0xc6, 0xfa, 0xeb, 0xce, 0x42, 0x5a, 0x4c,
-	0xb3, 0xb1, 0x5b, 0x65, 0xda, 0x78, 0x41, 0xfe, 0x54, 0xbe, 0x85, 0x37, 0x76, 0x88, 0x0f, 0xa8,
-	0xd4, 0x9b, 0x00, 0x55, 0xbf, 0x15, 0x55, 0xbf, 0x8c, 0x8f, 0xe8, 0xe7, 0x4b, 0x86, 0xb3, 0x55,
-	0xdb, 0x4c, 0x17, 0xac, 0x0a, 0x96, 0x93, 0xf1, 0x9f, 0x0b, 0xbc, 0xb8, 0xad, 0xba, 0xae, 0xf1,
-	0x74, 0x96, 0x15, 0x02, 0xaf, 0xea, 0x2c, 0x2b, 0x68, 0x01, 0xbc, 0xc5, 0x77, 0xcf, 0xc0, 0xb0,
-	0x70, 0x80, 0xfe, 0x8c, 0xc0, 0xb0, 0x28, 0x81, 0xd1, 0x8b, 0x11, 0xf7, 0x5e, 0xb0, 0x1c, 0x97,
-	0x7c, 0xa9, 0x37, 0x23, 0x8f, 0x9c, 0xa2, 0xbe, 0xfd, 0x87, 0x8f, 0x7f, 0x38, 0xf0, 0x02, 0x3d,
-	0xab, 0x76, 0xad, 0x4c, 0x7b, 0x25, 0xb5, 0x9f, 0x13, 0x18, 0x72, 0x21, 0xe8, 0x62, 0x0f, 0xf3,
-	0x49, 0x1f, 0x2f, 0xf6, 0x64, 0x83, 0x2e, 0xbe, 0x2c, 0x5c, 0xbc, 0x48, 0x17, 0xa2, 0xb9, 0xa8,
-	0xee, 0xc9, 0x90, 0xd9, 0xa7, 0x7f, 0x24, 0x30, 0xd5, 0x58, 0xf3, 0xa1, 0x97, 0x7b, 0x70, 0xa1,
-	0xa5, 0x88, 0x95, 0x5c, 0x8e, 0x69, 0x8d, 0x54, 0xd6, 0x04, 0x95, 0x2b, 0x74, 0x39, 0xa2, 0xda,
-	0x01, 0x2e, 0x6a, 0x60, 0x07, 0xfe, 0x83, 0xc0, 0x54, 0x63, 0xe1, 0x86, 0x66, 0x23, 0x3a, 0xd6,
-	0xb1, 0x8c, 0x94, 0x5c, 0x3b, 0x24, 0x0a, 0xd2, 0x7c, 0x4d, 0xd0, 0xcc, 0xd2, 0x4c, 0x0c, 0x9a,
-	0x7e, 0x15, 0x09, 0xcf, 0xdb, 0x4f, 0x08, 0x1c, 0x6b, 0x4a, 0xf4, 0xe9, 0x72, 0x64, 0x37, 0xc3,
-	0x0a, 0x4b, 0xc9, 0x57, 0xe3, 0x9a, 0x23, 0xbd, 0xbc, 0xa0, 0xf7, 0x06, 0xbd, 0x1b, 0x8b, 0x9e,
-	0xcc, 0x56, 0xbc, 0x1a, 0x85, 0xba, 0xd7, 0x92, 0xbf, 0xec, 0xd3, 0x8f, 0x09, 0x4c, 0x37, 0x17,
-	0x37, 0x68, 0x4c, 0xaf, 0xfd, 0xd0, 0xbd, 0x12, 0xdb, 0x1e, 0x69, 0x7f, 0x55, 0xd0, 0xce, 0xd1,
-	0x6b, 0xdd, 0x69, 0x37, 0xb3, 0xe4, 0xa1, 0x34, 0x7f, 0x47, 0x60, 0x22, 0x50, 0x04, 0xa1, 0x2f,
-	0xf7, 0xe6, 0x61, 0xa0, 0x98, 0x93, 0x5c, 0x8a, 0x63, 0x8a, 0xbc, 0xd6, 0x05, 0xaf, 0xab, 0xf4,
-	0xd5, 0xf8, 0xcb, 0x29, 0xdc, 0xff, 0x15, 0x81, 0x31, 0x59, 0x47, 0xa0, 0x5f, 0x8c, 0xe8, 0x50,
-	0x53, 0x25, 0x24, 0xf9, 0xa5, 0x9e, 0xed, 0x90, 0xc5, 0xaa, 0x60, 0xb1, 0x4c, 0x5f, 0x89, 0xc1,
-	0xc2, 0x2f, 0x54, 0xfc, 0x96, 0xc0, 0x98, 0x4c, 0xfd, 0x23, 0x53, 0x68, 0x2a, 0x46, 0x44, 0xa6,
-	0xd0, 0x5c, 0x63, 0x50, 0x6e, 0x08, 0x0a, 0xd7, 0xe8, 0x5a, 0xfc, 0x63, 0x83, 0xab, 0x7b, 0x58,
-	0xd8, 0xd8, 0x77, 0x4f, 0xc9, 0x59, 0xf7, 0x1c, 0x6e, 0xc9, 0x5f, 0x69, 0xd4, 0xad, 0xd0, 0x2e,
-	0xf3, 0x4d, 0x5e, 0x8d, 0x0f, 0xd0, 0x07, 0xae, 0xf5, 0x94, 0x1f, 0xff, 0x7c, 0xc7, 0xe9, 0xa3,
-	0x01, 0x38, 0xe5, 0xe6, 0xea, 0xcf, 0x2c, 0x5f, 0x5d, 0xf0, 0xbd, 0x4f, 0xdf, 0xe8, 0x0b, 0xdf,
-	0xd0, 0xe3, 0xe4, 0x23, 0x02, 0x33, 0xcf, 0x24, 0xf7, 0x8c, 0xe0, 0x7e, 0x99, 0x2e, 0x45, 0xe5,
-	0x1e, 0xb2, 0xc0, 0x9f, 0x10, 0x98, 0x0d, 0x2d, 0xc6, 0xd0, 0xd5, 0x88, 0xfe, 0x75, 0x2a, 0xe5,
-	0xf4, 0x81, 0xe4, 0x2d, 0x41, 0xf2, 0x75, 0x9a, 0xeb, 0x4e, 0xb2, 0xc6, 0x99, 0xcd, 0xd5, 0xbd,
-	0x60, 0xed, 0x28, 0x34, 0xa8, 0xff, 0x46, 0x60, 0xba, 0xb9, 0x78, 0x12, 0xf9, 0x1a, 0x6c, 0x53,
-	0x0e, 0x8a, 0x7c, 0x0d, 0xb6, 0xab, 0xda, 0x28, 0xd7, 0x05, 0xd1, 0x75, 0x9a, 0x8d, 0x11, 0xc9,
-	0xf5, 0x3f, 0xe0, 0x4b, 0x8e, 0xff, 0x22, 0x70, 0x3c, 0xa4, 0x80, 0x41, 0x57, 0x22, 0xdf, 0x03,
-	0xed, 0x0a, 0x33, 0xc9, 0xcc, 0x61, 0x20, 0x7a, 0xbf, 0xf3, 0x43, 0x6e, 0x95, 0x3a, 0xae, 0xcf,
-	0xf7, 0xcf, 0x04, 0xa6, 0x1a, 0x13, 0xe3, 0xc8, 0x2f, 0xf2, 0xd0, 0x84, 0x3e, 0xf2, 0x8b, 0x3c,
-	0x3c, 0x1b, 0xef, 0xe5, 0xa9, 0x5a, 0x11, 0x08, 0x32, 0x62, 0x19, 0x57, 0x45, 0xc6, 0xae, 0xee,
-	0xf9, 0x07, 0xd0, 0xa3, 0x01, 0x98, 0x0d, 0xcd, 0xfd, 0x23, 0xef, 0xd1, 0x4e, 0xa5, 0x8b, 0x64,
-	0xf6, 0x70, 0x20, 0x48, 0xf8, 0xeb, 0x82, 0xf0, 0x5d, 0x7a, 0x27, 0x06, 0x61, 0xaf, 0x12, 0x12,
-	0x5c, 0xe3, 0xbd, 0xc6, 0x62, 0xca, 0x3e, 0xfd, 0x0b, 0x81, 0x99, 0x96, 0x54, 0x3a, 0xf2, 0x21,
-	0xdc, 0xae, 0x10, 0x10, 0xf9, 0x7c, 0x6a, 0x9b, 0xc5, 0x2b, 0xd7, 0x04, 0xef, 0x15, 0x7a, 0xa5,
-	0x3b, 0xef, 0x82, 0x07, 0x92, 0xaf, 0x67, 0xe9, 0xc1, 0x9c, 0xf2, 0xdf, 0x04, 0x4e, 0xb7, 0x4c,
-	0x93, 0xd9, 0xf5, 0x8b, 0x0b, 0xcf, 0x02, 0xd9, 0x0d, 0x41, 0xf6, 0x2b, 0xf4, 0xfa, 0x21, 0xc9,
-	0xaa, 0x7b, 0xf5, 0x1a, 0xcb, 0x7e, 0xe6, 0xfe, 0xfb, 0x8f, 0x53, 0xe4, 0x83, 0xc7, 0x29, 0xf2,
-	0xd7, 0xc7, 0x29, 0xf2, 0xfd, 0x27, 0xa9, 0x23, 0x1f, 0x3c, 0x49, 0x1d, 0xf9, 0xd3, 0x93, 0xd4,
-	0x91, 0x7b, 0x2b, 0x81, 0x32, 0x48, 0x60, 0xc6, 0x0b, 0x22, 0x1f, 0x0f, 0xba, 0xf0, 0x30, 0xc4,
-	0x09, 0x51, 0x25, 0xd9, 0x1c, 0x11, 0xff, 0xcd, 0xed, 0xe2, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xa7, 0x4c, 0x57, 0x2d, 0x3f, 0x28, 0x00, 0x00,
+func (m *QueryRebalancePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Zones provides meta data on connected zones.
-	Zones(ctx context.Context, in *QueryZonesRequest, opts ...grpc.CallOption) (*QueryZonesResponse, error)
-	// Zone provides meta data on a specific zone.
-	Zone(ctx context.Context, in *QueryZoneRequest, opts ...grpc.CallOption) (*QueryZoneResponse, error)
-	ZoneValidators(ctx context.Context, in *QueryZoneValidatorsRequest, opts ...grpc.CallOption) (*QueryZoneValidatorsResponse, error)
-	// DepositAccount provides data on the deposit address for a connected zone.
-	DepositAccount(ctx context.Context, in *QueryDepositAccountForChainRequest, opts ...grpc.CallOption) (*QueryDepositAccountForChainResponse, error)
-	// DelegatorIntent provides data on the intent of the delegator for the given
-	// zone.
-	DelegatorIntent(ctx context.Context, in *QueryDelegatorIntentRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentResponse, error)
-	// DelegatorIntents provides data on the intent of the delegator for all zones
-	DelegatorIntents(ctx context.Context, in *QueryDelegatorIntentsRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentsResponse, error)
-	// Delegations provides data on the delegations for the given zone.
-	Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error)
-	// Delegations provides data on the delegations for the given zone.
-	Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error)
-	TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	ZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	UserZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	WithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	UserWithdrawalRecords(ctx context.Context, in *QueryUserWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
-	// UnbondingRecords provides data on the active unbondings.
-	UnbondingRecords(ctx context.Context, in *QueryUnbondingRecordsRequest, opts ...grpc.CallOption) (*QueryUnbondingRecordsResponse, error)
-	// RedelegationRecords provides data on the active unbondings.
-	RedelegationRecords(ctx context.Context, in *QueryRedelegationRecordsRequest, opts ...grpc.CallOption) (*QueryRedelegationRecordsResponse, error)
-	// MappedAccounts provides data on the mapped accounts for a given user over
-	// different host chains.
-	MappedAccounts(ctx context.Context, in *QueryMappedAccountsRequest, opts ...grpc.CallOption) (*QueryMappedAccountsResponse, error)
-	InverseMappedAccounts(ctx context.Context, in *QueryInverseMappedAccountsRequest, opts ...grpc.CallOption) (*QueryInverseMappedAccountsResponse, error)
-	// CLaimedPercentage provides data on the claimed percentage for a given zone
-	ClaimedPercentage(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error)
-	// ClaimedPercentageByClaimType provides data on the claimed percentage of a
-	// given claim type in a given zone
-	ClaimedPercentageByClaimType(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error)
+func (m *QueryRebalancePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRebalancePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRebalancePlanRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Zones(ctx context.Context, in *QueryZonesRequest, opts ...grpc.CallOption) (*QueryZonesResponse, error) {
-	out := new(QueryZonesResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Zones", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRebalancePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Zone(ctx context.Context, in *QueryZoneRequest, opts ...grpc.CallOption) (*QueryZoneResponse, error) {
-	out := new(QueryZoneResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Zone", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlanRequest proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ZoneValidators(ctx context.Context, in *QueryZoneValidatorsRequest, opts ...grpc.CallOption) (*QueryZoneValidatorsResponse, error) {
-	out := new(QueryZoneValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ZoneValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// AllocationDelta is the difference between the target and current
+// delegations of a validator.
+type AllocationDelta struct {
+	ValoperAddress string                `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (c *queryClient) DepositAccount(ctx context.Context, in *QueryDepositAccountForChainRequest, opts ...grpc.CallOption) (*QueryDepositAccountForChainResponse, error) {
-	out := new(QueryDepositAccountForChainResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DepositAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AllocationDelta) Reset()         { *m = AllocationDelta{} }
+func (m *AllocationDelta) String() string { return proto.CompactTextString(m) }
+func (*AllocationDelta) ProtoMessage()    {}
+func (*AllocationDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{36}
+}
+func (m *AllocationDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllocationDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllocationDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *AllocationDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocationDelta.Merge(m, src)
+}
+func (m *AllocationDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllocationDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocationDelta.DiscardUnknown(m)
 }
 
-func (c *queryClient) DelegatorIntent(ctx context.Context, in *QueryDelegatorIntentRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentResponse, error) {
-	out := new(QueryDelegatorIntentResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DelegatorIntent", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllocationDelta proto.InternalMessageInfo
+
+func (m *AllocationDelta) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DelegatorIntents(ctx context.Context, in *QueryDelegatorIntentsRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentsResponse, error) {
-	out := new(QueryDelegatorIntentsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DelegatorIntents", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryRebalancePlanResponse struct {
+	// targets are validators delegated below their target allocation.
+	Targets AllocationDeltas `protobuf:"bytes,1,rep,name=targets,proto3,castrepeated=AllocationDeltas" json:"targets,omitempty"`
+	// sources are unlocked validators delegated above their target allocation.
+	Sources       AllocationDeltas            `protobuf:"bytes,2,rep,name=sources,proto3,castrepeated=AllocationDeltas" json:"sources,omitempty"`
+	Redelegations []types2.MsgBeginRedelegate `protobuf:"bytes,3,rep,name=redelegations,proto3" json:"redelegations"`
+	// locked_validators are validators with incoming redelegations, which may
+	// not be used as sources.
+	LockedValidators []string `protobuf:"bytes,4,rep,name=locked_validators,json=lockedValidators,proto3" json:"locked_validators,omitempty"`
+	// redelegation_records are the in-flight redelegations to locked validators.
+	RedelegationRecords []RedelegationRecord `protobuf:"bytes,5,rep,name=redelegation_records,json=redelegationRecords,proto3" json:"redelegation_records"`
+	DistanceToTarget    string               `protobuf:"bytes,6,opt,name=distance_to_target,json=distanceToTarget,proto3" json:"distance_to_target,omitempty"`
+	// planned_distance_to_target is the distance to target once the planned
+	// redelegations complete.
+	PlannedDistanceToTarget string `protobuf:"bytes,7,opt,name=planned_distance_to_target,json=plannedDistanceToTarget,proto3" json:"planned_distance_to_target,omitempty"`
+}
+
+func (m *QueryRebalancePlanResponse) Reset()         { *m = QueryRebalancePlanResponse{} }
+func (m *QueryRebalancePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanResponse) ProtoMessage()    {}
+func (*QueryRebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{37}
+}
+func (m *QueryRebalancePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRebalancePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanResponse.Merge(m, src)
+}
+func (m *QueryRebalancePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error) {
-	out := new(QueryDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Delegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRebalancePlanResponse proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanResponse) GetTargets() AllocationDeltas {
+	if m != nil {
+		return m.Targets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error) {
-	out := new(QueryReceiptsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Receipts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetSources() AllocationDeltas {
+	if m != nil {
+		return m.Sources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error) {
-	out := new(QueryTxStatusResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/TxStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetRedelegations() []types2.MsgBeginRedelegate {
+	if m != nil {
+		return m.Redelegations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
-	out := new(QueryWithdrawalRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ZoneWithdrawalRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetLockedValidators() []string {
+	if m != nil {
+		return m.LockedValidators
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UserZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
-	out := new(QueryWithdrawalRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UserZoneWithdrawalRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetRedelegationRecords() []RedelegationRecord {
+	if m != nil {
+		return m.RedelegationRecords
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) WithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
-	out := new(QueryWithdrawalRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/WithdrawalRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetDistanceToTarget() string {
+	if m != nil {
+		return m.DistanceToTarget
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UserWithdrawalRecords(ctx context.Context, in *QueryUserWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
-	out := new(QueryWithdrawalRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UserWithdrawalRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetPlannedDistanceToTarget() string {
+	if m != nil {
+		return m.PlannedDistanceToTarget
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UnbondingRecords(ctx context.Context, in *QueryUnbondingRecordsRequest, opts ...grpc.CallOption) (*QueryUnbondingRecordsResponse, error) {
-	out := new(QueryUnbondingRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UnbondingRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLiquidityBufferRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
 }
 
-func (c *queryClient) RedelegationRecords(ctx context.Context, in *QueryRedelegationRecordsRequest, opts ...grpc.CallOption) (*QueryRedelegationRecordsResponse, error) {
-	out := new(QueryRedelegationRecordsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/RedelegationRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityBufferRequest) Reset()         { *m = QueryLiquidityBufferRequest{} }
+func (m *QueryLiquidityBufferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityBufferRequest) ProtoMessage()    {}
+func (*QueryLiquidityBufferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{38}
+}
+func (m *QueryLiquidityBufferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityBufferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityBufferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityBufferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBufferRequest.Merge(m, src)
+}
+func (m *QueryLiquidityBufferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityBufferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBufferRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) MappedAccounts(ctx context.Context, in *QueryMappedAccountsRequest, opts ...grpc.CallOption) (*QueryMappedAccountsResponse, error) {
-	out := new(QueryMappedAccountsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/MappedAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityBufferRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityBufferRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InverseMappedAccounts(ctx context.Context, in *QueryInverseMappedAccountsRequest, opts ...grpc.CallOption) (*QueryInverseMappedAccountsResponse, error) {
-	out := new(QueryInverseMappedAccountsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/InverseMappedAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLiquidityBufferResponse struct {
+	Buffer LiquidityBuffer `protobuf:"bytes,1,opt,name=buffer,proto3" json:"buffer"`
+	// available is the amount of native assets that may currently be paid out
+	// by instant redemptions, bounded by the buffer balance and the remaining
+	// epoch cap.
+	Available types.Coin `protobuf:"bytes,2,opt,name=available,proto3" json:"available"`
+	// rate is the number of native assets paid per qAsset, net of fees.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
 }
 
-func (c *queryClient) ClaimedPercentage(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error) {
-	out := new(QueryClaimedPercentageResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ClaimedPercentage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityBufferResponse) Reset()         { *m = QueryLiquidityBufferResponse{} }
+func (m *QueryLiquidityBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityBufferResponse) ProtoMessage()    {}
+func (*QueryLiquidityBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{39}
+}
+func (m *QueryLiquidityBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityBufferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityBufferResponse.Merge(m, src)
+}
+func (m *QueryLiquidityBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityBufferResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimedPercentageByClaimType(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error) {
-	out := new(QueryClaimedPercentageResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ClaimedPercentageByClaimType", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityBufferResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityBufferResponse) GetBuffer() LiquidityBuffer {
+	if m != nil {
+		return m.Buffer
 	}
-	return out, nil
+	return LiquidityBuffer{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Zones provides meta data on connected zones.
-	Zones(context.Context, *QueryZonesRequest) (*QueryZonesResponse, error)
-	// Zone provides meta data on a specific zone.
-	Zone(context.Context, *QueryZoneRequest) (*QueryZoneResponse, error)
-	ZoneValidators(context.Context, *QueryZoneValidatorsRequest) (*QueryZoneValidatorsResponse, error)
-	// DepositAccount provides data on the deposit address for a connected zone.
-	DepositAccount(context.Context, *QueryDepositAccountForChainRequest) (*QueryDepositAccountForChainResponse, error)
-	// DelegatorIntent provides data on the intent of the delegator for the given
-	// zone.
-	DelegatorIntent(context.Context, *QueryDelegatorIntentRequest) (*QueryDelegatorIntentResponse, error)
-	// DelegatorIntents provides data on the intent of the delegator for all zones
-	DelegatorIntents(context.Context, *QueryDelegatorIntentsRequest) (*QueryDelegatorIntentsResponse, error)
-	// Delegations provides data on the delegations for the given zone.
-	Delegations(context.Context, *QueryDelegationsRequest) (*QueryDelegationsResponse, error)
-	// Delegations provides data on the delegations for the given zone.
-	Receipts(context.Context, *QueryReceiptsRequest) (*QueryReceiptsResponse, error)
-	TxStatus(context.Context, *QueryTxStatusRequest) (*QueryTxStatusResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	ZoneWithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	UserZoneWithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	WithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
-	// WithdrawalRecords provides data on the active withdrawals.
-	UserWithdrawalRecords(context.Context, *QueryUserWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
-	// UnbondingRecords provides data on the active unbondings.
-	UnbondingRecords(context.Context, *QueryUnbondingRecordsRequest) (*QueryUnbondingRecordsResponse, error)
-	// RedelegationRecords provides data on the active unbondings.
-	RedelegationRecords(context.Context, *QueryRedelegationRecordsRequest) (*QueryRedelegationRecordsResponse, error)
-	// MappedAccounts provides data on the mapped accounts for a given user over
-	// different host chains.
-	MappedAccounts(context.Context, *QueryMappedAccountsRequest) (*QueryMappedAccountsResponse, error)
-	InverseMappedAccounts(context.Context, *QueryInverseMappedAccountsRequest) (*QueryInverseMappedAccountsResponse, error)
-	// CLaimedPercentage provides data on the claimed percentage for a given zone
-	ClaimedPercentage(context.Context, *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error)
-	// ClaimedPercentageByClaimType provides data on the claimed percentage of a
-	// given claim type in a given zone
-	ClaimedPercentageByClaimType(context.Context, *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error)
+func (m *QueryLiquidityBufferResponse) GetAvailable() types.Coin {
+	if m != nil {
+		return m.Available
+	}
+	return types.Coin{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryRedemptionRateHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// from is the first epoch to return; zero for the oldest recorded sample.
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the last epoch to return; zero for the latest recorded sample.
+	To int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Zones(ctx context.Context, req *QueryZonesRequest) (*QueryZonesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Zones not implemented")
+func (m *QueryRedemptionRateHistoryRequest) Reset()         { *m = QueryRedemptionRateHistoryRequest{} }
+func (m *QueryRedemptionRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{40}
 }
-func (*UnimplementedQueryServer) Zone(ctx context.Context, req *QueryZoneRequest) (*QueryZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Zone not implemented")
+func (m *QueryRedemptionRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ZoneValidators(ctx context.Context, req *QueryZoneValidatorsRequest) (*QueryZoneValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ZoneValidators not implemented")
+func (m *QueryRedemptionRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DepositAccount(ctx context.Context, req *QueryDepositAccountForChainRequest) (*QueryDepositAccountForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositAccount not implemented")
+func (m *QueryRedemptionRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DelegatorIntent(ctx context.Context, req *QueryDelegatorIntentRequest) (*QueryDelegatorIntentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorIntent not implemented")
+func (m *QueryRedemptionRateHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) DelegatorIntents(ctx context.Context, req *QueryDelegatorIntentsRequest) (*QueryDelegatorIntentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegatorIntents not implemented")
+func (m *QueryRedemptionRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Delegations(ctx context.Context, req *QueryDelegationsRequest) (*QueryDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delegations not implemented")
+
+var xxx_messageInfo_QueryRedemptionRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Receipts(ctx context.Context, req *QueryReceiptsRequest) (*QueryReceiptsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Receipts not implemented")
+
+func (m *QueryRedemptionRateHistoryRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) TxStatus(ctx context.Context, req *QueryTxStatusRequest) (*QueryTxStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+
+func (m *QueryRedemptionRateHistoryRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ZoneWithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ZoneWithdrawalRecords not implemented")
+
+type QueryRedemptionRateHistoryResponse struct {
+	Samples []RedemptionRateSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples"`
 }
-func (*UnimplementedQueryServer) UserZoneWithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserZoneWithdrawalRecords not implemented")
+
+func (m *QueryRedemptionRateHistoryResponse) Reset()         { *m = QueryRedemptionRateHistoryResponse{} }
+func (m *QueryRedemptionRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{41}
 }
-func (*UnimplementedQueryServer) WithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalRecords not implemented")
+func (m *QueryRedemptionRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UserWithdrawalRecords(ctx context.Context, req *QueryUserWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserWithdrawalRecords not implemented")
+func (m *QueryRedemptionRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UnbondingRecords(ctx context.Context, req *QueryUnbondingRecordsRequest) (*QueryUnbondingRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbondingRecords not implemented")
+func (m *QueryRedemptionRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RedelegationRecords(ctx context.Context, req *QueryRedelegationRecordsRequest) (*QueryRedelegationRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RedelegationRecords not implemented")
+func (m *QueryRedemptionRateHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) MappedAccounts(ctx context.Context, req *QueryMappedAccountsRequest) (*QueryMappedAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MappedAccounts not implemented")
+func (m *QueryRedemptionRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) InverseMappedAccounts(ctx context.Context, req *QueryInverseMappedAccountsRequest) (*QueryInverseMappedAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InverseMappedAccounts not implemented")
+
+var xxx_messageInfo_QueryRedemptionRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryResponse) GetSamples() []RedemptionRateSample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ClaimedPercentage(ctx context.Context, req *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimedPercentage not implemented")
+
+type QueryRedemptionRateTWAPRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// window is the length in seconds of the period ending at the current block
+	// time over which the rate is averaged.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
-func (*UnimplementedQueryServer) ClaimedPercentageByClaimType(ctx context.Context, req *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimedPercentageByClaimType not implemented")
+
+func (m *QueryRedemptionRateTWAPRequest) Reset()         { *m = QueryRedemptionRateTWAPRequest{} }
+func (m *QueryRedemptionRateTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateTWAPRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{42}
+}
+func (m *QueryRedemptionRateTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRedemptionRateTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateTWAPRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateTWAPRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryRedemptionRateTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateTWAPRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func _Query_Zones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryZonesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryRedemptionRateTWAPRequest) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Zones(ctx, in)
+	return 0
+}
+
+type QueryRedemptionRateTWAPResponse struct {
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	// start_time is the start of the period covered by recorded samples; later
+	// than the start of the window if history does not cover the full window.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// samples is the number of samples contributing to the average.
+	Samples uint32 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *QueryRedemptionRateTWAPResponse) Reset()         { *m = QueryRedemptionRateTWAPResponse{} }
+func (m *QueryRedemptionRateTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateTWAPResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8e4d79429548821, []int{43}
+}
+func (m *QueryRedemptionRateTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/Zones",
+}
+func (m *QueryRedemptionRateTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateTWAPResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionRateTWAPResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateTWAPResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Zones(ctx, req.(*QueryZonesRequest))
+	return time.Time{}
+}
+
+func (m *QueryRedemptionRateTWAPResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_Zone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Zone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/Zone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Zone(ctx, req.(*QueryZoneRequest))
+func (m *QueryRedemptionRateTWAPResponse) GetSamples() uint32 {
+	if m != nil {
+		return m.Samples
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ZoneValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryZoneValidatorsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*Statistics)(nil), "quicksilver.interchainstaking.v1.Statistics")
+	proto.RegisterType((*QueryZonesRequest)(nil), "quicksilver.interchainstaking.v1.QueryZonesRequest")
+	proto.RegisterType((*QueryZonesResponse)(nil), "quicksilver.interchainstaking.v1.QueryZonesResponse")
+	proto.RegisterType((*QueryZoneRequest)(nil), "quicksilver.interchainstaking.v1.QueryZoneRequest")
+	proto.RegisterType((*QueryZoneResponse)(nil), "quicksilver.interchainstaking.v1.QueryZoneResponse")
+	proto.RegisterType((*QueryZoneValidatorsRequest)(nil), "quicksilver.interchainstaking.v1.QueryZoneValidatorsRequest")
+	proto.RegisterType((*QueryZoneValidatorsResponse)(nil), "quicksilver.interchainstaking.v1.QueryZoneValidatorsResponse")
+	proto.RegisterType((*QueryDepositAccountForChainRequest)(nil), "quicksilver.interchainstaking.v1.QueryDepositAccountForChainRequest")
+	proto.RegisterType((*QueryDepositAccountForChainResponse)(nil), "quicksilver.interchainstaking.v1.QueryDepositAccountForChainResponse")
+	proto.RegisterType((*QueryDelegatorIntentRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentRequest")
+	proto.RegisterType((*QueryDelegatorIntentResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentResponse")
+	proto.RegisterType((*QueryDelegatorIntentsRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentsRequest")
+	proto.RegisterType((*DelegatorIntentsResponse)(nil), "quicksilver.interchainstaking.v1.DelegatorIntentsResponse")
+	proto.RegisterType((*QueryDelegatorIntentsResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegatorIntentsResponse")
+	proto.RegisterType((*QueryDelegationsRequest)(nil), "quicksilver.interchainstaking.v1.QueryDelegationsRequest")
+	proto.RegisterType((*QueryDelegationsResponse)(nil), "quicksilver.interchainstaking.v1.QueryDelegationsResponse")
+	proto.RegisterType((*QueryReceiptsRequest)(nil), "quicksilver.interchainstaking.v1.QueryReceiptsRequest")
+	proto.RegisterType((*QueryReceiptsResponse)(nil), "quicksilver.interchainstaking.v1.QueryReceiptsResponse")
+	proto.RegisterType((*QueryTxStatusRequest)(nil), "quicksilver.interchainstaking.v1.QueryTxStatusRequest")
+	proto.RegisterType((*QueryTxStatusResponse)(nil), "quicksilver.interchainstaking.v1.QueryTxStatusResponse")
+	proto.RegisterType((*QueryWithdrawalRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryWithdrawalRecordsRequest")
+	proto.RegisterType((*QueryWithdrawalRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryWithdrawalRecordsResponse")
+	proto.RegisterType((*QueryUserWithdrawalRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryUserWithdrawalRecordsRequest")
+	proto.RegisterType((*QueryUnbondingRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryUnbondingRecordsRequest")
+	proto.RegisterType((*QueryUnbondingRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryUnbondingRecordsResponse")
+	proto.RegisterType((*QueryRedelegationRecordsRequest)(nil), "quicksilver.interchainstaking.v1.QueryRedelegationRecordsRequest")
+	proto.RegisterType((*QueryRedelegationRecordsResponse)(nil), "quicksilver.interchainstaking.v1.QueryRedelegationRecordsResponse")
+	proto.RegisterType((*QueryMappedAccountsRequest)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsRequest")
+	proto.RegisterType((*QueryMappedAccountsResponse)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "quicksilver.interchainstaking.v1.QueryMappedAccountsResponse.RemoteAddressMapEntry")
+	proto.RegisterType((*QueryInverseMappedAccountsRequest)(nil), "quicksilver.interchainstaking.v1.QueryInverseMappedAccountsRequest")
+	proto.RegisterType((*QueryInverseMappedAccountsResponse)(nil), "quicksilver.interchainstaking.v1.QueryInverseMappedAccountsResponse")
+	proto.RegisterType((*QueryDenyListRequest)(nil), "quicksilver.interchainstaking.v1.QueryDenyListRequest")
+	proto.RegisterType((*QueryDenyListResponse)(nil), "quicksilver.interchainstaking.v1.QueryDenyListResponse")
+	proto.RegisterType((*QueryClaimedPercentageRequest)(nil), "quicksilver.interchainstaking.v1.QueryClaimedPercentageRequest")
+	proto.RegisterType((*QueryClaimedPercentageResponse)(nil), "quicksilver.interchainstaking.v1.QueryClaimedPercentageResponse")
+	proto.RegisterType((*QueryRebalancePlanRequest)(nil), "quicksilver.interchainstaking.v1.QueryRebalancePlanRequest")
+	proto.RegisterType((*AllocationDelta)(nil), "quicksilver.interchainstaking.v1.AllocationDelta")
+	proto.RegisterType((*QueryRebalancePlanResponse)(nil), "quicksilver.interchainstaking.v1.QueryRebalancePlanResponse")
+	proto.RegisterType((*QueryLiquidityBufferRequest)(nil), "quicksilver.interchainstaking.v1.QueryLiquidityBufferRequest")
+	proto.RegisterType((*QueryLiquidityBufferResponse)(nil), "quicksilver.interchainstaking.v1.QueryLiquidityBufferResponse")
+	proto.RegisterType((*QueryRedemptionRateHistoryRequest)(nil), "quicksilver.interchainstaking.v1.QueryRedemptionRateHistoryRequest")
+	proto.RegisterType((*QueryRedemptionRateHistoryResponse)(nil), "quicksilver.interchainstaking.v1.QueryRedemptionRateHistoryResponse")
+	proto.RegisterType((*QueryRedemptionRateTWAPRequest)(nil), "quicksilver.interchainstaking.v1.QueryRedemptionRateTWAPRequest")
+	proto.RegisterType((*QueryRedemptionRateTWAPResponse)(nil), "quicksilver.interchainstaking.v1.QueryRedemptionRateTWAPResponse")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/interchainstaking/v1/query.proto", fileDescriptor_c8e4d79429548821)
+}
+
+var fileDescriptor_c8e4d79429548821 = []byte{
+	// 2761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x50, 0xb2, 0x7e, 0x9e, 0x6c, 0x59, 0x1e, 0x5b, 0x31, 0xbd, 0x75, 0x25, 0x65, 0x03,
+	0xc4, 0x4e, 0x62, 0x93, 0x95, 0x1c, 0xe4, 0xc7, 0xb6, 0x62, 0x8b, 0xa4, 0xe4, 0x28, 0xb6, 0x1b,
+	0x65, 0xad, 0xc4, 0xc8, 0x4f, 0xc1, 0x8e, 0xb8, 0x63, 0x6a, 0x61, 0x72, 0x97, 0xde, 0x5d, 0xca,
+	0x56, 0x55, 0xb7, 0x49, 0x2e, 0xbd, 0x15, 0x29, 0x52, 0xb4, 0x48, 0xaf, 0xbd, 0x14, 0x01, 0x7a,
+	0xcb, 0xa5, 0xb7, 0xf6, 0xd0, 0x22, 0x28, 0x5a, 0x20, 0x48, 0x5b, 0xa0, 0x4d, 0x51, 0xa7, 0x4d,
+	0xd2, 0x43, 0x8b, 0xf6, 0x90, 0x1c, 0xda, 0xa2, 0xa7, 0x62, 0x67, 0xdf, 0x2c, 0x97, 0xe4, 0x92,
+	0x5c, 0xae, 0x58, 0xc4, 0x27, 0x71, 0x67, 0xe7, 0x7d, 0xf3, 0xbe, 0x37, 0x6f, 0xde, 0x9b, 0x7d,
+	0x4f, 0x70, 0xf2, 0x66, 0xdd, 0x28, 0xdd, 0x70, 0x8c, 0xca, 0x16, 0xb7, 0xb3, 0x86, 0xe9, 0x72,
+	0xbb, 0xb4, 0xc9, 0x0c, 0xd3, 0x71, 0xd9, 0x0d, 0xc3, 0x2c, 0x67, 0xb7, 0xe6, 0xb3, 0x37, 0xeb,
+	0xdc, 0xde, 0xce, 0xd4, 0x6c, 0xcb, 0xb5, 0xe8, 0x5c, 0x68, 0x76, 0xa6, 0x6d, 0x76, 0x66, 0x6b,
+	0x5e, 0x79, 0xb8, 0x64, 0x39, 0x55, 0xcb, 0xc9, 0x6e, 0x30, 0x87, 0xfb, 0xa2, 0xd9, 0xad, 0xf9,
+	0x0d, 0xee, 0xb2, 0xf9, 0x6c, 0x8d, 0x95, 0x0d, 0x93, 0xb9, 0x86, 0x65, 0xfa, 0x68, 0xca, 0x4c,
+	0x78, 0xae, 0x9c, 0x55, 0xb2, 0x0c, 0xf9, 0x7e, 0x16, 0xdf, 0x37, 0x94, 0xf1, 0xa7, 0xb8, 0xb7,
+	0x71, 0xc2, 0x51, 0x7f, 0x42, 0x51, 0x3c, 0x65, 0xfd, 0x07, 0x7c, 0x75, 0xb8, 0x6c, 0x95, 0x2d,
+	0x7f, 0xdc, 0xfb, 0x85, 0xa3, 0xc7, 0xca, 0x96, 0x55, 0xae, 0xf0, 0x2c, 0xab, 0x19, 0x59, 0x66,
+	0x9a, 0x96, 0x2b, 0xd4, 0x91, 0x32, 0xb3, 0xf8, 0x56, 0x3c, 0x6d, 0xd4, 0xaf, 0x67, 0x5d, 0xa3,
+	0xca, 0x1d, 0x97, 0x55, 0x6b, 0x38, 0xe1, 0x4b, 0x61, 0x63, 0x95, 0x2a, 0xcc, 0xa8, 0x3a, 0x55,
+	0x66, 0xb2, 0x32, 0xb7, 0x3d, 0x43, 0x35, 0x0d, 0xa0, 0xc4, 0x13, 0x3d, 0xcd, 0xdb, 0x6e, 0x45,
+	0x21, 0xa9, 0xfe, 0x77, 0x18, 0xe0, 0xaa, 0xa7, 0x9f, 0xe3, 0x1a, 0x25, 0x87, 0x1e, 0x85, 0x31,
+	0x31, 0xa9, 0x68, 0xe8, 0x69, 0x32, 0x47, 0x4e, 0x8c, 0x6b, 0xa3, 0xe2, 0x79, 0x55, 0xa7, 0xab,
+	0x30, 0xae, 0xf3, 0x9a, 0xe5, 0x18, 0x2e, 0xd7, 0xd3, 0x29, 0xef, 0x5d, 0xee, 0x91, 0x77, 0xef,
+	0xce, 0xee, 0xf9, 0xe0, 0xee, 0xec, 0xb4, 0x6f, 0x13, 0x47, 0xbf, 0x91, 0x31, 0xac, 0x6c, 0x95,
+	0xb9, 0x9b, 0x99, 0x55, 0xd3, 0x7d, 0xff, 0x9d, 0x53, 0x80, 0xc6, 0x5a, 0x35, 0x5d, 0xad, 0x21,
+	0x4d, 0x15, 0x18, 0xc3, 0x07, 0x27, 0x3d, 0x34, 0x47, 0x4e, 0x0c, 0x69, 0xc1, 0x33, 0x9d, 0x01,
+	0xc0, 0xdf, 0x96, 0xed, 0xa4, 0x87, 0xc5, 0xdb, 0xd0, 0x88, 0xaf, 0x46, 0x85, 0x97, 0x99, 0xa7,
+	0xc6, 0xde, 0x44, 0x6a, 0xa0, 0x34, 0xcd, 0xc3, 0x88, 0x53, 0xaf, 0xd5, 0x2a, 0xdb, 0xe9, 0x91,
+	0xfe, 0x71, 0x50, 0x94, 0x9e, 0x04, 0xaa, 0x1b, 0x8e, 0xcb, 0xcc, 0x12, 0x2f, 0xba, 0x56, 0xd1,
+	0x65, 0x76, 0x99, 0xbb, 0xe9, 0x51, 0x61, 0xbb, 0x29, 0xf9, 0x66, 0xdd, 0x5a, 0x17, 0xe3, 0xf4,
+	0x19, 0x98, 0xaa, 0x9b, 0x1b, 0x96, 0xa9, 0x1b, 0x66, 0xb9, 0xc8, 0xaa, 0x56, 0xdd, 0x74, 0xd3,
+	0x63, 0x73, 0xe4, 0xc4, 0xc4, 0xc2, 0xd1, 0x0c, 0x82, 0x7b, 0x6e, 0x9a, 0x41, 0x1f, 0xcc, 0xe4,
+	0x2d, 0xc3, 0xcc, 0x0d, 0x7b, 0x7a, 0x69, 0x07, 0x02, 0xc1, 0x25, 0x21, 0x47, 0x0b, 0xb0, 0xff,
+	0x66, 0x9d, 0xd7, 0xb9, 0x2e, 0x81, 0xc6, 0xe3, 0x01, 0xed, 0xf3, 0xa5, 0x10, 0xe5, 0x38, 0x34,
+	0x80, 0x8b, 0x25, 0x81, 0x03, 0x73, 0xe4, 0xc4, 0x7e, 0x6d, 0x32, 0x18, 0xce, 0x8b, 0x89, 0xf7,
+	0x03, 0x0a, 0xe2, 0xac, 0x09, 0x31, 0x6b, 0xc2, 0x1f, 0xf3, 0xa7, 0x64, 0xe0, 0x90, 0x2f, 0x54,
+	0xb4, 0x79, 0xc9, 0xb2, 0xe5, 0xcc, 0x7d, 0x62, 0xe6, 0x41, 0xff, 0x95, 0x26, 0xde, 0x88, 0xf9,
+	0xea, 0xcb, 0x70, 0xf0, 0x39, 0xef, 0xec, 0xbe, 0x64, 0x99, 0xdc, 0xd1, 0xf8, 0xcd, 0x3a, 0x77,
+	0x5c, 0xba, 0x02, 0xd0, 0x38, 0xc2, 0xc2, 0x09, 0x27, 0x16, 0x1e, 0x6c, 0xe2, 0xe4, 0x87, 0x0a,
+	0xc9, 0x6c, 0x8d, 0x95, 0x39, 0xca, 0x6a, 0x21, 0x49, 0xf5, 0xaf, 0x04, 0x68, 0x18, 0xdd, 0xa9,
+	0x59, 0xa6, 0xc3, 0x69, 0x0e, 0xf6, 0x7e, 0xcd, 0x1b, 0x48, 0x93, 0xb9, 0x21, 0x81, 0xdc, 0x2b,
+	0xd6, 0x64, 0x3c, 0x79, 0x34, 0x9d, 0x2f, 0xea, 0x61, 0x38, 0x2e, 0x73, 0x9d, 0x74, 0x4a, 0x60,
+	0x9c, 0xec, 0x8d, 0xd1, 0x38, 0x62, 0x9a, 0x2f, 0x4a, 0x2f, 0x36, 0xd1, 0x1c, 0x12, 0x34, 0x8f,
+	0xf7, 0xa4, 0xe9, 0x93, 0x68, 0xe2, 0x99, 0x83, 0xa9, 0x80, 0xa6, 0xb4, 0x61, 0xa6, 0xf5, 0x18,
+	0xe7, 0x0e, 0x7d, 0x76, 0x77, 0xf6, 0xc0, 0x36, 0xab, 0x56, 0xce, 0xa8, 0xf2, 0x8d, 0x1a, 0x9c,
+	0x6d, 0xf5, 0x2d, 0x12, 0xda, 0x89, 0xc0, 0x54, 0x17, 0x60, 0xd8, 0xe3, 0x1b, 0xec, 0x41, 0x3f,
+	0x96, 0x12, 0x92, 0x61, 0x43, 0x91, 0x84, 0x86, 0x52, 0xbf, 0x4f, 0x40, 0x09, 0x74, 0x7b, 0x81,
+	0x55, 0x0c, 0x9d, 0x79, 0x81, 0x40, 0x52, 0xed, 0x12, 0xb1, 0xee, 0x83, 0x11, 0x0f, 0xa2, 0xee,
+	0x2f, 0x3f, 0xae, 0xe1, 0x13, 0x5d, 0x89, 0x30, 0x7d, 0x12, 0x0f, 0xfb, 0x09, 0x81, 0x2f, 0x44,
+	0x6a, 0x86, 0xf6, 0x7b, 0x0e, 0x60, 0x2b, 0x18, 0x45, 0x7f, 0x7b, 0xa4, 0xb7, 0x09, 0x02, 0x24,
+	0x34, 0x65, 0x08, 0xa4, 0xc5, 0x6b, 0x52, 0xc9, 0xbd, 0x66, 0x1d, 0x54, 0xa1, 0x7a, 0xc1, 0x8f,
+	0xac, 0x4b, 0x25, 0x71, 0x54, 0x57, 0x2c, 0x3b, 0xef, 0x69, 0x93, 0xd4, 0x8f, 0x5e, 0x23, 0xf0,
+	0x40, 0x57, 0x58, 0xb4, 0xcc, 0x4b, 0x70, 0x04, 0x43, 0x7a, 0x91, 0xf9, 0x53, 0x8a, 0x4c, 0xd7,
+	0x6d, 0xee, 0x38, 0xb8, 0x8c, 0xfa, 0xd9, 0xdd, 0xd9, 0x19, 0x7f, 0x99, 0x0e, 0x13, 0x55, 0x6d,
+	0x5a, 0x6f, 0x5a, 0x64, 0x09, 0xc7, 0xbf, 0x2b, 0x77, 0xa5, 0xe0, 0x07, 0x7a, 0xcb, 0x5e, 0x35,
+	0x5d, 0x6e, 0xba, 0x09, 0x39, 0xd1, 0x65, 0x38, 0xa8, 0x4b, 0xa4, 0x40, 0x4b, 0x3f, 0xff, 0xa5,
+	0xdf, 0x7f, 0xe7, 0xd4, 0x61, 0x34, 0x3e, 0x2e, 0x7f, 0xd5, 0xb5, 0x0d, 0xb3, 0xac, 0x4d, 0x05,
+	0x22, 0x52, 0x2d, 0x03, 0x8e, 0x45, 0x6b, 0x85, 0x26, 0x59, 0x85, 0x11, 0x43, 0x8c, 0xe0, 0x71,
+	0x9b, 0xef, 0xed, 0x28, 0xad, 0x50, 0x08, 0xa0, 0xf2, 0xe8, 0xa5, 0x82, 0x23, 0x13, 0xc9, 0x88,
+	0xf4, 0xcd, 0xe8, 0x55, 0x02, 0xe9, 0xf6, 0x25, 0x90, 0x4e, 0xd7, 0x8b, 0x84, 0x64, 0x9a, 0xda,
+	0x2d, 0xd3, 0x3a, 0x7c, 0xb1, 0x03, 0x53, 0x54, 0x63, 0x1d, 0x46, 0xfd, 0xa9, 0xf2, 0xfc, 0x9d,
+	0xe9, 0x7b, 0xb1, 0x00, 0x4c, 0x93, 0x50, 0xea, 0x77, 0x08, 0x1c, 0x09, 0xaf, 0x6b, 0x58, 0xa6,
+	0x93, 0xd4, 0xbd, 0x56, 0x22, 0x4e, 0x74, 0x92, 0x60, 0xf4, 0x1f, 0x02, 0xe9, 0x76, 0x9d, 0x02,
+	0x33, 0x4c, 0xe8, 0x8d, 0x61, 0x34, 0xc5, 0xc9, 0xd8, 0xa6, 0x30, 0x2c, 0x79, 0x77, 0x08, 0xc3,
+	0xd0, 0x45, 0x18, 0x72, 0xb7, 0x2a, 0x49, 0xee, 0x82, 0x9e, 0xdc, 0xe0, 0x32, 0xe0, 0xb7, 0x09,
+	0x1c, 0x16, 0xd4, 0x35, 0x5e, 0xe2, 0x46, 0xcd, 0xfd, 0xdc, 0xf7, 0xe2, 0xc7, 0x04, 0xa6, 0x5b,
+	0x14, 0xc2, 0x8d, 0xb8, 0x04, 0x63, 0x36, 0x8e, 0xe1, 0x2e, 0x3c, 0xd4, 0x7b, 0x17, 0x10, 0x05,
+	0xb7, 0x20, 0x00, 0x18, 0x5c, 0x32, 0x28, 0xa2, 0xfd, 0xd6, 0x6f, 0x5f, 0x15, 0x19, 0x32, 0xa9,
+	0xfd, 0x8e, 0xc0, 0xa8, 0x7b, 0xbb, 0xb8, 0xc9, 0x9c, 0x4d, 0x99, 0x71, 0xdd, 0xdb, 0x4f, 0x33,
+	0x67, 0x53, 0x7d, 0x05, 0xa6, 0x5b, 0x16, 0x40, 0x7b, 0xe4, 0x61, 0x14, 0xe9, 0x60, 0xd8, 0x8b,
+	0x6f, 0x0e, 0x4d, 0x4a, 0xaa, 0x77, 0x09, 0x86, 0x81, 0x6b, 0x86, 0xbb, 0xa9, 0xdb, 0xec, 0x16,
+	0xab, 0xf8, 0xb7, 0x4c, 0xe7, 0xf3, 0x8d, 0xf9, 0x03, 0xbb, 0x68, 0xfc, 0x9c, 0xc0, 0x4c, 0x27,
+	0x82, 0x41, 0x46, 0x9d, 0xb8, 0x15, 0xbc, 0x94, 0xbe, 0xb5, 0xd0, 0xdb, 0x98, 0xad, 0x88, 0xf2,
+	0x9c, 0x87, 0xc0, 0x06, 0xe7, 0x67, 0x3f, 0x22, 0x70, 0xbf, 0xe0, 0xf1, 0xbc, 0xc3, 0xed, 0x8e,
+	0x9b, 0x75, 0x16, 0xf6, 0xd5, 0x1d, 0xde, 0x96, 0x99, 0x3e, 0xbb, 0x3b, 0x1b, 0x6d, 0xf7, 0x09,
+	0x6f, 0x76, 0xb4, 0xc9, 0x93, 0x1f, 0xe1, 0xef, 0x11, 0x4c, 0xa2, 0xcf, 0xcb, 0xaf, 0xa0, 0x5d,
+	0xba, 0xd4, 0xa0, 0x14, 0xfb, 0x99, 0x74, 0xf6, 0x76, 0xc5, 0xd0, 0x15, 0xae, 0x01, 0x04, 0x9f,
+	0x6e, 0xd2, 0x13, 0x62, 0xe4, 0xd8, 0x16, 0x3c, 0x79, 0xf9, 0x6c, 0x40, 0x0d, 0xce, 0x0f, 0xde,
+	0x22, 0x30, 0x8b, 0xf1, 0xb1, 0x91, 0x4f, 0xee, 0x11, 0xfb, 0xfe, 0x9a, 0xc0, 0x5c, 0x67, 0xdd,
+	0xd0, 0xc4, 0x5f, 0x85, 0xfd, 0x36, 0x6f, 0xcf, 0xa8, 0x8f, 0xc6, 0x09, 0x5e, 0xad, 0xa8, 0x68,
+	0xe8, 0x66, 0xc0, 0xc1, 0xd9, 0x7a, 0x0d, 0xbf, 0x9e, 0xae, 0xb0, 0x5a, 0x8d, 0xeb, 0x78, 0x57,
+	0x0e, 0xac, 0xbc, 0x00, 0xa3, 0x71, 0x2f, 0x80, 0x72, 0xa2, 0xfa, 0x0f, 0x79, 0xc1, 0x6e, 0x85,
+	0x44, 0xe3, 0x7c, 0x8b, 0x00, 0xb5, 0x79, 0xd5, 0x72, 0xb9, 0x3c, 0xc2, 0xc5, 0x2a, 0xab, 0xa1,
+	0x89, 0xae, 0xf6, 0x36, 0x51, 0x17, 0xec, 0x8c, 0x26, 0x70, 0x51, 0xb1, 0x2b, 0xac, 0xb6, 0x6c,
+	0xba, 0xf6, 0x36, 0x5a, 0x70, 0xca, 0x6e, 0x79, 0xa9, 0xe4, 0x61, 0x3a, 0x52, 0x80, 0x4e, 0xc1,
+	0xd0, 0x0d, 0xbe, 0x8d, 0x17, 0x53, 0xef, 0x27, 0x3d, 0x0c, 0x7b, 0xb7, 0x58, 0xa5, 0xce, 0x31,
+	0x71, 0xf9, 0x0f, 0x67, 0x52, 0x4f, 0x10, 0xf5, 0x9b, 0x18, 0xb3, 0x56, 0xcd, 0x2d, 0x6e, 0x3b,
+	0x3c, 0xda, 0x8e, 0xe7, 0x61, 0xb2, 0x99, 0x72, 0x4f, 0x73, 0xee, 0x6f, 0xd2, 0xb6, 0xe9, 0xbe,
+	0x9c, 0x6a, 0xba, 0x2f, 0xab, 0xab, 0xa0, 0x76, 0x53, 0x00, 0xad, 0xfe, 0x00, 0xec, 0xaf, 0x58,
+	0x25, 0x56, 0x69, 0x56, 0x40, 0xdb, 0x27, 0x06, 0xe5, 0x95, 0x7d, 0x05, 0x13, 0x7d, 0x81, 0x9b,
+	0xdb, 0x97, 0x0d, 0x27, 0xe9, 0x37, 0x91, 0xfa, 0x03, 0x79, 0xc1, 0x69, 0x00, 0xa1, 0x1a, 0x4f,
+	0xb4, 0x7d, 0xf3, 0x76, 0x33, 0xc2, 0xff, 0xe5, 0xd3, 0xf6, 0x75, 0x19, 0x21, 0xf3, 0x5e, 0xa5,
+	0x94, 0xeb, 0x6b, 0xdc, 0x2e, 0x71, 0xd3, 0x6d, 0x9c, 0xf7, 0x6e, 0x1f, 0x27, 0x2b, 0x00, 0xa2,
+	0xc0, 0x5a, 0x74, 0xb7, 0x6b, 0xbe, 0x33, 0x4c, 0x2e, 0x1c, 0x6f, 0xf2, 0xd9, 0xe6, 0xfa, 0xeb,
+	0xd6, 0x7c, 0x46, 0x2c, 0xb3, 0xbe, 0x5d, 0xe3, 0xda, 0x78, 0x49, 0xfe, 0x54, 0xbf, 0x81, 0x19,
+	0x3b, 0x42, 0x07, 0xb4, 0xd4, 0x2b, 0x00, 0xb5, 0x60, 0x14, 0xad, 0x7e, 0x0e, 0x2f, 0xd1, 0x0f,
+	0x96, 0x0d, 0x77, 0xb3, 0xbe, 0x91, 0x29, 0x59, 0x55, 0xac, 0x37, 0xe3, 0x9f, 0x53, 0x8e, 0x7e,
+	0x23, 0xeb, 0xa9, 0xe6, 0x64, 0x0a, 0xbc, 0x14, 0xba, 0x55, 0x17, 0x78, 0x49, 0x0b, 0xe1, 0xa9,
+	0x97, 0xe0, 0x28, 0x46, 0xb1, 0x0d, 0x56, 0x61, 0x66, 0x89, 0xaf, 0x55, 0x98, 0xb9, 0x8b, 0xf2,
+	0xd0, 0x81, 0xa5, 0x8a, 0xe7, 0x49, 0x9e, 0x81, 0x0b, 0xbc, 0xe2, 0x32, 0xba, 0x04, 0x07, 0xb6,
+	0x58, 0xc5, 0xaa, 0xf1, 0xf8, 0x9f, 0x90, 0x93, 0x28, 0x20, 0x7d, 0x3e, 0x0f, 0x23, 0x58, 0xb9,
+	0x4c, 0xf0, 0x09, 0x81, 0xa2, 0xea, 0x1f, 0x87, 0x41, 0x89, 0x62, 0x8a, 0x56, 0x66, 0x30, 0xea,
+	0x97, 0x64, 0xfb, 0xc8, 0x84, 0x2d, 0x54, 0x73, 0x87, 0xdf, 0xfe, 0x70, 0x76, 0xaa, 0x65, 0xd0,
+	0xd1, 0x24, 0xae, 0xb7, 0x84, 0x63, 0xd5, 0xed, 0x12, 0x97, 0xf5, 0xc0, 0xc1, 0x2d, 0x81, 0xb8,
+	0xf4, 0x85, 0xd6, 0x7c, 0x33, 0x24, 0x16, 0x7a, 0x58, 0x1e, 0x8f, 0x06, 0xb2, 0x7f, 0x36, 0xae,
+	0x38, 0xe5, 0x1c, 0x2f, 0x1b, 0x66, 0x90, 0x6d, 0x78, 0x74, 0x96, 0x59, 0x86, 0x83, 0x15, 0xab,
+	0x74, 0x83, 0xeb, 0xc5, 0xd0, 0xa1, 0x1d, 0xee, 0x71, 0x68, 0xa7, 0x7c, 0x91, 0x46, 0xc1, 0x8b,
+	0x56, 0xe1, 0x70, 0x18, 0x17, 0xab, 0xbf, 0x4e, 0x7a, 0xef, 0xae, 0xb3, 0xe2, 0x21, 0xbb, 0xed,
+	0x8d, 0xd3, 0xa1, 0xe4, 0x3e, 0xd2, 0xa1, 0xe4, 0x7e, 0x16, 0x94, 0x5a, 0x85, 0x99, 0x26, 0xd7,
+	0x8b, 0x1d, 0x0b, 0xf5, 0x47, 0x70, 0x46, 0xa1, 0x45, 0x58, 0xbd, 0x82, 0xa9, 0xee, 0xb2, 0x71,
+	0xb3, 0x6e, 0xe8, 0x86, 0xbb, 0x9d, 0xab, 0x5f, 0xbf, 0xce, 0xed, 0xa4, 0x07, 0xe9, 0xd5, 0x14,
+	0x1c, 0x8b, 0xc6, 0x43, 0x77, 0x7d, 0x16, 0x46, 0x36, 0xc4, 0x48, 0xfc, 0x2a, 0x50, 0x0b, 0x14,
+	0x1a, 0x0e, 0x61, 0xe8, 0x22, 0x8c, 0xb3, 0x2d, 0x66, 0x54, 0xd8, 0x46, 0x85, 0xa7, 0x53, 0xf1,
+	0x1a, 0x04, 0x0d, 0x09, 0xba, 0x06, 0xc3, 0x36, 0x73, 0x79, 0x7a, 0x68, 0x00, 0xe1, 0x49, 0x20,
+	0xa9, 0xb7, 0x30, 0x9d, 0x7a, 0x5b, 0x5e, 0xad, 0x89, 0x6d, 0x65, 0x2e, 0x7f, 0xda, 0x70, 0x5c,
+	0xcb, 0xde, 0x4e, 0x68, 0x57, 0x4a, 0x61, 0xf8, 0xba, 0x6d, 0x55, 0x05, 0xc1, 0x21, 0x4d, 0xfc,
+	0xa6, 0x93, 0x90, 0x72, 0x2d, 0x6c, 0x2f, 0xa5, 0x5c, 0x4b, 0xfd, 0x3a, 0xa8, 0xdd, 0x16, 0xc6,
+	0x0d, 0x78, 0x01, 0x46, 0x1d, 0x56, 0xad, 0x55, 0x82, 0x06, 0xc1, 0x63, 0xf1, 0xbc, 0xb7, 0x81,
+	0x78, 0x55, 0x88, 0xa3, 0x29, 0x25, 0x98, 0xba, 0x89, 0xf9, 0xa0, 0x79, 0xee, 0xfa, 0xb5, 0xa5,
+	0xb5, 0xa4, 0x9c, 0xef, 0x83, 0x91, 0x5b, 0x86, 0xa9, 0x5b, 0xb7, 0x04, 0xeb, 0x61, 0x0d, 0x9f,
+	0xd4, 0x37, 0x53, 0x30, 0xdb, 0x71, 0x29, 0x64, 0xb9, 0x06, 0xc3, 0xee, 0x2d, 0x56, 0xc3, 0x75,
+	0x76, 0xb9, 0xad, 0x1e, 0x12, 0xcd, 0x03, 0x38, 0x2e, 0xb3, 0xdd, 0xa2, 0x6b, 0x54, 0xa5, 0xa3,
+	0x29, 0x19, 0xbf, 0xd3, 0x99, 0x91, 0x9d, 0xce, 0xcc, 0xba, 0xec, 0x74, 0xe6, 0xc6, 0xbc, 0x35,
+	0xdf, 0xf8, 0x70, 0x96, 0x68, 0xe3, 0x42, 0xce, 0x7b, 0x43, 0xcf, 0xc3, 0x18, 0x37, 0x75, 0x1f,
+	0x62, 0xa8, 0x0f, 0x88, 0x51, 0x6e, 0xea, 0x02, 0x20, 0xdd, 0xd8, 0xbd, 0x61, 0xd1, 0x74, 0x92,
+	0x8f, 0x0b, 0xbf, 0x38, 0x01, 0x7b, 0x85, 0x55, 0xe8, 0x0f, 0x09, 0xec, 0x15, 0x2d, 0x21, 0x7a,
+	0x3a, 0xe6, 0x5d, 0x34, 0xdc, 0x9e, 0x52, 0x1e, 0xed, 0x4f, 0xc8, 0x37, 0xb8, 0x9a, 0x7d, 0xfd,
+	0x37, 0x9f, 0xbc, 0x99, 0x7a, 0x88, 0x1e, 0xcf, 0xf6, 0xec, 0xd4, 0xfa, 0x2d, 0xa6, 0xb7, 0x09,
+	0x0c, 0x7b, 0x10, 0x74, 0xa1, 0x8f, 0xf5, 0xa4, 0x8e, 0xa7, 0xfb, 0x92, 0x41, 0x15, 0x9f, 0x14,
+	0x2a, 0x9e, 0xa6, 0xf3, 0xf1, 0x54, 0xcc, 0xee, 0x48, 0x9f, 0xbc, 0x43, 0x7f, 0x4b, 0x60, 0xb2,
+	0xb9, 0x07, 0x42, 0xcf, 0xf5, 0xa1, 0x42, 0x5b, 0x53, 0x47, 0x59, 0x4c, 0x28, 0x8d, 0x54, 0x96,
+	0x05, 0x95, 0xf3, 0x74, 0x31, 0xa6, 0xb5, 0x43, 0x5c, 0xb2, 0xa1, 0x1b, 0xe9, 0xdf, 0x08, 0x4c,
+	0x36, 0x37, 0x32, 0x68, 0x21, 0xa6, 0x62, 0x5d, 0xdb, 0x2a, 0xca, 0xf2, 0x2e, 0x51, 0x90, 0xe6,
+	0x33, 0x82, 0x66, 0x81, 0xe6, 0x12, 0xd0, 0x0c, 0xba, 0x2a, 0x78, 0x17, 0xfb, 0x94, 0xc0, 0x81,
+	0x96, 0xc2, 0x37, 0x5d, 0x8c, 0xad, 0x66, 0x54, 0xa3, 0x45, 0x79, 0x2a, 0xa9, 0x38, 0xd2, 0x2b,
+	0x0a, 0x7a, 0x2f, 0xd2, 0x6b, 0x89, 0xe8, 0xc9, 0xea, 0x9d, 0x5f, 0xb3, 0xcf, 0xee, 0xb4, 0xd5,
+	0xf3, 0xee, 0xd0, 0x4f, 0x08, 0x4c, 0xb5, 0x2c, 0xee, 0xd0, 0x84, 0x5a, 0x07, 0xae, 0x7b, 0x3e,
+	0xb1, 0x3c, 0xd2, 0x7e, 0x56, 0xd0, 0x5e, 0xa5, 0x17, 0x7b, 0xd3, 0x6e, 0x65, 0xe9, 0x44, 0xd2,
+	0xfc, 0x15, 0x81, 0x89, 0x50, 0x53, 0x80, 0x3e, 0xd9, 0x9f, 0x86, 0xa1, 0xe6, 0x86, 0x72, 0x26,
+	0x89, 0x28, 0xf2, 0x5a, 0x11, 0xbc, 0x2e, 0xd0, 0xa7, 0x92, 0x6f, 0xa7, 0x50, 0xff, 0xa7, 0x04,
+	0xc6, 0x64, 0x5d, 0x9d, 0x3e, 0x16, 0x53, 0xa1, 0x96, 0xce, 0x80, 0xf2, 0x78, 0xdf, 0x72, 0xc8,
+	0x22, 0x2f, 0x58, 0x2c, 0xd2, 0xb3, 0x09, 0x58, 0x04, 0x85, 0xfb, 0x5f, 0x12, 0x18, 0x93, 0xa5,
+	0xf0, 0xd8, 0x14, 0x5a, 0x8a, 0xf3, 0xca, 0xe3, 0x7d, 0xcb, 0x21, 0x85, 0x2b, 0x82, 0xc2, 0x45,
+	0xba, 0x9c, 0x3c, 0x6c, 0x38, 0xd9, 0x1d, 0x2c, 0xf4, 0xdf, 0xf1, 0xa2, 0xe4, 0xb4, 0x17, 0x87,
+	0xdb, 0xea, 0xb9, 0x34, 0xee, 0x51, 0xe8, 0x54, 0x09, 0x56, 0x2e, 0x24, 0x07, 0x18, 0x00, 0xd7,
+	0x46, 0x09, 0x5c, 0x7e, 0xd0, 0xd0, 0xd7, 0x52, 0x70, 0xd4, 0xab, 0x5d, 0xdf, 0xb3, 0x7c, 0x99,
+	0xe0, 0xfb, 0x32, 0x7d, 0x71, 0x20, 0x7c, 0x23, 0xc3, 0xc9, 0x07, 0x04, 0x0e, 0xde, 0x93, 0xdc,
+	0x73, 0x82, 0xfb, 0x39, 0x7a, 0x26, 0x2e, 0xf7, 0x88, 0x0d, 0xfe, 0x94, 0xc0, 0x74, 0x64, 0x73,
+	0x82, 0xe6, 0x63, 0xea, 0xd7, 0xad, 0xb5, 0x31, 0x00, 0x92, 0xcf, 0x09, 0x92, 0x97, 0xe8, 0x6a,
+	0x6f, 0x92, 0x75, 0x87, 0xdb, 0x4e, 0x76, 0x27, 0xdc, 0x4b, 0x89, 0x74, 0xea, 0xbf, 0x10, 0x98,
+	0x6a, 0x6d, 0x26, 0xc4, 0x4e, 0x83, 0x1d, 0xda, 0x23, 0xca, 0xf9, 0xc4, 0xf2, 0x48, 0xf4, 0xb2,
+	0x20, 0xba, 0x42, 0x0b, 0x09, 0x3c, 0xb9, 0xf1, 0x0f, 0x6d, 0x92, 0xe3, 0x3f, 0x09, 0x1c, 0x8a,
+	0x28, 0xe8, 0xd3, 0xa5, 0xd8, 0x79, 0xa0, 0x53, 0xa3, 0x42, 0xc9, 0xed, 0x06, 0xa2, 0xff, 0x9c,
+	0x1f, 0x91, 0x55, 0xda, 0x2b, 0x2f, 0xf4, 0x0f, 0x04, 0x26, 0x9b, 0x0b, 0xc5, 0xb1, 0x6f, 0xe4,
+	0x91, 0x05, 0x6e, 0x65, 0x31, 0xa1, 0x74, 0xff, 0x57, 0xd5, 0xaa, 0x40, 0x90, 0x1e, 0xcb, 0x9d,
+	0xac, 0xa8, 0x60, 0x67, 0x77, 0x82, 0x00, 0xf4, 0x5a, 0x0a, 0xa6, 0x23, 0x6b, 0xe1, 0xb1, 0xcf,
+	0x68, 0xb7, 0x52, 0xbe, 0x52, 0xd8, 0x1d, 0x08, 0x12, 0xfe, 0x8a, 0x20, 0x7c, 0x8d, 0x3e, 0x9f,
+	0x80, 0xb0, 0xdf, 0x19, 0x08, 0xef, 0xf1, 0x4e, 0x73, 0x73, 0xe1, 0x0e, 0xfd, 0x90, 0xc0, 0xc1,
+	0xb6, 0xd2, 0x72, 0xec, 0x20, 0xdc, 0xa9, 0x30, 0xae, 0x5c, 0x48, 0x0e, 0x80, 0xbc, 0x2f, 0x0a,
+	0xde, 0x4b, 0xf4, 0x7c, 0x6f, 0xde, 0x25, 0x1f, 0xa4, 0xd8, 0xa8, 0x5a, 0x87, 0xbf, 0x29, 0xff,
+	0x4d, 0xe0, 0x58, 0xdb, 0x32, 0xb9, 0xed, 0xa0, 0xd8, 0x7e, 0x2f, 0x90, 0x5d, 0x17, 0x64, 0xbf,
+	0x4c, 0x2f, 0xef, 0x92, 0x6c, 0x76, 0xa7, 0xd1, 0x73, 0xb8, 0x43, 0x7f, 0x47, 0x60, 0x7f, 0x53,
+	0x31, 0x9b, 0x9e, 0x8d, 0x1d, 0x62, 0xda, 0x8b, 0xfd, 0xca, 0xb9, 0x64, 0xc2, 0x48, 0x71, 0x55,
+	0x50, 0xcc, 0xd3, 0xa5, 0x44, 0x91, 0x09, 0x11, 0x8b, 0x5e, 0x79, 0x95, 0xfe, 0x89, 0xc0, 0x81,
+	0x96, 0x62, 0x65, 0xec, 0x4f, 0xcc, 0xe8, 0xfa, 0xab, 0xf2, 0x54, 0x52, 0x71, 0x64, 0x77, 0x49,
+	0xb0, 0x5b, 0xa6, 0xf9, 0x04, 0xec, 0x2a, 0x12, 0xb3, 0x88, 0xa5, 0xd6, 0x7f, 0x11, 0x98, 0x6e,
+	0xae, 0xb9, 0x61, 0x71, 0x31, 0x76, 0x5c, 0xea, 0x56, 0x13, 0x55, 0x0a, 0xbb, 0x03, 0x41, 0xc6,
+	0x9a, 0x60, 0x7c, 0x99, 0x3e, 0x93, 0x30, 0xd3, 0xf8, 0xc8, 0x45, 0x9b, 0xb9, 0xbc, 0xb8, 0x89,
+	0xf4, 0xfe, 0x4e, 0x80, 0xb6, 0x17, 0x1b, 0xe9, 0x85, 0x44, 0x0a, 0x87, 0x4a, 0xa2, 0xca, 0xd2,
+	0x2e, 0x10, 0x06, 0x94, 0x59, 0xc3, 0x7c, 0xbd, 0x42, 0x67, 0xee, 0xe5, 0x77, 0x3f, 0x9a, 0x21,
+	0xef, 0x7d, 0x34, 0x43, 0xfe, 0xfc, 0xd1, 0x0c, 0x79, 0xe3, 0xe3, 0x99, 0x3d, 0xef, 0x7d, 0x3c,
+	0xb3, 0xe7, 0xf7, 0x1f, 0xcf, 0xec, 0x79, 0x69, 0x29, 0x54, 0x3e, 0x0d, 0x2d, 0x76, 0xca, 0xc3,
+	0x6d, 0x5a, 0xfd, 0x76, 0xc4, 0xfa, 0xa2, 0xba, 0xba, 0x31, 0x22, 0xca, 0x9c, 0xa7, 0xff, 0x37,
+	0x00, 0x00, 0xcc, 0x42, 0xdb, 0x2f, 0x33, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Zones provides meta data on connected zones.
+	Zones(ctx context.Context, in *QueryZonesRequest, opts ...grpc.CallOption) (*QueryZonesResponse, error)
+	// Zone provides meta data on a specific zone.
+	Zone(ctx context.Context, in *QueryZoneRequest, opts ...grpc.CallOption) (*QueryZoneResponse, error)
+	ZoneValidators(ctx context.Context, in *QueryZoneValidatorsRequest, opts ...grpc.CallOption) (*QueryZoneValidatorsResponse, error)
+	// DepositAccount provides data on the deposit address for a connected zone.
+	DepositAccount(ctx context.Context, in *QueryDepositAccountForChainRequest, opts ...grpc.CallOption) (*QueryDepositAccountForChainResponse, error)
+	// DelegatorIntent provides data on the intent of the delegator for the given
+	// zone.
+	DelegatorIntent(ctx context.Context, in *QueryDelegatorIntentRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentResponse, error)
+	// DelegatorIntents provides data on the intent of the delegator for all zones
+	DelegatorIntents(ctx context.Context, in *QueryDelegatorIntentsRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentsResponse, error)
+	// Delegations provides data on the delegations for the given zone.
+	Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error)
+	// Delegations provides data on the delegations for the given zone.
+	Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error)
+	TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	ZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	UserZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	WithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	UserWithdrawalRecords(ctx context.Context, in *QueryUserWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error)
+	// UnbondingRecords provides data on the active unbondings.
+	UnbondingRecords(ctx context.Context, in *QueryUnbondingRecordsRequest, opts ...grpc.CallOption) (*QueryUnbondingRecordsResponse, error)
+	// RedelegationRecords provides data on the active unbondings.
+	RedelegationRecords(ctx context.Context, in *QueryRedelegationRecordsRequest, opts ...grpc.CallOption) (*QueryRedelegationRecordsResponse, error)
+	// MappedAccounts provides data on the mapped accounts for a given user over
+	// different host chains.
+	MappedAccounts(ctx context.Context, in *QueryMappedAccountsRequest, opts ...grpc.CallOption) (*QueryMappedAccountsResponse, error)
+	InverseMappedAccounts(ctx context.Context, in *QueryInverseMappedAccountsRequest, opts ...grpc.CallOption) (*QueryInverseMappedAccountsResponse, error)
+	// CLaimedPercentage provides data on the claimed percentage for a given zone
+	ClaimedPercentage(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error)
+	// ClaimedPercentageByClaimType provides data on the claimed percentage of a
+	// given claim type in a given zone
+	ClaimedPercentageByClaimType(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error)
+	// RebalancePlan simulates the epochly rebalancing of a zone against current
+	// state, returning the redelegations that would be submitted.
+	RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error)
+	// LiquidityBuffer provides the depth of the instant redemption liquidity
+	// buffer of a zone.
+	LiquidityBuffer(ctx context.Context, in *QueryLiquidityBufferRequest, opts ...grpc.CallOption) (*QueryLiquidityBufferResponse, error)
+	// RedemptionRateHistory provides the recorded redemption rates of a zone.
+	RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error)
+	// RedemptionRateTWAP provides the time weighted average redemption rate of
+	// a zone over a window.
+	RedemptionRateTWAP(ctx context.Context, in *QueryRedemptionRateTWAPRequest, opts ...grpc.CallOption) (*QueryRedemptionRateTWAPResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Zones(ctx context.Context, in *QueryZonesRequest, opts ...grpc.CallOption) (*QueryZonesResponse, error) {
+	out := new(QueryZonesResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Zones", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ZoneValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/ZoneValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ZoneValidators(ctx, req.(*QueryZoneValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositAccountForChainRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Zone(ctx context.Context, in *QueryZoneRequest, opts ...grpc.CallOption) (*QueryZoneResponse, error) {
+	out := new(QueryZoneResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Zone", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DepositAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/DepositAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DepositAccount(ctx, req.(*QueryDepositAccountForChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DelegatorIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorIntentRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ZoneValidators(ctx context.Context, in *QueryZoneValidatorsRequest, opts ...grpc.CallOption) (*QueryZoneValidatorsResponse, error) {
+	out := new(QueryZoneValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ZoneValidators", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorIntent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/DelegatorIntent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorIntent(ctx, req.(*QueryDelegatorIntentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_DelegatorIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegatorIntentsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) DepositAccount(ctx context.Context, in *QueryDepositAccountForChainRequest, opts ...grpc.CallOption) (*QueryDepositAccountForChainResponse, error) {
+	out := new(QueryDepositAccountForChainResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DepositAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DelegatorIntents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/DelegatorIntents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DelegatorIntents(ctx, req.(*QueryDelegatorIntentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Delegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegationsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) DelegatorIntent(ctx context.Context, in *QueryDelegatorIntentRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentResponse, error) {
+	out := new(QueryDelegatorIntentResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DelegatorIntent", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Delegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/Delegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Delegations(ctx, req.(*QueryDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Receipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReceiptsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) DelegatorIntents(ctx context.Context, in *QueryDelegatorIntentsRequest, opts ...grpc.CallOption) (*QueryDelegatorIntentsResponse, error) {
+	out := new(QueryDelegatorIntentsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/DelegatorIntents", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Receipts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/Receipts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Receipts(ctx, req.(*QueryReceiptsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTxStatusRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Delegations(ctx context.Context, in *QueryDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegationsResponse, error) {
+	out := new(QueryDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Delegations", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TxStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/TxStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TxStatus(ctx, req.(*QueryTxStatusRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ZoneWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Receipts(ctx context.Context, in *QueryReceiptsRequest, opts ...grpc.CallOption) (*QueryReceiptsResponse, error) {
+	out := new(QueryReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/Receipts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ZoneWithdrawalRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/ZoneWithdrawalRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ZoneWithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UserZoneWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TxStatus(ctx context.Context, in *QueryTxStatusRequest, opts ...grpc.CallOption) (*QueryTxStatusResponse, error) {
+	out := new(QueryTxStatusResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/TxStatus", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserZoneWithdrawalRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/UserZoneWithdrawalRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserZoneWithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_WithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawalRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
+	out := new(QueryWithdrawalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ZoneWithdrawalRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).WithdrawalRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/WithdrawalRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UserWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserWithdrawalRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UserZoneWithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
+	out := new(QueryWithdrawalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UserZoneWithdrawalRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserWithdrawalRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/UserWithdrawalRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserWithdrawalRecords(ctx, req.(*QueryUserWithdrawalRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_UnbondingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) WithdrawalRecords(ctx context.Context, in *QueryWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
+	out := new(QueryWithdrawalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/WithdrawalRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnbondingRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/UnbondingRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnbondingRecords(ctx, req.(*QueryUnbondingRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RedelegationRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRedelegationRecordsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UserWithdrawalRecords(ctx context.Context, in *QueryUserWithdrawalRecordsRequest, opts ...grpc.CallOption) (*QueryWithdrawalRecordsResponse, error) {
+	out := new(QueryWithdrawalRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UserWithdrawalRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RedelegationRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/RedelegationRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RedelegationRecords(ctx, req.(*QueryRedelegationRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_MappedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMappedAccountsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UnbondingRecords(ctx context.Context, in *QueryUnbondingRecordsRequest, opts ...grpc.CallOption) (*QueryUnbondingRecordsResponse, error) {
+	out := new(QueryUnbondingRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/UnbondingRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).MappedAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/MappedAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MappedAccounts(ctx, req.(*QueryMappedAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_InverseMappedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInverseMappedAccountsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RedelegationRecords(ctx context.Context, in *QueryRedelegationRecordsRequest, opts ...grpc.CallOption) (*QueryRedelegationRecordsResponse, error) {
+	out := new(QueryRedelegationRecordsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/RedelegationRecords", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).InverseMappedAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/InverseMappedAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InverseMappedAccounts(ctx, req.(*QueryInverseMappedAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ClaimedPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimedPercentageRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) MappedAccounts(ctx context.Context, in *QueryMappedAccountsRequest, opts ...grpc.CallOption) (*QueryMappedAccountsResponse, error) {
+	out := new(QueryMappedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/MappedAccounts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimedPercentage(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) InverseMappedAccounts(ctx context.Context, in *QueryInverseMappedAccountsRequest, opts ...grpc.CallOption) (*QueryInverseMappedAccountsResponse, error) {
+	out := new(QueryInverseMappedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/InverseMappedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/ClaimedPercentage",
+	return out, nil
+}
+
+func (c *queryClient) ClaimedPercentage(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error) {
+	out := new(QueryClaimedPercentageResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ClaimedPercentage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimedPercentage(ctx, req.(*QueryClaimedPercentageRequest))
+	return out, nil
+}
+
+func (c *queryClient) ClaimedPercentageByClaimType(ctx context.Context, in *QueryClaimedPercentageRequest, opts ...grpc.CallOption) (*QueryClaimedPercentageResponse, error) {
+	out := new(QueryClaimedPercentageResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/ClaimedPercentageByClaimType", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ClaimedPercentageByClaimType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimedPercentageRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error) {
+	out := new(QueryRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/RebalancePlan", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimedPercentageByClaimType(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) LiquidityBuffer(ctx context.Context, in *QueryLiquidityBufferRequest, opts ...grpc.CallOption) (*QueryLiquidityBufferResponse, error) {
+	out := new(QueryLiquidityBufferResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/LiquidityBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.interchainstaking.v1.Query/ClaimedPercentageByClaimType",
+	return out, nil
+}
+
+func (c *queryClient) RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error) {
+	out := new(QueryRedemptionRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/RedemptionRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimedPercentageByClaimType(ctx, req.(*QueryClaimedPercentageRequest))
+	return out, nil
+}
+
+func (c *queryClient) RedemptionRateTWAP(ctx context.Context, in *QueryRedemptionRateTWAPRequest, opts ...grpc.CallOption) (*QueryRedemptionRateTWAPResponse, error) {
+	out := new(QueryRedemptionRateTWAPResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.interchainstaking.v1.Query/RedemptionRateTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "quicksilver.interchainstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Zones",
-			Handler:    _Query_Zones_Handler,
-		},
-		{
-			MethodName: "Zone",
-			Handler:    _Query_Zone_Handler,
-		},
-		{
-			MethodName: "ZoneValidators",
-			Handler:    _Query_ZoneValidators_Handler,
-		},
-		{
-			MethodName: "DepositAccount",
-			Handler:    _Query_DepositAccount_Handler,
-		},
-		{
-			MethodName: "DelegatorIntent",
-			Handler:    _Query_DelegatorIntent_Handler,
-		},
-		{
-			MethodName: "DelegatorIntents",
-			Handler:    _Query_DelegatorIntents_Handler,
-		},
-		{
-			MethodName: "Delegations",
-			Handler:    _Query_Delegations_Handler,
-		},
-		{
-			MethodName: "Receipts",
-			Handler:    _Query_Receipts_Handler,
-		},
-		{
-			MethodName: "TxStatus",
-			Handler:    _Query_TxStatus_Handler,
-		},
-		{
-			MethodName: "ZoneWithdrawalRecords",
-			Handler:    _Query_ZoneWithdrawalRecords_Handler,
-		},
-		{
-			MethodName: "UserZoneWithdrawalRecords",
-			Handler:    _Query_UserZoneWithdrawalRecords_Handler,
-		},
-		{
-			MethodName: "WithdrawalRecords",
-			Handler:    _Query_WithdrawalRecords_Handler,
-		},
-		{
-			MethodName: "UserWithdrawalRecords",
-			Handler:    _Query_UserWithdrawalRecords_Handler,
-		},
-		{
-			MethodName: "UnbondingRecords",
-			Handler:    _Query_UnbondingRecords_Handler,
-		},
-		{
-			MethodName: "RedelegationRecords",
-			Handler:    _Query_RedelegationRecords_Handler,
-		},
-		{
-			MethodName: "MappedAccounts",
-			Handler:    _Query_MappedAccounts_Handler,
-		},
-		{
-			MethodName: "InverseMappedAccounts",
-			Handler:    _Query_InverseMappedAccounts_Handler,
-		},
-		{
-			MethodName: "ClaimedPercentage",
-			Handler:    _Query_ClaimedPercentage_Handler,
-		},
-		{
-			MethodName: "ClaimedPercentageByClaimType",
-			Handler:    _Query_ClaimedPercentageByClaimType_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "quicksilver/interchainstaking/v1/query.proto",
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Zones provides meta data on connected zones.
+	Zones(context.Context, *QueryZonesRequest) (*QueryZonesResponse, error)
+	// Zone provides meta data on a specific zone.
+	Zone(context.Context, *QueryZoneRequest) (*QueryZoneResponse, error)
+	ZoneValidators(context.Context, *QueryZoneValidatorsRequest) (*QueryZoneValidatorsResponse, error)
+	// DepositAccount provides data on the deposit address for a connected zone.
+	DepositAccount(context.Context, *QueryDepositAccountForChainRequest) (*QueryDepositAccountForChainResponse, error)
+	// DelegatorIntent provides data on the intent of the delegator for the given
+	// zone.
+	DelegatorIntent(context.Context, *QueryDelegatorIntentRequest) (*QueryDelegatorIntentResponse, error)
+	// DelegatorIntents provides data on the intent of the delegator for all zones
+	DelegatorIntents(context.Context, *QueryDelegatorIntentsRequest) (*QueryDelegatorIntentsResponse, error)
+	// Delegations provides data on the delegations for the given zone.
+	Delegations(context.Context, *QueryDelegationsRequest) (*QueryDelegationsResponse, error)
+	// Delegations provides data on the delegations for the given zone.
+	Receipts(context.Context, *QueryReceiptsRequest) (*QueryReceiptsResponse, error)
+	TxStatus(context.Context, *QueryTxStatusRequest) (*QueryTxStatusResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	ZoneWithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	UserZoneWithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	WithdrawalRecords(context.Context, *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
+	// WithdrawalRecords provides data on the active withdrawals.
+	UserWithdrawalRecords(context.Context, *QueryUserWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error)
+	// UnbondingRecords provides data on the active unbondings.
+	UnbondingRecords(context.Context, *QueryUnbondingRecordsRequest) (*QueryUnbondingRecordsResponse, error)
+	// RedelegationRecords provides data on the active unbondings.
+	RedelegationRecords(context.Context, *QueryRedelegationRecordsRequest) (*QueryRedelegationRecordsResponse, error)
+	// MappedAccounts provides data on the mapped accounts for a given user over
+	// different host chains.
+	MappedAccounts(context.Context, *QueryMappedAccountsRequest) (*QueryMappedAccountsResponse, error)
+	InverseMappedAccounts(context.Context, *QueryInverseMappedAccountsRequest) (*QueryInverseMappedAccountsResponse, error)
+	// CLaimedPercentage provides data on the claimed percentage for a given zone
+	ClaimedPercentage(context.Context, *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error)
+	// ClaimedPercentageByClaimType provides data on the claimed percentage of a
+	// given claim type in a given zone
+	ClaimedPercentageByClaimType(context.Context, *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error)
+	// RebalancePlan simulates the epochly rebalancing of a zone against current
+	// state, returning the redelegations that would be submitted.
+	RebalancePlan(context.Context, *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error)
+	// LiquidityBuffer provides the depth of the instant redemption liquidity
+	// buffer of a zone.
+	LiquidityBuffer(context.Context, *QueryLiquidityBufferRequest) (*QueryLiquidityBufferResponse, error)
+	// RedemptionRateHistory provides the recorded redemption rates of a zone.
+	RedemptionRateHistory(context.Context, *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error)
+	// RedemptionRateTWAP provides the time weighted average redemption rate of
+	// a zone over a window.
+	RedemptionRateTWAP(context.Context, *QueryRedemptionRateTWAPRequest) (*QueryRedemptionRateTWAPResponse, error)
 }
 
-func (m *Statistics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *Statistics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Zones(ctx context.Context, req *QueryZonesRequest) (*QueryZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zones not implemented")
+}
+func (*UnimplementedQueryServer) Zone(ctx context.Context, req *QueryZoneRequest) (*QueryZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Zone not implemented")
+}
+func (*UnimplementedQueryServer) ZoneValidators(ctx context.Context, req *QueryZoneValidatorsRequest) (*QueryZoneValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneValidators not implemented")
+}
+func (*UnimplementedQueryServer) DepositAccount(ctx context.Context, req *QueryDepositAccountForChainRequest) (*QueryDepositAccountForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAccount not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorIntent(ctx context.Context, req *QueryDelegatorIntentRequest) (*QueryDelegatorIntentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorIntent not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorIntents(ctx context.Context, req *QueryDelegatorIntentsRequest) (*QueryDelegatorIntentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorIntents not implemented")
+}
+func (*UnimplementedQueryServer) Delegations(ctx context.Context, req *QueryDelegationsRequest) (*QueryDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegations not implemented")
+}
+func (*UnimplementedQueryServer) Receipts(ctx context.Context, req *QueryReceiptsRequest) (*QueryReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receipts not implemented")
+}
+func (*UnimplementedQueryServer) TxStatus(ctx context.Context, req *QueryTxStatusRequest) (*QueryTxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (*UnimplementedQueryServer) ZoneWithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZoneWithdrawalRecords not implemented")
+}
+func (*UnimplementedQueryServer) UserZoneWithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserZoneWithdrawalRecords not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawalRecords(ctx context.Context, req *QueryWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalRecords not implemented")
+}
+func (*UnimplementedQueryServer) UserWithdrawalRecords(ctx context.Context, req *QueryUserWithdrawalRecordsRequest) (*QueryWithdrawalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserWithdrawalRecords not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingRecords(ctx context.Context, req *QueryUnbondingRecordsRequest) (*QueryUnbondingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingRecords not implemented")
+}
+func (*UnimplementedQueryServer) RedelegationRecords(ctx context.Context, req *QueryRedelegationRecordsRequest) (*QueryRedelegationRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationRecords not implemented")
+}
+func (*UnimplementedQueryServer) MappedAccounts(ctx context.Context, req *QueryMappedAccountsRequest) (*QueryMappedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MappedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) InverseMappedAccounts(ctx context.Context, req *QueryInverseMappedAccountsRequest) (*QueryInverseMappedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InverseMappedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ClaimedPercentage(ctx context.Context, req *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimedPercentage not implemented")
+}
+func (*UnimplementedQueryServer) ClaimedPercentageByClaimType(ctx context.Context, req *QueryClaimedPercentageRequest) (*QueryClaimedPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimedPercentageByClaimType not implemented")
+}
+func (*UnimplementedQueryServer) RebalancePlan(ctx context.Context, req *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePlan not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityBuffer(ctx context.Context, req *QueryLiquidityBufferRequest) (*QueryLiquidityBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityBuffer not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateHistory(ctx context.Context, req *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateTWAP(ctx context.Context, req *QueryRedemptionRateTWAPRequest) (*QueryRedemptionRateTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateTWAP not implemented")
 }
 
-func (m *Statistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondRecordCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondRecordCount))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.QueuedCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedCount))
-		i--
-		dAtA[i] = 0x58
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Zones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UnbondingCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingCount))
-		i--
-		dAtA[i] = 0x50
+	if interceptor == nil {
+		return srv.(QueryServer).Zones(ctx, in)
 	}
-	{
-		size, err := m.QueuedAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/Zones",
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.UnbondingAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Zones(ctx, req.(*QueryZonesRequest))
 	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.DistanceToTarget) > 0 {
-		i -= len(m.DistanceToTarget)
-		copy(dAtA[i:], m.DistanceToTarget)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistanceToTarget)))
-		i--
-		dAtA[i] = 0x3a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Zone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Supply.Size()
-		i -= size
-		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).Zone(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Delegated.Size()
-		i -= size
-		if _, err := m.Delegated.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/Zone",
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Depositors != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Depositors))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Zone(ctx, req.(*QueryZoneRequest))
 	}
-	if m.Deposits != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Deposits))
-		i--
-		dAtA[i] = 0x18
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ZoneValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZoneValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Deposited.Size()
-		i -= size
-		if _, err := m.Deposited.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).ZoneValidators(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/ZoneValidators",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZoneValidators(ctx, req.(*QueryZoneValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZonesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DepositAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAccountForChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZonesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).DepositAccount(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/DepositAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositAccount(ctx, req.(*QueryDepositAccountForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZonesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorIntent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorIntentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZonesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorIntent(ctx, in)
 	}
-	if len(m.Stats) > 0 {
-		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/DelegatorIntent",
 	}
-	if len(m.Zones) > 0 {
-		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorIntent(ctx, req.(*QueryDelegatorIntentRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DelegatorIntents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorIntentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorIntents(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/DelegatorIntents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorIntents(ctx, req.(*QueryDelegatorIntentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Delegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Stats != nil {
-		{
-			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Delegations(ctx, in)
 	}
-	{
-		size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/Delegations",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Delegations(ctx, req.(*QueryDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZoneValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Receipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiptsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Receipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/Receipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Receipts(ctx, req.(*QueryReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZoneValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZoneValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).TxStatus(ctx, in)
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/TxStatus",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxStatus(ctx, req.(*QueryTxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryZoneValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ZoneWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryZoneValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZoneValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ZoneWithdrawalRecords(ctx, in)
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/ZoneWithdrawalRecords",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZoneWithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositAccountForChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserZoneWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDepositAccountForChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDepositAccountForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).UserZoneWithdrawalRecords(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/UserZoneWithdrawalRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserZoneWithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositAccountForChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_WithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDepositAccountForChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDepositAccountForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositAccountAddress) > 0 {
-		i -= len(m.DepositAccountAddress)
-		copy(dAtA[i:], m.DepositAccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositAccountAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawalRecords(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/WithdrawalRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawalRecords(ctx, req.(*QueryWithdrawalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UserWithdrawalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserWithdrawalRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorIntentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).UserWithdrawalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/UserWithdrawalRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserWithdrawalRecords(ctx, req.(*QueryUserWithdrawalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Query_UnbondingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingRecords(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/UnbondingRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingRecords(ctx, req.(*QueryUnbondingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RedelegationRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/RedelegationRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationRecords(ctx, req.(*QueryRedelegationRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Intent != nil {
-		{
-			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_MappedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMappedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).MappedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/MappedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MappedAccounts(ctx, req.(*QueryMappedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InverseMappedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInverseMappedAccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorIntentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorIntentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).InverseMappedAccounts(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/InverseMappedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InverseMappedAccounts(ctx, req.(*QueryInverseMappedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegatorIntentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimedPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimedPercentageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DelegatorIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DelegatorIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Intent != nil {
-		{
-			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimedPercentage(ctx, in)
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/ClaimedPercentage",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimedPercentage(ctx, req.(*QueryClaimedPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegatorIntentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClaimedPercentageByClaimType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimedPercentageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegatorIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegatorIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Intents) > 0 {
-		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimedPercentageByClaimType(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/ClaimedPercentageByClaimType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimedPercentageByClaimType(ctx, req.(*QueryClaimedPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancePlanRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).RebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/RebalancePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalancePlan(ctx, req.(*QueryRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_LiquidityBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityBuffer(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/LiquidityBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityBuffer(ctx, req.(*QueryLiquidityBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RedemptionRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/RedemptionRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, req.(*QueryRedemptionRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RedemptionRateTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.interchainstaking.v1.Query/RedemptionRateTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateTWAP(ctx, req.(*QueryRedemptionRateTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "quicksilver.interchainstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			MethodName: "Zones",
+			Handler:    _Query_Zones_Handler,
+		},
+		{
+			MethodName: "Zone",
+			Handler:    _Query_Zone_Handler,
+		},
+		{
+			MethodName: "ZoneValidators",
+			Handler:    _Query_ZoneValidators_Handler,
+		},
+		{
+			MethodName: "DepositAccount",
+			Handler:    _Query_DepositAccount_Handler,
+		},
+		{
+			MethodName: "DelegatorIntent",
+			Handler:    _Query_DelegatorIntent_Handler,
+		},
+		{
+			MethodName: "DelegatorIntents",
+			Handler:    _Query_DelegatorIntents_Handler,
+		},
+		{
+			MethodName: "Delegations",
+			Handler:    _Query_Delegations_Handler,
+		},
+		{
+			MethodName: "Receipts",
+			Handler:    _Query_Receipts_Handler,
+		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Query_TxStatus_Handler,
+		},
+		{
+			MethodName: "ZoneWithdrawalRecords",
+			Handler:    _Query_ZoneWithdrawalRecords_Handler,
+		},
+		{
+			MethodName: "UserZoneWithdrawalRecords",
+			Handler:    _Query_UserZoneWithdrawalRecords_Handler,
+		},
+		{
+			MethodName: "WithdrawalRecords",
+			Handler:    _Query_WithdrawalRecords_Handler,
+		},
+		{
+			MethodName: "UserWithdrawalRecords",
+			Handler:    _Query_UserWithdrawalRecords_Handler,
+		},
+		{
+			MethodName: "UnbondingRecords",
+			Handler:    _Query_UnbondingRecords_Handler,
+		},
+		{
+			MethodName: "RedelegationRecords",
+			Handler:    _Query_RedelegationRecords_Handler,
+		},
+		{
+			MethodName: "MappedAccounts",
+			Handler:    _Query_MappedAccounts_Handler,
+		},
+		{
+			MethodName: "InverseMappedAccounts",
+			Handler:    _Query_InverseMappedAccounts_Handler,
+		},
+		{
+			MethodName: "ClaimedPercentage",
+			Handler:    _Query_ClaimedPercentage_Handler,
+		},
+		{
+			MethodName: "ClaimedPercentageByClaimType",
+			Handler:    _Query_ClaimedPercentageByClaimType_Handler,
+		},
+		{
+			MethodName: "RebalancePlan",
+			Handler:    _Query_RebalancePlan_Handler,
+		},
+		{
+			MethodName: "LiquidityBuffer",
+			Handler:    _Query_LiquidityBuffer_Handler,
+		},
+		{
+			MethodName: "RedemptionRateHistory",
+			Handler:    _Query_RedemptionRateHistory_Handler,
+		},
+		{
+			MethodName: "RedemptionRateTWAP",
+			Handler:    _Query_RedemptionRateTWAP_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quicksilver/interchainstaking/v1/query.proto",
+}
+
+func (m *Statistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Statistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Statistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondRecordCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondRecordCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.QueuedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UnbondingCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.QueuedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.UnbondingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.DistanceToTarget) > 0 {
+		i -= len(m.DistanceToTarget)
+		copy(dAtA[i:], m.DistanceToTarget)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistanceToTarget)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
 	{
-		size := m.Tvl.Size()
+		size := m.Supply.Size()
 		i -= size
-		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	dAtA[i] = 0x32
+	{
+		size := m.Delegated.Size()
+		i -= size
+		if _, err := m.Delegated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Depositors != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depositors))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deposits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Deposits))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Deposited.Size()
+		i -= size
+		if _, err := m.Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReceiptsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZonesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3522,12 +3633,12 @@ func (m *QueryReceiptsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZonesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3542,19 +3653,12 @@ func (m *QueryReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryReceiptsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryZonesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3564,12 +3668,12 @@ func (m *QueryReceiptsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZonesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3584,12 +3688,26 @@ func (m *QueryReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.Receipts) > 0 {
-		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3603,7 +3721,7 @@ func (m *QueryReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZoneRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3613,23 +3731,16 @@ func (m *QueryTxStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZoneRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -3640,7 +3751,7 @@ func (m *QueryTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryZoneResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3650,19 +3761,19 @@ func (m *QueryTxStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZoneResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Receipt != nil {
+	if m.Stats != nil {
 		{
-			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3670,12 +3781,22 @@ func (m *QueryTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Zone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZoneValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3685,12 +3806,12 @@ func (m *QueryWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWithdrawalRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZoneValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZoneValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3707,10 +3828,10 @@ func (m *QueryWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3724,7 +3845,7 @@ func (m *QueryWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawalRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryZoneValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3734,12 +3855,12 @@ func (m *QueryWithdrawalRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWithdrawalRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZoneValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWithdrawalRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZoneValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3756,10 +3877,10 @@ func (m *QueryWithdrawalRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Withdrawals) > 0 {
-		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3773,7 +3894,7 @@ func (m *QueryWithdrawalRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDepositAccountForChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3783,39 +3904,57 @@ func (m *QueryUserWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUserWithdrawalRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDepositAccountForChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUserWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDepositAccountForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.UserAddress) > 0 {
-		i -= len(m.UserAddress)
-		copy(dAtA[i:], m.UserAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAccountForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAccountForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAccountForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositAccountAddress) > 0 {
+		i -= len(m.DepositAccountAddress)
+		copy(dAtA[i:], m.DepositAccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositAccountAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorIntentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3825,25 +3964,20 @@ func (m *QueryUnbondingRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUnbondingRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUnbondingRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3857,7 +3991,7 @@ func (m *QueryUnbondingRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorIntentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3867,19 +4001,19 @@ func (m *QueryUnbondingRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUnbondingRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUnbondingRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Intent != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3887,26 +4021,42 @@ func (m *QueryUnbondingRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorIntentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorIntentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorIntentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRedelegationRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorIntentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3916,19 +4066,19 @@ func (m *QueryRedelegationRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRedelegationRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRedelegationRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Intent != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Intent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3948,7 +4098,7 @@ func (m *QueryRedelegationRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRedelegationRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegatorIntentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3958,32 +4108,20 @@ func (m *QueryRedelegationRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRedelegationRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRedelegationRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegatorIntentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Redelegations) > 0 {
-		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Intents) > 0 {
+		for iNdEx := len(m.Intents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Intents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3997,7 +4135,7 @@ func (m *QueryRedelegationRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4007,27 +4145,39 @@ func (m *QueryMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMappedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMappedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMappedAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDelegationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4037,31 +4187,48 @@ func (m *QueryMappedAccountsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMappedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMappedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RemoteAddressMap) > 0 {
-		for k := range m.RemoteAddressMap {
-			v := m.RemoteAddressMap[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Tvl.Size()
+		i -= size
+		if _, err := m.Tvl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4069,7 +4236,7 @@ func (m *QueryMappedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInverseMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryReceiptsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4079,34 +4246,39 @@ func (m *QueryInverseMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryInverseMappedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInverseMappedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RemoteAddress) > 0 {
-		i -= len(m.RemoteAddress)
-		copy(dAtA[i:], m.RemoteAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RemoteAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryInverseMappedAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryReceiptsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4116,27 +4288,46 @@ func (m *QueryInverseMappedAccountsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryInverseMappedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInverseMappedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LocalAddress) > 0 {
-		i -= len(m.LocalAddress)
-		copy(dAtA[i:], m.LocalAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LocalAddress)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenyListRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4146,16 +4337,23 @@ func (m *QueryDenyListRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
@@ -4166,7 +4364,7 @@ func (m *QueryDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenyListResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTxStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4176,19 +4374,19 @@ func (m *QueryDenyListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Receipt != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4196,21 +4394,12 @@ func (m *QueryDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Validators[iNdEx])
-			copy(dAtA[i:], m.Validators[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedPercentageRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4220,20 +4409,34 @@ func (m *QueryClaimedPercentageRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedPercentageRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClaimType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimType))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
@@ -4245,7 +4448,7 @@ func (m *QueryClaimedPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedPercentageResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryWithdrawalRecordsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4255,648 +4458,2729 @@ func (m *QueryClaimedPercentageResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryWithdrawalRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryUserWithdrawalRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Statistics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryUserWithdrawalRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserWithdrawalRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Deposited.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Deposits != 0 {
-		n += 1 + sovQuery(uint64(m.Deposits))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Depositors != 0 {
-		n += 1 + sovQuery(uint64(m.Depositors))
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Delegated.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Supply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.DistanceToTarget)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.UnbondingAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QueuedAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.UnbondingCount != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingCount))
-	}
-	if m.QueuedCount != 0 {
-		n += 1 + sovQuery(uint64(m.QueuedCount))
-	}
-	if m.UnbondRecordCount != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondRecordCount))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZonesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZonesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Zones) > 0 {
-		for _, e := range m.Zones {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRedelegationRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Zone.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRedelegationRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryZoneValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRedelegationRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZoneValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRedelegationRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedelegationRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedelegationRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDepositAccountForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDepositAccountForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DepositAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryMappedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegatorIntentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMappedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorIntentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Intent != nil {
-		l = m.Intent.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMappedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegatorIntentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryMappedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorIntentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMappedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Intent != nil {
-		l = m.Intent.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemoteAddressMap) > 0 {
+		for k := range m.RemoteAddressMap {
+			v := m.RemoteAddressMap[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegatorIntentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Intents) > 0 {
-		for _, e := range m.Intents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryInverseMappedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryInverseMappedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInverseMappedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Tvl.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemoteAddress) > 0 {
+		i -= len(m.RemoteAddress)
+		copy(dAtA[i:], m.RemoteAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RemoteAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReceiptsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryInverseMappedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReceiptsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Receipts) > 0 {
-		for _, e := range m.Receipts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryInverseMappedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTxStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInverseMappedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LocalAddress) > 0 {
+		i -= len(m.LocalAddress)
+		copy(dAtA[i:], m.LocalAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LocalAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTxStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Receipt != nil {
-		l = m.Receipt.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDenyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWithdrawalRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawalRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Withdrawals) > 0 {
-		for _, e := range m.Withdrawals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUserWithdrawalRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClaimedPercentageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryUnbondingRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClaimedPercentageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedPercentageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ClaimType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnbondingRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimedPercentageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedPercentageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedPercentageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRedelegationRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRebalancePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRedelegationRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllocationDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AllocationDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllocationDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Redelegations) > 0 {
-		for _, e := range m.Redelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMappedAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRebalancePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMappedAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRebalancePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RemoteAddressMap) > 0 {
-		for k, v := range m.RemoteAddressMap {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if len(m.PlannedDistanceToTarget) > 0 {
+		i -= len(m.PlannedDistanceToTarget)
+		copy(dAtA[i:], m.PlannedDistanceToTarget)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlannedDistanceToTarget)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if len(m.DistanceToTarget) > 0 {
+		i -= len(m.DistanceToTarget)
+		copy(dAtA[i:], m.DistanceToTarget)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistanceToTarget)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RedelegationRecords) > 0 {
+		for iNdEx := len(m.RedelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockedValidators) > 0 {
+		for iNdEx := len(m.LockedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedValidators[iNdEx])
+			copy(dAtA[i:], m.LockedValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.LockedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInverseMappedAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLiquidityBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RemoteAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInverseMappedAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLiquidityBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LocalAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Available.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Buffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRedemptionRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenyListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRedemptionRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Validators) > 0 {
-		for _, s := range m.Validators {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Samples) > 0 {
+		for iNdEx := len(m.Samples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Samples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedPercentageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRedemptionRateTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
 	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ClaimType != 0 {
-		n += 1 + sovQuery(uint64(m.ClaimType))
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintQuery(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintQuery(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Statistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Deposited.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Deposits != 0 {
+		n += 1 + sovQuery(uint64(m.Deposits))
+	}
+	if m.Depositors != 0 {
+		n += 1 + sovQuery(uint64(m.Depositors))
+	}
+	l = m.Delegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DistanceToTarget)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UnbondingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QueuedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UnbondingCount != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingCount))
+	}
+	if m.QueuedCount != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedCount))
+	}
+	if m.UnbondRecordCount != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondRecordCount))
+	}
+	return n
+}
+
+func (m *QueryZonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Zone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZoneValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositAccountForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositAccountForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorIntentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorIntentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorIntentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Intent != nil {
+		l = m.Intent.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorIntentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Intents) > 0 {
+		for _, e := range m.Intents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Tvl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserWithdrawalRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedelegationRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMappedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMappedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteAddressMap) > 0 {
+		for k, v := range m.RemoteAddressMap {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryInverseMappedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RemoteAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInverseMappedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LocalAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedPercentageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimType))
+	}
+	return n
+}
+
+func (m *QueryClaimedPercentageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Percentage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRebalancePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllocationDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRebalancePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.LockedValidators) > 0 {
+		for _, s := range m.LockedValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RedelegationRecords) > 0 {
+		for _, e := range m.RedelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.DistanceToTarget)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PlannedDistanceToTarget)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Buffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Available.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovQuery(uint64(m.Samples))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Statistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Statistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Statistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			m.Deposits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deposits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositors", wireType)
+			}
+			m.Depositors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depositors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceToTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistanceToTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCount", wireType)
+			}
+			m.UnbondingCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCount", wireType)
+			}
+			m.QueuedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondRecordCount", wireType)
+			}
+			m.UnbondRecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondRecordCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, Zone{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &Statistics{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &Statistics{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZoneValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZoneValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZoneValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryClaimedPercentageResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Percentage.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Statistics) Unmarshal(dAtA []byte) error {
+func (m *QueryZoneValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4919,17 +7203,17 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Statistics: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryZoneValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Statistics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryZoneValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4939,29 +7223,31 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4971,50 +7257,83 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Deposits = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Deposits |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositors", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Depositors = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAccountForChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAccountForChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAccountForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5024,14 +7343,77 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Depositors |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositAccountForChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositAccountForChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositAccountForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAccountAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5059,13 +7441,61 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DepositAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorIntentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorIntentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorIntentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5093,13 +7523,11 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistanceToTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5127,44 +7555,61 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistanceToTarget = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorIntentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorIntentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5191,67 +7636,13 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QueuedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Intent == nil {
+				m.Intent = &DelegatorIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCount", wireType)
-			}
-			m.UnbondingCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedCount", wireType)
-			}
-			m.QueuedCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.QueuedCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondRecordCount", wireType)
-			}
-			m.UnbondRecordCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondRecordCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5273,7 +7664,7 @@ func (m *Statistics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZonesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorIntentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5296,17 +7687,17 @@ func (m *QueryZonesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZonesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorIntentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorIntentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5316,27 +7707,23 @@ func (m *QueryZonesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5359,7 +7746,7 @@ func (m *QueryZonesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
+func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5382,17 +7769,17 @@ func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZonesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorIntentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5402,29 +7789,27 @@ func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Zones = append(m.Zones, Zone{})
-			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5451,14 +7836,66 @@ func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stats = append(m.Stats, &Statistics{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Intent == nil {
+				m.Intent = &DelegatorIntent{}
+			}
+			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorIntentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5485,10 +7922,8 @@ func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Intents = append(m.Intents, &DelegatorIntentsResponse{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5513,7 +7948,7 @@ func (m *QueryZonesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5536,10 +7971,10 @@ func (m *QueryZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5574,6 +8009,42 @@ func (m *QueryZoneRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5595,7 +8066,7 @@ func (m *QueryZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5618,15 +8089,15 @@ func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5653,13 +8124,48 @@ func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Zone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5686,10 +8192,10 @@ func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &Statistics{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5714,7 +8220,7 @@ func (m *QueryZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZoneValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5737,10 +8243,10 @@ func (m *QueryZoneValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZoneValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReceiptsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZoneValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5776,38 +8282,6 @@ func (m *QueryZoneValidatorsRequest) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5864,7 +8338,7 @@ func (m *QueryZoneValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZoneValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5887,15 +8361,15 @@ func (m *QueryZoneValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZoneValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReceiptsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZoneValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5922,8 +8396,8 @@ func (m *QueryZoneValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Receipts = append(m.Receipts, Receipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5984,7 +8458,7 @@ func (m *QueryZoneValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositAccountForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6007,10 +8481,10 @@ func (m *QueryDepositAccountForChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountForChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6045,6 +8519,38 @@ func (m *QueryDepositAccountForChainRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6066,7 +8572,7 @@ func (m *QueryDepositAccountForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositAccountForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6089,17 +8595,17 @@ func (m *QueryDepositAccountForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6109,23 +8615,27 @@ func (m *QueryDepositAccountForChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositAccountAddress = string(dAtA[iNdEx:postIndex])
+			if m.Receipt == nil {
+				m.Receipt = &Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6148,7 +8658,7 @@ func (m *QueryDepositAccountForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorIntentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6171,10 +8681,10 @@ func (m *QueryDelegatorIntentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorIntentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorIntentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6239,7 +8749,43 @@ func (m *QueryDelegatorIntentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6262,7 +8808,7 @@ func (m *QueryDelegatorIntentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorIntentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6285,15 +8831,15 @@ func (m *QueryDelegatorIntentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorIntentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorIntentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6320,10 +8866,44 @@ func (m *QueryDelegatorIntentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Intent == nil {
-				m.Intent = &DelegatorIntent{}
+			m.Withdrawals = append(m.Withdrawals, WithdrawalRecord{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6348,7 +8928,7 @@ func (m *QueryDelegatorIntentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorIntentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6371,15 +8951,15 @@ func (m *QueryDelegatorIntentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorIntentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUserWithdrawalRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorIntentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUserWithdrawalRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6407,7 +8987,43 @@ func (m *QueryDelegatorIntentsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6430,7 +9046,7 @@ func (m *QueryDelegatorIntentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6453,10 +9069,10 @@ func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorIntentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6493,7 +9109,7 @@ func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6520,10 +9136,10 @@ func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Intent == nil {
-				m.Intent = &DelegatorIntent{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Intent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6548,7 +9164,7 @@ func (m *DelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6571,15 +9187,15 @@ func (m *QueryDelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorIntentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorIntentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6606,8 +9222,44 @@ func (m *QueryDelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Intents = append(m.Intents, &DelegatorIntentsResponse{})
-			if err := m.Intents[len(m.Intents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Unbondings = append(m.Unbondings, UnbondingRecord{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6632,7 +9284,7 @@ func (m *QueryDelegatorIntentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedelegationRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6655,10 +9307,10 @@ func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedelegationRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedelegationRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6750,7 +9402,7 @@ func (m *QueryDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedelegationRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6773,15 +9425,15 @@ func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedelegationRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedelegationRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6808,46 +9460,12 @@ func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, Delegation{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redelegations = append(m.Redelegations, RedelegationRecord{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tvl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Tvl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6904,7 +9522,7 @@ func (m *QueryDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6927,15 +9545,15 @@ func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReceiptsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMappedAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMappedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6961,45 +9579,9 @@ func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7022,7 +9604,7 @@ func (m *QueryReceiptsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7045,15 +9627,15 @@ func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReceiptsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMappedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMappedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddressMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7080,46 +9662,103 @@ func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, Receipt{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if m.RemoteAddressMap == nil {
+				m.RemoteAddressMap = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RemoteAddressMap[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7142,7 +9781,7 @@ func (m *QueryReceiptsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7165,15 +9804,15 @@ func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInverseMappedAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInverseMappedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7201,11 +9840,11 @@ func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7233,7 +9872,7 @@ func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7256,7 +9895,7 @@ func (m *QueryTxStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInverseMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7279,17 +9918,17 @@ func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInverseMappedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInverseMappedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7299,27 +9938,23 @@ func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Receipt == nil {
-				m.Receipt = &Receipt{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LocalAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7342,7 +9977,7 @@ func (m *QueryTxStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,87 +9994,23 @@ func (m *QueryWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalRecordsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenyListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7449,27 +10020,23 @@ func (m *QueryWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7492,7 +10059,7 @@ func (m *QueryWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawalRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7515,17 +10082,17 @@ func (m *QueryWithdrawalRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawalRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenyListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawalRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7535,25 +10102,23 @@ func (m *QueryWithdrawalRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawals = append(m.Withdrawals, WithdrawalRecord{})
-			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -7612,7 +10177,7 @@ func (m *QueryWithdrawalRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedPercentageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7635,15 +10200,15 @@ func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserWithdrawalRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedPercentageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserWithdrawalRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedPercentageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7671,13 +10236,13 @@ func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
 			}
-			var msglen int
+			m.ClaimType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7687,28 +10252,11 @@ func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClaimType |= types1.ClaimType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7730,7 +10278,7 @@ func (m *QueryUserWithdrawalRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedPercentageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7753,15 +10301,15 @@ func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedPercentageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7789,13 +10337,65 @@ func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRebalancePlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7805,27 +10405,23 @@ func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7848,7 +10444,7 @@ func (m *QueryUnbondingRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *AllocationDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7871,17 +10467,17 @@ func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocationDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocationDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7891,31 +10487,29 @@ func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, UnbondingRecord{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7925,25 +10519,23 @@ func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7968,7 +10560,7 @@ func (m *QueryUnbondingRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRedelegationRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7991,17 +10583,17 @@ func (m *QueryRedelegationRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8011,27 +10603,29 @@ func (m *QueryRedelegationRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Targets = append(m.Targets, &AllocationDelta{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8058,64 +10652,12 @@ func (m *QueryRedelegationRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRedelegationRecordsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sources = append(m.Sources, &AllocationDelta{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRedelegationRecordsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRedelegationRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
@@ -8144,14 +10686,46 @@ func (m *QueryRedelegationRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Redelegations = append(m.Redelegations, RedelegationRecord{})
+			m.Redelegations = append(m.Redelegations, types2.MsgBeginRedelegate{})
 			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedValidators = append(m.LockedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8178,66 +10752,46 @@ func (m *QueryRedelegationRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RedelegationRecords = append(m.RedelegationRecords, RedelegationRecord{})
+			if err := m.RedelegationRecords[len(m.RedelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistanceToTarget", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMappedAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMappedAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMappedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DistanceToTarget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedDistanceToTarget", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8265,7 +10819,7 @@ func (m *QueryMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.PlannedDistanceToTarget = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8288,7 +10842,7 @@ func (m *QueryMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMappedAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityBufferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8311,138 +10865,43 @@ func (m *QueryMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMappedAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityBufferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMappedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddressMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RemoteAddressMap == nil {
-				m.RemoteAddressMap = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.RemoteAddressMap[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8465,7 +10924,7 @@ func (m *QueryMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityBufferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8488,17 +10947,17 @@ func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInverseMappedAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityBufferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInverseMappedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8508,27 +10967,61 @@ func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Buffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Available.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8556,7 +11049,9 @@ func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8579,7 +11074,7 @@ func (m *QueryInverseMappedAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInverseMappedAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8602,15 +11097,15 @@ func (m *QueryInverseMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInverseMappedAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInverseMappedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8638,8 +11133,46 @@ func (m *QueryInverseMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8661,7 +11194,7 @@ func (m *QueryInverseMappedAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8684,17 +11217,17 @@ func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenyListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8704,23 +11237,25 @@ func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Samples = append(m.Samples, RedemptionRateSample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8743,7 +11278,7 @@ func (m *QueryDenyListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8766,15 +11301,15 @@ func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenyListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8802,13 +11337,13 @@ func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var msglen int
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8818,28 +11353,11 @@ func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8861,7 +11379,7 @@ func (m *QueryDenyListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimedPercentageRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRateTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8884,15 +11402,15 @@ func (m *QueryClaimedPercentageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedPercentageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRateTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedPercentageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRateTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8920,13 +11438,15 @@ func (m *QueryClaimedPercentageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.ClaimType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8936,66 +11456,30 @@ func (m *QueryClaimedPercentageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClaimType |= types1.ClaimType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryClaimedPercentageResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedPercentageResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedPercentageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9005,26 +11489,44 @@ func (m *QueryClaimedPercentageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
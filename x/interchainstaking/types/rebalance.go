@@ -85,9 +85,30 @@ func CalculateAllocationDeltas(
 	return targets, sources
 }
 
-type AllocationDelta struct {
-	ValoperAddress string
-	Amount         sdkmath.Int
+// DistanceBetweenIntents returns the euclidean distance between current and target intents, over the union of
+// their validators. It is reported by the RebalancePlan query, whose planned intents may include validators that
+// Keeper.DistanceToTarget, summed over the zone validator set, would not account for.
+func DistanceBetweenIntents(current, target ValidatorIntents) float64 {
+	valopers := make([]string, 0, len(current)+len(target))
+	for _, intents := range []ValidatorIntents{current, target} {
+		for _, intent := range intents {
+			valopers = append(valopers, intent.ValoperAddress)
+		}
+	}
+
+	preSqRt := sdk.ZeroDec()
+	for _, valoper := range utils.Unique(valopers) {
+		c := current.MustGetForValoper(valoper)
+		t := target.MustGetForValoper(valoper)
+		v := c.Weight.Sub(t.Weight)
+		preSqRt = preSqRt.AddMut(v.Mul(v))
+	}
+
+	psqrtf, err := preSqRt.Float64()
+	if err != nil {
+		panic("this value should never be greater than 64-bit dec!")
+	}
+	return math.Sqrt(psqrtf)
 }
 
 type AllocationDeltas []*AllocationDelta
@@ -237,9 +258,65 @@ func (tgts RebalanceTargets) RemoveDuplicates() RebalanceTargets {
 	return result
 }
 
-// DetermineAllocationsForRebalancing takes maps of current and locked delegations, and based upon the target allocations,
-// attempts to satisfy the target allocations in the fewest number of transformations. It returns a slice of RebalanceTargets.
-func DetermineAllocationsForRebalancing(
+const (
+	// RebalanceStrategyGreedy moves toward the target allocations as far as the rebalance budget allows.
+	RebalanceStrategyGreedy = "greedy"
+	// RebalanceStrategyThreshold only moves validators deviating from their target allocation by more than
+	// the zone rebalance threshold.
+	RebalanceStrategyThreshold = "threshold"
+	// RebalanceStrategyCappedTurnover limits the rebalance budget to the zone max turnover.
+	RebalanceStrategyCappedTurnover = "capped_turnover"
+)
+
+// ValidateRebalanceStrategy returns an error if name is not a known rebalance strategy.
+func ValidateRebalanceStrategy(name string) error {
+	switch name {
+	case RebalanceStrategyGreedy, RebalanceStrategyThreshold, RebalanceStrategyCappedTurnover:
+		return nil
+	default:
+		return fmt.Errorf("unknown rebalance strategy: %s", name)
+	}
+}
+
+// RebalanceStrategy determines the redelegations required to move current delegations toward the target allocations.
+type RebalanceStrategy interface {
+	DetermineAllocationsForRebalancing(
+		currentAllocations map[string]sdkmath.Int,
+		currentLocked map[string]bool,
+		currentSum sdkmath.Int,
+		lockedSum sdkmath.Int,
+		targetAllocations ValidatorIntents,
+		maxCanAllocate map[string]sdkmath.Int,
+		logger log.Logger,
+	) RebalanceTargets
+}
+
+// GreedyRebalanceStrategy implements RebalanceStrategy using DetermineAllocationsForRebalancing.
+type GreedyRebalanceStrategy struct{}
+
+var _ RebalanceStrategy = GreedyRebalanceStrategy{}
+
+func (GreedyRebalanceStrategy) DetermineAllocationsForRebalancing(
+	currentAllocations map[string]sdkmath.Int,
+	currentLocked map[string]bool,
+	currentSum sdkmath.Int,
+	lockedSum sdkmath.Int,
+	targetAllocations ValidatorIntents,
+	maxCanAllocate map[string]sdkmath.Int,
+	logger log.Logger,
+) RebalanceTargets {
+	return DetermineAllocationsForRebalancing(currentAllocations, currentLocked, currentSum, lockedSum, targetAllocations, maxCanAllocate, logger)
+}
+
+// ThresholdRebalanceStrategy implements RebalanceStrategy, ignoring validators whose deviation from target allocation
+// does not exceed Threshold, expressed as a fraction of total delegations.
+type ThresholdRebalanceStrategy struct {
+	Threshold sdk.Dec
+}
+
+var _ RebalanceStrategy = ThresholdRebalanceStrategy{}
+
+func (s ThresholdRebalanceStrategy) DetermineAllocationsForRebalancing(
 	currentAllocations map[string]sdkmath.Int,
 	currentLocked map[string]bool,
 	currentSum sdkmath.Int,
@@ -248,17 +325,87 @@ func DetermineAllocationsForRebalancing(
 	maxCanAllocate map[string]sdkmath.Int,
 	logger log.Logger,
 ) RebalanceTargets {
-	out := make(RebalanceTargets, 0)
 	targets, sources := CalculateAllocationDeltas(currentAllocations, currentLocked, currentSum, targetAllocations, maxCanAllocate)
 
+	minDelta := s.Threshold.MulInt(currentSum).TruncateInt()
+	filter := func(deltas AllocationDeltas) AllocationDeltas {
+		out := make(AllocationDeltas, 0, len(deltas))
+		for _, delta := range deltas {
+			if delta.Amount.GT(minDelta) {
+				out = append(out, delta)
+			}
+		}
+		return out
+	}
+
+	if logger != nil {
+		logger.Debug("Rebalancing with threshold", "threshold", s.Threshold, "minDelta", minDelta)
+	}
+
+	return allocateRebalances(filter(targets), filter(sources), rebalanceBudget(currentSum, lockedSum), logger)
+}
+
+// CappedTurnoverRebalanceStrategy implements RebalanceStrategy, limiting the amount redelegated per epoch to
+// MaxTurnover, expressed as a fraction of total delegations.
+type CappedTurnoverRebalanceStrategy struct {
+	MaxTurnover sdk.Dec
+}
+
+var _ RebalanceStrategy = CappedTurnoverRebalanceStrategy{}
+
+func (s CappedTurnoverRebalanceStrategy) DetermineAllocationsForRebalancing(
+	currentAllocations map[string]sdkmath.Int,
+	currentLocked map[string]bool,
+	currentSum sdkmath.Int,
+	lockedSum sdkmath.Int,
+	targetAllocations ValidatorIntents,
+	maxCanAllocate map[string]sdkmath.Int,
+	logger log.Logger,
+) RebalanceTargets {
+	targets, sources := CalculateAllocationDeltas(currentAllocations, currentLocked, currentSum, targetAllocations, maxCanAllocate)
+
+	budget := sdkmath.MinInt(rebalanceBudget(currentSum, lockedSum), s.MaxTurnover.MulInt(currentSum).TruncateInt())
+
+	if logger != nil {
+		logger.Debug("Rebalancing with capped turnover", "maxTurnover", s.MaxTurnover, "rebalanceBudget", budget)
+	}
+
+	return allocateRebalances(targets, sources, budget, logger)
+}
+
+// rebalanceBudget returns the maximum amount that may be redelegated in a single rebalance.
+func rebalanceBudget(currentSum, lockedSum sdkmath.Int) sdkmath.Int {
 	// rebalanceBudget = (total_delegations - locked)/2 == 50% of (total_delegations - locked)
 	// TODO: make this 2 (max_redelegation_factor) a param.
-	rebalanceBudget := currentSum.Sub(lockedSum).Quo(sdk.NewInt(2))
+	return currentSum.Sub(lockedSum).Quo(sdk.NewInt(2))
+}
+
+// DetermineAllocationsForRebalancing takes maps of current and locked delegations, and based upon the target allocations,
+// attempts to satisfy the target allocations in the fewest number of transformations. It returns a slice of RebalanceTargets.
+func DetermineAllocationsForRebalancing(
+	currentAllocations map[string]sdkmath.Int,
+	currentLocked map[string]bool,
+	currentSum sdkmath.Int,
+	lockedSum sdkmath.Int,
+	targetAllocations ValidatorIntents,
+	maxCanAllocate map[string]sdkmath.Int,
+	logger log.Logger,
+) RebalanceTargets {
+	targets, sources := CalculateAllocationDeltas(currentAllocations, currentLocked, currentSum, targetAllocations, maxCanAllocate)
+	budget := rebalanceBudget(currentSum, lockedSum)
 
 	if logger != nil {
-		logger.Debug("Rebalancing", "total", currentSum, "totalLocked", lockedSum, "rebalanceBudget", rebalanceBudget)
+		logger.Debug("Rebalancing", "total", currentSum, "totalLocked", lockedSum, "rebalanceBudget", budget)
 	}
 
+	return allocateRebalances(targets, sources, budget, logger)
+}
+
+// allocateRebalances matches target (underallocated) and source (overallocated) deltas, satisfying the largest
+// targets first, until either the targets are satisfied or rebalanceBudget is exhausted.
+func allocateRebalances(targets, sources AllocationDeltas, rebalanceBudget sdkmath.Int, logger log.Logger) RebalanceTargets {
+	out := make(RebalanceTargets, 0)
+
 TARGET:
 	// targets are validators with a delegation deficit, sorted in descending order.
 	// that is, those at the top should be satisfied first to maximise progress toward goal.
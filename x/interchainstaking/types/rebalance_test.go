@@ -386,3 +386,211 @@ func TestDetermineAllocationsForRebalancing(t *testing.T) {
 		})
 	}
 }
+
+func TestDistanceBetweenIntents(t *testing.T) {
+	vals := addressutils.GenerateValidatorsSorted(3)
+
+	tests := []struct {
+		name     string
+		current  types.ValidatorIntents
+		target   types.ValidatorIntents
+		expected float64
+	}{
+		{
+			name:     "empty",
+			current:  types.ValidatorIntents{},
+			target:   types.ValidatorIntents{},
+			expected: 0,
+		},
+		{
+			name: "on target",
+			current: types.ValidatorIntents{
+				{ValoperAddress: vals[0], Weight: sdk.NewDecWithPrec(5, 1)},
+				{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			target: types.ValidatorIntents{
+				{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(5, 1)},
+				{ValoperAddress: vals[0], Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			expected: 0,
+		},
+		{
+			name: "disjoint validators",
+			current: types.ValidatorIntents{
+				{ValoperAddress: vals[0], Weight: sdk.NewDecWithPrec(6, 1)},
+				{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+			target: types.ValidatorIntents{
+				{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(4, 1)},
+				{ValoperAddress: vals[2], Weight: sdk.NewDecWithPrec(6, 1)},
+			},
+			expected: 0.848528,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.InDelta(t, tt.expected, types.DistanceBetweenIntents(tt.current, tt.target), 0.000001)
+		})
+	}
+}
+
+func TestRebalanceStrategies(t *testing.T) {
+	vals := addressutils.GenerateValidatorsSorted(4)
+
+	evenAllocations := map[string]math.Int{
+		vals[0]: math.NewInt(10),
+		vals[1]: math.NewInt(10),
+		vals[2]: math.NewInt(10),
+		vals[3]: math.NewInt(10),
+	}
+	skewedAllocations := map[string]math.Int{
+		vals[0]: math.NewInt(16),
+		vals[1]: math.NewInt(10),
+		vals[2]: math.NewInt(9),
+		vals[3]: math.NewInt(5),
+	}
+	halfIntent := types.ValidatorIntents{
+		&types.ValidatorIntent{ValoperAddress: vals[0], Weight: sdk.NewDecWithPrec(5, 1)},
+		&types.ValidatorIntent{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(5, 1)},
+	}
+	evenIntent := types.ValidatorIntents{
+		&types.ValidatorIntent{ValoperAddress: vals[0], Weight: sdk.NewDecWithPrec(25, 2)},
+		&types.ValidatorIntent{ValoperAddress: vals[1], Weight: sdk.NewDecWithPrec(25, 2)},
+		&types.ValidatorIntent{ValoperAddress: vals[2], Weight: sdk.NewDecWithPrec(25, 2)},
+		&types.ValidatorIntent{ValoperAddress: vals[3], Weight: sdk.NewDecWithPrec(25, 2)},
+	}
+
+	type testcase struct {
+		name        string
+		strategy    types.RebalanceStrategy
+		allocations map[string]math.Int
+		target      types.ValidatorIntents
+		locked      map[string]bool
+		expected    types.RebalanceTargets
+	}
+
+	tcs := []testcase{
+		{
+			name:        "greedy",
+			strategy:    types.GreedyRebalanceStrategy{},
+			allocations: evenAllocations,
+			target:      halfIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[2], Target: vals[0], Amount: math.NewInt(10)},
+				{Source: vals[3], Target: vals[1], Amount: math.NewInt(10)},
+			},
+		},
+		{
+			name:        "greedy, skewed",
+			strategy:    types.GreedyRebalanceStrategy{},
+			allocations: skewedAllocations,
+			target:      evenIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[0], Target: vals[3], Amount: math.NewInt(5)},
+				{Source: vals[0], Target: vals[2], Amount: math.NewInt(1)},
+			},
+		},
+		{
+			name:        "threshold, zero threshold behaves as greedy",
+			strategy:    types.ThresholdRebalanceStrategy{Threshold: sdk.ZeroDec()},
+			allocations: skewedAllocations,
+			target:      evenIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[0], Target: vals[3], Amount: math.NewInt(5)},
+				{Source: vals[0], Target: vals[2], Amount: math.NewInt(1)},
+			},
+		},
+		{
+			name:        "threshold 10%, small deviations ignored",
+			strategy:    types.ThresholdRebalanceStrategy{Threshold: sdk.NewDecWithPrec(1, 1)},
+			allocations: skewedAllocations,
+			target:      evenIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[0], Target: vals[3], Amount: math.NewInt(5)},
+			},
+		},
+		{
+			name:     "threshold 10%, no deviation exceeds threshold",
+			strategy: types.ThresholdRebalanceStrategy{Threshold: sdk.NewDecWithPrec(1, 1)},
+			allocations: map[string]math.Int{
+				vals[0]: math.NewInt(12),
+				vals[1]: math.NewInt(10),
+				vals[2]: math.NewInt(10),
+				vals[3]: math.NewInt(8),
+			},
+			target:   evenIntent,
+			locked:   map[string]bool{},
+			expected: types.RebalanceTargets{},
+		},
+		{
+			name:        "threshold 10%, locked source",
+			strategy:    types.ThresholdRebalanceStrategy{Threshold: sdk.NewDecWithPrec(1, 1)},
+			allocations: skewedAllocations,
+			target:      evenIntent,
+			locked:      map[string]bool{vals[0]: true},
+			expected:    types.RebalanceTargets{},
+		},
+		{
+			name:        "capped turnover 10%",
+			strategy:    types.CappedTurnoverRebalanceStrategy{MaxTurnover: sdk.NewDecWithPrec(1, 1)},
+			allocations: evenAllocations,
+			target:      halfIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[2], Target: vals[0], Amount: math.NewInt(4)},
+			},
+		},
+		{
+			name:        "capped turnover 30%",
+			strategy:    types.CappedTurnoverRebalanceStrategy{MaxTurnover: sdk.NewDecWithPrec(3, 1)},
+			allocations: evenAllocations,
+			target:      halfIntent,
+			locked:      map[string]bool{},
+			expected: types.RebalanceTargets{
+				{Source: vals[2], Target: vals[0], Amount: math.NewInt(10)},
+				{Source: vals[3], Target: vals[1], Amount: math.NewInt(2)},
+			},
+		},
+		{
+			name:        "capped turnover 100%, constrained by locked budget",
+			strategy:    types.CappedTurnoverRebalanceStrategy{MaxTurnover: sdk.OneDec()},
+			allocations: evenAllocations,
+			target:      halfIntent,
+			locked:      map[string]bool{vals[3]: true},
+			expected: types.RebalanceTargets{
+				{Source: vals[2], Target: vals[0], Amount: math.NewInt(10)},
+			},
+		},
+		{
+			name:        "capped turnover 0%",
+			strategy:    types.CappedTurnoverRebalanceStrategy{MaxTurnover: sdk.ZeroDec()},
+			allocations: evenAllocations,
+			target:      halfIntent,
+			locked:      map[string]bool{},
+			expected:    types.RebalanceTargets{},
+		},
+	}
+
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			currentSum, lockedSum := math.ZeroInt(), math.ZeroInt()
+			for k, v := range tt.allocations {
+				currentSum = currentSum.Add(v)
+				if tt.locked[k] {
+					lockedSum = lockedSum.Add(v)
+				}
+			}
+
+			actual := tt.strategy.DetermineAllocationsForRebalancing(
+				tt.allocations, tt.locked, currentSum, lockedSum, tt.target, make(map[string]math.Int), nil,
+			)
+
+			require.ElementsMatch(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RedemptionRateHistoryLength is the number of redemption rate samples retained
+// per zone; older samples are pruned as new samples are recorded.
+const RedemptionRateHistoryLength = 365
+
+// RedemptionRateTWAP returns the time weighted average of the given samples,
+// which must be in ascending order, over the period [start, end]. Each sample
+// rate is in effect from its time until the time of the next sample. It
+// returns the average, the start of the period covered by the samples, and the
+// number of samples contributing to the average.
+//
+// If no time within the period is covered, for example if the period is empty,
+// the rate of the latest sample at or before end is returned. ok is false if
+// there is no such sample.
+func RedemptionRateTWAP(samples []RedemptionRateSample, start, end time.Time) (twap sdk.Dec, coveredFrom time.Time, count uint32, ok bool) {
+	weighted := sdk.ZeroDec()
+	total := int64(0)
+	coveredFrom = end
+	latest := -1
+
+	for i, sample := range samples {
+		if sample.Time.After(end) {
+			break
+		}
+		latest = i
+
+		segmentStart := sample.Time
+		if segmentStart.Before(start) {
+			segmentStart = start
+		}
+		segmentEnd := end
+		if i+1 < len(samples) && samples[i+1].Time.Before(end) {
+			segmentEnd = samples[i+1].Time
+		}
+
+		duration := segmentEnd.Sub(segmentStart)
+		if duration <= 0 {
+			continue
+		}
+
+		if segmentStart.Before(coveredFrom) {
+			coveredFrom = segmentStart
+		}
+		weighted = weighted.Add(sample.Rate.MulInt64(int64(duration)))
+		total += int64(duration)
+		count++
+	}
+
+	if latest < 0 {
+		return sdk.ZeroDec(), end, 0, false
+	}
+	if total == 0 {
+		return samples[latest].Rate, end, 1, true
+	}
+
+	return weighted.QuoInt64(total), coveredFrom, count, true
+}
@@ -0,0 +1,113 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+)
+
+func TestRedemptionRateTWAP(t *testing.T) {
+	base := time.Unix(1700000000, 0).UTC()
+	sample := func(hours int, rate string) types.RedemptionRateSample {
+		return types.RedemptionRateSample{Time: base.Add(time.Duration(hours) * time.Hour), Rate: sdk.MustNewDecFromStr(rate)}
+	}
+	samples := []types.RedemptionRateSample{
+		sample(0, "1.0"),
+		sample(10, "1.1"),
+		sample(20, "1.2"),
+	}
+
+	tests := []struct {
+		name          string
+		samples       []types.RedemptionRateSample
+		start         time.Time
+		end           time.Time
+		expectOk      bool
+		expectTwap    sdk.Dec
+		expectFrom    time.Time
+		expectSamples uint32
+	}{
+		{
+			name:     "no samples",
+			samples:  nil,
+			start:    base,
+			end:      base.Add(time.Hour),
+			expectOk: false,
+		},
+		{
+			name:     "all samples after window",
+			samples:  samples,
+			start:    base.Add(-2 * time.Hour),
+			end:      base.Add(-time.Hour),
+			expectOk: false,
+		},
+		{
+			name:          "full coverage",
+			samples:       samples,
+			start:         base,
+			end:           base.Add(30 * time.Hour),
+			expectOk:      true,
+			expectTwap:    sdk.MustNewDecFromStr("1.1"),
+			expectFrom:    base,
+			expectSamples: 3,
+		},
+		{
+			name:          "window starts before history",
+			samples:       samples,
+			start:         base.Add(-10 * time.Hour),
+			end:           base.Add(20 * time.Hour),
+			expectOk:      true,
+			expectTwap:    sdk.MustNewDecFromStr("1.05"),
+			expectFrom:    base,
+			expectSamples: 2,
+		},
+		{
+			name:          "window starts mid segment",
+			samples:       samples,
+			start:         base.Add(15 * time.Hour),
+			end:           base.Add(25 * time.Hour),
+			expectOk:      true,
+			expectTwap:    sdk.MustNewDecFromStr("1.15"),
+			expectFrom:    base.Add(15 * time.Hour),
+			expectSamples: 2,
+		},
+		{
+			name:          "window ends before later samples",
+			samples:       samples,
+			start:         base.Add(5 * time.Hour),
+			end:           base.Add(15 * time.Hour),
+			expectOk:      true,
+			expectTwap:    sdk.MustNewDecFromStr("1.05"),
+			expectFrom:    base.Add(5 * time.Hour),
+			expectSamples: 2,
+		},
+		{
+			name:          "empty window returns latest rate",
+			samples:       samples,
+			start:         base.Add(20 * time.Hour),
+			end:           base.Add(20 * time.Hour),
+			expectOk:      true,
+			expectTwap:    sdk.MustNewDecFromStr("1.2"),
+			expectFrom:    base.Add(20 * time.Hour),
+			expectSamples: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			twap, from, count, ok := types.RedemptionRateTWAP(tt.samples, tt.start, tt.end)
+			require.Equal(t, tt.expectOk, ok)
+			if !tt.expectOk {
+				return
+			}
+			require.Equal(t, tt.expectTwap, twap)
+			require.True(t, tt.expectFrom.Equal(from), "expected %s, got %s", tt.expectFrom, from)
+			require.Equal(t, tt.expectSamples, count)
+		})
+	}
+}
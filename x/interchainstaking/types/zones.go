@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -17,6 +18,47 @@ func (z Zone) SupportReturnToSender() bool { return z.ReturnToSender }
 func (z Zone) IsUnbondingEnabled() bool    { return z.UnbondingEnabled }
 func (z Zone) SupportLsm() bool            { return z.LiquidityModule }
 
+// Rebalancer returns the RebalanceStrategy selected by the zone; zones without a
+// strategy use the greedy strategy.
+func (z *Zone) Rebalancer() RebalanceStrategy {
+	switch z.RebalanceStrategy {
+	case RebalanceStrategyThreshold:
+		threshold := z.RebalanceThreshold
+		if threshold.IsNil() {
+			threshold = sdk.ZeroDec()
+		}
+		return ThresholdRebalanceStrategy{Threshold: threshold}
+	case RebalanceStrategyCappedTurnover:
+		maxTurnover := z.RebalanceMaxTurnover
+		if maxTurnover.IsNil() {
+			maxTurnover = sdk.ZeroDec()
+		}
+		return CappedTurnoverRebalanceStrategy{MaxTurnover: maxTurnover}
+	default:
+		return GreedyRebalanceStrategy{}
+	}
+}
+
+// HasUnbondingInterval returns true if the zone batches unbondings on its own
+// cadence, rather than at the end of each epoch.
+func (z *Zone) HasUnbondingInterval() bool {
+	return z.UnbondingIntervalBlocks > 0 || z.UnbondingIntervalHours > 0
+}
+
+// UnbondingBatchDue returns true if, given the zone's unbonding schedule, an
+// unbonding batch is due at the given height and time. unbonding_interval_blocks
+// takes precedence over unbonding_interval_hours.
+func (z *Zone) UnbondingBatchDue(schedule UnbondingSchedule, height int64, blockTime time.Time) bool {
+	switch {
+	case z.UnbondingIntervalBlocks > 0:
+		return height-schedule.LastBatchHeight >= z.UnbondingIntervalBlocks
+	case z.UnbondingIntervalHours > 0:
+		return !blockTime.Before(schedule.LastBatchTime.Add(time.Duration(z.UnbondingIntervalHours) * time.Hour))
+	default:
+		return false
+	}
+}
+
 func (z *Zone) GetValoperPrefix() string {
 	if z != nil {
 		return z.AccountPrefix + "valoper"
@@ -71,10 +113,10 @@ func (z *Zone) IncrementWithdrawalWaitgroup(logger log.Logger, num uint32, reaso
 // ValidateCoinsForZone checks whether an inbound denomination is valid for this zone.
 // valid coins comprise:
 //   - non-lsm: staking denom only (z.BaseDenom)
-//   - lsm: staking denom, and tokens prefixed with zone.AccountPrefix
+//   - lsm: staking denom, and tokenized shares recognised by the zone's LsmProvider for validators prefixed with zone.AccountPrefix
 //
 // lsm: if valoper is not in zoneVals map, then we return true, false, and this tx will be revisited.
-func (z *Zone) ValidateCoinsForZone(coins sdk.Coins, zoneVals map[string]bool) (valid bool, matchesVal bool) {
+func (z *Zone) ValidateCoinsForZone(coins sdk.Coins, zoneVals map[string]bool, provider LsmProvider) (valid bool, matchesVal bool) {
 	for _, coin := range coins.Sort() {
 		if coin.Denom == z.BaseDenom {
 			continue
@@ -82,12 +124,12 @@ func (z *Zone) ValidateCoinsForZone(coins sdk.Coins, zoneVals map[string]bool) (
 
 		// if liquidity module enabled, check to see if this is a tokenized share.
 		if z.LiquidityModule {
-			coinParts := strings.Split(coin.Denom, "/")
-			if len(coinParts) != 2 || !strings.HasPrefix(coinParts[0], z.AccountPrefix) {
+			valoper, ok := provider.ParseShareDenom(coin.Denom)
+			if !ok || !strings.HasPrefix(valoper, z.AccountPrefix) {
 				return false, false
 			}
 
-			if _, ok := zoneVals[coinParts[0]]; !ok {
+			if _, ok := zoneVals[valoper]; !ok {
 				return true, false
 			}
 
@@ -102,33 +144,33 @@ func (z *Zone) ValidateCoinsForZone(coins sdk.Coins, zoneVals map[string]bool) (
 // memo functionality
 
 // this method exist to make testing easier!
-func (z *Zone) UpdateIntentWithCoins(intent DelegatorIntent, multiplier sdk.Dec, inAmount sdk.Coins, vals map[string]bool) DelegatorIntent {
+func (z *Zone) UpdateIntentWithCoins(intent DelegatorIntent, multiplier sdk.Dec, inAmount sdk.Coins, vals map[string]bool, provider LsmProvider) DelegatorIntent {
 	// coinIntent is ordinal
-	return intent.AddOrdinal(multiplier, z.ConvertCoinsToOrdinalIntents(inAmount, vals))
+	return intent.AddOrdinal(multiplier, z.ConvertCoinsToOrdinalIntents(inAmount, vals, provider))
 }
 
 func (*Zone) UpdateZoneIntentWithMemo(memoIntent ValidatorIntents, intent DelegatorIntent, multiplier sdk.Dec) DelegatorIntent {
 	return intent.AddOrdinal(multiplier, memoIntent)
 }
 
-func (*Zone) ConvertCoinsToOrdinalIntents(coins sdk.Coins, zoneVals map[string]bool) ValidatorIntents {
+func (*Zone) ConvertCoinsToOrdinalIntents(coins sdk.Coins, zoneVals map[string]bool, provider LsmProvider) ValidatorIntents {
 	out := make(ValidatorIntents, 0, len(coins))
 	for _, coin := range coins {
-		coinParts := strings.Split(coin.Denom, "/")
-		if len(coinParts) != 2 {
+		valoper, ok := provider.ParseShareDenom(coin.Denom)
+		if !ok {
 			continue
 		}
 
-		if _, ok := zoneVals[coinParts[0]]; !ok {
+		if _, ok := zoneVals[valoper]; !ok {
 			continue
 		}
 
-		val, ok := out.GetForValoper(coinParts[0])
+		val, ok := out.GetForValoper(valoper)
 		if !ok {
-			val = &ValidatorIntent{ValoperAddress: coinParts[0], Weight: sdk.ZeroDec()}
+			val = &ValidatorIntent{ValoperAddress: valoper, Weight: sdk.ZeroDec()}
 		}
 		val.Weight = val.Weight.Add(sdk.NewDecFromInt(coin.Amount))
-		out = out.SetForValoper(coinParts[0], val)
+		out = out.SetForValoper(valoper, val)
 	}
 
 	return out
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -64,28 +65,28 @@ func TestValidateCoinsForZone(t *testing.T) {
 	}
 
 	// valid AND matches a validator BUT lsm is off: false, false
-	valid, matches := zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1", sdk.OneInt())), valAddresses)
+	valid, matches := zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1", sdk.OneInt())), valAddresses, types.GaiaLsmProvider{})
 	require.False(t, valid)
 	require.False(t, matches)
 
 	zone.LiquidityModule = true
 	// valid AND matches a validator: true, true
-	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1", sdk.OneInt())), valAddresses)
+	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy/1", sdk.OneInt())), valAddresses, types.GaiaLsmProvider{})
 	require.True(t, valid)
 	require.True(t, matches)
 
 	// valid format but does not match: true, false
-	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper18ldc09yx4aua9g8mkl3sj526hgydzzyehcyjjr/1", sdk.OneInt())), valAddresses)
+	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("cosmosvaloper18ldc09yx4aua9g8mkl3sj526hgydzzyehcyjjr/1", sdk.OneInt())), valAddresses, types.GaiaLsmProvider{})
 	require.True(t, valid)
 	require.False(t, matches)
 
 	// invalid format (although valid ibc!) - false, false
-	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.OneInt())), valAddresses)
+	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.OneInt())), valAddresses, types.GaiaLsmProvider{})
 	require.False(t, valid)
 	require.False(t, matches)
 
 	// staking token - true, true
-	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("uatom", sdk.OneInt())), valAddresses)
+	valid, matches = zone.ValidateCoinsForZone(sdk.NewCoins(sdk.NewCoin("uatom", sdk.OneInt())), valAddresses, types.GaiaLsmProvider{})
 	require.True(t, valid)
 	require.True(t, matches)
 }
@@ -142,7 +143,7 @@ func TestCoinsToIntent(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		out := zone.ConvertCoinsToOrdinalIntents(tc.amount, valAddresses)
+		out := zone.ConvertCoinsToOrdinalIntents(tc.amount, valAddresses, types.GaiaLsmProvider{})
 		for _, v := range out {
 			if !tc.expectedIntent[v.ValoperAddress].Equal(v.Weight) {
 				t.Errorf("Got %v expected %v", v.Weight, tc.expectedIntent[v.ValoperAddress])
@@ -506,7 +507,7 @@ func TestUpdateIntentWithCoins(t *testing.T) {
 	valAddresses := []string{"cosmosvaloper1sjllsnramtg3ewxqwwrwjxfgc4n4ef9u2lcnj0", "cosmosvaloper156gqf9837u7d4c4678yt3rl4ls9c5vuursrrzf", "cosmosvaloper14lultfckehtszvzw4ehu0apvsr77afvyju5zzy", "cosmosvaloper1a3yjj7d3qnx4spgvjcwjq9cw9snrrrhu5h6jll", "cosmosvaloper1z8zjv3lntpwxua0rtpvgrcwl0nm0tltgpgs6l7"}
 
 	for _, tc := range testCases {
-		intent := zone.UpdateIntentWithCoins(intentFromDecSlice(tc.originalIntent), sdk.NewDec(int64(tc.baseAmount)), tc.amount, utils.StringSliceToMap(valAddresses))
+		intent := zone.UpdateIntentWithCoins(intentFromDecSlice(tc.originalIntent), sdk.NewDec(int64(tc.baseAmount)), tc.amount, utils.StringSliceToMap(valAddresses), types.GaiaLsmProvider{})
 		for _, v := range intent.Intents {
 			if !tc.expectedIntent[v.ValoperAddress].Equal(v.Weight) {
 				t.Errorf("Got %v expected %v", v.Weight, tc.expectedIntent[v.ValoperAddress])
@@ -721,3 +722,39 @@ func TestParseMemoFields(t *testing.T) {
 		})
 	}
 }
+
+func TestZoneRebalancer(t *testing.T) {
+	zone := types.Zone{}
+	require.Equal(t, types.GreedyRebalanceStrategy{}, zone.Rebalancer())
+
+	zone.RebalanceStrategy = types.RebalanceStrategyThreshold
+	require.Equal(t, types.ThresholdRebalanceStrategy{Threshold: sdk.ZeroDec()}, zone.Rebalancer())
+	zone.RebalanceThreshold = sdk.NewDecWithPrec(5, 2)
+	require.Equal(t, types.ThresholdRebalanceStrategy{Threshold: sdk.NewDecWithPrec(5, 2)}, zone.Rebalancer())
+
+	zone.RebalanceStrategy = types.RebalanceStrategyCappedTurnover
+	zone.RebalanceMaxTurnover = sdk.NewDecWithPrec(1, 1)
+	require.Equal(t, types.CappedTurnoverRebalanceStrategy{MaxTurnover: sdk.NewDecWithPrec(1, 1)}, zone.Rebalancer())
+
+	zone.RebalanceStrategy = "unknown"
+	require.Equal(t, types.GreedyRebalanceStrategy{}, zone.Rebalancer())
+}
+
+func TestZoneUnbondingBatchDue(t *testing.T) {
+	lastBatch := time.Unix(1700000000, 0).UTC()
+	schedule := types.UnbondingSchedule{ChainId: "cosmoshub-4", Sequence: 3, LastBatchHeight: 100, LastBatchTime: lastBatch}
+
+	zone := types.Zone{}
+	require.False(t, zone.HasUnbondingInterval())
+	require.False(t, zone.UnbondingBatchDue(schedule, 1000, lastBatch.Add(time.Hour*1000)))
+
+	zone.UnbondingIntervalHours = 6
+	require.True(t, zone.HasUnbondingInterval())
+	require.False(t, zone.UnbondingBatchDue(schedule, 1000, lastBatch.Add(time.Hour*5)))
+	require.True(t, zone.UnbondingBatchDue(schedule, 101, lastBatch.Add(time.Hour*6)))
+
+	zone.UnbondingIntervalBlocks = 50
+	require.True(t, zone.HasUnbondingInterval())
+	require.False(t, zone.UnbondingBatchDue(schedule, 149, lastBatch.Add(time.Hour*6)))
+	require.True(t, zone.UnbondingBatchDue(schedule, 150, lastBatch))
+}
@@ -2,10 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -20,7 +25,199 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		GetTokenValuesCmd(),
+		GetPricePathCmd(),
+		GetValidateClaimCmd(),
+		GetEstimatedRewardsCmd(),
+		GetValidatorScoresCmd(),
+	)
 
 	return cmd
 }
+
+// GetTokenValuesCmd returns the token values recorded at the end of an epoch.
+func GetTokenValuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-values [epoch]",
+		Short: "Query the token values recorded at the end of an epoch, or of the latest epoch if omitted.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query participationrewards token-values 100`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenValuesRequest{}
+			if len(args) > 0 {
+				if req.Epoch, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return fmt.Errorf("invalid epoch %s: %w", args[0], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TokenValues(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPricePathCmd returns the value of a denom and the path of pools by which
+// it was priced.
+func GetPricePathCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-path [denom] [epoch]",
+		Short: "Query how a denom was priced at the end of an epoch, or of the latest epoch if omitted.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query participationrewards price-path uqatom`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPricePathRequest{
+				Denom: args[0],
+			}
+			if len(args) > 1 {
+				if req.Epoch, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid epoch %s: %w", args[1], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PricePath(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidateClaimCmd performs the checks of a claim without submitting it.
+func GetValidateClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-claim [claim-file]",
+		Short: "Validate a JSON encoded MsgSubmitClaim without submitting it.",
+		Long:  "Validate a JSON encoded MsgSubmitClaim without submitting it, reporting the amount each proof would credit and the reason any proof would be rejected.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query participationrewards validate-claim claim.json`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			claim := &types.MsgSubmitClaim{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, claim); err != nil {
+				return fmt.Errorf("invalid claim file %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidateClaim(cmd.Context(), &types.QueryValidateClaimRequest{Claim: claim})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEstimatedRewardsCmd returns the participation rewards an address would be
+// paid at the end of the current epoch.
+func GetEstimatedRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimated-rewards [address]",
+		Short: "Query the participation rewards an address would be paid at the end of the current epoch.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query participationrewards estimated-rewards quick1...`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimatedRewards(cmd.Context(), &types.QueryEstimatedRewardsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorScoresCmd returns the validator scores of a zone calculated in
+// an epoch.
+func GetValidatorScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-scores [chain_id] [epoch]",
+		Short: "Query the validator scores of a zone calculated in an epoch, or the latest scores if epoch is omitted.",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query participationrewards validator-scores cosmoshub-4 100`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorScoresRequest{ChainId: args[0]}
+			if len(args) > 1 {
+				if req.Epoch, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid epoch %s: %w", args[1], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValidatorScores(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
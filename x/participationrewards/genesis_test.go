@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -79,6 +81,9 @@ func TestParticipationRewardsInitGenesis(t *testing.T) {
 				HoldingsAllocation:           sdk.NewDecWithPrec(5, 1),
 				LockupAllocation:             sdk.ZeroDec(),
 			},
+			MinPoolLiquidity:  math.ZeroInt(),
+			MaxPriceDeviation: sdk.ZeroDec(),
+			ScoringWeights:    types.DefaultScoringWeights,
 		},
 		ProtocolData: []*types.KeyedProtocolData{kpd},
 	}
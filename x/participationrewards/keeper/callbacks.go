@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,19 +12,32 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/concentrated-liquidity/model"
 	gamm "github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm/types"
-	umeetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	epochstypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
 	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
 const (
-	ValidatorSelectionRewardsCallbackID       = "validatorselectionrewards"
-	OsmosisPoolUpdateCallbackID               = "osmosispoolupdate"
-	OsmosisClPoolUpdateCallbackID             = "osmosisclpoolupdate"
-	SetEpochBlockCallbackID                   = "epochblock"
+	ValidatorSelectionRewardsCallbackID      = "validatorselectionrewards"
+	OsmosisPoolUpdateCallbackID              = "osmosispoolupdate"
+	OsmosisClPoolUpdateCallbackID            = "osmosisclpoolupdate"
+	SetEpochBlockCallbackID                  = "epochblock"
+	MoneyMarketUpdateCallbackID              = "moneymarketupdate"
+	MoneyMarketModuleBalanceUpdateCallbackID = "moneymarketmodulebalanceupdate"
+	SlashingParamsCallbackID                 = "slashingparams"
+	SigningInfosCallbackID                   = "signinginfos"
+	GovProposalsCallbackID                   = "govproposals"
+	GovVoteCallbackID                        = "govvote"
+
+	// Umee callback ids are retained so that queries made before Umee became a
+	// money market instance are handled by the money market callbacks.
 	UmeeReservesUpdateCallbackID              = "umeereservesupdatecallback"
 	UmeeTotalBorrowsUpdateCallbackID          = "umeetotalborrowsupdatecallback"
 	UmeeInterestScalarUpdateCallbackID        = "umeeinterestscalarupdatecallback"
@@ -34,15 +48,19 @@ const (
 // Callback wrapper struct for interchainstaking keeper.
 type Callback func(sdk.Context, *Keeper, []byte, icqtypes.Query) error
 
+// TimeoutCallback is invoked when a query expires without a response.
+type TimeoutCallback func(sdk.Context, *Keeper, icqtypes.Query) error
+
 type Callbacks struct {
 	k         *Keeper
 	callbacks map[string]Callback
+	timeouts  map[string]TimeoutCallback
 }
 
 var _ icqtypes.QueryCallbacks = Callbacks{}
 
 func (k *Keeper) CallbackHandler() Callbacks {
-	return Callbacks{k, make(map[string]Callback)}
+	return Callbacks{k, make(map[string]Callback), make(map[string]TimeoutCallback)}
 }
 
 // Call calls callback handler.
@@ -63,19 +81,61 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 	return c
 }
 
+// CallTimeout calls the timeout handler for id, if one is registered.
+func (c Callbacks) CallTimeout(ctx sdk.Context, id string, query icqtypes.Query) error {
+	fn, found := c.timeouts[id]
+	if !found {
+		return nil
+	}
+	return fn(ctx, c.k, query)
+}
+
+func (c Callbacks) AddTimeoutCallback(id string, fn TimeoutCallback) Callbacks {
+	c.timeouts[id] = fn
+	return c
+}
+
 func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 	a := c.
 		AddCallback(ValidatorSelectionRewardsCallbackID, Callback(ValidatorSelectionRewardsCallback)).
 		AddCallback(OsmosisPoolUpdateCallbackID, Callback(OsmosisPoolUpdateCallback)).
 		AddCallback(OsmosisClPoolUpdateCallbackID, Callback(OsmosisClPoolUpdateCallback)).
 		AddCallback(SetEpochBlockCallbackID, Callback(SetEpochBlockCallback)).
-		AddCallback(UmeeReservesUpdateCallbackID, Callback(UmeeReservesUpdateCallback)).
-		AddCallback(UmeeTotalBorrowsUpdateCallbackID, Callback(UmeeTotalBorrowsUpdateCallback)).
-		AddCallback(UmeeInterestScalarUpdateCallbackID, Callback(UmeeInterestScalarUpdateCallback)).
-		AddCallback(UmeeUTokenSupplyUpdateCallbackID, Callback(UmeeUTokenSupplyUpdateCallback)).
-		AddCallback(UmeeLeverageModuleBalanceUpdateCallbackID, Callback(UmeeLeverageModuleBalanceUpdateCallback))
+		AddCallback(MoneyMarketUpdateCallbackID, Callback(MoneyMarketUpdateCallback)).
+		AddCallback(MoneyMarketModuleBalanceUpdateCallbackID, Callback(MoneyMarketModuleBalanceUpdateCallback)).
+		AddCallback(UmeeReservesUpdateCallbackID, Callback(MoneyMarketUpdateCallback)).
+		AddCallback(UmeeTotalBorrowsUpdateCallbackID, Callback(MoneyMarketUpdateCallback)).
+		AddCallback(UmeeInterestScalarUpdateCallbackID, Callback(MoneyMarketUpdateCallback)).
+		AddCallback(UmeeUTokenSupplyUpdateCallbackID, Callback(MoneyMarketUpdateCallback)).
+		AddCallback(UmeeLeverageModuleBalanceUpdateCallbackID, Callback(MoneyMarketModuleBalanceUpdateCallback)).
+		AddCallback(SlashingParamsCallbackID, Callback(SlashingParamsCallback)).
+		AddCallback(SigningInfosCallbackID, Callback(SigningInfosCallback)).
+		AddCallback(GovProposalsCallbackID, Callback(GovProposalsCallback)).
+		AddCallback(GovVoteCallbackID, Callback(GovVoteCallback))
+
+	return a.(Callbacks).
+		AddTimeoutCallback(ValidatorSelectionRewardsCallbackID, ValidatorSelectionRewardsTimeoutCallback)
+}
+
+// Timeout callbacks
+
+// ValidatorSelectionRewardsTimeoutCallback handles expiry of the performance rewards query. The
+// zone's validator selection allocation is left undistributed in the module account, to be
+// reallocated at the next epoch, and intents are snapshotted as if the callback had succeeded.
+func ValidatorSelectionRewardsTimeoutCallback(ctx sdk.Context, k *Keeper, query icqtypes.Query) error {
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	k.Logger(ctx).Error("validator selection rewards query timed out; allocation rolled over", "zone", zone.ChainId, "allocation", zone.ValidatorSelectionAllocation)
+
+	k.SnapshotIntents(ctx, &zone)
+
+	zone.ValidatorSelectionAllocation = 0
+	k.icsKeeper.SetZone(ctx, &zone)
 
-	return a.(Callbacks)
+	return nil
 }
 
 // Callbacks
@@ -104,6 +164,9 @@ func ValidatorSelectionRewardsCallback(ctx sdk.Context, k *Keeper, response []by
 		"validator scores", zs.ValidatorScores,
 	)
 
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+	k.SetEpochZoneScore(ctx, zs.EpochZoneScore(epoch, ctx.BlockHeight()))
+
 	// snapshot obtained and used here
 	userAllocations := k.CalcUserValidatorSelectionAllocations(ctx, &zone, *zs)
 
@@ -112,9 +175,7 @@ func ValidatorSelectionRewardsCallback(ctx sdk.Context, k *Keeper, response []by
 	}
 
 	// create snapshot of current intents for next epoch boundary
-	for _, di := range k.icsKeeper.AllDelegatorIntents(ctx, &zone, false) {
-		k.icsKeeper.SetDelegatorIntent(ctx, &zone, di, true)
-	}
+	k.SnapshotIntents(ctx, &zone)
 
 	// set zone ValidatorSelectionAllocation to zero
 	zone.ValidatorSelectionAllocation = 0
@@ -123,201 +184,305 @@ func ValidatorSelectionRewardsCallback(ctx sdk.Context, k *Keeper, response []by
 	return nil
 }
 
-func OsmosisPoolUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	var pd gamm.CFMMPoolI
-	if err := k.cdc.UnmarshalInterface(response, &pd); err != nil {
+// SlashingParamsCallback records the signed blocks window of the zone, against
+// which validator missed blocks are measured.
+func SlashingParamsCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	paramsResponse := slashingtypes.QueryParamsResponse{}
+	if err := k.cdc.Unmarshal(response, &paramsResponse); err != nil {
 		return err
 	}
 
-	// check query.Request is at least 9 bytes in length. (0x02 + 8 bytes for uint64)
-	if len(query.Request) < 9 {
-		return errors.New("query request not sufficient length")
+	if _, found := k.icsKeeper.GetZone(ctx, query.GetChainId()); !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
-	// assert first character is 0x02 as expected.
-	if query.Request[0] != 0x02 {
-		return errors.New("query request has unexpected prefix")
+
+	zsi, found := k.GetZoneSigningInfo(ctx, query.GetChainId())
+	if !found {
+		zsi.ChainId = query.GetChainId()
 	}
+	zsi.SignedBlocksWindow = paramsResponse.Params.SignedBlocksWindow
+	k.SetZoneSigningInfo(ctx, zsi)
 
-	poolID := sdk.BigEndianToUint64(query.Request[1:])
-	key := fmt.Sprintf("%d", poolID)
-	data, pool, err := GetAndUnmarshalProtocolData[*types.OsmosisPoolProtocolData](ctx, k, key, types.ProtocolDataTypeOsmosisPool)
-	if err != nil {
+	return nil
+}
+
+// SigningInfosCallback records the missed blocks of zone validators. The first
+// page of results replaces previously recorded signing info; subsequent pages
+// are requested until all signing info has been obtained.
+func SigningInfosCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	infosResponse := slashingtypes.QuerySigningInfosResponse{}
+	if err := k.cdc.Unmarshal(response, &infosResponse); err != nil {
 		return err
 	}
 
-	pool.PoolData, err = json.Marshal(pd)
-	if err != nil {
+	infosRequest := slashingtypes.QuerySigningInfosRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &infosRequest); err != nil {
 		return err
 	}
-	pool.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(pool)
-	if err != nil {
-		return err
+
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
+	}
+
+	zsi, found := k.GetZoneSigningInfo(ctx, zone.ChainId)
+	if !found {
+		zsi.ChainId = zone.ChainId
+	}
+	if infosRequest.Pagination == nil || len(infosRequest.Pagination.Key) == 0 {
+		zsi.Validators = nil
+	}
+
+	for _, info := range infosResponse.Info {
+		consAddr, err := addressutils.AddressFromBech32(info.Address, "")
+		if err != nil {
+			return err
+		}
+		valoper, found := k.icsKeeper.GetValidatorAddrByConsAddr(ctx, zone.ChainId, consAddr)
+		if !found {
+			continue
+		}
+		zsi.Validators = append(zsi.Validators, types.ValidatorMissedBlocks{ValoperAddress: valoper, MissedBlocks: info.MissedBlocksCounter})
+	}
+	k.SetZoneSigningInfo(ctx, zsi)
+
+	if infosResponse.Pagination != nil && len(infosResponse.Pagination.NextKey) > 0 {
+		k.querySigningInfos(ctx, &zone, infosResponse.Pagination.NextKey)
 	}
-	k.SetProtocolData(ctx, pool.GenerateKey(), &data)
 
 	return nil
 }
 
-func OsmosisClPoolUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	var pd model.Pool
-	if err := k.cdc.Unmarshal(response, &pd); err != nil {
+// GovProposalsCallback tracks zone governance proposals in their voting period
+// and requests the votes of bonded validators that have not yet been observed
+// to vote on them. Proposals are tracked for GovProposalHistoryLength epochs.
+func GovProposalsCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	proposalsResponse := govv1.QueryProposalsResponse{}
+	if err := k.cdc.Unmarshal(response, &proposalsResponse); err != nil {
 		return err
 	}
 
-	// check query.Request is at least 2 bytes - 0x03 + poolID
-	if len(query.Request) < 2 {
-		return errors.New("query request not sufficient length")
-	}
-	// assert first character is 0x03 as expected (cl pool prefix)
-	if query.Request[0] != 0x03 {
-		return errors.New("query request has unexpected prefix")
+	proposalsRequest := govv1.QueryProposalsRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &proposalsRequest); err != nil {
+		return err
 	}
 
-	poolID, err := strconv.ParseInt(string(query.Request[1:]), 10, 64)
-	if err != nil {
-		return err
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
 
-	data, pool, err := GetAndUnmarshalProtocolData[*types.OsmosisClPoolProtocolData](ctx, k, fmt.Sprintf("%d", poolID), types.ProtocolDataTypeOsmosisCLPool)
-	if err != nil {
-		return err
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, epochstypes.EpochIdentifierEpoch).CurrentEpoch
+	if proposalsRequest.Pagination == nil || len(proposalsRequest.Pagination.Key) == 0 {
+		k.pruneGovProposalVotes(ctx, zone.ChainId, epoch-types.GovProposalHistoryLength)
 	}
 
-	pool.PoolData, err = json.Marshal(pd)
-	if err != nil {
-		return err
+	validators := k.icsKeeper.GetValidators(ctx, zone.ChainId)
+	for _, proposal := range proposalsResponse.Proposals {
+		gpv, found := k.GetGovProposalVotes(ctx, zone.ChainId, proposal.Id)
+		if !found {
+			gpv = types.GovProposalVotes{ChainId: zone.ChainId, ProposalId: proposal.Id, Epoch: epoch}
+			k.SetGovProposalVotes(ctx, gpv)
+		}
+
+		for _, val := range validators {
+			if val.Status != stakingtypes.BondStatusBonded || gpv.HasVoted(val.ValoperAddress) {
+				continue
+			}
+			valAddr, err := addressutils.ValAddressFromBech32(val.ValoperAddress, zone.GetValoperPrefix())
+			if err != nil {
+				return err
+			}
+			voter, err := addressutils.EncodeAddressToBech32(zone.AccountPrefix, valAddr)
+			if err != nil {
+				return err
+			}
+			k.queryGovVote(ctx, &zone, proposal.Id, voter)
+		}
 	}
-	pool.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(pool)
-	if err != nil {
-		return err
+
+	if proposalsResponse.Pagination != nil && len(proposalsResponse.Pagination.NextKey) > 0 {
+		k.queryGovProposals(ctx, &zone, proposalsResponse.Pagination.NextKey)
 	}
-	k.SetProtocolData(ctx, pool.GenerateKey(), &data)
 
 	return nil
 }
 
-func UmeeReservesUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	reserveAmount := sdk.ZeroInt()
-	if err := reserveAmount.Unmarshal(response); err != nil {
+// GovVoteCallback records the vote of a zone validator on a tracked governance
+// proposal. An empty response indicates that the validator has not voted.
+func GovVoteCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	if len(response) == 0 {
+		return nil
+	}
+
+	voteResponse := govv1.QueryVoteResponse{}
+	if err := k.cdc.Unmarshal(response, &voteResponse); err != nil {
 		return err
 	}
+	if voteResponse.Vote == nil {
+		return nil
+	}
 
-	if query.Request[0] != umeetypes.KeyPrefixReserveAmount[0] {
-		return errors.New("query request has unexpected prefix")
+	zone, found := k.icsKeeper.GetZone(ctx, query.GetChainId())
+	if !found {
+		return fmt.Errorf("no registered zone for chain id: %s", query.GetChainId())
 	}
 
-	denom := umeetypes.DenomFromKey(query.Request, umeetypes.KeyPrefixReserveAmount)
-	data, reserves, err := GetAndUnmarshalProtocolData[*types.UmeeReservesProtocolData](ctx, k, denom, types.ProtocolDataTypeUmeeReserves)
-	if err != nil {
-		return err
+	gpv, found := k.GetGovProposalVotes(ctx, zone.ChainId, voteResponse.Vote.ProposalId)
+	if !found {
+		// proposal no longer tracked
+		return nil
 	}
 
-	reserves.Data, err = json.Marshal(reserveAmount)
+	voter, err := addressutils.AccAddressFromBech32(voteResponse.Vote.Voter, zone.AccountPrefix)
 	if err != nil {
 		return err
 	}
-	reserves.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(reserves)
+	valoper, err := addressutils.EncodeAddressToBech32(zone.GetValoperPrefix(), sdk.ValAddress(voter))
 	if err != nil {
 		return err
 	}
-	k.SetProtocolData(ctx, reserves.GenerateKey(), &data)
+
+	if !gpv.HasVoted(valoper) {
+		gpv.Voters = append(gpv.Voters, valoper)
+		k.SetGovProposalVotes(ctx, gpv)
+	}
 
 	return nil
 }
 
-func UmeeTotalBorrowsUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	if g, w := query.Request[0], umeetypes.KeyPrefixAdjustedTotalBorrow[0]; g != w {
-		return fmt.Errorf("unexpected query request prefix %q, want %q", g, w)
+func OsmosisPoolUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	var pd gamm.CFMMPoolI
+	if err := k.cdc.UnmarshalInterface(response, &pd); err != nil {
+		return err
 	}
 
-	totalBorrows := sdk.ZeroDec()
-	if err := totalBorrows.Unmarshal(response); err != nil {
-		return err
+	// check query.Request is at least 9 bytes in length. (0x02 + 8 bytes for uint64)
+	if len(query.Request) < 9 {
+		return errors.New("query request not sufficient length")
+	}
+	// assert first character is 0x02 as expected.
+	if query.Request[0] != 0x02 {
+		return errors.New("query request has unexpected prefix")
 	}
 
-	denom := umeetypes.DenomFromKey(query.Request, umeetypes.KeyPrefixAdjustedTotalBorrow)
-	data, borrows, err := GetAndUnmarshalProtocolData[*types.UmeeTotalBorrowsProtocolData](ctx, k, denom, types.ProtocolDataTypeUmeeTotalBorrows)
+	poolID := sdk.BigEndianToUint64(query.Request[1:])
+	key := fmt.Sprintf("%d", poolID)
+	data, pool, err := GetAndUnmarshalProtocolData[*types.OsmosisPoolProtocolData](ctx, k, key, types.ProtocolDataTypeOsmosisPool)
 	if err != nil {
 		return err
 	}
 
-	borrows.Data, err = json.Marshal(totalBorrows)
+	pool.PoolData, err = json.Marshal(pd)
 	if err != nil {
 		return err
 	}
-	borrows.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(borrows)
+	pool.LastUpdated = ctx.BlockTime()
+	pool.LastUpdatedHeight = ctx.BlockHeight()
+	data.Data, err = json.Marshal(pool)
 	if err != nil {
 		return err
 	}
-	k.SetProtocolData(ctx, borrows.GenerateKey(), &data)
+	k.SetProtocolData(ctx, pool.GenerateKey(), &data)
 
 	return nil
 }
 
-func UmeeInterestScalarUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	if g, w := query.Request[0], umeetypes.KeyPrefixInterestScalar[0]; g != w {
-		return fmt.Errorf("unexpected query request prefix %q, want %q", g, w)
+func OsmosisClPoolUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	var pd model.Pool
+	if err := k.cdc.Unmarshal(response, &pd); err != nil {
+		return err
+	}
+
+	// check query.Request is at least 2 bytes - 0x03 + poolID
+	if len(query.Request) < 2 {
+		return errors.New("query request not sufficient length")
+	}
+	// assert first character is 0x03 as expected (cl pool prefix)
+	if query.Request[0] != 0x03 {
+		return errors.New("query request has unexpected prefix")
 	}
 
-	interestScalar := sdk.ZeroDec()
-	if err := interestScalar.Unmarshal(response); err != nil {
+	poolID, err := strconv.ParseInt(string(query.Request[1:]), 10, 64)
+	if err != nil {
 		return err
 	}
 
-	denom := umeetypes.DenomFromKey(query.Request, umeetypes.KeyPrefixInterestScalar)
-	data, interest, err := GetAndUnmarshalProtocolData[*types.UmeeInterestScalarProtocolData](ctx, k, denom, types.ProtocolDataTypeUmeeInterestScalar)
+	data, pool, err := GetAndUnmarshalProtocolData[*types.OsmosisClPoolProtocolData](ctx, k, fmt.Sprintf("%d", poolID), types.ProtocolDataTypeOsmosisCLPool)
 	if err != nil {
 		return err
 	}
 
-	interest.Data, err = json.Marshal(interestScalar)
+	pool.PoolData, err = json.Marshal(pd)
 	if err != nil {
 		return err
 	}
-	interest.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(interest)
+	pool.LastUpdated = ctx.BlockTime()
+	pool.LastUpdatedHeight = ctx.BlockHeight()
+	data.Data, err = json.Marshal(pool)
 	if err != nil {
 		return err
 	}
-	k.SetProtocolData(ctx, interest.GenerateKey(), &data)
+	k.SetProtocolData(ctx, pool.GenerateKey(), &data)
 
 	return nil
 }
 
-func UmeeUTokenSupplyUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
-	supplyAmount := sdk.ZeroInt()
-	if err := supplyAmount.Unmarshal(response); err != nil {
+// MoneyMarketUpdateCallback records the reserves, interest scalar, adjusted total borrows or uToken supply of
+// a money market token, as identified by the prefix of the queried key.
+func MoneyMarketUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+	_, market, err := GetAndUnmarshalProtocolData[*types.MoneyMarketProtocolData](ctx, k, query.ChainId, types.ProtocolDataTypeMoneyMarket)
+	if err != nil {
 		return err
 	}
 
-	if query.Request[0] != umeetypes.KeyPrefixUtokenSupply[0] {
-		return errors.New("query request has unexpected prefix")
-	}
+	var (
+		prefix []byte
+		update func(state *types.MoneyMarketDenomProtocolData) error
+	)
 
-	denom := umeetypes.DenomFromKey(query.Request, umeetypes.KeyPrefixUtokenSupply)
-	data, supply, err := GetAndUnmarshalProtocolData[*types.UmeeUTokenSupplyProtocolData](ctx, k, denom, types.ProtocolDataTypeUmeeUTokenSupply)
+	switch {
+	case bytes.HasPrefix(query.Request, market.ReservePrefix):
+		prefix = market.ReservePrefix
+		update = func(state *types.MoneyMarketDenomProtocolData) error {
+			state.Reserves = sdk.ZeroInt()
+			return state.Reserves.Unmarshal(response)
+		}
+	case bytes.HasPrefix(query.Request, market.InterestScalarPrefix):
+		prefix = market.InterestScalarPrefix
+		update = func(state *types.MoneyMarketDenomProtocolData) error {
+			state.InterestScalar = sdk.ZeroDec()
+			return state.InterestScalar.Unmarshal(response)
+		}
+	case bytes.HasPrefix(query.Request, market.AdjustedTotalBorrowPrefix):
+		prefix = market.AdjustedTotalBorrowPrefix
+		update = func(state *types.MoneyMarketDenomProtocolData) error {
+			state.AdjustedTotalBorrows = sdk.ZeroDec()
+			return state.AdjustedTotalBorrows.Unmarshal(response)
+		}
+	case bytes.HasPrefix(query.Request, market.UTokenSupplyPrefix):
+		prefix = market.UTokenSupplyPrefix
+		update = func(state *types.MoneyMarketDenomProtocolData) error {
+			state.UTokenSupply = sdk.ZeroInt()
+			return state.UTokenSupply.Unmarshal(response)
+		}
+	default:
+		return fmt.Errorf("query request has unexpected prefix %X", query.Request)
+	}
+
+	denom, err := types.DenomFromKey(query.Request, prefix)
 	if err != nil {
 		return err
 	}
-	supply.Data, err = json.Marshal(supplyAmount)
-	if err != nil {
-		return err
+	if bytes.Equal(prefix, market.UTokenSupplyPrefix) {
+		denom = market.ToTokenDenom(denom)
 	}
-	supply.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(supply)
-	if err != nil {
-		return err
-	}
-	k.SetProtocolData(ctx, supply.GenerateKey(), &data)
 
-	return nil
+	return k.updateMoneyMarketDenom(ctx, query.ChainId, denom, update)
 }
 
-func UmeeLeverageModuleBalanceUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
+// MoneyMarketModuleBalanceUpdateCallback records the balance of a token held by the money market module account.
+func MoneyMarketModuleBalanceUpdateCallback(ctx sdk.Context, k *Keeper, response []byte, query icqtypes.Query) error {
 	if len(query.Request) < 2 {
 		k.Logger(ctx).Error("unable to unmarshal balance request, request length is too short")
 		return errors.New("account balance icq request must always have a length of at least 2 bytes")
@@ -333,22 +498,28 @@ func UmeeLeverageModuleBalanceUpdateCallback(ctx sdk.Context, k *Keeper, respons
 	if err != nil {
 		return err
 	}
-	balanceAmount := balanceCoin.Amount
 
-	data, balance, err := GetAndUnmarshalProtocolData[*types.UmeeLeverageModuleBalanceProtocolData](ctx, k, denom, types.ProtocolDataTypeUmeeLeverageModuleBalance)
+	return k.updateMoneyMarketDenom(ctx, query.ChainId, denom, func(state *types.MoneyMarketDenomProtocolData) error {
+		state.ModuleBalance = balanceCoin.Amount
+		return nil
+	})
+}
+
+func (k *Keeper) updateMoneyMarketDenom(ctx sdk.Context, chainID, denom string, update func(state *types.MoneyMarketDenomProtocolData) error) error {
+	data, state, err := GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, k, fmt.Sprintf("%s_%s", chainID, denom), types.ProtocolDataTypeMoneyMarketDenom)
 	if err != nil {
 		return err
 	}
-	balance.Data, err = json.Marshal(balanceAmount)
-	if err != nil {
+
+	if err := update(state); err != nil {
 		return err
 	}
-	balance.LastUpdated = ctx.BlockTime()
-	data.Data, err = json.Marshal(balance)
+	state.LastUpdated = ctx.BlockTime()
+	data.Data, err = json.Marshal(state)
 	if err != nil {
 		return err
 	}
-	k.SetProtocolData(ctx, balance.GenerateKey(), &data)
+	k.SetProtocolData(ctx, state.GenerateKey(), &data)
 
 	return nil
 }
@@ -12,15 +12,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/concentrated-liquidity/model"
 	gamm "github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm/types"
 	leveragetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	icqkeeper "github.com/quicksilver-zone/quicksilver/x/interchainquery/keeper"
+	icqtypes "github.com/quicksilver-zone/quicksilver/x/interchainquery/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -176,11 +182,12 @@ func (suite *KeeperTestSuite) executeOsmosisPoolUpdateCallback() {
 	suite.NoError(err)
 
 	want := &types.OsmosisPoolProtocolData{
-		PoolID:      1,
-		PoolName:    "atom/osmo",
-		LastUpdated: ctx.BlockTime(),
-		PoolData:    expectedData,
-		PoolType:    "balancer",
+		PoolID:            1,
+		PoolName:          "atom/osmo",
+		LastUpdated:       ctx.BlockTime(),
+		LastUpdatedHeight: ctx.BlockHeight(),
+		PoolData:          expectedData,
+		PoolType:          "balancer",
 		Denoms: map[string]types.DenomWithZone{
 			cosmosIBCDenom:  {ChainID: "cosmoshub-4", Denom: "uatom"},
 			osmosisIBCDenom: {ChainID: "osmosis-1", Denom: "uosmo"},
@@ -273,51 +280,57 @@ func (suite *KeeperTestSuite) executeSetEpochBlockCallback() {
 	suite.NoError(err)
 }
 
-func (suite *KeeperTestSuite) executeUmeeReservesUpdateCallback() {
+func (suite *KeeperTestSuite) executeMoneyMarketUpdateCallbacks() {
 	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
 	ctx := suite.chainA.GetContext()
 
-	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/leverage/key", leveragetypes.KeyReserveAmount(umeeBaseDenom), types.ModuleName, keeper.UmeeReservesUpdateCallbackID)
+	market := types.UmeeMoneyMarket(umeeTestChain)
 
-	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
-	suite.True(found, "qid: %s", qid)
-
-	data := sdk.NewInt(100000)
-	resp, err := data.Marshal()
-	suite.NoError(err)
-	expectedData, err := json.Marshal(data)
-	suite.NoError(err)
-
-	// setup for expected
-
-	err = keeper.UmeeReservesUpdateCallback(
-		ctx,
-		prk,
-		resp,
-		query,
-	)
-	suite.NoError(err)
+	marshal := func(v interface{ Marshal() ([]byte, error) }) []byte {
+		bz, err := v.Marshal()
+		suite.NoError(err)
+		return bz
+	}
 
-	want := &types.UmeeReservesProtocolData{
-		UmeeProtocolData: types.UmeeProtocolData{
-			Denom:       umeeBaseDenom,
-			LastUpdated: ctx.BlockTime(),
-			Data:        expectedData,
-		},
+	for _, c := range []struct {
+		key  []byte
+		resp []byte
+	}{
+		{market.KeyReserveAmount(umeeBaseDenom), marshal(sdk.NewInt(100000))},
+		{market.KeyAdjustedTotalBorrow(umeeBaseDenom), marshal(sdk.NewDec(150000))},
+		{market.KeyInterestScalar(umeeBaseDenom), marshal(sdk.NewDec(1))},
+		{market.KeyUTokenSupply(market.ToUTokenDenom(umeeBaseDenom)), marshal(sdk.NewInt(100000))},
+	} {
+		qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/leverage/key", c.key, types.ModuleName, keeper.MoneyMarketUpdateCallbackID)
+
+		query, found := prk.IcqKeeper.GetQuery(ctx, qid)
+		suite.True(found, "qid: %s", qid)
+
+		err := keeper.MoneyMarketUpdateCallback(
+			ctx,
+			prk,
+			c.resp,
+			query,
+		)
+		suite.NoError(err)
 	}
 
-	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.UmeeReservesProtocolData](ctx, prk, umeeBaseDenom, types.ProtocolDataTypeUmeeReserves)
+	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, prk, umeeTestChain+"_"+umeeBaseDenom, types.ProtocolDataTypeMoneyMarketDenom)
 	suite.NoError(err)
-	suite.Equal(want, result)
+	suite.Equal(sdk.NewInt(100000), result.Reserves)
+	suite.Equal(sdk.NewDec(150000), result.AdjustedTotalBorrows)
+	suite.Equal(sdk.NewDec(1), result.InterestScalar)
+	suite.Equal(sdk.NewInt(100000), result.UTokenSupply)
+	suite.Equal(ctx.BlockTime(), result.LastUpdated)
 }
 
-func (suite *KeeperTestSuite) executeUmeeLeverageModuleBalanceUpdateCallback() {
+func (suite *KeeperTestSuite) executeMoneyMarketModuleBalanceUpdateCallback() {
 	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
 	ctx := suite.chainA.GetContext()
 
 	accountPrefix := banktypes.CreateAccountBalancesPrefix(authtypes.NewModuleAddress(leveragetypes.LeverageModuleName))
 
-	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/bank/key", append(accountPrefix, umeeBaseDenom...), types.ModuleName, keeper.UmeeLeverageModuleBalanceUpdateCallbackID)
+	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/bank/key", append(accountPrefix, umeeBaseDenom...), types.ModuleName, keeper.MoneyMarketModuleBalanceUpdateCallbackID)
 
 	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
 	suite.True(found, "qid: %s", qid)
@@ -325,12 +338,8 @@ func (suite *KeeperTestSuite) executeUmeeLeverageModuleBalanceUpdateCallback() {
 	data := sdk.NewInt(1400000)
 	resp, err := data.Marshal()
 	suite.NoError(err)
-	expectedData, err := json.Marshal(data)
-	suite.NoError(err)
 
-	// setup for expected
-
-	err = keeper.UmeeLeverageModuleBalanceUpdateCallback(
+	err = keeper.MoneyMarketModuleBalanceUpdateCallback(
 		ctx,
 		prk,
 		resp,
@@ -338,129 +347,160 @@ func (suite *KeeperTestSuite) executeUmeeLeverageModuleBalanceUpdateCallback() {
 	)
 	suite.NoError(err)
 
-	want := &types.UmeeLeverageModuleBalanceProtocolData{
-		UmeeProtocolData: types.UmeeProtocolData{
-			Denom:       umeeBaseDenom,
-			LastUpdated: ctx.BlockTime(),
-			Data:        expectedData,
-		},
-	}
-
-	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.UmeeLeverageModuleBalanceProtocolData](ctx, prk, umeeBaseDenom, types.ProtocolDataTypeUmeeLeverageModuleBalance)
+	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, prk, umeeTestChain+"_"+umeeBaseDenom, types.ProtocolDataTypeMoneyMarketDenom)
 	suite.NoError(err)
-	suite.Equal(want, result)
+	suite.Equal(data, result.ModuleBalance)
+	// (1400000 + 150000 * 1 - 100000) / 100000
+	suite.Equal(sdk.NewDecWithPrec(145, 1), result.ExchangeRate())
 }
 
-func (suite *KeeperTestSuite) executeUmeeUTokenSupplyUpdateCallback() {
+func (suite *KeeperTestSuite) TestMoneyMarketUpdateCallback_UnexpectedPrefix() {
+	suite.SetupTest()
 	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
 	ctx := suite.chainA.GetContext()
 
-	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/leverage/key", leveragetypes.KeyUTokenSupply(leveragetypes.UTokenPrefix+umeeBaseDenom), types.ModuleName, keeper.UmeeUTokenSupplyUpdateCallbackID)
-
-	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
-	suite.True(found, "qid: %s", qid)
-
-	data := sdk.NewInt(100000)
-	resp, err := data.Marshal()
-	suite.NoError(err)
-	expectedData, err := json.Marshal(data)
-	suite.NoError(err)
-
-	// setup for expected
-
-	err = keeper.UmeeUTokenSupplyUpdateCallback(
-		ctx,
-		prk,
-		resp,
-		query,
-	)
+	query := icqtypes.Query{ChainId: umeeTestChain, Request: append([]byte{0x01}, []byte(umeeBaseDenom+"\x00")...)}
+	resp, err := sdk.NewInt(1).Marshal()
 	suite.NoError(err)
+	suite.ErrorContains(keeper.MoneyMarketUpdateCallback(ctx, prk, resp, query), "unexpected prefix")
 
-	want := &types.UmeeUTokenSupplyProtocolData{
-		UmeeProtocolData: types.UmeeProtocolData{
-			Denom:       leveragetypes.UTokenPrefix + umeeBaseDenom,
-			LastUpdated: ctx.BlockTime(),
-			Data:        expectedData,
-		},
-	}
-
-	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.UmeeUTokenSupplyProtocolData](ctx, prk, leveragetypes.UTokenPrefix+umeeBaseDenom, types.ProtocolDataTypeUmeeUTokenSupply)
-	suite.NoError(err)
-	suite.Equal(want, result)
+	unknown := types.UmeeMoneyMarket("unknown-1")
+	query = icqtypes.Query{ChainId: unknown.ChainID, Request: unknown.KeyReserveAmount(umeeBaseDenom)}
+	suite.Error(keeper.MoneyMarketUpdateCallback(ctx, prk, resp, query))
 }
 
-func (suite *KeeperTestSuite) executeUmeeTotalBorrowsUpdateCallback() {
-	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+func (suite *KeeperTestSuite) TestSigningInfosCallbacks() {
+	suite.SetupTest()
+
 	ctx := suite.chainA.GetContext()
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	prk := appA.ParticipationRewardsKeeper
 
-	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/leverage/key", leveragetypes.KeyAdjustedTotalBorrow(umeeBaseDenom), types.ModuleName, keeper.UmeeTotalBorrowsUpdateCallbackID)
+	zone, found := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	vals := appA.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId)
 
-	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
-	suite.True(found, "qid: %s", qid)
+	consAddrs := make([]sdk.ConsAddress, 2)
+	for i := range consAddrs {
+		consAddrs[i] = sdk.ConsAddress(addressutils.GenerateAccAddressForTest())
+		appA.InterchainstakingKeeper.SetValidatorAddrByConsAddr(ctx, zone.ChainId, vals[i].ValoperAddress, consAddrs[i])
+	}
 
-	data := sdk.NewDec(150000)
-	resp, err := data.Marshal()
+	paramsResp, err := prk.GetCodec().Marshal(&slashingtypes.QueryParamsResponse{Params: slashingtypes.Params{SignedBlocksWindow: 100}})
 	suite.NoError(err)
-	expectedData, err := json.Marshal(data)
+	err = keeper.SlashingParamsCallback(ctx, prk, paramsResp, icqtypes.Query{ChainId: zone.ChainId})
 	suite.NoError(err)
 
-	// setup for expected
+	// first page replaces any previously recorded signing info.
+	prk.SetZoneSigningInfo(ctx, types.ZoneSigningInfo{ChainId: zone.ChainId, SignedBlocksWindow: 100, Validators: []types.ValidatorMissedBlocks{{ValoperAddress: "stale", MissedBlocks: 1}}})
 
-	err = keeper.UmeeTotalBorrowsUpdateCallback(
-		ctx,
-		prk,
-		resp,
-		query,
-	)
+	firstReq := prk.GetCodec().MustMarshal(&slashingtypes.QuerySigningInfosRequest{Pagination: &query.PageRequest{Limit: keeper.SigningInfosPageLimit}})
+	firstResp := prk.GetCodec().MustMarshal(&slashingtypes.QuerySigningInfosResponse{
+		Info: []slashingtypes.ValidatorSigningInfo{
+			{Address: addressutils.MustEncodeAddressToBech32("cosmosvalcons", consAddrs[0]), MissedBlocksCounter: 10},
+			// unknown validators are ignored.
+			{Address: addressutils.MustEncodeAddressToBech32("cosmosvalcons", addressutils.GenerateAccAddressForTest()), MissedBlocksCounter: 20},
+		},
+		Pagination: &query.PageResponse{NextKey: []byte("next")},
+	})
+	err = keeper.SigningInfosCallback(ctx, prk, firstResp, icqtypes.Query{ChainId: zone.ChainId, ConnectionId: zone.ConnectionId, Request: firstReq})
 	suite.NoError(err)
 
-	want := &types.UmeeTotalBorrowsProtocolData{
-		UmeeProtocolData: types.UmeeProtocolData{
-			Denom:       umeeBaseDenom,
-			LastUpdated: ctx.BlockTime(),
-			Data:        expectedData,
-		},
-	}
+	// the next page is requested.
+	nextReq := prk.GetCodec().MustMarshal(&slashingtypes.QuerySigningInfosRequest{Pagination: &query.PageRequest{Key: []byte("next"), Limit: keeper.SigningInfosPageLimit}})
+	qid := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.slashing.v1beta1.Query/SigningInfos", nextReq, types.ModuleName, keeper.SigningInfosCallbackID)
+	_, found = prk.IcqKeeper.GetQuery(ctx, qid)
+	suite.True(found, "qid: %s", qid)
 
-	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.UmeeTotalBorrowsProtocolData](ctx, prk, umeeBaseDenom, types.ProtocolDataTypeUmeeTotalBorrows)
-	suite.NoError(err)
-	suite.Equal(want, result)
+	nextResp := prk.GetCodec().MustMarshal(&slashingtypes.QuerySigningInfosResponse{
+		Info: []slashingtypes.ValidatorSigningInfo{
+			{Address: addressutils.MustEncodeAddressToBech32("cosmosvalcons", consAddrs[1]), MissedBlocksCounter: 50},
+		},
+	})
+	err = keeper.SigningInfosCallback(ctx, prk, nextResp, icqtypes.Query{ChainId: zone.ChainId, ConnectionId: zone.ConnectionId, Request: nextReq})
+	suite.NoError(err)
+
+	zsi, found := prk.GetZoneSigningInfo(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(types.ZoneSigningInfo{
+		ChainId:            zone.ChainId,
+		SignedBlocksWindow: 100,
+		Validators: []types.ValidatorMissedBlocks{
+			{ValoperAddress: vals[0].ValoperAddress, MissedBlocks: 10},
+			{ValoperAddress: vals[1].ValoperAddress, MissedBlocks: 50},
+		},
+	}, zsi)
+	suite.Equal(sdk.MustNewDecFromStr("0.9"), zsi.UptimeScore(vals[0].ValoperAddress))
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), zsi.UptimeScore(vals[1].ValoperAddress))
 }
 
-func (suite *KeeperTestSuite) executeUmeeInterestScalarUpdateCallback() {
-	prk := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+func (suite *KeeperTestSuite) TestGovCallbacks() {
+	suite.SetupTest()
+
 	ctx := suite.chainA.GetContext()
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	prk := appA.ParticipationRewardsKeeper
 
-	qid := icqkeeper.GenerateQueryHash(umeeTestConnection, umeeTestChain, "store/leverage/key", leveragetypes.KeyInterestScalar(umeeBaseDenom), types.ModuleName, keeper.UmeeInterestScalarUpdateCallbackID)
+	zone, found := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	vals := appA.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId)
+	suite.Equal(stakingtypes.BondStatusBonded, vals[0].Status)
 
-	query, found := prk.IcqKeeper.GetQuery(ctx, qid)
-	suite.True(found, "qid: %s", qid)
+	params := prk.GetParams(ctx)
+	params.ScoringWeights.Governance = sdk.OneDec()
+	prk.SetParams(ctx, params)
 
-	data := sdk.NewDec(1)
-	resp, err := data.Marshal()
-	suite.NoError(err)
-	expectedData, err := json.Marshal(data)
-	suite.NoError(err)
+	prk.QueryValidatorScoringData(ctx, &zone)
 
-	// setup for expected
+	proposalsReq := prk.GetCodec().MustMarshal(&govv1.QueryProposalsRequest{ProposalStatus: govv1.StatusVotingPeriod, Pagination: &query.PageRequest{}})
+	qid := icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.gov.v1.Query/Proposals", proposalsReq, types.ModuleName, keeper.GovProposalsCallbackID)
+	_, found = prk.IcqKeeper.GetQuery(ctx, qid)
+	suite.True(found, "qid: %s", qid)
 
-	err = keeper.UmeeInterestScalarUpdateCallback(
-		ctx,
-		prk,
-		resp,
-		query,
-	)
+	// proposals tracked beyond the history length are pruned.
+	epoch := appA.EpochsKeeper.GetEpochInfo(ctx, "epoch").CurrentEpoch
+	prk.SetGovProposalVotes(ctx, types.GovProposalVotes{ChainId: zone.ChainId, ProposalId: 1, Epoch: epoch - types.GovProposalHistoryLength})
+	// validators already observed to vote are not queried again.
+	prk.SetGovProposalVotes(ctx, types.GovProposalVotes{ChainId: zone.ChainId, ProposalId: 3, Epoch: epoch, Voters: []string{vals[0].ValoperAddress}})
+
+	proposalsResp := prk.GetCodec().MustMarshal(&govv1.QueryProposalsResponse{Proposals: []*govv1.Proposal{{Id: 2}, {Id: 3}}})
+	err := keeper.GovProposalsCallback(ctx, prk, proposalsResp, icqtypes.Query{ChainId: zone.ChainId, ConnectionId: zone.ConnectionId, Request: proposalsReq})
 	suite.NoError(err)
 
-	want := &types.UmeeInterestScalarProtocolData{
-		UmeeProtocolData: types.UmeeProtocolData{
-			Denom:       umeeBaseDenom,
-			LastUpdated: ctx.BlockTime(),
-			Data:        expectedData,
-		},
+	_, found = prk.GetGovProposalVotes(ctx, zone.ChainId, 1)
+	suite.False(found)
+	gpv, found := prk.GetGovProposalVotes(ctx, zone.ChainId, 2)
+	suite.True(found)
+	suite.Equal(epoch, gpv.Epoch)
+
+	voteQueryID := func(proposalID uint64, valoper string) string {
+		voter := addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, addressutils.MustValAddressFromBech32(valoper, zone.GetValoperPrefix()))
+		req := prk.GetCodec().MustMarshal(&govv1.QueryVoteRequest{ProposalId: proposalID, Voter: voter})
+		return icqkeeper.GenerateQueryHash(zone.ConnectionId, zone.ChainId, "cosmos.gov.v1.Query/Vote", req, types.ModuleName, keeper.GovVoteCallbackID)
 	}
 
-	_, result, err := keeper.GetAndUnmarshalProtocolData[*types.UmeeInterestScalarProtocolData](ctx, prk, umeeBaseDenom, types.ProtocolDataTypeUmeeInterestScalar)
-	suite.NoError(err)
-	suite.Equal(want, result)
+	_, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID(2, vals[0].ValoperAddress))
+	suite.True(found)
+	_, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID(3, vals[0].ValoperAddress))
+	suite.False(found)
+	_, found = prk.IcqKeeper.GetQuery(ctx, voteQueryID(3, vals[1].ValoperAddress))
+	suite.True(found)
+
+	// an empty response indicates that the validator has not voted.
+	suite.NoError(keeper.GovVoteCallback(ctx, prk, []byte{}, icqtypes.Query{ChainId: zone.ChainId}))
+
+	voter := addressutils.MustEncodeAddressToBech32(zone.AccountPrefix, addressutils.MustValAddressFromBech32(vals[1].ValoperAddress, zone.GetValoperPrefix()))
+	voteResp := prk.GetCodec().MustMarshal(&govv1.QueryVoteResponse{Vote: &govv1.Vote{ProposalId: 2, Voter: voter}})
+	suite.NoError(keeper.GovVoteCallback(ctx, prk, voteResp, icqtypes.Query{ChainId: zone.ChainId}))
+	// repeated votes are recorded once.
+	suite.NoError(keeper.GovVoteCallback(ctx, prk, voteResp, icqtypes.Query{ChainId: zone.ChainId}))
+
+	gpv, found = prk.GetGovProposalVotes(ctx, zone.ChainId, 2)
+	suite.True(found)
+	suite.Equal([]string{vals[1].ValoperAddress}, gpv.Voters)
+
+	proposals := prk.AllGovProposalVotes(ctx, zone.ChainId)
+	suite.Len(proposals, 2)
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), types.GovernanceScore(proposals, vals[0].ValoperAddress))
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), types.GovernanceScore(proposals, vals[1].ValoperAddress))
 }
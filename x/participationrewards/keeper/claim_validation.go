@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	claimsmanagertypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// claimConnectionData returns the connection protocol data of the source zone
+// of a claim.
+func (k *Keeper) claimConnectionData(ctx sdk.Context, msg *types.MsgSubmitClaim) (*types.ConnectionProtocolData, error) {
+	pd, ok := k.GetProtocolData(ctx, types.ProtocolDataTypeConnection, msg.SrcZone)
+	if !ok {
+		return nil, fmt.Errorf("unable to obtain connection protocol data for %q", msg.SrcZone)
+	}
+
+	iConnectionData, err := types.UnmarshalProtocolData(types.ProtocolDataTypeConnection, pd.Data)
+	if err != nil {
+		k.Logger(ctx).Error("SubmitClaim: error unmarshalling protocol data")
+		return nil, fmt.Errorf("unable to unmarshal connection protocol data for %q", msg.SrcZone)
+	}
+	connectionData, ok := iConnectionData.(*types.ConnectionProtocolData)
+	if !ok {
+		return nil, fmt.Errorf("unable to cast connection protocol data for %q", msg.SrcZone)
+	}
+
+	return connectionData, nil
+}
+
+// validateClaim checks that claims are enabled and that the zone and source
+// zone of a claim are supported, returning the connection protocol data of the
+// source zone and the submodule of the claim type, or nil if the claim type has
+// no submodule.
+func (k *Keeper) validateClaim(ctx sdk.Context, msg *types.MsgSubmitClaim) (*types.ConnectionProtocolData, Submodule, error) {
+	if !k.GetClaimsEnabled(ctx) {
+		return nil, nil, errors.New("claims currently disabled")
+	}
+	if _, ok := k.icsKeeper.GetZone(ctx, msg.Zone); !ok {
+		return nil, nil, fmt.Errorf("invalid zone, chain id %q not found", msg.Zone)
+	}
+	connectionData, err := k.claimConnectionData(ctx, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return connectionData, k.PrSubmodules[msg.ClaimType], nil
+}
+
+// validateClaimProof checks that a claim proof is for the last epoch of the
+// source zone, and that its proof ops prove its key and data.
+func (k *Keeper) validateClaimProof(ctx sdk.Context, msg *types.MsgSubmitClaim, connectionData *types.ConnectionProtocolData, proof *claimsmanagertypes.Proof) (types.ClaimValidationError, error) {
+	if proof.Height != connectionData.LastEpoch {
+		return types.ClaimValidationErrorHeightMismatch, fmt.Errorf(
+			"invalid claim for last epoch, expected height %d, got %d",
+			connectionData.LastEpoch,
+			proof.Height,
+		)
+	}
+
+	// if we are claiming against Quicksilver, use the SelfProofOpsFn.
+	if msg.SrcZone == ctx.ChainID() {
+		if err := k.ValidateSelfProofOps(
+			ctx,
+			k.ClaimsManagerKeeper,
+			"epoch",
+			proof.ProofType,
+			proof.Key,
+			proof.Data,
+			proof.ProofOps,
+		); err != nil {
+			return types.ClaimValidationErrorInvalidProof, err
+		}
+		return types.ClaimValidationErrorNone, nil
+	}
+
+	if err := k.ValidateProofOps(
+		ctx,
+		k.IBCKeeper,
+		connectionData.ConnectionID,
+		connectionData.ChainID,
+		proof.Height,
+		proof.ProofType,
+		proof.Key,
+		proof.Data,
+		proof.ProofOps,
+	); err != nil {
+		return types.ClaimValidationErrorInvalidProof, err
+	}
+	return types.ClaimValidationErrorNone, nil
+}
+
+// DryRunClaim performs the checks of SubmitClaim against a cached context,
+// without recording the claim. Each proof is validated, and credited by the
+// claim type submodule, independently, such that every rejected proof is
+// reported.
+func (k *Keeper) DryRunClaim(ctx sdk.Context, msg *types.MsgSubmitClaim) types.QueryValidateClaimResponse {
+	ctx, _ = ctx.CacheContext()
+
+	res := types.QueryValidateClaimResponse{Amount: math.ZeroInt(), Proofs: make([]types.ProofValidation, 0, len(msg.Proofs))}
+	fail := func(err error) types.QueryValidateClaimResponse {
+		res.Valid = false
+		res.Error = err.Error()
+		return res
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return fail(err)
+	}
+	connectionData, mod, err := k.validateClaim(ctx, msg)
+	if err != nil {
+		return fail(err)
+	}
+	// SubmitClaim accepts claims of unsupported types without recording them;
+	// report them here, as they will never be credited.
+	if mod == nil {
+		return fail(fmt.Errorf("unsupported claim type %s", msg.ClaimType))
+	}
+
+	res.Valid = true
+	keys := make(map[string]struct{})
+	for i, proof := range msg.Proofs {
+		result := types.ProofValidation{Index: uint32(i), Amount: math.ZeroInt()} //nolint:gosec
+
+		if reason, err := k.validateClaimProof(ctx, msg, connectionData, proof); err != nil {
+			result.Reason, result.Error = reason, err.Error()
+			res.Valid = false
+		} else if _, found := keys[string(proof.Key)]; found {
+			result.Reason, result.Error = types.ClaimValidationErrorDuplicateKey, "duplicate proof key, proof is not credited"
+		} else {
+			proofMsg := *msg
+			proofMsg.Proofs = []*claimsmanagertypes.Proof{proof}
+			amount, err := mod.ValidateClaim(ctx, k, &proofMsg)
+			if err != nil {
+				result.Reason, result.Error = types.ClaimValidationErrorSubmoduleRejected, err.Error()
+				res.Valid = false
+			} else {
+				result.Amount = amount
+			}
+		}
+		keys[string(proof.Key)] = struct{}{}
+
+		res.Proofs = append(res.Proofs, result)
+	}
+
+	if !res.Valid {
+		res.Error = "one or more proofs are invalid"
+		return res
+	}
+
+	amount, err := mod.ValidateClaim(ctx, k, msg)
+	if err != nil {
+		return fail(fmt.Errorf("claim validation failed: %w", err))
+	}
+	res.Amount = amount
+
+	return res
+}
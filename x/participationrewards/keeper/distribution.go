@@ -3,11 +3,10 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"go.uber.org/multierr"
 
-	"cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm/pool-models/stableswap"
@@ -20,38 +19,190 @@ import (
 
 type TokenValues map[string]sdk.Dec
 
-type (
-	AssetGraph      map[string]map[string]sdk.Dec
-	AssetGraphSlice map[string]map[string][]sdk.Dec
-)
+// PoolQuote is the spot price of a single pool for an edge of the price graph.
+type PoolQuote struct {
+	PoolID uint64
+	// Price is the value of one unit of the edge target denom, in units of the
+	// edge source denom.
+	Price sdk.Dec
+	// Reserve is the amount of the edge source denom held by the pool.
+	Reserve sdk.Dec
+}
+
+// AssetGraph maps a source denom to each target denom it shares a pool with,
+// and the pool quotes pricing the target in units of the source.
+type AssetGraph map[string]map[string][]PoolQuote
+
+// AddPool adds the quotes of a pool between denom0 and denom1 to the graph, in
+// both directions, given the value of one denom1 in units of denom0.
+func (g AssetGraph) AddPool(poolID uint64, denom0, denom1 string, price, reserve0, reserve1 sdk.Dec) {
+	for _, denom := range []string{denom0, denom1} {
+		if _, ok := g[denom]; !ok {
+			g[denom] = make(map[string][]PoolQuote)
+		}
+	}
+	g[denom0][denom1] = append(g[denom0][denom1], PoolQuote{PoolID: poolID, Price: price, Reserve: reserve0})
+	g[denom1][denom0] = append(g[denom1][denom0], PoolQuote{PoolID: poolID, Price: sdk.OneDec().Quo(price), Reserve: reserve1})
+}
+
+// PricePaths maps each priced denom to the hops traversed from the base denom
+// to price it.
+type PricePaths map[string][]types.PriceHop
+
+// PriceGraph values every denom reachable from baseDenom in units of
+// baseDenom. Each denom is priced via the path with the greatest bottleneck
+// liquidity, where the liquidity of a hop is the value of the source denom
+// reserves of the pools quoting it. See PriceHopFromQuotes for how the quotes
+// of a hop are filtered and combined.
+func PriceGraph(graph AssetGraph, baseDenom string, minLiquidity, maxDeviation sdk.Dec) (TokenValues, PricePaths) {
+	type candidate struct {
+		value     sdk.Dec
+		liquidity sdk.Dec
+		path      []types.PriceHop
+	}
+
+	tvs := TokenValues{baseDenom: sdk.OneDec()}
+	paths := PricePaths{baseDenom: nil}
+	candidates := make(map[string]candidate)
+
+	for asset := baseDenom; asset != ""; {
+		var bottleneck sdk.Dec
+		if asset != baseDenom {
+			bottleneck = candidates[asset].liquidity
+			delete(candidates, asset)
+		}
+
+		for _, neighbour := range utils.Keys(graph[asset]) {
+			if _, priced := tvs[neighbour]; priced {
+				continue
+			}
+			hop, liquidity, ok := PriceHopFromQuotes(asset, neighbour, graph[asset][neighbour], tvs[asset], minLiquidity, maxDeviation)
+			if !ok {
+				continue
+			}
+			if !bottleneck.IsNil() && bottleneck.LT(liquidity) {
+				liquidity = bottleneck
+			}
+			if existing, ok := candidates[neighbour]; ok && !liquidity.GT(existing.liquidity) {
+				continue
+			}
+			candidates[neighbour] = candidate{
+				value:     hop.Price.Mul(tvs[asset]),
+				liquidity: liquidity,
+				path:      append(slices.Clone(paths[asset]), hop),
+			}
+		}
+
+		// price the candidate with the greatest bottleneck liquidity next.
+		asset = ""
+		for _, denom := range utils.Keys(candidates) {
+			if asset == "" || candidates[denom].liquidity.GT(candidates[asset].liquidity) {
+				asset = denom
+			}
+		}
+		if asset != "" {
+			tvs[asset] = candidates[asset].value
+			paths[asset] = candidates[asset].path
+		}
+	}
+
+	return tvs, paths
+}
+
+// PriceHopFromQuotes combines the pool quotes between two denoms into a single
+// price hop, given the value of the source denom. Quotes of pools whose source
+// denom reserves are valued below minLiquidity are discarded, as are quotes
+// deviating from the liquidity weighted median quote by more than
+// maxDeviation. Zero thresholds are not applied. The remaining quotes are
+// averaged, weighted by liquidity. It returns the hop, its total liquidity,
+// and false if no quote remains.
+func PriceHopFromQuotes(from, to string, quotes []PoolQuote, fromValue, minLiquidity, maxDeviation sdk.Dec) (types.PriceHop, sdk.Dec, bool) {
+	type weightedQuote struct {
+		PoolQuote
+		liquidity sdk.Dec
+	}
+
+	eligible := make([]weightedQuote, 0, len(quotes))
+	for _, quote := range quotes {
+		liquidity := quote.Reserve.Mul(fromValue)
+		if !quote.Price.IsPositive() || !liquidity.IsPositive() || liquidity.LT(minLiquidity) {
+			continue
+		}
+		eligible = append(eligible, weightedQuote{PoolQuote: quote, liquidity: liquidity})
+	}
+	if len(eligible) == 0 {
+		return types.PriceHop{}, sdk.ZeroDec(), false
+	}
 
-func DepthFirstSearch(graph AssetGraph, visited map[string]struct{}, asset string, price sdk.Dec, result TokenValues) {
-	visited[asset] = struct{}{}
-	result[asset] = price
+	if maxDeviation.IsPositive() {
+		sorted := slices.Clone(eligible)
+		slices.SortStableFunc(sorted, func(a, b weightedQuote) int { return a.Price.BigInt().Cmp(b.Price.BigInt()) })
+		total := sdk.ZeroDec()
+		for _, quote := range sorted {
+			total = total.Add(quote.liquidity)
+		}
+		median := sorted[len(sorted)-1].Price
+		cumulative := sdk.ZeroDec()
+		for _, quote := range sorted {
+			cumulative = cumulative.Add(quote.liquidity)
+			if cumulative.MulInt64(2).GTE(total) {
+				median = quote.Price
+				break
+			}
+		}
 
-	for _, neighbour := range utils.Keys(graph[asset]) {
-		if _, ok := visited[neighbour]; !ok {
-			DepthFirstSearch(graph, visited, neighbour, graph[asset][neighbour].Mul(price), result)
+		filtered := eligible[:0]
+		for _, quote := range eligible {
+			if quote.Price.Sub(median).Abs().Quo(median).LTE(maxDeviation) {
+				filtered = append(filtered, quote)
+			}
 		}
+		eligible = filtered
 	}
+
+	hop := types.PriceHop{FromDenom: from, ToDenom: to, PoolIds: make([]uint64, 0, len(eligible))}
+	weighted := sdk.ZeroDec()
+	liquidity := sdk.ZeroDec()
+	for _, quote := range eligible {
+		weighted = weighted.Add(quote.Price.Mul(quote.liquidity))
+		liquidity = liquidity.Add(quote.liquidity)
+		hop.PoolIds = append(hop.PoolIds, quote.PoolID)
+	}
+	hop.Price = weighted.Quo(liquidity)
+
+	return hop, liquidity, true
 }
 
 func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
+	tvs, _, err := k.CalcTokenValuesWithPaths(ctx)
+	return tvs, err
+}
+
+// CalcTokenValuesWithPaths calculates token values as CalcTokenValues, and
+// additionally returns the path of pools by which each denom was priced. The
+// epoch of the returned EpochTokenValues is left unset.
+func (k *Keeper) CalcTokenValuesWithPaths(ctx sdk.Context) (TokenValues, types.EpochTokenValues, error) {
 	k.Logger(ctx).Info("calcTokenValues")
 
 	_, osmoParams, err := GetAndUnmarshalProtocolData[*types.OsmosisParamsProtocolData](ctx, k, "osmosisparams", types.ProtocolDataTypeOsmosisParams)
 	if err != nil {
-		return TokenValues{}, err
+		return TokenValues{}, types.EpochTokenValues{}, err
 	}
 
 	baseDenom := osmoParams.BaseDenom
+	params := k.GetParams(ctx)
 
-	tvs := make(TokenValues)
-	graph := make(AssetGraphSlice)
-	graph2 := make(AssetGraph)
+	graph := make(AssetGraph)
 
-	// add base value
-	tvs[baseDenom] = sdk.OneDec()
+	// isStale returns true if pool data was last updated longer ago than permitted by params. Pool data
+	// without an update height predates its recording, and is not considered stale until next updated.
+	isStale := func(poolID uint64, lastUpdatedHeight int64) bool {
+		if params.MaxPoolDataAge == 0 || lastUpdatedHeight == 0 || ctx.BlockHeight()-lastUpdatedHeight <= int64(params.MaxPoolDataAge) { //nolint:gosec
+			return false
+		}
+		k.Logger(ctx).Info("ignoring stale pool data", "pool", poolID, "last_updated_height", lastUpdatedHeight)
+		return true
+	}
 
 	// capture errors from iterator
 	errs := make(map[string]error)
@@ -73,6 +224,9 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 			errs[idxLabel] = errors.New("pool data is nil, awaiting OsmosisPoolUpdateCallback")
 			return false
 		}
+		if isStale(pool.PoolID, pool.LastUpdatedHeight) {
+			return false
+		}
 		gammPool, err := pool.GetPool()
 		if err != nil {
 			errs[idxLabel] = err
@@ -81,15 +235,6 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 
 		denoms := utils.Keys(pool.Denoms)
 
-		prettyDenom0 := pool.Denoms[denoms[0]].Denom
-		prettyDenom1 := pool.Denoms[denoms[1]].Denom
-
-		for _, ibcDenom := range denoms {
-			if _, ok := graph[pool.Denoms[ibcDenom].Denom]; !ok {
-				graph[pool.Denoms[ibcDenom].Denom] = make(map[string][]sdk.Dec)
-			}
-		}
-
 		if gammPool.GetType() == poolmanager.Stableswap {
 			// be defensive. if scaling_factors are missing, avoid panic.
 			ss, ok := gammPool.(*stableswap.Pool)
@@ -109,9 +254,16 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 		}
 
 		decVal := sdk.NewDecFromBigIntWithPrec(value.Dec().BigInt(), 18)
-
-		graph[prettyDenom0][prettyDenom1] = append(graph[prettyDenom0][prettyDenom1], decVal)
-		graph[prettyDenom1][prettyDenom0] = append(graph[prettyDenom1][prettyDenom0], sdk.OneDec().Quo(decVal))
+		liquidity := gammPool.GetTotalPoolLiquidity(ctx)
+
+		graph.AddPool(
+			pool.PoolID,
+			pool.Denoms[denoms[0]].Denom,
+			pool.Denoms[denoms[1]].Denom,
+			decVal,
+			sdk.NewDecFromInt(liquidity.AmountOf(denoms[0])),
+			sdk.NewDecFromInt(liquidity.AmountOf(denoms[1])),
+		)
 
 		return false
 	})
@@ -134,6 +286,9 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 			errs[idxLabel] = errors.New("pool data is nil, awaiting OsmosisClPoolUpdateCallback")
 			return false
 		}
+		if isStale(pool.PoolID, pool.LastUpdatedHeight) {
+			return false
+		}
 		clPool, err := pool.GetPool()
 		if err != nil {
 			errs[idxLabel] = err
@@ -141,14 +296,6 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 		}
 
 		denoms := utils.Keys(pool.Denoms)
-		prettyDenom0 := pool.Denoms[denoms[0]].Denom
-		prettyDenom1 := pool.Denoms[denoms[1]].Denom
-
-		for _, ibcDenom := range denoms {
-			if _, ok := graph[pool.Denoms[ibcDenom].Denom]; !ok {
-				graph[pool.Denoms[ibcDenom].Denom] = make(map[string][]sdk.Dec)
-			}
-		}
 
 		value, err := clPool.SpotPrice(ctx, denoms[0], denoms[1])
 		if err != nil {
@@ -158,34 +305,42 @@ func (k *Keeper) CalcTokenValues(ctx sdk.Context) (TokenValues, error) {
 
 		decVal := sdk.NewDecFromBigIntWithPrec(value.Dec().BigInt(), 18)
 
-		graph[prettyDenom0][prettyDenom1] = append(graph[prettyDenom0][prettyDenom1], decVal)
-		graph[prettyDenom1][prettyDenom0] = append(graph[prettyDenom1][prettyDenom0], sdk.OneDec().Quo(decVal))
+		// the reserves in range of the current tick are used as the pool
+		// liquidity: L/sqrt(P) of token0 and L*sqrt(P) of token1.
+		sqrtPrice := sdk.NewDecFromBigIntWithPrec(clPool.GetCurrentSqrtPrice().Dec().BigInt(), 18)
+		reserve0, reserve1 := sdk.ZeroDec(), sdk.ZeroDec()
+		if sqrtPrice.IsPositive() {
+			reserve0 = clPool.GetLiquidity().Quo(sqrtPrice)
+			reserve1 = clPool.GetLiquidity().Mul(sqrtPrice)
+		}
+		if clPool.GetToken0() != denoms[0] {
+			reserve0, reserve1 = reserve1, reserve0
+		}
+
+		graph.AddPool(
+			pool.PoolID,
+			pool.Denoms[denoms[0]].Denom,
+			pool.Denoms[denoms[1]].Denom,
+			decVal,
+			reserve0,
+			reserve1,
+		)
 
 		return false
 	})
 
-	for _, denom0 := range utils.Keys(graph) {
-		graph2[denom0] = make(map[string]sdk.Dec)
-		values := graph[denom0]
-		for _, denom1 := range utils.Keys(values) {
-			value := sdk.ZeroDec()
-			count := math.ZeroInt()
-			for _, asset := range values[denom1] {
-				value = value.Add(asset)
-				count = count.Add(math.OneInt())
-			}
-			graph2[denom0][denom1] = value.QuoInt(count)
-		}
+	if len(errs) > 0 {
+		return TokenValues{}, types.EpochTokenValues{}, multierr.Combine(utils.ErrorMapToSlice(errs)...)
 	}
 
-	visited := make(map[string]struct{})
-	DepthFirstSearch(graph2, visited, baseDenom, sdk.OneDec(), tvs)
+	tvs, paths := PriceGraph(graph, baseDenom, sdk.NewDecFromInt(params.MinPoolLiquidity), params.MaxPriceDeviation)
 
-	if len(errs) > 0 {
-		return TokenValues{}, multierr.Combine(utils.ErrorMapToSlice(errs)...)
+	etv := types.EpochTokenValues{BaseDenom: baseDenom, Values: make([]types.TokenValue, 0, len(tvs))}
+	for _, denom := range utils.Keys(tvs) {
+		etv.Values = append(etv.Values, types.TokenValue{Denom: denom, Value: tvs[denom], Path: paths[denom]})
 	}
 
-	return tvs, nil
+	return tvs, etv, nil
 }
 
 // AllocateZoneRewards executes zone based rewards allocation. This entails
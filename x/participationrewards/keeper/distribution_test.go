@@ -4,8 +4,11 @@ import (
 	"encoding/json"
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/gamm/pool-models/balancer"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -142,9 +145,9 @@ func (suite *KeeperTestSuite) TestCalcTokenValues() {
 			expectedTvs: keeper.TokenValues{
 				"uatom":  sdk.MustNewDecFromStr("18.680609802053228684"),
 				"uosmo":  sdk.MustNewDecFromStr("1.000000000000000000"),
-				"uqatom": sdk.MustNewDecFromStr("21.292547025239143631"),
+				"uqatom": sdk.MustNewDecFromStr("20.906762084414649136"),
 				"uqck":   sdk.MustNewDecFromStr("0.156547296630061979"),
-				"uqosmo": sdk.MustNewDecFromStr("1.076844826109830973"),
+				"uqosmo": sdk.MustNewDecFromStr("1.101069045339376041"),
 				"usaga":  sdk.MustNewDecFromStr("3.399452074581916723"),
 				"adydx":  sdk.MustNewDecFromStr("0.000000000002440520"),
 				"uusdc":  sdk.MustNewDecFromStr("1.124381939441023032"),
@@ -240,7 +243,7 @@ func (suite *KeeperTestSuite) TestCalcTokenValuesIncCLPools() {
 		"ujuno":   sdk.MustNewDecFromStr("0.252676989792765540"),
 		"uosmo":   sdk.MustNewDecFromStr("1.000000000000000000"),
 		"uqatom":  sdk.MustNewDecFromStr("16.637863612013346262"),
-		"uqosmo":  sdk.MustNewDecFromStr("1.201708298212189075"),
+		"uqosmo":  sdk.MustNewDecFromStr("1.216086291080952000"),
 		"uqregen": sdk.MustNewDecFromStr("0.069536169040918330"),
 		"uqsomm":  sdk.MustNewDecFromStr("0.073765486093994849"),
 		"uqstars": sdk.MustNewDecFromStr("0.029935583655522436"),
@@ -334,4 +337,193 @@ func (suite *KeeperTestSuite) TestCalcTokenValuesPoolsMultihop() {
 	for denom, expectedValue := range expected {
 		suite.Equal(tvs[denom], expectedValue)
 	}
+	_, etv, err := qs.ParticipationRewardsKeeper.CalcTokenValuesWithPaths(ctx)
+	suite.NoError(err)
+	suite.Equal("uosmo", etv.BaseDenom)
+	suite.Len(etv.Values, len(tvs))
+
+	base, found := etv.GetTokenValue("uosmo")
+	suite.True(found)
+	suite.Empty(base.Path)
+
+	aarch, found := etv.GetTokenValue("aarch")
+	suite.True(found)
+	suite.Equal(expected["aarch"], aarch.Value)
+	// aarch is priced via uusdc, as there is no direct uosmo/aarch pool.
+	suite.Equal([]types.PriceHop{
+		{FromDenom: "uosmo", ToDenom: "uusdc", Price: sdk.MustNewDecFromStr("3.216091876550989897"), PoolIds: []uint64{1464}},
+		{FromDenom: "uusdc", ToDenom: "aarch", Price: sdk.MustNewDecFromStr("0.000000000000015275"), PoolIds: []uint64{1375}},
+	}, aarch.Path)
+}
+
+var pricingFixtureDenoms = map[string]types.DenomWithZone{
+	"uosmo":     {Denom: "uosmo", ChainID: "osmosis-1"},
+	"ibc/ATOM":  {Denom: "uatom", ChainID: "cosmoshub-4"},
+	"ibc/QATOM": {Denom: "uqatom", ChainID: "cosmoshub-4"},
+}
+
+// setPricingFixture sets osmosis params with base denom uosmo, and a balancer
+// pool of equal weights for each of the given pool reserves.
+func (suite *KeeperTestSuite) setPricingFixture(ctx sdk.Context, pools map[uint64]sdk.Coins, lastUpdatedHeights map[uint64]int64) {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+
+	osmoParams := types.OsmosisParamsProtocolData{ChainID: "osmosis-1", BaseDenom: "uosmo", BaseChain: "osmosis-1"}
+	osmoParamsJSON, err := json.Marshal(osmoParams)
+	suite.NoError(err)
+	k.SetProtocolData(ctx, osmoParams.GenerateKey(), &types.ProtocolData{
+		Type: types.ProtocolDataType_name[int32(types.ProtocolDataTypeOsmosisParams)],
+		Data: osmoParamsJSON,
+	})
+
+	for poolID, reserves := range pools {
+		assets := []balancer.PoolAsset{}
+		denoms := map[string]types.DenomWithZone{}
+		for _, reserve := range reserves {
+			assets = append(assets, balancer.PoolAsset{Token: reserve, Weight: math.NewInt(100)})
+			denoms[reserve.Denom] = pricingFixtureDenoms[reserve.Denom]
+		}
+		pool, err := balancer.NewBalancerPool(poolID, balancer.PoolParams{SwapFee: sdk.ZeroDec(), ExitFee: sdk.ZeroDec()}, assets, "", ctx.BlockTime())
+		suite.NoError(err)
+		poolData, err := json.Marshal(pool)
+		suite.NoError(err)
+
+		pd := types.OsmosisPoolProtocolData{
+			PoolID:            poolID,
+			LastUpdated:       ctx.BlockTime(),
+			LastUpdatedHeight: lastUpdatedHeights[poolID],
+			PoolData:          poolData,
+			PoolType:          types.PoolTypeBalancer,
+			Denoms:            denoms,
+		}
+		pdJSON, err := json.Marshal(pd)
+		suite.NoError(err)
+		k.SetProtocolData(ctx, pd.GenerateKey(), &types.ProtocolData{
+			Type: types.ProtocolDataType_name[int32(types.ProtocolDataTypeOsmosisPool)],
+			Data: pdJSON,
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCalcTokenValuesLiquidityWeighted() {
+	// pool 1 is deep, valuing atom at 10 osmo; pool 2 is thin, valuing atom at 20 osmo.
+	pools := map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000_000), sdk.NewInt64Coin("ibc/ATOM", 100_000_000)),
+		2: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000), sdk.NewInt64Coin("ibc/ATOM", 50_000)),
+	}
+
+	tests := []struct {
+		name          string
+		minLiquidity  math.Int
+		maxDeviation  sdk.Dec
+		maxAge        uint64
+		lastUpdated   map[uint64]int64
+		expectedValue sdk.Dec
+		expectedPools []uint64
+	}{
+		{
+			name:          "quotes weighted by liquidity",
+			minLiquidity:  math.ZeroInt(),
+			maxDeviation:  sdk.ZeroDec(),
+			expectedValue: sdk.NewDec(10_020_000_000).Quo(sdk.NewDec(1_001_000_000)),
+			expectedPools: []uint64{1, 2},
+		},
+		{
+			name:          "quotes below min liquidity dropped",
+			minLiquidity:  math.NewInt(10_000_000),
+			maxDeviation:  sdk.ZeroDec(),
+			expectedValue: sdk.NewDec(10),
+			expectedPools: []uint64{1},
+		},
+		{
+			name:          "quotes beyond max deviation dropped",
+			minLiquidity:  math.ZeroInt(),
+			maxDeviation:  sdk.NewDecWithPrec(5, 2),
+			expectedValue: sdk.NewDec(10),
+			expectedPools: []uint64{1},
+		},
+		{
+			name:          "stale pools ignored",
+			minLiquidity:  math.ZeroInt(),
+			maxDeviation:  sdk.ZeroDec(),
+			maxAge:        100,
+			lastUpdated:   map[uint64]int64{1: 1000, 2: 900},
+			expectedValue: sdk.NewDec(10),
+			expectedPools: []uint64{1},
+		},
+		{
+			name:          "pools without update height not stale",
+			minLiquidity:  math.ZeroInt(),
+			maxDeviation:  sdk.ZeroDec(),
+			maxAge:        100,
+			expectedValue: sdk.NewDec(10_020_000_000).Quo(sdk.NewDec(1_001_000_000)),
+			expectedPools: []uint64{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+
+			k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+			ctx := suite.chainA.GetContext().WithBlockHeight(1050)
+
+			params := k.GetParams(ctx)
+			params.MinPoolLiquidity = tt.minLiquidity
+			params.MaxPriceDeviation = tt.maxDeviation
+			params.MaxPoolDataAge = tt.maxAge
+			k.SetParams(ctx, params)
+
+			suite.setPricingFixture(ctx, pools, tt.lastUpdated)
+
+			tvs, etv, err := k.CalcTokenValuesWithPaths(ctx)
+			suite.NoError(err)
+			suite.Equal(tt.expectedValue, tvs["uatom"])
+
+			atom, found := etv.GetTokenValue("uatom")
+			suite.True(found)
+			suite.Len(atom.Path, 1)
+			suite.Equal(tt.expectedPools, atom.Path[0].PoolIds)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCalcTokenValuesNoEligiblePools() {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext().WithBlockHeight(1050)
+
+	params := k.GetParams(ctx)
+	params.MinPoolLiquidity = math.NewInt(10_000_000_000)
+	k.SetParams(ctx, params)
+
+	suite.setPricingFixture(ctx, map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000_000), sdk.NewInt64Coin("ibc/ATOM", 100_000_000)),
+	}, nil)
+
+	tvs, err := k.CalcTokenValues(ctx)
+	suite.NoError(err)
+	suite.Equal(keeper.TokenValues{"uosmo": sdk.OneDec()}, tvs)
+}
+
+func (suite *KeeperTestSuite) TestCalcTokenValuesPrefersLiquidPath() {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+
+	// qatom can be priced directly from a thin osmo pool at 30 osmo, or via
+	// deep osmo/atom and atom/qatom pools at 10 * 1.25 osmo.
+	suite.setPricingFixture(ctx, map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000_000_000), sdk.NewInt64Coin("ibc/ATOM", 100_000_000)),
+		3: sdk.NewCoins(sdk.NewInt64Coin("ibc/ATOM", 100_000_000), sdk.NewInt64Coin("ibc/QATOM", 80_000_000)),
+		4: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 3_000_000), sdk.NewInt64Coin("ibc/QATOM", 100_000)),
+	}, nil)
+
+	tvs, etv, err := k.CalcTokenValuesWithPaths(ctx)
+	suite.NoError(err)
+	suite.Equal(sdk.MustNewDecFromStr("12.5"), tvs["uqatom"])
+
+	qatom, found := etv.GetTokenValue("uqatom")
+	suite.True(found)
+	suite.Equal([]types.PriceHop{
+		{FromDenom: "uosmo", ToDenom: "uatom", Price: sdk.NewDec(10), PoolIds: []uint64{1}},
+		{FromDenom: "uatom", ToDenom: "uqatom", Price: sdk.MustNewDecFromStr("1.25"), PoolIds: []uint64{3}},
+	}, qatom.Path)
 }
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// EstimateRewards projects the participation rewards that would be paid to
+// address at the end of the current epoch.
+//
+// Zone allocations are derived from the module balance and the given token
+// values, as they are at epoch end; the allocation is applied to a cached
+// context and discarded. Only the share of address is calculated: holdings
+// rewards from its current claims and the supply of the zone's qAsset, and
+// validator selection rewards from its snapshotted intent, being the intent
+// rewarded at epoch end, relative to the total score of the snapshotted
+// intents recorded when they were taken. Both are valued at the last
+// calculated validator scores.
+func (k *Keeper) EstimateRewards(ctx sdk.Context, address string, etv types.EpochTokenValues) types.QueryEstimatedRewardsResponse {
+	tvs := make(TokenValues, len(etv.Values))
+	for _, tv := range etv.Values {
+		tvs[tv.Denom] = tv.Value
+	}
+
+	// silence the allocation logging of the cached state transitions.
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithLogger(log.NewNopLogger())
+
+	allocated := false
+	allocation, err := types.GetRewardsAllocations(k.GetModuleBalance(ctx), k.GetParams(ctx).DistributionProportions)
+	if err == nil {
+		allocated = k.SetZoneAllocations(cacheCtx, tvs, *allocation) == nil
+	}
+
+	zones := make([]*icstypes.Zone, 0)
+	k.icsKeeper.IterateZones(cacheCtx, func(_ int64, zone *icstypes.Zone) (stop bool) {
+		zones = append(zones, zone)
+		return false
+	})
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	resp := types.QueryEstimatedRewardsResponse{
+		Epoch: etv.Epoch,
+		Zones: make([]types.ZoneEstimatedRewards, 0, len(zones)),
+		Total: sdk.NewCoin(bondDenom, math.ZeroInt()),
+	}
+
+	for _, zone := range zones {
+		if !allocated {
+			zone.HoldingsAllocation = 0
+			zone.ValidatorSelectionAllocation = 0
+		}
+
+		zr := types.ZoneEstimatedRewards{
+			ChainId:                      zone.ChainId,
+			HoldingsAllocation:           math.NewIntFromUint64(zone.HoldingsAllocation),
+			ValidatorSelectionAllocation: math.NewIntFromUint64(zone.ValidatorSelectionAllocation),
+		}
+		zr.Holdings, zr.HoldingsRewards = k.estimateHoldingsRewards(ctx, zone, address, bondDenom)
+		zr.ValidatorSelectionRewards = k.estimateValidatorSelectionRewards(ctx, zone, address, bondDenom)
+
+		resp.Total = resp.Total.Add(zr.HoldingsRewards).Add(zr.ValidatorSelectionRewards)
+		resp.Zones = append(resp.Zones, zr)
+	}
+
+	return resp
+}
+
+// estimateHoldingsRewards returns the current claims of address for the given
+// zone summed by claim type, and the holdings rewards they would be allocated,
+// as by CalcUserHoldingsAllocations. The rewards are split between claim types
+// in proportion to their amounts, with any remainder of the split attributed
+// to the largest.
+func (k *Keeper) estimateHoldingsRewards(ctx sdk.Context, zone *icstypes.Zone, address, bondDenom string) ([]types.ClaimTypeEstimatedRewards, sdk.Coin) {
+	amounts := make(map[cmtypes.ClaimType]math.Int)
+	total := math.ZeroInt()
+	k.ClaimsManagerKeeper.IterateUserClaims(ctx, zone.ChainId, address, func(_ int64, claim cmtypes.Claim) (stop bool) {
+		if _, exists := amounts[claim.Module]; !exists {
+			amounts[claim.Module] = math.ZeroInt()
+		}
+		amounts[claim.Module] = amounts[claim.Module].Add(claim.Amount)
+		total = total.Add(claim.Amount)
+		return false
+	})
+
+	rewards := math.ZeroInt()
+	if supply := k.bankKeeper.GetSupply(ctx, zone.LocalDenom); zone.HoldingsAllocation > 0 && supply.Amount.IsPositive() {
+		tokensPerAsset := sdk.NewDecFromInt(math.NewIntFromUint64(zone.HoldingsAllocation)).Quo(sdk.NewDecFromInt(supply.Amount))
+		rewards = sdk.NewDecFromInt(total).Mul(tokensPerAsset).TruncateInt()
+	}
+
+	out := make([]types.ClaimTypeEstimatedRewards, 0, len(amounts))
+	for claimType, amount := range amounts {
+		out = append(out, types.ClaimTypeEstimatedRewards{
+			ClaimType: claimType,
+			Amount:    amount,
+			Rewards:   sdk.NewCoin(bondDenom, math.ZeroInt()),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ClaimType < out[j].ClaimType })
+
+	if rewards.IsPositive() {
+		largest, remainder := 0, rewards
+		for i := range out {
+			out[i].Rewards.Amount = rewards.Mul(out[i].Amount).Quo(total)
+			remainder = remainder.Sub(out[i].Rewards.Amount)
+			if out[i].Amount.GT(out[largest].Amount) {
+				largest = i
+			}
+		}
+		out[largest].Rewards.Amount = out[largest].Rewards.Amount.Add(remainder)
+	}
+
+	return out, sdk.NewCoin(bondDenom, rewards)
+}
+
+// estimateValidatorSelectionRewards returns the validator selection rewards
+// the snapshotted intent of address would be allocated, as by
+// CalcUserValidatorSelectionAllocations.
+func (k *Keeper) estimateValidatorSelectionRewards(ctx sdk.Context, zone *icstypes.Zone, address, bondDenom string) sdk.Coin {
+	rewards := sdk.NewCoin(bondDenom, math.ZeroInt())
+	if zone.ValidatorSelectionAllocation == 0 {
+		return rewards
+	}
+
+	di, found := k.icsKeeper.GetDelegatorIntent(ctx, zone, address, true)
+	if !found {
+		return rewards
+	}
+	total, found := k.GetIntentScore(ctx, zone.ChainId)
+	if !found || !total.IsPositive() {
+		return rewards
+	}
+
+	score := intentScore(di, k.validatorScores(ctx, zone.ChainId))
+	allocation := sdk.NewDecFromInt(math.NewIntFromUint64(zone.ValidatorSelectionAllocation))
+	// validator scores may have been recalculated since the intents were
+	// snapshotted, so the share is capped at the whole allocation.
+	rewards.Amount = sdk.MinDec(score.Mul(allocation.Quo(total)), allocation).TruncateInt()
+	return rewards
+}
@@ -3,9 +3,14 @@ package keeper
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
@@ -36,3 +41,129 @@ func (k *Keeper) ProtocolData(c context.Context, q *types.QueryProtocolDataReque
 
 	return &types.QueryProtocolDataResponse{Data: out}, nil
 }
+
+// TokenValues returns the token values recorded at the end of the requested
+// epoch, or of the latest epoch if none is specified.
+func (k *Keeper) TokenValues(c context.Context, req *types.QueryTokenValuesRequest) (*types.QueryTokenValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	etv, err := k.epochTokenValues(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokenValuesResponse{TokenValues: etv}, nil
+}
+
+// PricePath returns the value of a denom, and the path of pools by which it
+// was priced, at the end of the requested epoch, or of the latest epoch if
+// none is specified.
+func (k *Keeper) PricePath(c context.Context, req *types.QueryPricePathRequest) (*types.QueryPricePathResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom must not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	etv, err := k.epochTokenValues(ctx, req.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	tv, found := etv.GetTokenValue(req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no price found for %s in epoch %d", req.Denom, etv.Epoch))
+	}
+
+	return &types.QueryPricePathResponse{Epoch: etv.Epoch, BaseDenom: etv.BaseDenom, TokenValue: tv}, nil
+}
+
+// ValidateClaim performs the checks of SubmitClaim without recording the
+// claim, returning the amount each proof would credit and the reason any
+// proof would be rejected.
+func (k *Keeper) ValidateClaim(c context.Context, req *types.QueryValidateClaimRequest) (*types.QueryValidateClaimResponse, error) {
+	if req == nil || req.Claim == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := k.DryRunClaim(ctx, req.Claim)
+
+	return &res, nil
+}
+
+// EstimatedRewards returns the participation rewards the given address would
+// be paid at the end of the current epoch, based upon the latest token values.
+func (k *Keeper) EstimatedRewards(c context.Context, req *types.QueryEstimatedRewardsRequest) (*types.QueryEstimatedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := addressutils.AccAddressFromBech32(req.Address, ""); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	etv, err := k.epochTokenValues(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	res := k.EstimateRewards(ctx, req.Address, etv)
+
+	return &res, nil
+}
+
+// ValidatorScores returns the validator scores of a zone calculated in the
+// requested epoch, or the latest scores if none is specified.
+func (k *Keeper) ValidatorScores(c context.Context, req *types.QueryValidatorScoresRequest) (*types.QueryValidatorScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ChainId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chain id must not be empty")
+	}
+	if req.Epoch < 0 {
+		return nil, status.Error(codes.InvalidArgument, "epoch must not be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Epoch == 0 {
+		ezs, found := k.GetLatestEpochZoneScore(ctx, req.ChainId)
+		if !found {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("no validator scores recorded for %s", req.ChainId))
+		}
+		return &types.QueryValidatorScoresResponse{ZoneScore: ezs}, nil
+	}
+
+	ezs, found := k.GetEpochZoneScore(ctx, req.ChainId, req.Epoch)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no validator scores recorded for %s in epoch %d", req.ChainId, req.Epoch))
+	}
+
+	return &types.QueryValidatorScoresResponse{ZoneScore: ezs}, nil
+}
+
+func (k *Keeper) epochTokenValues(ctx sdk.Context, epoch int64) (types.EpochTokenValues, error) {
+	if epoch < 0 {
+		return types.EpochTokenValues{}, status.Error(codes.InvalidArgument, "epoch must not be negative")
+	}
+
+	if epoch == 0 {
+		etv, found := k.GetLatestEpochTokenValues(ctx)
+		if !found {
+			return etv, status.Error(codes.NotFound, "no token values recorded")
+		}
+		return etv, nil
+	}
+
+	etv, found := k.GetEpochTokenValues(ctx, epoch)
+	if !found {
+		return etv, status.Error(codes.NotFound, fmt.Sprintf("no token values recorded for epoch %d", epoch))
+	}
+	return etv, nil
+}
@@ -4,6 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
@@ -41,3 +53,335 @@ func (suite *KeeperTestSuite) TestKeeper_ProtocolData() {
 		suite.Equal(want, *got)
 	})
 }
+
+func (suite *KeeperTestSuite) TestKeeper_TokenValues() {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := k.TokenValues(ctx, nil)
+	suite.Error(err)
+
+	epoch1 := types.EpochTokenValues{Epoch: 100, BaseDenom: "uosmo", Values: []types.TokenValue{{Denom: "uosmo", Value: sdk.OneDec()}}}
+	epoch2 := types.EpochTokenValues{
+		Epoch:     101,
+		BaseDenom: "uosmo",
+		Values: []types.TokenValue{
+			{Denom: "uosmo", Value: sdk.OneDec()},
+			{Denom: "uqatom", Value: sdk.NewDec(9), Path: []types.PriceHop{{FromDenom: "uosmo", ToDenom: "uqatom", Price: sdk.NewDec(9), PoolIds: []uint64{944}}}},
+		},
+	}
+	k.SetEpochTokenValues(ctx, epoch1)
+	k.SetEpochTokenValues(ctx, epoch2)
+
+	got, err := k.TokenValues(ctx, &types.QueryTokenValuesRequest{})
+	suite.NoError(err)
+	suite.Equal(epoch2, got.TokenValues)
+
+	got, err = k.TokenValues(ctx, &types.QueryTokenValuesRequest{Epoch: 100})
+	suite.NoError(err)
+	suite.Equal(epoch1, got.TokenValues)
+
+	_, err = k.TokenValues(ctx, &types.QueryTokenValuesRequest{Epoch: 102})
+	suite.ErrorContains(err, "no token values recorded for epoch 102")
+
+	_, err = k.TokenValues(ctx, &types.QueryTokenValuesRequest{Epoch: -1})
+	suite.Error(err)
+
+	// entries older than the retained history are pruned.
+	k.SetEpochTokenValues(ctx, types.EpochTokenValues{Epoch: 100 + types.TokenValuesHistoryLength, BaseDenom: "uosmo"})
+	_, err = k.TokenValues(ctx, &types.QueryTokenValuesRequest{Epoch: 100})
+	suite.Error(err)
+	_, err = k.TokenValues(ctx, &types.QueryTokenValuesRequest{Epoch: 101})
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_PricePath() {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+
+	qatom := types.TokenValue{Denom: "uqatom", Value: sdk.NewDec(9), Path: []types.PriceHop{{FromDenom: "uosmo", ToDenom: "uqatom", Price: sdk.NewDec(9), PoolIds: []uint64{944}}}}
+	k.SetEpochTokenValues(ctx, types.EpochTokenValues{Epoch: 100, BaseDenom: "uosmo", Values: []types.TokenValue{{Denom: "uosmo", Value: sdk.OneDec()}, qatom}})
+
+	_, err := k.PricePath(ctx, nil)
+	suite.Error(err)
+
+	_, err = k.PricePath(ctx, &types.QueryPricePathRequest{})
+	suite.Error(err)
+
+	got, err := k.PricePath(ctx, &types.QueryPricePathRequest{Denom: "uqatom"})
+	suite.NoError(err)
+	suite.Equal(types.QueryPricePathResponse{Epoch: 100, BaseDenom: "uosmo", TokenValue: qatom}, *got)
+
+	_, err = k.PricePath(ctx, &types.QueryPricePathRequest{Denom: "uqosmo", Epoch: 100})
+	suite.ErrorContains(err, "no price found for uqosmo in epoch 100")
+
+	_, err = k.PricePath(ctx, &types.QueryPricePathRequest{Denom: "uqatom", Epoch: 99})
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ValidateClaim() {
+	suite.SetupTest()
+
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	k := appA.ParticipationRewardsKeeper
+	k.ValidateProofOps = utils.ValidateProofOps
+	k.ValidateSelfProofOps = utils.ValidateSelfProofOps
+
+	address := addressutils.GenerateAccAddressForTest()
+
+	suite.coordinator.CommitBlock(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	suite.NoError(appA.BankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(100)))))
+	suite.NoError(appA.BankKeeper.SendCoinsFromModuleToAccount(ctx, "mint", address, sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(100)))))
+	rawPd := types.LiquidAllowedDenomProtocolData{
+		ChainID:               suite.chainA.ChainID,
+		IbcDenom:              "uqatom",
+		QAssetDenom:           "uqatom",
+		RegisteredZoneChainID: suite.chainB.ChainID,
+	}
+	blob, err := json.Marshal(rawPd)
+	suite.NoError(err)
+	k.SetProtocolData(ctx, rawPd.GenerateKey(), types.NewProtocolData(types.ProtocolDataType_name[int32(types.ProtocolDataTypeLiquidToken)], blob))
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.NoError(appA.ClaimsManagerKeeper.StoreSelfConsensusState(ctx, "epoch"))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx = suite.chainA.GetContext()
+	resp := appA.Query(abci.RequestQuery{
+		Data:   banktypes.CreatePrefixedAccountStoreKey(address, []byte("uqatom")),
+		Path:   "/store/bank/key",
+		Height: ctx.BlockHeight() - 2,
+		Prove:  true,
+	})
+	proof := func() *cmtypes.Proof {
+		return &cmtypes.Proof{Key: resp.Key, Data: resp.Value, ProofOps: resp.ProofOps, Height: resp.Height, ProofType: "bank"}
+	}
+	claim := func(user string, proofs ...*cmtypes.Proof) *types.MsgSubmitClaim {
+		return &types.MsgSubmitClaim{
+			UserAddress: user,
+			Zone:        suite.chainB.ChainID,
+			SrcZone:     suite.chainA.ChainID,
+			ClaimType:   cmtypes.ClaimTypeLiquidToken,
+			Proofs:      proofs,
+		}
+	}
+
+	_, err = k.ValidateClaim(ctx, nil)
+	suite.Error(err)
+
+	// claims are disabled by default.
+	got, err := k.ValidateClaim(ctx, &types.QueryValidateClaimRequest{Claim: claim(address.String(), proof())})
+	suite.NoError(err)
+	suite.False(got.Valid)
+	suite.Equal("claims currently disabled", got.Error)
+
+	params := k.GetParams(ctx)
+	params.ClaimsEnabled = true
+	k.SetParams(ctx, params)
+
+	// a valid proof is credited once, and its duplicate is not credited.
+	got, err = k.ValidateClaim(ctx, &types.QueryValidateClaimRequest{Claim: claim(address.String(), proof(), proof())})
+	suite.NoError(err)
+	suite.True(got.Valid, got.Error)
+	suite.Equal(math.NewInt(100), got.Amount)
+	suite.Equal([]types.ProofValidation{
+		{Index: 0, Amount: math.NewInt(100), Reason: types.ClaimValidationErrorNone},
+		{Index: 1, Amount: math.ZeroInt(), Reason: types.ClaimValidationErrorDuplicateKey, Error: "duplicate proof key, proof is not credited"},
+	}, got.Proofs)
+
+	// each invalid proof is reported with its reason.
+	wrongHeight := proof()
+	wrongHeight.Height++
+	tampered := proof()
+	tampered.Data = append([]byte{}, tampered.Data...)
+	tampered.Data[len(tampered.Data)-1]++
+	got, err = k.ValidateClaim(ctx, &types.QueryValidateClaimRequest{Claim: claim(address.String(), proof(), wrongHeight, tampered)})
+	suite.NoError(err)
+	suite.False(got.Valid)
+	suite.True(got.Amount.IsZero())
+	suite.Len(got.Proofs, 3)
+	suite.Equal(types.ClaimValidationErrorNone, got.Proofs[0].Reason)
+	suite.Equal(math.NewInt(100), got.Proofs[0].Amount)
+	suite.Equal(types.ClaimValidationErrorHeightMismatch, got.Proofs[1].Reason)
+	suite.Equal(types.ClaimValidationErrorInvalidProof, got.Proofs[2].Reason)
+	suite.NotEmpty(got.Proofs[2].Error)
+
+	// a proof of another user's balance is rejected by the submodule.
+	got, err = k.ValidateClaim(ctx, &types.QueryValidateClaimRequest{Claim: claim(addressutils.GenerateAccAddressForTest().String(), proof())})
+	suite.NoError(err)
+	suite.False(got.Valid)
+	suite.Equal(types.ClaimValidationErrorSubmoduleRejected, got.Proofs[0].Reason)
+
+	// claim types without a submodule are reported, though submission accepts them without recording a claim.
+	unsupported := claim(address.String(), proof())
+	unsupported.ClaimType = cmtypes.ClaimTypeSifchainPool //nolint:staticcheck // deprecated claim type has no submodule.
+	got, err = k.ValidateClaim(ctx, &types.QueryValidateClaimRequest{Claim: unsupported})
+	suite.NoError(err)
+	suite.False(got.Valid)
+	suite.Equal("unsupported claim type ClaimTypeSifchainPool", got.Error)
+	_, err = keeper.NewMsgServerImpl(k).SubmitClaim(ctx, unsupported)
+	suite.NoError(err)
+	_, found := appA.ClaimsManagerKeeper.GetClaim(ctx, suite.chainB.ChainID, address.String(), cmtypes.ClaimTypeSifchainPool, suite.chainA.ChainID) //nolint:staticcheck // deprecated claim type has no submodule.
+	suite.False(found)
+
+	// nothing is written to the claims store.
+	_, found = appA.ClaimsManagerKeeper.GetClaim(ctx, suite.chainB.ChainID, address.String(), cmtypes.ClaimTypeLiquidToken, suite.chainA.ChainID)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_EstimatedRewards() {
+	appA := suite.GetQuicksilverApp(suite.chainA)
+	k := appA.ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+	bondDenom := appA.StakingKeeper.BondDenom(ctx)
+	user := addressutils.GenerateAccAddressForTest().String()
+
+	_, err := k.EstimatedRewards(ctx, nil)
+	suite.Error(err)
+
+	_, err = k.EstimatedRewards(ctx, &types.QueryEstimatedRewardsRequest{Address: "invalid"})
+	suite.Error(err)
+
+	k.SetEpochTokenValues(ctx, types.EpochTokenValues{
+		Epoch:     100,
+		BaseDenom: "uosmo",
+		Values:    []types.TokenValue{{Denom: "uosmo", Value: sdk.OneDec()}, {Denom: "uatom", Value: sdk.NewDec(10)}},
+	})
+
+	suite.NoError(appA.BankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000)))))
+	suite.NoError(appA.BankKeeper.SendCoinsFromModuleToModule(ctx, "mint", types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000)))))
+
+	zone, found := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+	suite.True(found)
+	suite.NoError(appA.BankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin(zone.LocalDenom, math.NewInt(10000)))))
+
+	appA.ClaimsManagerKeeper.SetClaim(ctx, &cmtypes.Claim{UserAddress: user, ChainId: zone.ChainId, Module: cmtypes.ClaimTypeOsmosisPool, SourceChainId: "osmosis-1", Amount: math.NewInt(500)})
+	appA.ClaimsManagerKeeper.SetClaim(ctx, &cmtypes.Claim{UserAddress: user, ChainId: zone.ChainId, Module: cmtypes.ClaimTypeLiquidToken, SourceChainId: "osmosis-1", Amount: math.NewInt(700)})
+	appA.ClaimsManagerKeeper.SetClaim(ctx, &cmtypes.Claim{UserAddress: user, ChainId: zone.ChainId, Module: cmtypes.ClaimTypeLiquidToken, SourceChainId: suite.chainA.ChainID, Amount: math.NewInt(300)})
+
+	// the user and one other hold the snapshotted intents, for a single scored
+	// validator, with the other scoring three times as much.
+	for _, snapshot := range []bool{false, true} {
+		for _, di := range appA.InterchainstakingKeeper.AllDelegatorIntents(ctx, &zone, snapshot) {
+			appA.InterchainstakingKeeper.DeleteDelegatorIntent(ctx, &zone, di.Delegator, snapshot)
+		}
+	}
+	val := appA.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId)[0]
+	val.Score = sdk.NewDec(2)
+	suite.NoError(appA.InterchainstakingKeeper.SetValidator(ctx, zone.ChainId, val))
+	other := addressutils.GenerateAccAddressForTest().String()
+	appA.InterchainstakingKeeper.SetDelegatorIntent(ctx, &zone, icstypes.DelegatorIntent{Delegator: user, Intents: icstypes.ValidatorIntents{{ValoperAddress: val.ValoperAddress, Weight: sdk.NewDecWithPrec(5, 1)}}}, false)
+	appA.InterchainstakingKeeper.SetDelegatorIntent(ctx, &zone, icstypes.DelegatorIntent{Delegator: other, Intents: icstypes.ValidatorIntents{{ValoperAddress: val.ValoperAddress, Weight: sdk.NewDecWithPrec(15, 1)}}}, false)
+	k.SnapshotIntents(ctx, &zone)
+	score, found := k.GetIntentScore(ctx, zone.ChainId)
+	suite.True(found)
+	suite.Equal(sdk.NewDec(4), score)
+
+	// intents changed since the snapshot are not rewarded until the next epoch.
+	appA.InterchainstakingKeeper.SetDelegatorIntent(ctx, &zone, icstypes.DelegatorIntent{Delegator: user, Intents: icstypes.ValidatorIntents{{ValoperAddress: val.ValoperAddress, Weight: sdk.NewDec(10)}}}, false)
+
+	res, err := k.EstimatedRewards(ctx, &types.QueryEstimatedRewardsRequest{Address: user})
+	suite.NoError(err)
+	suite.Equal(int64(100), res.Epoch)
+
+	var zr *types.ZoneEstimatedRewards
+	total := sdk.NewCoin(bondDenom, math.ZeroInt())
+	for i := range res.Zones {
+		if res.Zones[i].ChainId == zone.ChainId {
+			zr = &res.Zones[i]
+		}
+		total = total.Add(res.Zones[i].HoldingsRewards).Add(res.Zones[i].ValidatorSelectionRewards)
+	}
+	suite.NotNil(zr)
+	suite.Equal(total, res.Total)
+
+	suite.True(zr.HoldingsAllocation.IsPositive())
+	suite.True(zr.ValidatorSelectionAllocation.IsPositive())
+
+	// the holdings rewards are split between claim types in proportion to
+	// their amounts, with the remainder attributed to the largest.
+	supply := appA.BankKeeper.GetSupply(ctx, zone.LocalDenom).Amount
+	holdingsRewards := sdk.NewDecFromInt(math.NewInt(1500)).Mul(sdk.NewDecFromInt(zr.HoldingsAllocation).Quo(sdk.NewDecFromInt(supply))).TruncateInt()
+	poolRewards := holdingsRewards.MulRaw(500).QuoRaw(1500)
+	suite.Equal(sdk.NewCoin(bondDenom, holdingsRewards), zr.HoldingsRewards)
+	suite.Equal([]types.ClaimTypeEstimatedRewards{
+		{ClaimType: cmtypes.ClaimTypeLiquidToken, Amount: math.NewInt(1000), Rewards: sdk.NewCoin(bondDenom, holdingsRewards.Sub(poolRewards))},
+		{ClaimType: cmtypes.ClaimTypeOsmosisPool, Amount: math.NewInt(500), Rewards: sdk.NewCoin(bondDenom, poolRewards)},
+	}, zr.Holdings)
+	suite.Equal(sdk.NewCoin(bondDenom, zr.ValidatorSelectionAllocation.QuoRaw(4)), zr.ValidatorSelectionRewards)
+
+	// the estimate does not modify state.
+	_, found = appA.ClaimsManagerKeeper.GetClaim(ctx, zone.ChainId, user, cmtypes.ClaimTypeOsmosisPool, "osmosis-1")
+	suite.True(found)
+	_, found = appA.ClaimsManagerKeeper.GetLastEpochClaim(ctx, zone.ChainId, user, cmtypes.ClaimTypeOsmosisPool, "osmosis-1")
+	suite.False(found)
+	stored, _ := appA.InterchainstakingKeeper.GetZone(ctx, zone.ChainId)
+	suite.Equal(zone.HoldingsAllocation, stored.HoldingsAllocation)
+	suite.Equal(zone.ValidatorSelectionAllocation, stored.ValidatorSelectionAllocation)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ValidatorScores() {
+	k := suite.GetQuicksilverApp(suite.chainA).ParticipationRewardsKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, err := k.ValidatorScores(ctx, nil)
+	suite.Error(err)
+
+	_, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{})
+	suite.Error(err)
+
+	// scores are recorded by the validator selection rewards callback.
+	got, err := k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: suite.chainB.ChainID})
+	suite.NoError(err)
+	suite.Equal(suite.chainB.ChainID, got.ZoneScore.ChainId)
+	suite.Len(got.ZoneScore.ValidatorScores, len(suite.GetQuicksilverApp(suite.chainA).InterchainstakingKeeper.GetValidators(ctx, suite.chainB.ChainID)))
+	for _, vs := range got.ZoneScore.ValidatorScores {
+		suite.True(vs.Score.Equal(vs.DistributionScore.Mul(vs.PerformanceScore)))
+	}
+
+	score := func(power int64) []types.ValidatorScore {
+		return []types.ValidatorScore{{
+			ValoperAddress:    "cosmosvaloper1a",
+			VotingPower:       math.NewInt(power),
+			PowerPercentage:   sdk.OneDec(),
+			DistributionScore: sdk.OneDec(),
+			PerformanceScore:  sdk.OneDec(),
+			Score:             sdk.OneDec(),
+			CommissionRate:    sdk.MustNewDecFromStr("0.05"),
+			RewardsScore:      sdk.OneDec(),
+			UptimeScore:       sdk.OneDec(),
+			CommissionScore:   sdk.OneDec(),
+			GovernanceScore:   sdk.OneDec(),
+		}}
+	}
+	epoch1 := types.EpochZoneScore{ChainId: "cosmoshub-4", Epoch: 100, Height: 1000, TotalVotingPower: math.NewInt(100), ValidatorScores: score(100)}
+	epoch2 := types.EpochZoneScore{ChainId: "cosmoshub-4", Epoch: 101, Height: 1100, TotalVotingPower: math.NewInt(200), ValidatorScores: score(200)}
+	other := types.EpochZoneScore{ChainId: "cosmoshub-40", Epoch: 102, Height: 1200, TotalVotingPower: math.NewInt(300), ValidatorScores: score(300)}
+	k.SetEpochZoneScore(ctx, epoch1)
+	k.SetEpochZoneScore(ctx, epoch2)
+	k.SetEpochZoneScore(ctx, other)
+
+	got, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4"})
+	suite.NoError(err)
+	suite.Equal(epoch2, got.ZoneScore)
+
+	got, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4", Epoch: 100})
+	suite.NoError(err)
+	suite.Equal(epoch1, got.ZoneScore)
+
+	_, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4", Epoch: 102})
+	suite.ErrorContains(err, "no validator scores recorded for cosmoshub-4 in epoch 102")
+
+	_, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4", Epoch: -1})
+	suite.Error(err)
+
+	// entries older than the retained history are pruned, per zone.
+	k.SetEpochZoneScore(ctx, types.EpochZoneScore{ChainId: "cosmoshub-4", Epoch: 100 + types.ZoneScoreHistoryLength, TotalVotingPower: math.ZeroInt()})
+	_, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4", Epoch: 100})
+	suite.Error(err)
+	_, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-4", Epoch: 101})
+	suite.NoError(err)
+	got, err = k.ValidatorScores(ctx, &types.QueryValidatorScoresRequest{ChainId: "cosmoshub-40"})
+	suite.NoError(err)
+	suite.Equal(other, got.ZoneScore)
+}
@@ -20,7 +20,7 @@ func (*Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
 	return nil
 }
 
-func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) error {
+func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if epochIdentifier != epochstypes.EpochIdentifierEpoch {
 		return nil
 	}
@@ -79,12 +79,15 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 		return false
 	})
 
-	tvs, err := k.CalcTokenValues(ctx)
+	tvs, etv, err := k.CalcTokenValuesWithPaths(ctx)
 	if err != nil {
 		k.Logger(ctx).Error("unable to calculate token values", "error", err.Error())
 		return nil
 	}
 
+	etv.Epoch = epochNumber
+	k.SetEpochTokenValues(ctx, etv)
+
 	if allocation == nil {
 		// if allocation is unset, then return early to avoid panic
 		k.Logger(ctx).Error("nil allocation")
@@ -219,51 +222,53 @@ func (k *Keeper) AfterZoneCreated(ctx sdk.Context, zone *icstypes.Zone) error {
 		})
 	}
 
-	// Fetch UmeeParamsProtocolData and create LiquidAllowedDenomProtocolData for Umee
-	umeeParamsData, found := k.GetProtocolData(ctx, types.ProtocolDataTypeUmeeParams, types.UmeeParamsKey)
-	if found {
-		umeeParams, err := types.UnmarshalProtocolData(types.ProtocolDataTypeUmeeParams, umeeParamsData.Data)
+	// Create LiquidAllowedDenomProtocolData for each money market
+	var marketErr error
+	k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(types.ProtocolDataTypeMoneyMarket), func(_ int64, _ []byte, data types.ProtocolData) bool {
+		imarket, err := types.UnmarshalProtocolData(types.ProtocolDataTypeMoneyMarket, data.Data)
 		if err != nil {
-			return err
+			marketErr = err
+			return true
 		}
+		market, _ := imarket.(*types.MoneyMarketProtocolData)
 
-		umeeParamsData, ok := umeeParams.(*types.UmeeParamsProtocolData)
-		if !ok {
-			return errors.New("error unmarshalling protocol data for umee chain")
-		}
-
-		_, tt, err := GetAndUnmarshalProtocolData[*types.ConnectionProtocolData](ctx, k, umeeParamsData.ChainID, types.ProtocolDataTypeConnection)
+		_, tt, err := GetAndUnmarshalProtocolData[*types.ConnectionProtocolData](ctx, k, market.ChainID, types.ProtocolDataTypeConnection)
 		if err != nil {
-			k.Logger(ctx).Error("Error unmarshalling protocol data for umee chain")
-			return err
+			k.Logger(ctx).Error("Error unmarshalling protocol data for money market chain", "chain_id", market.ChainID)
+			marketErr = err
+			return true
 		}
-		umeeChannel := tt.TransferChannel
+		marketChannel := tt.TransferChannel
 
-		// channel for the umee chain
-		channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, umeeChannel)
+		// channel for the money market chain
+		channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, marketChannel)
 		if !found {
-			return errors.New("channel not found: " + umeeChannel)
+			marketErr = errors.New("channel not found: " + marketChannel)
+			return true
 		}
-		umeeDenom := types.LiquidAllowedDenomProtocolData{
-			ChainID:               umeeParamsData.ChainID,
+		marketDenom := types.LiquidAllowedDenomProtocolData{
+			ChainID:               market.ChainID,
 			RegisteredZoneChainID: zone.ChainId,
-			IbcDenom:              utils.DeriveIbcDenom(transfertypes.PortID, channel.Counterparty.ChannelId, transfertypes.PortID, umeeChannel, zone.LocalDenom),
+			IbcDenom:              utils.DeriveIbcDenom(transfertypes.PortID, channel.Counterparty.ChannelId, transfertypes.PortID, marketChannel, zone.LocalDenom),
 			QAssetDenom:           zone.LocalDenom,
 		}
-		if err := umeeDenom.ValidateBasic(); err != nil {
-			return err
+		if err := marketDenom.ValidateBasic(); err != nil {
+			marketErr = err
+			return true
 		}
-		umeeDenomBytes, err := json.Marshal(umeeDenom)
+		marketDenomBytes, err := json.Marshal(marketDenom)
 		if err != nil {
-			return err
+			marketErr = err
+			return true
 		}
-		k.SetProtocolData(ctx, umeeDenom.GenerateKey(), &types.ProtocolData{
+		k.SetProtocolData(ctx, marketDenom.GenerateKey(), &types.ProtocolData{
 			Type: types.ProtocolDataType_name[int32(types.ProtocolDataTypeLiquidToken)],
-			Data: umeeDenomBytes,
+			Data: marketDenomBytes,
 		})
-	}
+		return false
+	})
 
-	return nil
+	return marketErr
 }
 
 // ___________________________________________________________________________________________________
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// SnapshotIntents snapshots the current intents of the zone, to be rewarded
+// at the next epoch boundary, and records their total score against the
+// current validator scores, from which validator selection rewards are
+// estimated without iterating every intent.
+func (k *Keeper) SnapshotIntents(ctx sdk.Context, zone *icstypes.Zone) {
+	scores := k.validatorScores(ctx, zone.ChainId)
+	total := sdk.ZeroDec()
+	for _, di := range k.icsKeeper.AllDelegatorIntents(ctx, zone, false) {
+		k.icsKeeper.SetDelegatorIntent(ctx, zone, di, true)
+		total = total.Add(intentScore(di, scores))
+	}
+	k.SetIntentScore(ctx, zone.ChainId, total)
+}
+
+// GetIntentScore returns the total score of the snapshotted intents of the
+// given zone, as recorded when they were snapshotted.
+func (k *Keeper) GetIntentScore(ctx sdk.Context, chainID string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIntentScore)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return sdk.ZeroDec(), false
+	}
+
+	score := sdk.Dec{}
+	if err := score.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return score, true
+}
+
+// SetIntentScore records the total score of the snapshotted intents of the
+// given zone.
+func (k *Keeper) SetIntentScore(ctx sdk.Context, chainID string, score sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIntentScore)
+	bz, err := score.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(chainID), bz)
+}
+
+// validatorScores returns the last calculated scores of the validators of
+// the given zone, by valoper address.
+func (k *Keeper) validatorScores(ctx sdk.Context, chainID string) map[string]sdk.Dec {
+	scores := make(map[string]sdk.Dec)
+	for _, val := range k.icsKeeper.GetValidators(ctx, chainID) {
+		if !val.Score.IsNil() {
+			scores[val.ValoperAddress] = val.Score
+		}
+	}
+	return scores
+}
+
+// intentScore returns the score of a delegator intent; the sum of its weights
+// multiplied by the scores of their validators, as in
+// CalcUserValidatorSelectionAllocations.
+func intentScore(di icstypes.DelegatorIntent, scores map[string]sdk.Dec) sdk.Dec {
+	score := sdk.ZeroDec()
+	for _, intent := range di.GetIntents() {
+		if vs, exists := scores[intent.ValoperAddress]; exists {
+			score = score.Add(intent.Weight.Mul(vs))
+		}
+	}
+	return score
+}
@@ -17,7 +17,6 @@ import (
 
 	config "github.com/quicksilver-zone/quicksilver/cmd/config" //nolint:revive
 	osmosistypes "github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types"
-	umeetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types"
 	"github.com/quicksilver-zone/quicksilver/utils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	epochskeeper "github.com/quicksilver-zone/quicksilver/x/epochs/keeper"
@@ -34,7 +33,6 @@ type UserAllocation struct {
 
 var (
 	_ osmosistypes.ParticipationRewardsKeeper = &Keeper{}
-	_ umeetypes.ParticipationRewardsKeeper    = &Keeper{}
 )
 
 type Keeper struct {
@@ -176,7 +174,9 @@ func LoadSubmodules() map[cmtypes.ClaimType]Submodule {
 	out[cmtypes.ClaimTypeLiquidToken] = &LiquidTokensModule{}
 	out[cmtypes.ClaimTypeOsmosisPool] = &OsmosisModule{}
 	out[cmtypes.ClaimTypeOsmosisCLPool] = &OsmosisClModule{}
-	out[cmtypes.ClaimTypeUmeeToken] = &UmeeModule{}
+	out[cmtypes.ClaimTypeUmeeToken] = &MoneyMarketModule{}
+	out[cmtypes.ClaimTypeMoneyMarket] = &MoneyMarketModule{}
 	out[cmtypes.ClaimTypeMembrane] = &MembraneModule{}
+	out[cmtypes.ClaimTypeCosmWasm] = &CosmWasmModule{}
 	return out
 }
@@ -20,7 +20,6 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	"github.com/quicksilver-zone/quicksilver/app"
-	umeetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	epochtypes "github.com/quicksilver-zone/quicksilver/x/epochs/types"
@@ -113,8 +112,8 @@ func (suite *KeeperTestSuite) coreTest() {
 	suite.setupTestProtocolData()
 
 	akpd = quicksilver.ParticipationRewardsKeeper.AllKeyedProtocolDatas(suite.chainA.GetContext())
-	// added 19 in setupTestProtocolData
-	suite.Equal(15, len(akpd))
+	// added 10 in setupTestProtocolData
+	suite.Equal(11, len(akpd))
 
 	// advance the chains
 	suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
@@ -122,11 +121,8 @@ func (suite *KeeperTestSuite) coreTest() {
 	// callback test
 	suite.executeSetEpochBlockCallback()
 	suite.executeOsmosisPoolUpdateCallback()
-	suite.executeUmeeReservesUpdateCallback()
-	suite.executeUmeeTotalBorrowsUpdateCallback()
-	suite.executeUmeeInterestScalarUpdateCallback()
-	suite.executeUmeeLeverageModuleBalanceUpdateCallback()
-	suite.executeUmeeUTokenSupplyUpdateCallback()
+	suite.executeMoneyMarketUpdateCallbacks()
+	suite.executeMoneyMarketModuleBalanceUpdateCallback()
 
 	suite.setupTestDeposits()
 	suite.setupTestIntents()
@@ -415,44 +411,21 @@ func (suite *KeeperTestSuite) setupTestProtocolData() {
 		types.ProtocolDataTypeConnection,
 		[]byte(fmt.Sprintf("{\"connectionid\": %q,\"chainid\": %q,\"lastepoch\": %d,\"transferchannel\": %q}", suite.path.EndpointB.ConnectionID, suite.chainB.ChainID, 0, "channel-1")),
 	)
-	// umee-types params
+	// umee-types money market
+	upd, _ := json.Marshal(types.UmeeMoneyMarket(umeeTestChain))
 	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeParams,
-		[]byte(fmt.Sprintf("{\"ChainID\": %q}", umeeTestChain)),
+		types.ProtocolDataTypeMoneyMarket,
+		upd,
 	)
 	// umee-types test chain
 	suite.addProtocolData(
 		types.ProtocolDataTypeConnection,
 		[]byte(fmt.Sprintf("{\"connectionid\": %q,\"chainid\": %q,\"lastepoch\": %d,\"transferchannel\": %q}", umeeTestConnection, umeeTestChain, 0, "channel-5")),
 	)
-	// umee-types test reserves
-	upd, _ := json.Marshal(types.UmeeReservesProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: umeeBaseDenom}})
-	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeReserves,
-		upd,
-	)
-	// umee-types test leverage module balance
-	upd, _ = json.Marshal(types.UmeeLeverageModuleBalanceProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: umeeBaseDenom}})
-	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeLeverageModuleBalance,
-		upd,
-	)
-	// umee-types test borrows
-	upd, _ = json.Marshal(types.UmeeTotalBorrowsProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: umeeBaseDenom}})
-	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeTotalBorrows,
-		upd,
-	)
-	// umee-types test interest scalar
-	upd, _ = json.Marshal(types.UmeeInterestScalarProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: umeeBaseDenom}})
-	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeInterestScalar,
-		upd,
-	)
-	// umee-types test utoken supply
-	upd, _ = json.Marshal(types.UmeeUTokenSupplyProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: umeetypes.UTokenPrefix + umeeBaseDenom}})
+	// umee-types test denom
+	upd, _ = json.Marshal(types.MoneyMarketDenomProtocolData{ChainID: umeeTestChain, Denom: umeeBaseDenom})
 	suite.addProtocolData(
-		types.ProtocolDataTypeUmeeUTokenSupply,
+		types.ProtocolDataTypeMoneyMarketDenom,
 		upd,
 	)
 	// osmosis params
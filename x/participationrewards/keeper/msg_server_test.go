@@ -310,6 +310,10 @@ func (suite *KeeperTestSuite) Test_msgServer_SubmitClaim() {
 		{
 			"valid_umee",
 			func() {
+				// umee money market on the claim source chain
+				market := types.UmeeMoneyMarket("testchain-1")
+				suite.NoError(keeper.MarshalAndSetProtocolData(suite.chainA.GetContext(), appA.ParticipationRewardsKeeper, types.ProtocolDataTypeMoneyMarket, &market))
+
 				address := addressutils.GenerateAccAddressForTest()
 
 				userAddress, _ := addressutils.EncodeAddressToBech32("umee", address)
@@ -15,11 +15,21 @@ import (
 // rewards account for each zone to determine validator performance and
 // corresponding rewards allocations. Each zone's response is dealt with
 // individually in a callback.
+//
+// The scores are calculated from the uptime and governance inputs recorded
+// when the rewards response is handled. Responses are not ordered, and further
+// pages of signing infos and the votes on proposals are only requested as
+// earlier responses return, so these inputs may lag by up to an epoch.
 func (k Keeper) AllocateValidatorSelectionRewards(ctx sdk.Context) {
 	k.icsKeeper.IterateZones(ctx, func(_ int64, zone *icstypes.Zone) (stop bool) {
 		if zone.PerformanceAddress != nil {
 			k.Logger(ctx).Info("zones", "chain_id", zone.ChainId, "performance address", zone.PerformanceAddress.Address)
 
+			// obtain inputs to weighted performance score components ahead of
+			// the rewards, such that their first pages are normally recorded
+			// before the scores are calculated.
+			k.QueryValidatorScoringData(ctx, zone)
+
 			// obtain zone performance account rewards
 			rewardsQuery := distrtypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: zone.PerformanceAddress.Address}
 			bz := k.cdc.MustMarshal(&rewardsQuery)
@@ -147,9 +157,14 @@ func (k Keeper) CalcDistributionScores(ctx sdk.Context, zone icstypes.Zone, zs *
 // from the zone performance account that delegates an exact amount to each
 // validator. The total rewards earned by the performance account is divided
 // by the number of active validators to obtain the expected rewards. The
-// performance score for each validator is then simply the percentage of actual
+// rewards score for each validator is then simply the percentage of actual
 // rewards compared to the expected rewards (capped at 100%).
 //
+// The performance score is the mean of the rewards score and the uptime,
+// commission and governance participation scores, weighted by the
+// ScoringWeights parameter. Under the default weights the performance score is
+// the rewards score.
+//
 // On completion a msg is submitted to withdraw the zone performance rewards,
 // resetting zone performance scoring for the next epoch.
 func (k Keeper) CalcOverallScores(
@@ -182,6 +197,7 @@ func (k Keeper) CalcOverallScores(
 
 	msgs := make([]sdk.Msg, 0)
 	limit := sdk.NewDec(1.0)
+	scorer := k.newPerformanceScorer(ctx, zone.ChainId)
 	for _, reward := range rewards {
 		vs, exists := zs.ValidatorScores[reward.ValidatorAddress]
 		if !exists {
@@ -189,11 +205,20 @@ func (k Keeper) CalcOverallScores(
 			continue
 		}
 
-		vs.PerformanceScore = reward.Reward.AmountOf(zone.BaseDenom).Quo(expected)
-		if vs.PerformanceScore.GT(limit) {
-			vs.PerformanceScore = limit
+		vs.RewardsScore = reward.Reward.AmountOf(zone.BaseDenom).Quo(expected)
+		if vs.RewardsScore.GT(limit) {
+			vs.RewardsScore = limit
 		}
-		k.Logger(ctx).Info("performance score", "validator", vs.ValoperAddress, "performance", vs.PerformanceScore)
+		scorer.score(vs)
+		k.Logger(ctx).Info(
+			"performance score",
+			"validator", vs.ValoperAddress,
+			"rewards", vs.RewardsScore,
+			"uptime", vs.UptimeScore,
+			"commission", vs.CommissionScore,
+			"governance", vs.GovernanceScore,
+			"performance", vs.PerformanceScore,
+		)
 
 		// calculate and set overall score
 		vs.Score = vs.DistributionScore.Mul(vs.PerformanceScore)
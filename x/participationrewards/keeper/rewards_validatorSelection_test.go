@@ -550,6 +550,98 @@ func (suite *KeeperTestSuite) TestCalcOverallScores() {
 				suite.Equal(zs.ValidatorScores[delegatorRewards[2].ValidatorAddress].Score, validators[2].Score)
 			},
 		},
+		{
+			name: "weighted performance components",
+			malleate: func(ctx sdk.Context, appA *app.Quicksilver) {
+				params := appA.ParticipationRewardsKeeper.GetParams(ctx)
+				params.ScoringWeights = types.ScoringWeights{Rewards: sdk.OneDec(), Uptime: sdk.OneDec(), Commission: sdk.OneDec(), Governance: sdk.OneDec()}
+				appA.ParticipationRewardsKeeper.SetParams(ctx, params)
+
+				zone, _ := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+				vals := appA.InterchainstakingKeeper.GetValidators(ctx, zone.ChainId)
+
+				appA.ParticipationRewardsKeeper.SetZoneSigningInfo(ctx, types.ZoneSigningInfo{
+					ChainId:            zone.ChainId,
+					SignedBlocksWindow: 100,
+					Validators: []types.ValidatorMissedBlocks{
+						{ValoperAddress: vals[0].ValoperAddress, MissedBlocks: 0},
+						{ValoperAddress: vals[1].ValoperAddress, MissedBlocks: 50},
+					},
+				})
+
+				appA.ParticipationRewardsKeeper.SetEpochZoneScore(ctx, types.EpochZoneScore{
+					ChainId:          zone.ChainId,
+					Epoch:            1000,
+					TotalVotingPower: sdk.ZeroInt(),
+					ValidatorScores: []types.ValidatorScore{
+						{ValoperAddress: vals[2].ValoperAddress, VotingPower: sdk.ZeroInt(), CommissionRate: sdk.MustNewDecFromStr("0.05")},
+					},
+				})
+
+				appA.ParticipationRewardsKeeper.SetGovProposalVotes(ctx, types.GovProposalVotes{ChainId: zone.ChainId, ProposalId: 1, Voters: []string{vals[0].ValoperAddress, vals[1].ValoperAddress}})
+				appA.ParticipationRewardsKeeper.SetGovProposalVotes(ctx, types.GovProposalVotes{ChainId: zone.ChainId, ProposalId: 2, Voters: []string{vals[0].ValoperAddress}})
+			},
+			validatorScores: func(ctx sdk.Context, appA *app.Quicksilver, chainId string) map[string]*types.Validator {
+				validatorScores := make(map[string]*types.Validator)
+				vals := appA.InterchainstakingKeeper.GetValidators(ctx, chainId)
+
+				// commission increased since the previous epoch.
+				vals[2].CommissionRate = sdk.MustNewDecFromStr("0.1")
+
+				validatorScores[vals[0].ValoperAddress] = &types.Validator{
+					PowerPercentage:   sdk.NewDec(1),
+					DistributionScore: sdk.NewDec(1),
+					Validator:         &vals[0],
+				}
+				validatorScores[vals[1].ValoperAddress] = &types.Validator{
+					PowerPercentage:   sdk.NewDec(1),
+					DistributionScore: sdk.NewDec(5),
+					Validator:         &vals[1],
+				}
+				validatorScores[vals[2].ValoperAddress] = &types.Validator{
+					PowerPercentage:   sdk.NewDec(1),
+					DistributionScore: sdk.NewDec(7),
+					Validator:         &vals[2],
+				}
+				return validatorScores
+			},
+			delegatorRewards: func(ctx sdk.Context, appA *app.Quicksilver, chainID string) distributiontypes.QueryDelegationTotalRewardsResponse {
+				zone, _ := appA.InterchainstakingKeeper.GetZone(ctx, suite.chainB.ChainID)
+				validators := appA.InterchainstakingKeeper.GetValidatorAddresses(ctx, chainID)
+				return distributiontypes.QueryDelegationTotalRewardsResponse{Rewards: []distributiontypes.DelegationDelegatorReward{
+					{ValidatorAddress: validators[0], Reward: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(5)))},
+					{ValidatorAddress: validators[1], Reward: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(10)))},
+					{ValidatorAddress: validators[2], Reward: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(15)))},
+				}, Total: sdk.NewDecCoins(sdk.NewDecCoin(zone.BaseDenom, sdk.NewInt(30)))}
+			},
+			verify: func(zs types.ZoneScore, delegatorRewards []distributiontypes.DelegationDelegatorReward, validators []icstypes.Validator) {
+				// rewards 0.5, uptime 1, commission 1, governance 1
+				vs := zs.ValidatorScores[delegatorRewards[0].ValidatorAddress]
+				suite.Equal(sdk.MustNewDecFromStr("0.5"), vs.RewardsScore)
+				suite.Equal(sdk.OneDec(), vs.UptimeScore)
+				suite.Equal(sdk.OneDec(), vs.CommissionScore)
+				suite.Equal(sdk.OneDec(), vs.GovernanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("0.875"), vs.PerformanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("0.875"), vs.Score)
+				suite.Equal(vs.Score, validators[0].Score)
+
+				// rewards 1, uptime 0.5, commission 1, governance 0.5
+				vs = zs.ValidatorScores[delegatorRewards[1].ValidatorAddress]
+				suite.Equal(sdk.MustNewDecFromStr("0.5"), vs.UptimeScore)
+				suite.Equal(sdk.MustNewDecFromStr("0.5"), vs.GovernanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("0.75"), vs.PerformanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("3.75"), vs.Score)
+				suite.Equal(vs.Score, validators[1].Score)
+
+				// rewards 1, uptime 1, commission 0, governance 0
+				vs = zs.ValidatorScores[delegatorRewards[2].ValidatorAddress]
+				suite.Equal(sdk.ZeroDec(), vs.CommissionScore)
+				suite.Equal(sdk.ZeroDec(), vs.GovernanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("0.5"), vs.PerformanceScore)
+				suite.Equal(sdk.MustNewDecFromStr("3.5"), vs.Score)
+				suite.Equal(vs.Score, validators[2].Score)
+			},
+		},
 	}
 
 	for _, tt := range tests {
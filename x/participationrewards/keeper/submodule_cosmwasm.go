@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"github.com/quicksilver-zone/quicksilver/cmd/config"
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// CosmWasmStoreKey is the store of the CosmWasm module, against which contract
+// storage proofs are made.
+const CosmWasmStoreKey = "wasm"
+
+// CosmWasmModule validates claims against any CosmWasm contract registered by a
+// CosmWasmContract protocol data entry.
+type CosmWasmModule struct{}
+
+var _ Submodule = &CosmWasmModule{}
+
+func (*CosmWasmModule) Hooks(_ sdk.Context, _ *Keeper) {
+}
+
+func (*CosmWasmModule) ValidateClaim(ctx sdk.Context, k *Keeper, msg *types.MsgSubmitClaim) (math.Int, error) {
+	zone, ok := k.icsKeeper.GetZone(ctx, msg.Zone)
+	if !ok {
+		return sdk.ZeroInt(), fmt.Errorf("unable to find registered zone for chain id: %s", msg.Zone)
+	}
+
+	submitAddress, err := addressutils.AccAddressFromBech32(msg.UserAddress, config.Bech32Prefix)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	amount := sdk.ZeroInt()
+	keyCache := make(map[string]bool)
+
+	for _, proof := range msg.Proofs {
+		if _, found := keyCache[string(proof.Key)]; found {
+			continue
+		}
+		keyCache[string(proof.Key)] = true
+
+		if proof.Data == nil {
+			continue
+		}
+
+		if proof.ProofType != CosmWasmStoreKey {
+			return sdk.ZeroInt(), fmt.Errorf("invalid proof type %q for cosmwasm claims", proof.ProofType)
+		}
+
+		contractAddr, _, err := utils.DecodeCwNamespacedKey(proof.Key, 1)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		contract, prefix, found := cosmWasmContract(ctx, k, msg.SrcZone, contractAddr)
+		if !found {
+			return sdk.ZeroInt(), fmt.Errorf("not a registered cosmwasm contract on %s", msg.SrcZone)
+		}
+
+		_, parts, err := utils.DecodeCwNamespacedKey(proof.Key, len(contract.Namespaces)+1)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		for i, ns := range contract.Namespaces {
+			if string(parts[i]) != ns {
+				return sdk.ZeroInt(), errors.New("not a valid key for cosmwasm contract claims")
+			}
+		}
+
+		userBytes, err := addressutils.AccAddressFromBech32(string(parts[len(parts)-1]), prefix)
+		if err != nil {
+			return sdk.ZeroInt(), errors.New("user address is not valid")
+		}
+
+		if !userBytes.Equals(submitAddress) {
+			mappedAddr, found := k.icsKeeper.GetRemoteAddressMap(ctx, submitAddress, msg.SrcZone)
+			if !found {
+				return sdk.ZeroInt(), errors.New("not a valid key for submitting user (mapped address not found)")
+			}
+			if !userBytes.Equals(mappedAddr) {
+				return sdk.ZeroInt(), errors.New("not a valid key for submitting user (mapped address does not match)")
+			}
+		}
+
+		assets, err := contract.Assets(proof.Data)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		for _, asset := range assets {
+			_, denomData, err := GetAndUnmarshalProtocolData[*types.LiquidAllowedDenomProtocolData](ctx, k, fmt.Sprintf("%s_%s", msg.SrcZone, asset.Denom), types.ProtocolDataTypeLiquidToken)
+			if err != nil {
+				// we don't have a record for this denom, but this is okay, we don't want to submit records for every ibc denom.
+				continue
+			}
+			if denomData.QAssetDenom == zone.LocalDenom && denomData.IbcDenom == asset.Denom {
+				amount = amount.Add(asset.Amount)
+			}
+		}
+	}
+
+	return amount, nil
+}
+
+// cosmWasmContract returns the registered contract of the given chain with the
+// given address, and its bech32 prefix.
+func cosmWasmContract(ctx sdk.Context, k *Keeper, chainID string, address sdk.AccAddress) (*types.CosmWasmContractProtocolData, string, bool) {
+	var (
+		contract *types.CosmWasmContractProtocolData
+		prefix   string
+	)
+	k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(types.ProtocolDataTypeCosmWasmContract), func(_ int64, _ []byte, data types.ProtocolData) bool {
+		pd, err := types.UnmarshalProtocolData(types.ProtocolDataTypeCosmWasmContract, data.Data)
+		if err != nil {
+			return false
+		}
+		cpd, _ := pd.(*types.CosmWasmContractProtocolData)
+		if cpd.ChainID != chainID {
+			return false
+		}
+		hrp, addr, err := bech32.DecodeAndConvert(cpd.ContractAddress)
+		if err != nil || !address.Equals(sdk.AccAddress(addr)) {
+			return false
+		}
+		contract, prefix = cpd, hrp
+		return true
+	})
+	return contract, prefix, contract != nil
+}
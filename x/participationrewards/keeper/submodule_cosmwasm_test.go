@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/app"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// membraneCosmWasmContract describes the membrane positions contract as a
+// generic CosmWasm contract.
+func membraneCosmWasmContract() types.CosmWasmContractProtocolData {
+	return types.CosmWasmContractProtocolData{
+		ChainID:         "osmosis-1",
+		ContractAddress: membraneContractAddress,
+		Namespaces:      []string{"positions"},
+		AssetsPath:      "[].collateral_assets[]",
+		AmountPath:      "asset.amount",
+		DenomPath:       "asset.info.native_token.denom",
+	}
+}
+
+func addCosmWasmContractPD(app *app.Quicksilver, ctx sdk.Context, contract types.CosmWasmContractProtocolData) error {
+	blob, err := json.Marshal(contract)
+	if err != nil {
+		return err
+	}
+
+	return keeper.HandleAddProtocolDataProposal(ctx, app.ParticipationRewardsKeeper, &types.AddProtocolDataProposal{
+		Title:       "Add membrane positions contract",
+		Description: "Register the membrane positions contract for cosmwasm claims",
+		Type:        types.ProtocolDataType_name[int32(types.ProtocolDataTypeCosmWasmContract)],
+		Data:        blob,
+	})
+}
+
+func (suite *KeeperTestSuite) Test_CosmWasm_ValidateClaim() {
+	cases := []struct {
+		name          string
+		submitAddress string
+		mappedAddress string
+		malleate      func(*types.CosmWasmContractProtocolData, *cmtypes.Proof)
+		expected      math.Int
+		expectErr     bool
+	}{
+		{
+			name:          "valid - submitter",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			expected:      math.NewInt(103200),
+		},
+		{
+			name:          "valid - mapped",
+			submitAddress: "quick1jc24kwznud9m3mwqmcz3xw33ndjuufngu5m0y6",
+			mappedAddress: "osmo16qqhmsqcs4j6mfa92flnz4n8tj2s53jwwg8ujn",
+			expected:      math.NewInt(103200),
+		},
+		{
+			name:          "valid - fixed denom",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.DenomPath = ""
+				contract.Denom = "ibc/42D24879D4569CE6477B7E88206ADBFE47C222C6CAD51A54083E4A72594269FC"
+			},
+			expected: math.NewInt(103200),
+		},
+		{
+			name:          "valid - unclaimable denom",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.DenomPath = ""
+				contract.Denom = "uosmo"
+			},
+			expected: math.ZeroInt(),
+		},
+		{
+			name:          "invalid - no mapped",
+			submitAddress: "quick1jc24kwznud9m3mwqmcz3xw33ndjuufngu5m0y6",
+			expectErr:     true,
+		},
+		{
+			name:          "invalid - mapped no match",
+			submitAddress: "quick1jc24kwznud9m3mwqmcz3xw33ndjuufngu5m0y6",
+			mappedAddress: "osmo18e8drgypatsw0skt5ywzeqhlk365hlul3pnasr",
+			expectErr:     true,
+		},
+		{
+			name:          "invalid - unregistered contract",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.ContractAddress = "osmo1mlng7pz4pnyxtpq0akfwall37czyk9lukaucsrn30ameplhhshtqdvfm5c"
+			},
+			expectErr: true,
+		},
+		{
+			name:          "invalid - contract on other chain",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.ChainID = "juno-1"
+			},
+			expectErr: true,
+		},
+		{
+			name:          "invalid - namespace mismatch",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.Namespaces = []string{"vaults"}
+			},
+			expectErr: true,
+		},
+		{
+			name:          "invalid - amount path",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(contract *types.CosmWasmContractProtocolData, _ *cmtypes.Proof) {
+				contract.AmountPath = "asset.balance"
+			},
+			expectErr: true,
+		},
+		{
+			name:          "invalid - proof type",
+			submitAddress: "quick16qqhmsqcs4j6mfa92flnz4n8tj2s53jwdhy7an",
+			malleate: func(_ *types.CosmWasmContractProtocolData, proof *cmtypes.Proof) {
+				proof.ProofType = "bank"
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+
+			app := suite.GetQuicksilverApp(suite.chainA)
+			ctx := suite.chainA.GetContext()
+
+			key, data, proofOps, err := getMembraneClaimData()
+			suite.NoError(err)
+
+			proof := &cmtypes.Proof{
+				Key:       key,
+				Data:      data,
+				ProofOps:  proofOps,
+				Height:    38973687,
+				ProofType: keeper.CosmWasmStoreKey,
+			}
+
+			contract := membraneCosmWasmContract()
+			if c.malleate != nil {
+				c.malleate(&contract, proof)
+			}
+
+			suite.NoError(addCosmWasmContractPD(app, ctx, contract))
+			suite.NoError(createLiquidTokenPD(app, ctx, "osmosis-1", "cosmoshub-4", "uqatom", "ibc/42D24879D4569CE6477B7E88206ADBFE47C222C6CAD51A54083E4A72594269FC"))
+
+			if c.mappedAddress != "" {
+				app.InterchainstakingKeeper.SetRemoteAddressMap(ctx, addressutils.MustAccAddressFromBech32(c.submitAddress, ""), addressutils.MustAccAddressFromBech32(c.mappedAddress, ""), "osmosis-1")
+			}
+
+			msgClaim := types.MsgSubmitClaim{
+				UserAddress: c.submitAddress,
+				Zone:        "cosmoshub-4",
+				SrcZone:     "osmosis-1",
+				ClaimType:   cmtypes.ClaimTypeCosmWasm,
+				Proofs:      []*cmtypes.Proof{proof, proof},
+			}
+
+			suite.NoError(msgClaim.ValidateBasic())
+
+			out, err := app.ParticipationRewardsKeeper.PrSubmodules[cmtypes.ClaimTypeCosmWasm].ValidateClaim(ctx, app.ParticipationRewardsKeeper, &msgClaim)
+			if c.expectErr {
+				suite.Error(err)
+				suite.Equal(math.ZeroInt(), out)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(c.expected, out)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_CosmWasm_AddProtocolDataProposal() {
+	suite.SetupTest()
+
+	app := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+
+	contract := membraneCosmWasmContract()
+	suite.NoError(addCosmWasmContractPD(app, ctx, contract))
+
+	_, stored, err := keeper.GetAndUnmarshalProtocolData[*types.CosmWasmContractProtocolData](ctx, app.ParticipationRewardsKeeper, "osmosis-1_"+membraneContractAddress, types.ProtocolDataTypeCosmWasmContract)
+	suite.NoError(err)
+	suite.Equal(contract, *stored)
+
+	// contracts must describe how amounts are read.
+	contract.AmountPath = ""
+	suite.Error(addCosmWasmContractPD(app, ctx, contract))
+}
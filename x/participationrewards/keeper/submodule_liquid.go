@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,8 +11,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
+	osmolockup "github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/lockup/types"
 	"github.com/quicksilver-zone/quicksilver/utils"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
@@ -22,6 +26,13 @@ var _ Submodule = &LiquidTokensModule{}
 func (*LiquidTokensModule) Hooks(_ sdk.Context, _ *Keeper) {
 }
 
+// ValidateClaim returns the amount of the zone's qAsset held by the user, or their mapped account, on the source
+// chain, as proven by bank balance proofs and osmosis-style lockup proofs.
+//
+// Vesting accounts hold both their vesting and vested coins in the account balance, so a bank proof of a vesting
+// account accounts for the whole position. qAssets escrowed in other module accounts are not claimable: the escrowed
+// balance belongs to the module account, with no generic record attributing it to the user, so a proof of it is
+// rejected as not belonging to the user. Lockups, escrowed by the lockup module, are claimed by lockup proofs instead.
 func (*LiquidTokensModule) ValidateClaim(ctx sdk.Context, k *Keeper, msg *types.MsgSubmitClaim) (math.Int, error) {
 	// message
 	// check denom is valid vs allowed
@@ -49,6 +60,15 @@ func (*LiquidTokensModule) ValidateClaim(ctx sdk.Context, k *Keeper, msg *types.
 			continue
 		}
 
+		if proof.ProofType == types.ProofTypeLockup {
+			lockAmount, err := k.liquidLockupAmount(ctx, &zone, msg.SrcZone, addr, proof.Key, proof.Data)
+			if err != nil {
+				return sdk.ZeroInt(), err
+			}
+			amount = amount.Add(lockAmount)
+			continue
+		}
+
 		// DenomFromRequestKey will error if the user address does not match the address in the key
 		// or if the denom found is not valid.
 		denom, err := utils.DenomFromRequestKey(proof.Key, addr)
@@ -65,23 +85,95 @@ func (*LiquidTokensModule) ValidateClaim(ctx sdk.Context, k *Keeper, msg *types.
 			}
 		}
 
-		data, found := k.GetProtocolData(ctx, types.ProtocolDataTypeLiquidToken, fmt.Sprintf("%s_%s", msg.SrcZone, denom))
-		if !found {
+		allowed, err := k.IsAllowedLiquidDenom(ctx, &zone, msg.SrcZone, denom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		if !allowed {
 			// we don't have a record for this denom, but this is okay, we don't want to submit records for every ibc denom.
 			continue
 		}
-		denomData := types.LiquidAllowedDenomProtocolData{}
-		err = json.Unmarshal(data.Data, &denomData)
+
+		coin, err := bankkeeper.UnmarshalBalanceCompat(k.cdc, proof.Data, denom)
 		if err != nil {
 			return sdk.ZeroInt(), err
 		}
-		if denomData.QAssetDenom == zone.LocalDenom && denomData.IbcDenom == denom {
-			coin, err := bankkeeper.UnmarshalBalanceCompat(k.cdc, proof.Data, denomData.IbcDenom)
-			if err != nil {
-				return sdk.ZeroInt(), err
-			}
+		amount = amount.Add(coin.Amount)
+	}
+	return amount, nil
+}
+
+// liquidLockupAmount returns the amount of the zone's qAsset held in an osmosis-style lockup position, after
+// verifying that the proven key matches the lock and that the lock is owned by the user or their mapped account.
+// Locks that are unlocking are still held by the lockup module and are counted in full.
+func (k *Keeper) liquidLockupAmount(ctx sdk.Context, zone *icstypes.Zone, srcChainID string, addr sdk.AccAddress, key, data []byte) (math.Int, error) {
+	lock := osmolockup.PeriodLock{}
+	if err := k.cdc.Unmarshal(data, &lock); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if !bytes.Equal(key, osmolockup.GetPeriodLockKey(lock.ID)) {
+		return sdk.ZeroInt(), fmt.Errorf("proof key does not match lock %d", lock.ID)
+	}
+
+	_, lockupOwner, err := bech32.DecodeAndConvert(lock.Owner)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if !bytes.Equal(lockupOwner, addr) {
+		mappedAddr, found := k.icsKeeper.GetRemoteAddressMap(ctx, addr, srcChainID)
+		if !found || !bytes.Equal(lockupOwner, mappedAddr) {
+			return sdk.ZeroInt(), errors.New("not a valid proof for submitting user or mapped account")
+		}
+	}
+
+	amount := sdk.ZeroInt()
+	for _, coin := range lock.Coins {
+		allowed, err := k.IsAllowedLiquidDenom(ctx, zone, srcChainID, coin.Denom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		if allowed {
 			amount = amount.Add(coin.Amount)
 		}
 	}
 	return amount, nil
 }
+
+// IsAllowedLiquidDenom determines whether denom on chainID represents the qAsset of the given zone. Explicitly
+// registered LiquidAllowedDenomProtocolData takes precedence; otherwise the denom is derived from the IBC denom
+// trace of the transfer channel of the chain's connection protocol data.
+func (k *Keeper) IsAllowedLiquidDenom(ctx sdk.Context, zone *icstypes.Zone, chainID, denom string) (bool, error) {
+	data, found := k.GetProtocolData(ctx, types.ProtocolDataTypeLiquidToken, fmt.Sprintf("%s_%s", chainID, denom))
+	if found {
+		denomData := types.LiquidAllowedDenomProtocolData{}
+		if err := json.Unmarshal(data.Data, &denomData); err != nil {
+			return false, err
+		}
+		return denomData.QAssetDenom == zone.LocalDenom && denomData.IbcDenom == denom, nil
+	}
+
+	derived, found := k.DeriveLiquidDenom(ctx, zone, chainID)
+	return found && derived == denom, nil
+}
+
+// DeriveLiquidDenom returns the denom of the zone's qAsset on chainID, as transferred over the transfer channel
+// registered in the connection protocol data for chainID.
+func (k *Keeper) DeriveLiquidDenom(ctx sdk.Context, zone *icstypes.Zone, chainID string) (string, bool) {
+	if chainID == ctx.ChainID() {
+		return zone.LocalDenom, true
+	}
+
+	_, connectionData, err := GetAndUnmarshalProtocolData[*types.ConnectionProtocolData](ctx, k, chainID, types.ProtocolDataTypeConnection)
+	if err != nil || connectionData.TransferChannel == "" {
+		return "", false
+	}
+
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, connectionData.TransferChannel)
+	if !found {
+		return "", false
+	}
+
+	return utils.DeriveIbcDenom(transfertypes.PortID, channel.Counterparty.ChannelId, channel.Counterparty.PortId, connectionData.TransferChannel, zone.LocalDenom), true
+}
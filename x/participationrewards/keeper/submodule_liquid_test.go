@@ -3,11 +3,20 @@ package keeper_test
 import (
 	"encoding/base64"
 	"encoding/json"
+	"time"
 
 	"cosmossdk.io/math"
 
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	osmolockup "github.com/quicksilver-zone/quicksilver/third-party-chains/osmosis-types/lockup/types"
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
@@ -184,6 +193,158 @@ func (suite *KeeperTestSuite) Test_LiquidToken_ValidateClaim_IgnoreUnclaimableDe
 	})
 }
 
+func (suite *KeeperTestSuite) Test_LiquidToken_ValidateClaim_DerivedDenom() {
+	user := addressutils.GenerateAccAddressForTest()
+	mapped := addressutils.GenerateAccAddressForTest()
+	// uqatom transferred to osmosis-1 over channel-2 <-> channel-1000.
+	derivedDenom := utils.DeriveIbcDenom("transfer", counterpartyOsmosisChannel, "transfer", "channel-2", "uqatom")
+
+	bankKey := func(addr sdk.AccAddress, denom string) []byte {
+		return append(banktypes.CreateAccountBalancesPrefix(addr), []byte(denom)...)
+	}
+	lockProof := func(id uint64, key []byte, owner sdk.AccAddress, coins sdk.Coins) *cmtypes.Proof {
+		lock := osmolockup.PeriodLock{
+			ID:       id,
+			Owner:    addressutils.MustEncodeAddressToBech32("osmo", owner),
+			Duration: time.Hour * 24 * 14,
+			EndTime:  time.Time{},
+			Coins:    coins,
+		}
+		bz, err := lock.Marshal()
+		suite.NoError(err)
+		if key == nil {
+			key = osmolockup.GetPeriodLockKey(id)
+		}
+		return &cmtypes.Proof{Key: key, Data: bz, ProofType: types.ProofTypeLockup}
+	}
+	intBytes := func(amount int64) []byte {
+		bz, err := math.NewInt(amount).Marshal()
+		suite.NoError(err)
+		return bz
+	}
+
+	cases := []struct {
+		name      string
+		proofs    func() []*cmtypes.Proof
+		expectErr bool
+		expected  math.Int
+	}{
+		{
+			"bank balance of derived denom",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{{Key: bankKey(user, derivedDenom), Data: intBytes(1000), ProofType: types.ProofTypeBank}}
+			},
+			false,
+			math.NewInt(1000),
+		},
+		{
+			"bank balance of mapped account",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{{Key: bankKey(mapped, derivedDenom), Data: intBytes(700), ProofType: types.ProofTypeBank}}
+			},
+			false,
+			math.NewInt(700),
+		},
+		{
+			"bank balance of vesting account counts vesting and vested coins",
+			func() []*cmtypes.Proof {
+				// a vesting account of 3000, of which 1000 has vested, holds all 3000 in its balance.
+				return []*cmtypes.Proof{{Key: bankKey(user, derivedDenom), Data: intBytes(3000), ProofType: types.ProofTypeBank}}
+			},
+			false,
+			math.NewInt(3000),
+		},
+		{
+			"bank balance of transfer escrow account is rejected",
+			func() []*cmtypes.Proof {
+				escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, counterpartyOsmosisChannel)
+				return []*cmtypes.Proof{{Key: bankKey(escrow, derivedDenom), Data: intBytes(1000), ProofType: types.ProofTypeBank}}
+			},
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"bank balance of lockup module account is rejected",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{{Key: bankKey(authtypes.NewModuleAddress(osmolockup.ModuleName), derivedDenom), Data: intBytes(5000), ProofType: types.ProofTypeBank}}
+			},
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"bank balance of unrelated denom is ignored",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{{Key: bankKey(user, "uosmo"), Data: intBytes(1000), ProofType: types.ProofTypeBank}}
+			},
+			false,
+			math.ZeroInt(),
+		},
+		{
+			"lockup owned by user",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{lockProof(12, nil, user, sdk.NewCoins(sdk.NewCoin(derivedDenom, math.NewInt(5000)), sdk.NewCoin("uosmo", math.NewInt(9000))))}
+			},
+			false,
+			math.NewInt(5000),
+		},
+		{
+			"lockup owned by mapped account plus bank balance",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{
+					lockProof(13, nil, mapped, sdk.NewCoins(sdk.NewCoin(derivedDenom, math.NewInt(5000)))),
+					{Key: bankKey(user, derivedDenom), Data: intBytes(1000), ProofType: types.ProofTypeBank},
+				}
+			},
+			false,
+			math.NewInt(6000),
+		},
+		{
+			"lockup owned by another account",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{lockProof(14, nil, addressutils.GenerateAccAddressForTest(), sdk.NewCoins(sdk.NewCoin(derivedDenom, math.NewInt(5000))))}
+			},
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"lockup proof key does not match lock",
+			func() []*cmtypes.Proof {
+				return []*cmtypes.Proof{lockProof(15, osmolockup.GetPeriodLockKey(16), user, sdk.NewCoins(sdk.NewCoin(derivedDenom, math.NewInt(5000))))}
+			},
+			true,
+			math.ZeroInt(),
+		},
+	}
+
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+			suite.setupChannelForHookTest()
+
+			app := suite.GetQuicksilverApp(suite.chainA)
+			ctx := suite.chainA.GetContext()
+
+			app.InterchainstakingKeeper.SetRemoteAddressMap(ctx, user, mapped, osmosisTestChain)
+
+			msgClaim := types.MsgSubmitClaim{
+				UserAddress: addressutils.MustEncodeAddressToBech32("quick", user),
+				Zone:        suite.chainB.ChainID,
+				SrcZone:     osmosisTestChain,
+				Proofs:      c.proofs(),
+			}
+
+			out, err := app.ParticipationRewardsKeeper.PrSubmodules[cmtypes.ClaimTypeLiquidToken].ValidateClaim(ctx, app.ParticipationRewardsKeeper, &msgClaim)
+			if c.expectErr {
+				suite.Error(err)
+				suite.Equal(math.ZeroInt(), out)
+			} else {
+				suite.NoError(err)
+				suite.Equal(c.expected, out)
+			}
+		})
+	}
+}
+
 const (
 	testLiquidKey      = "AhSWFVs4U+NLuO3A3gUTOjGbZc4maHVxYXRvbQ=="
 	testLiquidData     = "MjQzMDc="
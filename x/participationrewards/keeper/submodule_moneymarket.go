@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// MoneyMarketModule validates claims against x/leverage-style money markets, each described by
+// MoneyMarketProtocolData. Umee is one such market.
+type MoneyMarketModule struct{}
+
+var _ Submodule = &MoneyMarketModule{}
+
+func (MoneyMarketModule) Hooks(ctx sdk.Context, k *Keeper) {
+	k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(types.ProtocolDataTypeMoneyMarket), func(_ int64, _ []byte, data types.ProtocolData) bool {
+		imarket, err := types.UnmarshalProtocolData(types.ProtocolDataTypeMoneyMarket, data.Data)
+		if err != nil {
+			k.Logger(ctx).Error("unable to unmarshal money market in MoneyMarketModule hook", "error", err)
+			return false
+		}
+		market, _ := imarket.(*types.MoneyMarketProtocolData)
+
+		_, connectionData, err := GetAndUnmarshalProtocolData[*types.ConnectionProtocolData](ctx, k, market.ChainID, types.ProtocolDataTypeConnection)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to query connection/%s in MoneyMarketModule hook", market.ChainID), "error", err)
+			return false
+		}
+
+		for _, denom := range k.moneyMarketDenoms(ctx, market.ChainID) {
+			k.queryMoneyMarketDenom(ctx, market, connectionData, denom)
+		}
+		return false
+	})
+}
+
+// moneyMarketDenoms returns the denoms tracked for the money market on chainID.
+func (k *Keeper) moneyMarketDenoms(ctx sdk.Context, chainID string) []string {
+	denoms := []string{}
+	k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(types.ProtocolDataTypeMoneyMarketDenom), func(_ int64, _ []byte, data types.ProtocolData) bool {
+		istate, err := types.UnmarshalProtocolData(types.ProtocolDataTypeMoneyMarketDenom, data.Data)
+		if err != nil {
+			return false
+		}
+		state, _ := istate.(*types.MoneyMarketDenomProtocolData)
+		if state.ChainID == chainID {
+			denoms = append(denoms, state.Denom)
+		}
+		return false
+	})
+	return denoms
+}
+
+// queryMoneyMarketDenom queries the state of a money market token from which its exchange rate is derived.
+func (k *Keeper) queryMoneyMarketDenom(ctx sdk.Context, market *types.MoneyMarketProtocolData, connectionData *types.ConnectionProtocolData, denom string) {
+	for _, key := range [][]byte{
+		market.KeyReserveAmount(denom),
+		market.KeyInterestScalar(denom),
+		market.KeyAdjustedTotalBorrow(denom),
+		market.KeyUTokenSupply(market.ToUTokenDenom(denom)),
+	} {
+		k.IcqKeeper.MakeRequest(
+			ctx,
+			connectionData.ConnectionID,
+			connectionData.ChainID,
+			market.StorePath(),
+			key,
+			sdk.NewInt(-1),
+			types.ModuleName,
+			MoneyMarketUpdateCallbackID,
+			0,
+		)
+	}
+
+	accountPrefix := banktypes.CreateAccountBalancesPrefix(market.ModuleAddress())
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		connectionData.ConnectionID,
+		connectionData.ChainID,
+		icstypes.BankStoreKey,
+		append(accountPrefix, denom...),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		MoneyMarketModuleBalanceUpdateCallbackID,
+		0,
+	) // query module balance
+}
+
+func getDenomFromProof(proof *cmtypes.Proof, addr []byte) (string, error) {
+	denom, err := utils.DenomFromRequestKey(proof.Key, addr)
+	if err != nil {
+		return "", err
+	}
+	if proof.ProofType == types.ProofTypeLeverage {
+		denom = denom[:len(denom)-1]
+	}
+	return denom, err
+}
+
+func (MoneyMarketModule) ValidateClaim(ctx sdk.Context, k *Keeper, msg *types.MsgSubmitClaim) (math.Int, error) {
+	zone, ok := k.icsKeeper.GetZone(ctx, msg.Zone)
+	if !ok {
+		return sdk.ZeroInt(), fmt.Errorf("unable to find registered zone for chain id: %s", msg.Zone)
+	}
+
+	_, market, err := GetAndUnmarshalProtocolData[*types.MoneyMarketProtocolData](ctx, k, msg.SrcZone, types.ProtocolDataTypeMoneyMarket)
+	if err != nil {
+		return sdk.ZeroInt(), fmt.Errorf("no money market registered for chain id %s: %w", msg.SrcZone, err)
+	}
+
+	addr, err := addressutils.AccAddressFromBech32(msg.UserAddress, "")
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	amount := sdk.ZeroInt()
+	keyCache := make(map[string]bool)
+
+	for _, proof := range msg.Proofs {
+		if _, found := keyCache[string(proof.Key)]; found {
+			continue
+		}
+		keyCache[string(proof.Key)] = true
+
+		if proof.Data == nil {
+			continue
+		}
+
+		udenom, err := getDenomFromProof(proof, addr)
+		if err != nil {
+			mappedAddr, found := k.icsKeeper.GetRemoteAddressMap(ctx, addr, msg.SrcZone)
+			if found {
+				udenom, err = getDenomFromProof(proof, mappedAddr)
+				if err != nil {
+					return sdk.ZeroInt(), errors.New("not a valid proof for submitting user or mapped account")
+				}
+			} else {
+				return sdk.ZeroInt(), errors.New("not a valid proof for submitting user")
+			}
+		}
+
+		denom := market.ToTokenDenom(udenom)
+		if denom == "" {
+			continue
+		}
+
+		allowed, err := k.IsAllowedLiquidDenom(ctx, &zone, msg.SrcZone, denom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		if !allowed {
+			// we don't have a record for this denom, but this is okay, we don't want to submit records for every ibc denom.
+			continue
+		}
+
+		_, state, err := GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, k, fmt.Sprintf("%s_%s", market.ChainID, denom), types.ProtocolDataTypeMoneyMarketDenom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		uToken, err := bankkeeper.UnmarshalBalanceCompat(k.cdc, proof.Data, udenom)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		amount = amount.Add(state.ExchangeUToken(uToken.Amount))
+	}
+
+	return amount, nil
+}
+
+// MigrateUmeeProtocolData replaces the Umee params and per-denom Umee protocol data with a money market
+// configured for the Umee chain, and the money market state of each tracked denom.
+//
+//nolint:staticcheck // SA1019 the deprecated Umee protocol data types are read in order to be migrated.
+func (k *Keeper) MigrateUmeeProtocolData(ctx sdk.Context) error {
+	_, params, err := GetAndUnmarshalProtocolData[*types.UmeeParamsProtocolData](ctx, k, types.UmeeParamsKey, types.ProtocolDataTypeUmeeParams)
+	if err != nil {
+		k.Logger(ctx).Info("no umee params to migrate")
+		return nil
+	}
+
+	market := types.UmeeMoneyMarket(params.ChainID)
+	if err := MarshalAndSetProtocolData(ctx, k, types.ProtocolDataTypeMoneyMarket, &market); err != nil {
+		return err
+	}
+
+	states := map[string]*types.MoneyMarketDenomProtocolData{}
+	denoms := []string{}
+	state := func(denom string) *types.MoneyMarketDenomProtocolData {
+		if _, found := states[denom]; !found {
+			states[denom] = &types.MoneyMarketDenomProtocolData{ChainID: market.ChainID, Denom: denom}
+			denoms = append(denoms, denom)
+		}
+		return states[denom]
+	}
+
+	keys := [][]byte{types.GetProtocolDataKey(types.ProtocolDataTypeUmeeParams, []byte(types.UmeeParamsKey))}
+	for _, pdType := range []types.ProtocolDataType{
+		types.ProtocolDataTypeUmeeReserves,
+		types.ProtocolDataTypeUmeeInterestScalar,
+		types.ProtocolDataTypeUmeeTotalBorrows,
+		types.ProtocolDataTypeUmeeUTokenSupply,
+		types.ProtocolDataTypeUmeeLeverageModuleBalance,
+	} {
+		k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(pdType), func(_ int64, key []byte, data types.ProtocolData) bool {
+			keys = append(keys, append([]byte{}, key...))
+
+			pd, err := types.UnmarshalProtocolData(pdType, data.Data)
+			if err != nil {
+				k.Logger(ctx).Error("unable to unmarshal umee protocol data", "type", pdType, "error", err)
+				return false
+			}
+
+			// denoms remain tracked even if their values have not yet been populated by a callback; unset values
+			// are populated at the next epoch.
+			switch pd := pd.(type) {
+			case *types.UmeeReservesProtocolData:
+				s := state(pd.Denom)
+				if amount, err := pd.GetReserveAmount(); err == nil {
+					s.Reserves = amount
+				}
+			case *types.UmeeInterestScalarProtocolData:
+				s := state(pd.Denom)
+				if scalar, err := pd.GetInterestScalar(); err == nil {
+					s.InterestScalar = scalar
+				}
+			case *types.UmeeTotalBorrowsProtocolData:
+				s := state(pd.Denom)
+				if borrows, err := pd.GetTotalBorrows(); err == nil {
+					s.AdjustedTotalBorrows = borrows
+				}
+			case *types.UmeeUTokenSupplyProtocolData:
+				denom := market.ToTokenDenom(pd.Denom)
+				if denom == "" {
+					return false
+				}
+				s := state(denom)
+				if supply, err := pd.GetUTokenSupply(); err == nil {
+					s.UTokenSupply = supply
+				}
+			case *types.UmeeLeverageModuleBalanceProtocolData:
+				s := state(pd.Denom)
+				if balance, err := pd.GetModuleBalance(); err == nil {
+					s.ModuleBalance = balance
+				}
+			}
+			return false
+		})
+	}
+
+	for _, denom := range denoms {
+		states[denom].LastUpdated = ctx.BlockTime()
+		if err := MarshalAndSetProtocolData(ctx, k, types.ProtocolDataTypeMoneyMarketDenom, states[denom]); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		k.DeleteProtocolData(ctx, key)
+	}
+
+	k.Logger(ctx).Info("migrated umee protocol data to money market", "chain_id", market.ChainID, "denoms", denoms)
+	return nil
+}
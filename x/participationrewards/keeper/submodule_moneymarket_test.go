@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/keeper"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+func (suite *KeeperTestSuite) Test_MoneyMarket_ValidateClaim() {
+	user := addressutils.GenerateAccAddressForTest()
+	market := types.UmeeMoneyMarket(umeeTestChain)
+	// uqatom transferred to the umee test chain over channel-5 <-> channel-2000.
+	denom := utils.DeriveIbcDenom("transfer", counterpartyUmeeChannel, "transfer", "channel-5", "uqatom")
+	udenom := market.ToUTokenDenom(denom)
+
+	intBytes := func(amount int64) []byte {
+		bz, err := math.NewInt(amount).Marshal()
+		suite.NoError(err)
+		return bz
+	}
+
+	cases := []struct {
+		name      string
+		srcZone   string
+		proofs    []*cmtypes.Proof
+		expectErr bool
+		expected  math.Int
+	}{
+		{
+			"collateral of qAsset uTokens",
+			umeeTestChain,
+			[]*cmtypes.Proof{{Key: market.KeyCollateralAmount(user, udenom), Data: intBytes(1000), ProofType: types.ProofTypeLeverage}},
+			false,
+			math.NewInt(1500),
+		},
+		{
+			"collateral of other uTokens is ignored",
+			umeeTestChain,
+			[]*cmtypes.Proof{{Key: market.KeyCollateralAmount(user, market.ToUTokenDenom(umeeBaseDenom)), Data: intBytes(1000), ProofType: types.ProofTypeLeverage}},
+			false,
+			math.ZeroInt(),
+		},
+		{
+			"collateral of another account",
+			umeeTestChain,
+			[]*cmtypes.Proof{{Key: market.KeyCollateralAmount(addressutils.GenerateAccAddressForTest(), udenom), Data: intBytes(1000), ProofType: types.ProofTypeLeverage}},
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"no money market on source chain",
+			osmosisTestChain,
+			[]*cmtypes.Proof{{Key: market.KeyCollateralAmount(user, udenom), Data: intBytes(1000), ProofType: types.ProofTypeLeverage}},
+			true,
+			math.ZeroInt(),
+		},
+	}
+
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			suite.SetupTest()
+			suite.setupChannelForHookTest()
+
+			app := suite.GetQuicksilverApp(suite.chainA)
+			ctx := suite.chainA.GetContext()
+
+			state := types.MoneyMarketDenomProtocolData{
+				ChainID:       umeeTestChain,
+				Denom:         denom,
+				ModuleBalance: math.NewInt(1500),
+				UTokenSupply:  math.NewInt(1000),
+			}
+			suite.NoError(keeper.MarshalAndSetProtocolData(ctx, app.ParticipationRewardsKeeper, types.ProtocolDataTypeMoneyMarketDenom, &state))
+
+			msgClaim := types.MsgSubmitClaim{
+				UserAddress: addressutils.MustEncodeAddressToBech32("quick", user),
+				Zone:        suite.chainB.ChainID,
+				SrcZone:     c.srcZone,
+				ClaimType:   cmtypes.ClaimTypeMoneyMarket,
+				Proofs:      c.proofs,
+			}
+
+			out, err := app.ParticipationRewardsKeeper.PrSubmodules[cmtypes.ClaimTypeMoneyMarket].ValidateClaim(ctx, app.ParticipationRewardsKeeper, &msgClaim)
+			if c.expectErr {
+				suite.Error(err)
+				suite.Equal(math.ZeroInt(), out)
+			} else {
+				suite.NoError(err)
+				suite.Equal(c.expected, out)
+			}
+		})
+	}
+}
+
+//nolint:staticcheck // SA1019 the deprecated Umee protocol data types are written in order to be migrated.
+func (suite *KeeperTestSuite) TestMigrateUmeeProtocolData() {
+	suite.SetupTest()
+
+	app := suite.GetQuicksilverApp(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	k := app.ParticipationRewardsKeeper
+
+	// replace the money market set up for the test chain with the protocol data it replaces.
+	k.DeleteProtocolData(ctx, types.GetProtocolDataKey(types.ProtocolDataTypeMoneyMarket, []byte(umeeTestChain)))
+	k.DeleteProtocolData(ctx, types.GetProtocolDataKey(types.ProtocolDataTypeMoneyMarketDenom, []byte(umeeTestChain+"_"+umeeBaseDenom)))
+
+	set := func(pdType types.ProtocolDataType, pd types.ProtocolDataI) {
+		suite.NoError(keeper.MarshalAndSetProtocolData(ctx, k, pdType, pd))
+	}
+	umeeData := func(denom string, value any) types.UmeeProtocolData {
+		bz, err := json.Marshal(value)
+		suite.NoError(err)
+		return types.UmeeProtocolData{Denom: denom, Data: bz}
+	}
+
+	set(types.ProtocolDataTypeUmeeParams, &types.UmeeParamsProtocolData{ChainID: umeeTestChain})
+	set(types.ProtocolDataTypeUmeeReserves, &types.UmeeReservesProtocolData{UmeeProtocolData: umeeData(umeeBaseDenom, math.NewInt(100))})
+	set(types.ProtocolDataTypeUmeeInterestScalar, &types.UmeeInterestScalarProtocolData{UmeeProtocolData: umeeData(umeeBaseDenom, sdk.NewDecWithPrec(12, 1))})
+	set(types.ProtocolDataTypeUmeeTotalBorrows, &types.UmeeTotalBorrowsProtocolData{UmeeProtocolData: umeeData(umeeBaseDenom, sdk.NewDec(500))})
+	set(types.ProtocolDataTypeUmeeUTokenSupply, &types.UmeeUTokenSupplyProtocolData{UmeeProtocolData: umeeData("u/"+umeeBaseDenom, math.NewInt(1000))})
+	set(types.ProtocolDataTypeUmeeLeverageModuleBalance, &types.UmeeLeverageModuleBalanceProtocolData{UmeeProtocolData: umeeData(umeeBaseDenom, math.NewInt(1000))})
+	// a denom whose values have not yet been queried.
+	set(types.ProtocolDataTypeUmeeReserves, &types.UmeeReservesProtocolData{UmeeProtocolData: types.UmeeProtocolData{Denom: "uatom"}})
+
+	suite.NoError(k.MigrateUmeeProtocolData(ctx))
+
+	_, market, err := keeper.GetAndUnmarshalProtocolData[*types.MoneyMarketProtocolData](ctx, k, umeeTestChain, types.ProtocolDataTypeMoneyMarket)
+	suite.NoError(err)
+	want := types.UmeeMoneyMarket(umeeTestChain)
+	suite.Equal(&want, market)
+
+	_, state, err := keeper.GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, k, umeeTestChain+"_"+umeeBaseDenom, types.ProtocolDataTypeMoneyMarketDenom)
+	suite.NoError(err)
+	suite.Equal(math.NewInt(100), state.Reserves)
+	suite.Equal(math.NewInt(1000), state.UTokenSupply)
+	suite.Equal(sdk.NewDecWithPrec(15, 1), state.ExchangeRate())
+
+	_, state, err = keeper.GetAndUnmarshalProtocolData[*types.MoneyMarketDenomProtocolData](ctx, k, umeeTestChain+"_uatom", types.ProtocolDataTypeMoneyMarketDenom)
+	suite.NoError(err)
+	suite.Equal(sdk.OneDec(), state.ExchangeRate())
+
+	for _, pdType := range []types.ProtocolDataType{
+		types.ProtocolDataTypeUmeeParams,
+		types.ProtocolDataTypeUmeeReserves,
+		types.ProtocolDataTypeUmeeInterestScalar,
+		types.ProtocolDataTypeUmeeTotalBorrows,
+		types.ProtocolDataTypeUmeeUTokenSupply,
+		types.ProtocolDataTypeUmeeLeverageModuleBalance,
+	} {
+		k.IteratePrefixedProtocolDatas(ctx, types.GetPrefixProtocolDataKey(pdType), func(_ int64, key []byte, _ types.ProtocolData) bool {
+			suite.Failf("umee protocol data not migrated", "key %X", key)
+			return true
+		})
+	}
+}
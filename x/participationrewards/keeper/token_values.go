@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// GetEpochTokenValues returns the token values recorded for the given epoch.
+func (k *Keeper) GetEpochTokenValues(ctx sdk.Context, epoch int64) (types.EpochTokenValues, bool) {
+	etv := types.EpochTokenValues{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochTokenValues)
+	bz := store.Get(types.GetEpochTokenValuesKey(epoch))
+	if len(bz) == 0 {
+		return etv, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &etv)
+	return etv, true
+}
+
+// GetLatestEpochTokenValues returns the most recently recorded token values.
+func (k *Keeper) GetLatestEpochTokenValues(ctx sdk.Context) (types.EpochTokenValues, bool) {
+	etv := types.EpochTokenValues{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochTokenValues)
+	iterator := sdk.KVStoreReversePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return etv, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &etv)
+	return etv, true
+}
+
+// SetEpochTokenValues records the token values of an epoch, pruning entries
+// older than TokenValuesHistoryLength epochs.
+func (k *Keeper) SetEpochTokenValues(ctx sdk.Context, etv types.EpochTokenValues) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochTokenValues)
+	bz := k.cdc.MustMarshal(&etv)
+	store.Set(types.GetEpochTokenValuesKey(etv.Epoch), bz)
+
+	if etv.Epoch > types.TokenValuesHistoryLength {
+		k.pruneEpochTokenValues(ctx, etv.Epoch-types.TokenValuesHistoryLength)
+	}
+}
+
+// pruneEpochTokenValues deletes the token values of epochs up to and
+// including the given epoch.
+func (k *Keeper) pruneEpochTokenValues(ctx sdk.Context, epoch int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochTokenValues)
+	iterator := store.Iterator(nil, types.GetEpochTokenValuesKey(epoch+1))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
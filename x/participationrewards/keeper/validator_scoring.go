@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// SigningInfosPageLimit is the number of validator signing infos requested per
+// query.
+const SigningInfosPageLimit = 200
+
+// GetZoneSigningInfo returns the validator signing info obtained for the given
+// zone.
+func (k *Keeper) GetZoneSigningInfo(ctx sdk.Context, chainID string) (types.ZoneSigningInfo, bool) {
+	zsi := types.ZoneSigningInfo{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneSigningInfo)
+	bz := store.Get([]byte(chainID))
+	if len(bz) == 0 {
+		return zsi, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &zsi)
+	return zsi, true
+}
+
+// SetZoneSigningInfo sets the validator signing info of a zone.
+func (k *Keeper) SetZoneSigningInfo(ctx sdk.Context, zsi types.ZoneSigningInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixZoneSigningInfo)
+	bz := k.cdc.MustMarshal(&zsi)
+	store.Set([]byte(zsi.ChainId), bz)
+}
+
+// GetGovProposalVotes returns the tracked votes of the given zone governance
+// proposal.
+func (k *Keeper) GetGovProposalVotes(ctx sdk.Context, chainID string, proposalID uint64) (types.GovProposalVotes, bool) {
+	gpv := types.GovProposalVotes{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGovProposalVotes)
+	bz := store.Get(types.GetGovProposalVotesKey(chainID, proposalID))
+	if len(bz) == 0 {
+		return gpv, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &gpv)
+	return gpv, true
+}
+
+// SetGovProposalVotes sets the tracked votes of a zone governance proposal.
+func (k *Keeper) SetGovProposalVotes(ctx sdk.Context, gpv types.GovProposalVotes) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGovProposalVotes)
+	bz := k.cdc.MustMarshal(&gpv)
+	store.Set(types.GetGovProposalVotesKey(gpv.ChainId, gpv.ProposalId), bz)
+}
+
+// DeleteGovProposalVotes deletes the tracked votes of a zone governance
+// proposal.
+func (k *Keeper) DeleteGovProposalVotes(ctx sdk.Context, chainID string, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGovProposalVotes)
+	store.Delete(types.GetGovProposalVotesKey(chainID, proposalID))
+}
+
+// IterateGovProposalVotes iterates over the tracked governance proposals of
+// the given zone.
+func (k *Keeper) IterateGovProposalVotes(ctx sdk.Context, chainID string, fn func(index int64, gpv types.GovProposalVotes) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixGovProposalVotes, types.GetPrefixGovProposalVotesKey(chainID)...))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		gpv := types.GovProposalVotes{}
+		k.cdc.MustUnmarshal(iterator.Value(), &gpv)
+		if stop := fn(i, gpv); stop {
+			break
+		}
+		i++
+	}
+}
+
+// AllGovProposalVotes returns the tracked governance proposals of the given
+// zone.
+func (k *Keeper) AllGovProposalVotes(ctx sdk.Context, chainID string) []types.GovProposalVotes {
+	out := make([]types.GovProposalVotes, 0)
+	k.IterateGovProposalVotes(ctx, chainID, func(_ int64, gpv types.GovProposalVotes) (stop bool) {
+		out = append(out, gpv)
+		return false
+	})
+	return out
+}
+
+// pruneGovProposalVotes deletes the tracked governance proposals of the given
+// zone first observed in or before the given epoch.
+func (k *Keeper) pruneGovProposalVotes(ctx sdk.Context, chainID string, epoch int64) {
+	for _, gpv := range k.AllGovProposalVotes(ctx, chainID) {
+		if gpv.Epoch <= epoch {
+			k.DeleteGovProposalVotes(ctx, chainID, gpv.ProposalId)
+		}
+	}
+}
+
+// QueryValidatorScoringData emits the queries that obtain the inputs to the
+// uptime and governance components of validator scoring for the given zone,
+// if those components are weighted. Inputs are recorded as the
+// responses return, and are used by the next scores calculated.
+func (k *Keeper) QueryValidatorScoringData(ctx sdk.Context, zone *icstypes.Zone) {
+	weights := k.GetParams(ctx).ScoringWeights
+
+	if weights.Uptime.IsPositive() {
+		k.IcqKeeper.MakeRequest(
+			ctx,
+			zone.ConnectionId,
+			zone.ChainId,
+			"cosmos.slashing.v1beta1.Query/Params",
+			k.cdc.MustMarshal(&slashingtypes.QueryParamsRequest{}),
+			sdk.NewInt(-1),
+			types.ModuleName,
+			SlashingParamsCallbackID,
+			0,
+		)
+		k.querySigningInfos(ctx, zone, nil)
+	}
+
+	if weights.Governance.IsPositive() {
+		k.queryGovProposals(ctx, zone, nil)
+	}
+}
+
+func (k *Keeper) querySigningInfos(ctx sdk.Context, zone *icstypes.Zone, key []byte) {
+	req := slashingtypes.QuerySigningInfosRequest{Pagination: &query.PageRequest{Key: key, Limit: SigningInfosPageLimit}}
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.slashing.v1beta1.Query/SigningInfos",
+		k.cdc.MustMarshal(&req),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		SigningInfosCallbackID,
+		0,
+	)
+}
+
+func (k *Keeper) queryGovProposals(ctx sdk.Context, zone *icstypes.Zone, key []byte) {
+	req := govv1.QueryProposalsRequest{ProposalStatus: govv1.StatusVotingPeriod, Pagination: &query.PageRequest{Key: key}}
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.gov.v1.Query/Proposals",
+		k.cdc.MustMarshal(&req),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		GovProposalsCallbackID,
+		0,
+	)
+}
+
+func (k *Keeper) queryGovVote(ctx sdk.Context, zone *icstypes.Zone, proposalID uint64, voter string) {
+	req := govv1.QueryVoteRequest{ProposalId: proposalID, Voter: voter}
+	k.IcqKeeper.MakeRequest(
+		ctx,
+		zone.ConnectionId,
+		zone.ChainId,
+		"cosmos.gov.v1.Query/Vote",
+		k.cdc.MustMarshal(&req),
+		sdk.NewInt(-1),
+		types.ModuleName,
+		GovVoteCallbackID,
+		0,
+	)
+}
+
+// performanceScorer sets the performance score of zone validators from their
+// rewards score and the stored uptime, commission and governance inputs.
+type performanceScorer struct {
+	weights     types.ScoringWeights
+	signingInfo types.ZoneSigningInfo
+	lastScore   types.EpochZoneScore
+	proposals   []types.GovProposalVotes
+}
+
+func (k *Keeper) newPerformanceScorer(ctx sdk.Context, chainID string) performanceScorer {
+	signingInfo, _ := k.GetZoneSigningInfo(ctx, chainID)
+	lastScore, _ := k.GetLatestEpochZoneScore(ctx, chainID)
+	return performanceScorer{
+		weights:     k.GetParams(ctx).ScoringWeights,
+		signingInfo: signingInfo,
+		lastScore:   lastScore,
+		proposals:   k.AllGovProposalVotes(ctx, chainID),
+	}
+}
+
+// score sets the performance score components of the given validator, other
+// than the rewards score, and the weighted performance score.
+func (ps performanceScorer) score(vs *types.Validator) {
+	vs.UptimeScore = ps.signingInfo.UptimeScore(vs.ValoperAddress)
+	vs.CommissionScore = sdk.OneDec()
+	if last, found := ps.lastScore.GetValidatorScore(vs.ValoperAddress); found {
+		vs.CommissionScore = types.CommissionScore(last.CommissionRate, vs.CommissionRate)
+	}
+	vs.GovernanceScore = types.GovernanceScore(ps.proposals, vs.ValoperAddress)
+	vs.PerformanceScore = ps.weights.PerformanceScore(vs.RewardsScore, vs.UptimeScore, vs.CommissionScore, vs.GovernanceScore)
+}
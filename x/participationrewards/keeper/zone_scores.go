@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+// GetEpochZoneScore returns the validator scores of the given zone recorded in
+// the given epoch.
+func (k *Keeper) GetEpochZoneScore(ctx sdk.Context, chainID string, epoch int64) (types.EpochZoneScore, bool) {
+	ezs := types.EpochZoneScore{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochZoneScore)
+	bz := store.Get(types.GetEpochZoneScoreKey(chainID, epoch))
+	if len(bz) == 0 {
+		return ezs, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &ezs)
+	return ezs, true
+}
+
+// GetLatestEpochZoneScore returns the most recently recorded validator scores
+// of the given zone.
+func (k *Keeper) GetLatestEpochZoneScore(ctx sdk.Context, chainID string) (types.EpochZoneScore, bool) {
+	ezs := types.EpochZoneScore{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixEpochZoneScore, types.GetPrefixEpochZoneScoreKey(chainID)...))
+	iterator := sdk.KVStoreReversePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return ezs, false
+	}
+
+	k.cdc.MustUnmarshal(iterator.Value(), &ezs)
+	return ezs, true
+}
+
+// SetEpochZoneScore records the validator scores of a zone for an epoch,
+// pruning the entries of that zone older than ZoneScoreHistoryLength epochs.
+func (k *Keeper) SetEpochZoneScore(ctx sdk.Context, ezs types.EpochZoneScore) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochZoneScore)
+	bz := k.cdc.MustMarshal(&ezs)
+	store.Set(types.GetEpochZoneScoreKey(ezs.ChainId, ezs.Epoch), bz)
+
+	if ezs.Epoch > types.ZoneScoreHistoryLength {
+		k.pruneEpochZoneScores(ctx, ezs.ChainId, ezs.Epoch-types.ZoneScoreHistoryLength)
+	}
+}
+
+// pruneEpochZoneScores deletes the validator scores of the given zone for
+// epochs up to and including the given epoch.
+func (k *Keeper) pruneEpochZoneScores(ctx sdk.Context, chainID string, epoch int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochZoneScore)
+	iterator := store.Iterator(types.GetPrefixEpochZoneScoreKey(chainID), types.GetEpochZoneScoreKey(chainID, epoch+1))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -44,6 +44,7 @@ type StakingKeeper interface {
 type ClaimsManagerKeeper interface {
 	ArchiveAndGarbageCollectClaims(ctx sdk.Context, chainID string)
 	IterateClaims(ctx sdk.Context, chainID string, fn func(index int64, data claimsmanagertypes.Claim) (stop bool))
+	IterateUserClaims(ctx sdk.Context, chainID, address string, fn func(index int64, data claimsmanagertypes.Claim) (stop bool))
 	IterateLastEpochClaims(ctx sdk.Context, chainID string, fn func(index int64, data claimsmanagertypes.Claim) (stop bool))
 	GetSelfConsensusState(ctx sdk.Context, key string) (ibctmtypes.ConsensusState, bool)
 	SetClaim(ctx sdk.Context, claim *claimsmanagertypes.Claim)
@@ -70,11 +71,13 @@ type InterchainStakingKeeper interface {
 	GetZone(ctx sdk.Context, chainID string) (interchainstakingtypes.Zone, bool)
 	SetZone(ctx sdk.Context, zone *interchainstakingtypes.Zone)
 	AllDelegatorIntents(ctx sdk.Context, zone *interchainstakingtypes.Zone, snapshot bool) []interchainstakingtypes.DelegatorIntent
+	GetDelegatorIntent(ctx sdk.Context, zone *interchainstakingtypes.Zone, delegator string, snapshot bool) (interchainstakingtypes.DelegatorIntent, bool)
 	SetDelegatorIntent(ctx sdk.Context, zone *interchainstakingtypes.Zone, intent interchainstakingtypes.DelegatorIntent, snapshot bool)
 	GetDelegatedAmount(ctx sdk.Context, zone *interchainstakingtypes.Zone) sdk.Coin
 	GetDelegationsInProcess(ctx sdk.Context, chainID string) sdkmath.Int
 	IterateDelegatorIntents(ctx sdk.Context, zone *interchainstakingtypes.Zone, snapshot bool, fn func(index int64, intent interchainstakingtypes.DelegatorIntent) (stop bool))
 	GetValidators(ctx sdk.Context, chainID string) []interchainstakingtypes.Validator
+	GetValidatorAddrByConsAddr(ctx sdk.Context, chainID string, consAddr []byte) (string, bool)
 	SetValidator(ctx sdk.Context, chainID string, val interchainstakingtypes.Validator) error
 	GetLocalAddressMap(ctx sdk.Context, remoteAddress sdk.AccAddress, chainID string) (sdk.AccAddress, bool)
 	GetRemoteAddressMap(ctx sdk.Context, localAddress sdk.AccAddress, chainID string) (sdk.AccAddress, bool)
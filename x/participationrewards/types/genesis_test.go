@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
@@ -19,6 +21,9 @@ func TestGenesisState(t *testing.T) {
 				HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
 				LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
 			},
+			MinPoolLiquidity:  math.ZeroInt(),
+			MaxPriceDeviation: sdk.ZeroDec(),
+			ScoringWeights:    types.DefaultScoringWeights,
 		},
 	}
 	defaultGenesisState := types.DefaultGenesisState()
@@ -31,6 +36,9 @@ func TestGenesisState(t *testing.T) {
 				HoldingsAllocation:           sdk.MustNewDecFromStr("0.3"),
 				LockupAllocation:             sdk.MustNewDecFromStr("0.2"),
 			},
+			MinPoolLiquidity:  math.ZeroInt(),
+			MaxPriceDeviation: sdk.ZeroDec(),
+			ScoringWeights:    types.DefaultScoringWeights,
 		},
 	)
 	testGenesisState = types.GenesisState{
@@ -40,6 +48,9 @@ func TestGenesisState(t *testing.T) {
 				HoldingsAllocation:           sdk.MustNewDecFromStr("0.3"),
 				LockupAllocation:             sdk.MustNewDecFromStr("0.2"),
 			},
+			MinPoolLiquidity:  math.ZeroInt(),
+			MaxPriceDeviation: sdk.ZeroDec(),
+			ScoringWeights:    types.DefaultScoringWeights,
 		},
 	}
 	require.Equal(t, *newGenesisState, testGenesisState)
@@ -23,9 +23,17 @@ const (
 	ProofTypeBank     = "bank"
 	ProofTypeLeverage = "leverage"
 	ProofTypeLPFarm   = "lpfarm"
+	ProofTypeLockup   = "lockup"
 )
 
-var KeyPrefixProtocolData = []byte{0x00}
+var (
+	KeyPrefixProtocolData     = []byte{0x00}
+	KeyPrefixEpochTokenValues = []byte{0x01}
+	KeyPrefixEpochZoneScore   = []byte{0x02}
+	KeyPrefixZoneSigningInfo  = []byte{0x03}
+	KeyPrefixGovProposalVotes = []byte{0x04}
+	KeyPrefixIntentScore      = []byte{0x05}
+)
 
 func GetProtocolDataKey(pdType ProtocolDataType, key []byte) []byte {
 	if pdType < 1 {
@@ -40,3 +48,34 @@ func GetPrefixProtocolDataKey(pdType ProtocolDataType) []byte {
 	}
 	return sdk.Uint64ToBigEndian(uint64(pdType)) //nolint:gosec
 }
+
+// GetEpochTokenValuesKey returns the key under which the token values of the
+// given epoch are stored, relative to KeyPrefixEpochTokenValues.
+func GetEpochTokenValuesKey(epoch int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epoch)) //nolint:gosec
+}
+
+// GetPrefixEpochZoneScoreKey returns the prefix under which the zone scores of
+// the given zone are stored, relative to KeyPrefixEpochZoneScore.
+func GetPrefixEpochZoneScoreKey(chainID string) []byte {
+	return append([]byte(chainID), 0x00)
+}
+
+// GetEpochZoneScoreKey returns the key under which the zone score of the given
+// zone and epoch is stored, relative to KeyPrefixEpochZoneScore.
+func GetEpochZoneScoreKey(chainID string, epoch int64) []byte {
+	return append(GetPrefixEpochZoneScoreKey(chainID), sdk.Uint64ToBigEndian(uint64(epoch))...) //nolint:gosec
+}
+
+// GetPrefixGovProposalVotesKey returns the prefix under which the governance
+// proposal votes of the given zone are stored, relative to
+// KeyPrefixGovProposalVotes.
+func GetPrefixGovProposalVotesKey(chainID string) []byte {
+	return append([]byte(chainID), 0x00)
+}
+
+// GetGovProposalVotesKey returns the key under which the votes of the given
+// zone governance proposal are stored, relative to KeyPrefixGovProposalVotes.
+func GetGovProposalVotesKey(chainID string, proposalID uint64) []byte {
+	return append(GetPrefixGovProposalVotesKey(chainID), sdk.Uint64ToBigEndian(proposalID)...)
+}
@@ -5,6 +5,8 @@ import (
 
 	"gopkg.in/yaml.v2"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -12,11 +14,24 @@ import (
 var (
 	KeyDistributionProportions = []byte("DistributionProportions")
 	KeyClaimsEnabled           = []byte("ClaimsEnabled")
+	KeyMinPoolLiquidity        = []byte("MinPoolLiquidity")
+	KeyMaxPriceDeviation       = []byte("MaxPriceDeviation")
+	KeyMaxPoolDataAge          = []byte("MaxPoolDataAge")
+	KeyScoringWeights          = []byte("ScoringWeights")
 
 	DefaultValidatorSelectionAllocation = sdk.NewDecWithPrec(34, 2)
 	DefaultHoldingsAllocation           = sdk.NewDecWithPrec(33, 2)
 	DefaultLockupAllocation             = sdk.NewDecWithPrec(33, 2)
 	DefaultClaimsEnabled                = false
+	DefaultMinPoolLiquidity             = math.ZeroInt()
+	DefaultMaxPriceDeviation            = sdk.ZeroDec()
+	DefaultMaxPoolDataAge               = uint64(0)
+	DefaultScoringWeights               = ScoringWeights{
+		Rewards:    sdk.OneDec(),
+		Uptime:     sdk.ZeroDec(),
+		Commission: sdk.ZeroDec(),
+		Governance: sdk.ZeroDec(),
+	}
 )
 
 // ParamKeyTable for participationrewards module.
@@ -37,7 +52,11 @@ func NewParams(
 			HoldingsAllocation:           holdingsAllocation,
 			LockupAllocation:             lockupAllocation,
 		},
-		ClaimsEnabled: claimsEnabled,
+		ClaimsEnabled:     claimsEnabled,
+		MinPoolLiquidity:  DefaultMinPoolLiquidity,
+		MaxPriceDeviation: DefaultMaxPriceDeviation,
+		MaxPoolDataAge:    DefaultMaxPoolDataAge,
+		ScoringWeights:    DefaultScoringWeights,
 	}
 }
 
@@ -56,6 +75,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyClaimsEnabled, &p.ClaimsEnabled, validateBoolean),
+		paramtypes.NewParamSetPair(KeyMinPoolLiquidity, &p.MinPoolLiquidity, validateMinPoolLiquidity),
+		paramtypes.NewParamSetPair(KeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+		paramtypes.NewParamSetPair(KeyMaxPoolDataAge, &p.MaxPoolDataAge, validateUint64),
+		paramtypes.NewParamSetPair(KeyScoringWeights, &p.ScoringWeights, validateScoringWeights),
 	}
 }
 
@@ -68,6 +91,56 @@ func validateDistributionProportions(i interface{}) error {
 	return dp.ValidateBasic()
 }
 
+func validateScoringWeights(i interface{}) error {
+	sw, ok := i.(ScoringWeights)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sw.ValidateBasic()
+}
+
+func validateMinPoolLiquidity(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("min pool liquidity must not be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min pool liquidity must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max price deviation must not be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max price deviation must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateBoolean(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -79,7 +152,16 @@ func validateBoolean(i interface{}) error {
 
 // Validate performs stateless validity checks on params.
 func (p *Params) Validate() error {
-	return validateDistributionProportions(p.DistributionProportions)
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateMinPoolLiquidity(p.MinPoolLiquidity); err != nil {
+		return err
+	}
+	if err := validateMaxPriceDeviation(p.MaxPriceDeviation); err != nil {
+		return err
+	}
+	return validateScoringWeights(p.ScoringWeights)
 }
 
 // String implements the Stringer interface.
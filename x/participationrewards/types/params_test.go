@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,6 +14,10 @@ func TestParams_Validate(t *testing.T) {
 	type fields struct {
 		DistributionProportions DistributionProportions
 		ClaimsEnabled           bool
+		MinPoolLiquidity        math.Int
+		MaxPriceDeviation       sdk.Dec
+		MaxPoolDataAge          uint64
+		ScoringWeights          ScoringWeights
 	}
 	tests := []struct {
 		name    string
@@ -23,8 +29,6 @@ func TestParams_Validate(t *testing.T) {
 			fields{},
 			true,
 		},
-		// currently params struct only contains DistributionProportions,
-		// thus its testcases are to provide sufficient coverage.
 		{
 			"valid",
 			fields{
@@ -33,16 +37,104 @@ func TestParams_Validate(t *testing.T) {
 					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
 					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
 				},
-				ClaimsEnabled: false,
+				ClaimsEnabled:     false,
+				MinPoolLiquidity:  math.NewInt(1000000),
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.05"),
+				MaxPoolDataAge:    100000,
+				ScoringWeights: ScoringWeights{
+					Rewards:    sdk.MustNewDecFromStr("0.5"),
+					Uptime:     sdk.MustNewDecFromStr("0.3"),
+					Commission: sdk.MustNewDecFromStr("0.1"),
+					Governance: sdk.MustNewDecFromStr("0.1"),
+				},
 			},
 			false,
 		},
+		{
+			"nil pricing params",
+			fields{
+				DistributionProportions: DistributionProportions{
+					ValidatorSelectionAllocation: sdk.MustNewDecFromStr("0.34"),
+					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
+					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
+				},
+			},
+			true,
+		},
+		{
+			"negative min pool liquidity",
+			fields{
+				DistributionProportions: DistributionProportions{
+					ValidatorSelectionAllocation: sdk.MustNewDecFromStr("0.34"),
+					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
+					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
+				},
+				MinPoolLiquidity:  math.NewInt(-1),
+				MaxPriceDeviation: sdk.ZeroDec(),
+			},
+			true,
+		},
+		{
+			"negative max price deviation",
+			fields{
+				DistributionProportions: DistributionProportions{
+					ValidatorSelectionAllocation: sdk.MustNewDecFromStr("0.34"),
+					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
+					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
+				},
+				MinPoolLiquidity:  math.ZeroInt(),
+				MaxPriceDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			true,
+		},
+		{
+			"negative scoring weight",
+			fields{
+				DistributionProportions: DistributionProportions{
+					ValidatorSelectionAllocation: sdk.MustNewDecFromStr("0.34"),
+					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
+					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
+				},
+				MinPoolLiquidity:  math.ZeroInt(),
+				MaxPriceDeviation: sdk.ZeroDec(),
+				ScoringWeights: ScoringWeights{
+					Rewards:    sdk.OneDec(),
+					Uptime:     sdk.MustNewDecFromStr("-0.1"),
+					Commission: sdk.ZeroDec(),
+					Governance: sdk.ZeroDec(),
+				},
+			},
+			true,
+		},
+		{
+			"zero total scoring weight",
+			fields{
+				DistributionProportions: DistributionProportions{
+					ValidatorSelectionAllocation: sdk.MustNewDecFromStr("0.34"),
+					HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
+					LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
+				},
+				MinPoolLiquidity:  math.ZeroInt(),
+				MaxPriceDeviation: sdk.ZeroDec(),
+				ScoringWeights: ScoringWeights{
+					Rewards:    sdk.ZeroDec(),
+					Uptime:     sdk.ZeroDec(),
+					Commission: sdk.ZeroDec(),
+					Governance: sdk.ZeroDec(),
+				},
+			},
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := Params{
 				DistributionProportions: tt.fields.DistributionProportions,
 				ClaimsEnabled:           tt.fields.ClaimsEnabled,
+				MinPoolLiquidity:        tt.fields.MinPoolLiquidity,
+				MaxPriceDeviation:       tt.fields.MaxPriceDeviation,
+				MaxPoolDataAge:          tt.fields.MaxPoolDataAge,
+				ScoringWeights:          tt.fields.ScoringWeights,
 			}
 			err := p.Validate()
 			if tt.wantErr {
@@ -63,7 +155,16 @@ func TestParams(t *testing.T) {
 			HoldingsAllocation:           sdk.MustNewDecFromStr("0.33"),
 			LockupAllocation:             sdk.MustNewDecFromStr("0.33"),
 		},
-		ClaimsEnabled: false,
+		ClaimsEnabled:     false,
+		MinPoolLiquidity:  math.ZeroInt(),
+		MaxPriceDeviation: sdk.ZeroDec(),
+		MaxPoolDataAge:    0,
+		ScoringWeights: ScoringWeights{
+			Rewards:    sdk.OneDec(),
+			Uptime:     sdk.ZeroDec(),
+			Commission: sdk.ZeroDec(),
+			Governance: sdk.ZeroDec(),
+		},
 	}
 	defaultParams := DefaultParams()
 	require.Equal(t, defaultParams, testParams)
@@ -73,6 +174,14 @@ func TestParams(t *testing.T) {
   holdingsallocation: "0.330000000000000000"
   lockupallocation: "0.330000000000000000"
 claimsenabled: false
+minpoolliquidity: "0"
+maxpricedeviation: "0.000000000000000000"
+maxpooldataage: 0
+scoringweights:
+  rewards: "1.000000000000000000"
+  uptime: "0.000000000000000000"
+  commission: "0.000000000000000000"
+  governance: "0.000000000000000000"
 `
 	require.Equal(t, str, testParams.String())
 }
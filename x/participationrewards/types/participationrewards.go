@@ -59,6 +59,38 @@ func (dp *DistributionProportions) Total() sdk.Dec {
 	return dp.ValidatorSelectionAllocation.Add(dp.HoldingsAllocation).Add(dp.LockupAllocation)
 }
 
+func (sw *ScoringWeights) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	for name, weight := range map[string]sdk.Dec{
+		"Rewards":    sw.Rewards,
+		"Uptime":     sw.Uptime,
+		"Commission": sw.Commission,
+		"Governance": sw.Governance,
+	} {
+		if weight.IsNil() {
+			errs[name] = ErrUndefinedAttribute
+		} else if weight.IsNegative() {
+			errs[name] = ErrNegativeAttribute
+		}
+	}
+
+	// no errors yet: check total weight
+	if len(errs) == 0 && !sw.Total().IsPositive() {
+		errs["TotalWeight"] = fmt.Errorf("%w: total scoring weight, got %v", ErrNotPositive, sw.Total())
+	}
+
+	if len(errs) > 0 {
+		return multierr.Combine(utils.ErrorMapToSlice(errs)...)
+	}
+
+	return nil
+}
+
+func (sw *ScoringWeights) Total() sdk.Dec {
+	return sw.Rewards.Add(sw.Uptime).Add(sw.Commission).Add(sw.Governance)
+}
+
 func (kpd *KeyedProtocolData) ValidateBasic() error {
 	errs := make(map[string]error)
 
@@ -147,6 +179,12 @@ type Validator struct {
 	PerformanceScore  sdk.Dec
 	DistributionScore sdk.Dec
 
+	// components of the performance score.
+	RewardsScore    sdk.Dec
+	UptimeScore     sdk.Dec
+	CommissionScore sdk.Dec
+	GovernanceScore sdk.Dec
+
 	*icstypes.Validator
 }
 
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_json "encoding/json"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -36,17 +37,20 @@ const (
 	ProtocolDataTypeLiquidToken                   ProtocolDataType = 3
 	ProtocolDataTypeOsmosisPool                   ProtocolDataType = 4
 	ProtocolDataTypeMembraneParams                ProtocolDataType = 5
-	ProtocolDataTypeSifchainPool                  ProtocolDataType = 6 // Deprecated: Do not use.
-	ProtocolDataTypeUmeeParams                    ProtocolDataType = 7
-	ProtocolDataTypeUmeeReserves                  ProtocolDataType = 8
-	ProtocolDataTypeUmeeInterestScalar            ProtocolDataType = 9
-	ProtocolDataTypeUmeeTotalBorrows              ProtocolDataType = 10
-	ProtocolDataTypeUmeeUTokenSupply              ProtocolDataType = 11
-	ProtocolDataTypeUmeeLeverageModuleBalance     ProtocolDataType = 12
+	ProtocolDataTypeSifchainPool                  ProtocolDataType = 6  // Deprecated: Do not use.
+	ProtocolDataTypeUmeeParams                    ProtocolDataType = 7  // Deprecated: Do not use.
+	ProtocolDataTypeUmeeReserves                  ProtocolDataType = 8  // Deprecated: Do not use.
+	ProtocolDataTypeUmeeInterestScalar            ProtocolDataType = 9  // Deprecated: Do not use.
+	ProtocolDataTypeUmeeTotalBorrows              ProtocolDataType = 10 // Deprecated: Do not use.
+	ProtocolDataTypeUmeeUTokenSupply              ProtocolDataType = 11 // Deprecated: Do not use.
+	ProtocolDataTypeUmeeLeverageModuleBalance     ProtocolDataType = 12 // Deprecated: Do not use.
 	ProtocolDataTypeCrescentParams                ProtocolDataType = 13 // Deprecated: Do not use.
 	ProtocolDataTypeCrescentReserveAddressBalance ProtocolDataType = 14 // Deprecated: Do not use.
 	ProtocolDataTypeCrescentPoolCoinSupply        ProtocolDataType = 15 // Deprecated: Do not use.
 	ProtocolDataTypeOsmosisCLPool                 ProtocolDataType = 16
+	ProtocolDataTypeCosmWasmContract              ProtocolDataType = 17
+	ProtocolDataTypeMoneyMarket                   ProtocolDataType = 18
+	ProtocolDataTypeMoneyMarketDenom              ProtocolDataType = 19
 )
 
 var ProtocolDataType_name = map[int32]string{
@@ -67,6 +71,9 @@ var ProtocolDataType_name = map[int32]string{
 	14: "ProtocolDataTypeCrescentReserveAddressBalance",
 	15: "ProtocolDataTypeCrescentPoolCoinSupply",
 	16: "ProtocolDataTypeOsmosisCLPool",
+	17: "ProtocolDataTypeCosmWasmContract",
+	18: "ProtocolDataTypeMoneyMarket",
+	19: "ProtocolDataTypeMoneyMarketDenom",
 }
 
 var ProtocolDataType_value = map[string]int32{
@@ -87,6 +94,9 @@ var ProtocolDataType_value = map[string]int32{
 	"ProtocolDataTypeCrescentReserveAddressBalance": 14,
 	"ProtocolDataTypeCrescentPoolCoinSupply":        15,
 	"ProtocolDataTypeOsmosisCLPool":                 16,
+	"ProtocolDataTypeCosmWasmContract":              17,
+	"ProtocolDataTypeMoneyMarket":                   18,
+	"ProtocolDataTypeMoneyMarketDenom":              19,
 }
 
 func (x ProtocolDataType) String() string {
@@ -138,18 +148,80 @@ func (m *DistributionProportions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DistributionProportions proto.InternalMessageInfo
 
+// ScoringWeights defines the weights of the components of the validator
+// performance score. Each component is a score between zero and one; the
+// performance score is their weighted mean.
+type ScoringWeights struct {
+	// rewards weights the rewards earned by the zone performance account.
+	Rewards github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewards"`
+	// uptime weights the blocks signed within the zone signed blocks window.
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// commission weights the absence of commission rate increases.
+	Commission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// governance weights participation in zone governance proposals.
+	Governance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=governance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"governance"`
+}
+
+func (m *ScoringWeights) Reset()         { *m = ScoringWeights{} }
+func (m *ScoringWeights) String() string { return proto.CompactTextString(m) }
+func (*ScoringWeights) ProtoMessage()    {}
+func (*ScoringWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{1}
+}
+func (m *ScoringWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScoringWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScoringWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScoringWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoringWeights.Merge(m, src)
+}
+func (m *ScoringWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScoringWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoringWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoringWeights proto.InternalMessageInfo
+
 // Params holds parameters for the participationrewards module.
 type Params struct {
 	// distribution_proportions defines the proportions of the minted
 	// participation rewards;
 	DistributionProportions DistributionProportions `protobuf:"bytes,1,opt,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions"`
 	ClaimsEnabled           bool                    `protobuf:"varint,2,opt,name=claims_enabled,json=claimsEnabled,proto3" json:"claims_enabled,omitempty"`
+	// min_pool_liquidity is the minimum liquidity, in units of the osmosis base
+	// denom, of the side of a pool a denom is priced from, for the pool quote to
+	// be used in token valuation. Zero disables the check.
+	MinPoolLiquidity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_pool_liquidity,json=minPoolLiquidity,proto3,customtype=cosmossdk.io/math.Int" json:"min_pool_liquidity"`
+	// max_price_deviation is the maximum relative deviation of a pool quote from
+	// the liquidity weighted median quote of its pair; quotes deviating further
+	// are discarded. Zero disables the check.
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation"`
+	// max_pool_data_age is the maximum number of blocks since pool protocol data
+	// was last updated, for the pool to be used in token valuation. Zero
+	// disables the check.
+	MaxPoolDataAge uint64 `protobuf:"varint,5,opt,name=max_pool_data_age,json=maxPoolDataAge,proto3" json:"max_pool_data_age,omitempty"`
+	// scoring_weights defines the weights of the components of the validator
+	// performance score.
+	ScoringWeights ScoringWeights `protobuf:"bytes,6,opt,name=scoring_weights,json=scoringWeights,proto3" json:"scoring_weights"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4fb4e5bb851c124, []int{1}
+	return fileDescriptor_d4fb4e5bb851c124, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,7 +259,7 @@ func (m *KeyedProtocolData) Reset()         { *m = KeyedProtocolData{} }
 func (m *KeyedProtocolData) String() string { return proto.CompactTextString(m) }
 func (*KeyedProtocolData) ProtoMessage()    {}
 func (*KeyedProtocolData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4fb4e5bb851c124, []int{2}
+	return fileDescriptor_d4fb4e5bb851c124, []int{3}
 }
 func (m *KeyedProtocolData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +313,7 @@ func (m *ProtocolData) Reset()         { *m = ProtocolData{} }
 func (m *ProtocolData) String() string { return proto.CompactTextString(m) }
 func (*ProtocolData) ProtoMessage()    {}
 func (*ProtocolData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4fb4e5bb851c124, []int{3}
+	return fileDescriptor_d4fb4e5bb851c124, []int{4}
 }
 func (m *ProtocolData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,326 +356,3420 @@ func (m *ProtocolData) GetData() encoding_json.RawMessage {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("quicksilver.participationrewards.v1.ProtocolDataType", ProtocolDataType_name, ProtocolDataType_value)
-	proto.RegisterType((*DistributionProportions)(nil), "quicksilver.participationrewards.v1.DistributionProportions")
-	proto.RegisterType((*Params)(nil), "quicksilver.participationrewards.v1.Params")
-	proto.RegisterType((*KeyedProtocolData)(nil), "quicksilver.participationrewards.v1.KeyedProtocolData")
-	proto.RegisterType((*ProtocolData)(nil), "quicksilver.participationrewards.v1.ProtocolData")
+// PriceHop is a single edge of the price graph traversed when pricing a denom.
+type PriceHop struct {
+	FromDenom string `protobuf:"bytes,1,opt,name=from_denom,json=fromDenom,proto3" json:"from_denom,omitempty"`
+	ToDenom   string `protobuf:"bytes,2,opt,name=to_denom,json=toDenom,proto3" json:"to_denom,omitempty"`
+	// price is the value of one to_denom in units of from_denom, averaged over
+	// the pools listed in pool_ids.
+	Price   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	PoolIds []uint64                               `protobuf:"varint,4,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/participationrewards/v1/participationrewards.proto", fileDescriptor_d4fb4e5bb851c124)
+func (m *PriceHop) Reset()         { *m = PriceHop{} }
+func (m *PriceHop) String() string { return proto.CompactTextString(m) }
+func (*PriceHop) ProtoMessage()    {}
+func (*PriceHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{5}
 }
-
-var fileDescriptor_d4fb4e5bb851c124 = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0x3b, 0x45,
-	0x14, 0xef, 0x96, 0x82, 0x65, 0x28, 0xb8, 0x8c, 0x26, 0x40, 0x85, 0x2d, 0x56, 0x6c, 0xd4, 0xa4,
-	0x5b, 0xc1, 0x78, 0x21, 0xc4, 0x84, 0xb6, 0x1e, 0x8c, 0x10, 0xc9, 0xb6, 0x78, 0xf0, 0x60, 0x33,
-	0x9d, 0x7d, 0x94, 0xb1, 0xd3, 0x99, 0x65, 0x66, 0x5b, 0xac, 0x09, 0x17, 0x4f, 0x1c, 0x3d, 0x7a,
-	0x31, 0x31, 0xf1, 0x5f, 0xf0, 0xe0, 0x9f, 0xc0, 0x91, 0x78, 0x32, 0x1e, 0x88, 0x81, 0xff, 0xc2,
-	0x83, 0x31, 0xfb, 0xa3, 0x7c, 0xf7, 0x5b, 0xb6, 0x09, 0x07, 0x4e, 0x9d, 0x7d, 0xf3, 0x79, 0x9f,
-	0xcf, 0x7b, 0xef, 0xf3, 0x76, 0x8b, 0x3e, 0xbb, 0x18, 0x32, 0xda, 0xd7, 0x8c, 0x8f, 0x40, 0xd5,
-	0x3c, 0xa2, 0x7c, 0x46, 0x99, 0x47, 0x7c, 0x26, 0x85, 0x82, 0x4b, 0xa2, 0x5c, 0x5d, 0x1b, 0xed,
-	0xa6, 0xc6, 0x6d, 0x4f, 0x49, 0x5f, 0xe2, 0xf7, 0x12, 0xf9, 0x76, 0x2a, 0x6e, 0xb4, 0x5b, 0xdc,
-	0xa0, 0x52, 0x0f, 0xa4, 0xee, 0x84, 0x29, 0xb5, 0xe8, 0x21, 0xca, 0x2f, 0xbe, 0xdd, 0x93, 0x3d,
-	0x19, 0xc5, 0x83, 0x53, 0x14, 0x2d, 0xff, 0x97, 0x45, 0x6b, 0x4d, 0xa6, 0x7d, 0xc5, 0xba, 0xc3,
-	0x80, 0xeb, 0x44, 0x49, 0x4f, 0xaa, 0xe0, 0xa4, 0xf1, 0x8f, 0x06, 0xb2, 0x46, 0x84, 0x33, 0x97,
-	0xf8, 0x52, 0x75, 0x34, 0x70, 0xa0, 0xc1, 0x45, 0x87, 0x70, 0x2e, 0x69, 0xa8, 0xbc, 0x6e, 0x6c,
-	0x1b, 0x1f, 0x2c, 0xd6, 0x0f, 0x6e, 0xee, 0x4a, 0x99, 0xbf, 0xef, 0x4a, 0x95, 0x1e, 0xf3, 0xcf,
-	0x87, 0x5d, 0x9b, 0xca, 0x41, 0xac, 0x1d, 0xff, 0x54, 0xb5, 0xdb, 0xaf, 0xf9, 0x63, 0x0f, 0xb4,
-	0xdd, 0x04, 0xfa, 0xe7, 0xef, 0x55, 0x14, 0x97, 0xd6, 0x04, 0xea, 0x6c, 0x3e, 0x6a, 0xb4, 0x26,
-	0x12, 0x87, 0x8f, 0x0a, 0x78, 0x80, 0xde, 0x3a, 0x97, 0xdc, 0x65, 0xa2, 0xa7, 0x93, 0xc2, 0xd9,
-	0x17, 0x10, 0xc6, 0x13, 0xe2, 0x84, 0x1c, 0x43, 0xab, 0x5c, 0xd2, 0xfe, 0xd0, 0x4b, 0x8a, 0xcd,
-	0xbd, 0x80, 0x98, 0x19, 0xd1, 0xbe, 0x92, 0xda, 0xcf, 0x5d, 0xff, 0x5a, 0xca, 0x94, 0xff, 0x30,
-	0xd0, 0xc2, 0x09, 0x51, 0x64, 0xa0, 0xf1, 0x15, 0x5a, 0x77, 0x13, 0x56, 0x04, 0x26, 0x4e, 0xbc,
-	0x08, 0x07, 0xbd, 0xb4, 0x77, 0x60, 0x3f, 0x63, 0x09, 0xec, 0x19, 0x7e, 0xd6, 0x73, 0x41, 0x03,
-	0xce, 0x9a, 0x3b, 0xc3, 0xee, 0xf7, 0xd1, 0x0a, 0xe5, 0x84, 0x0d, 0x74, 0x07, 0x04, 0xe9, 0x72,
-	0x70, 0xc3, 0x21, 0xe7, 0x9d, 0xe5, 0x28, 0xfa, 0x79, 0x14, 0xdc, 0xcf, 0x07, 0x65, 0xff, 0x1c,
-	0x94, 0x7e, 0x85, 0x56, 0xbf, 0x84, 0x31, 0xb8, 0x27, 0xc1, 0x26, 0x51, 0xc9, 0x9b, 0xc4, 0x27,
-	0xd8, 0x44, 0x73, 0x7d, 0x18, 0x47, 0x8b, 0xe1, 0x04, 0x47, 0xfc, 0x35, 0x5a, 0xf6, 0x62, 0x44,
-	0xc7, 0x25, 0x3e, 0x09, 0x69, 0x97, 0xf6, 0x76, 0x9f, 0xd5, 0x4b, 0x92, 0xdb, 0x29, 0x78, 0x89,
-	0xa7, 0x72, 0x1b, 0x15, 0x5e, 0x53, 0xc6, 0x28, 0x17, 0x0c, 0x3f, 0x96, 0x0e, 0xcf, 0xf8, 0x63,
-	0x94, 0x7b, 0x94, 0x2c, 0xd4, 0x37, 0xff, 0xbd, 0x2b, 0xad, 0x83, 0xa0, 0x32, 0x70, 0xbd, 0xf6,
-	0x9d, 0x96, 0xc2, 0x76, 0xc8, 0xe5, 0x31, 0x68, 0x4d, 0x7a, 0xe0, 0x84, 0xc8, 0x8f, 0x7e, 0x99,
-	0x47, 0x66, 0x92, 0xb6, 0x1d, 0xd0, 0x6c, 0xa1, 0x8d, 0xe9, 0xd8, 0xa9, 0x70, 0xe1, 0x8c, 0x09,
-	0x70, 0xcd, 0x0c, 0xb6, 0x50, 0x71, 0xfa, 0xba, 0x21, 0x85, 0x88, 0x76, 0xd9, 0x34, 0xf0, 0xbb,
-	0x68, 0x6b, 0xfa, 0xfe, 0xab, 0x60, 0x31, 0x98, 0x8e, 0x9c, 0x37, 0xb3, 0xb8, 0x84, 0xde, 0x99,
-	0x86, 0x1c, 0xb1, 0x8b, 0x21, 0x73, 0xdb, 0xb2, 0x0f, 0xc2, 0x9c, 0x4b, 0x03, 0x4c, 0x38, 0xa4,
-	0xe4, 0x66, 0x0e, 0x97, 0x91, 0x35, 0x0d, 0x38, 0x86, 0x41, 0x57, 0x11, 0x01, 0xb1, 0xca, 0x3c,
-	0xde, 0x41, 0x9b, 0xd3, 0x98, 0x16, 0x3b, 0xa3, 0xe7, 0x84, 0x89, 0x90, 0x65, 0xa1, 0x98, 0xcd,
-	0x1b, 0x69, 0xed, 0x9c, 0x0e, 0x60, 0xc2, 0xf2, 0x06, 0xde, 0x7e, 0xca, 0x12, 0xdc, 0x3b, 0xa0,
-	0x41, 0x8d, 0x40, 0x9b, 0x79, 0x5c, 0x41, 0xe5, 0x34, 0xc4, 0x17, 0xc2, 0x07, 0x05, 0xda, 0x6f,
-	0x51, 0xc2, 0x89, 0x32, 0x17, 0xf1, 0x0e, 0xda, 0x4e, 0xc3, 0xb5, 0xa5, 0x4f, 0x78, 0x5d, 0x2a,
-	0x25, 0x2f, 0xb5, 0x89, 0x66, 0xa1, 0x4e, 0xc3, 0xd1, 0xb4, 0x86, 0x9e, 0xc7, 0xc7, 0xe6, 0x12,
-	0xae, 0xa2, 0x0f, 0xd3, 0x50, 0x47, 0x30, 0x02, 0x45, 0x7a, 0x70, 0x2c, 0xdd, 0x21, 0x87, 0x3a,
-	0xe1, 0x44, 0x50, 0x30, 0x0b, 0xb8, 0xf2, 0x74, 0x5c, 0x0d, 0x05, 0x9a, 0x82, 0xf0, 0xe3, 0x46,
-	0x97, 0xc3, 0x61, 0x7c, 0x8a, 0xaa, 0xb3, 0x70, 0x71, 0xc3, 0x87, 0xae, 0xab, 0x40, 0xeb, 0x09,
-	0xf5, 0x4a, 0x98, 0x66, 0xa3, 0xca, 0x4c, 0x7a, 0x29, 0x79, 0x43, 0xb2, 0x49, 0xe5, 0x6f, 0x86,
-	0xf8, 0xd9, 0x2b, 0xd2, 0x38, 0x0a, 0xad, 0x31, 0x8b, 0xb9, 0xeb, 0xdf, 0xac, 0x4c, 0xfd, 0xdb,
-	0x9b, 0x7b, 0xcb, 0xb8, 0xbd, 0xb7, 0x8c, 0x7f, 0xee, 0x2d, 0xe3, 0xa7, 0x07, 0x2b, 0x73, 0xfb,
-	0x60, 0x65, 0xfe, 0x7a, 0xb0, 0x32, 0xdf, 0x34, 0x13, 0xdf, 0xa5, 0xc4, 0xab, 0x55, 0xfd, 0x41,
-	0x0a, 0x48, 0x06, 0x6a, 0xdf, 0xa7, 0xff, 0xfd, 0x84, 0x5f, 0xae, 0xee, 0x42, 0xf8, 0x8e, 0x7d,
-	0xf2, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x23, 0x4e, 0xad, 0xaf, 0x06, 0x00, 0x00,
+func (m *PriceHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHop.Merge(m, src)
+}
+func (m *PriceHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHop.DiscardUnknown(m)
 }
 
-func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LockupAllocation.Size()
-		i -= size
-		if _, err := m.LockupAllocation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.HoldingsAllocation.Size()
-		i -= size
-		if _, err := m.HoldingsAllocation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+var xxx_messageInfo_PriceHop proto.InternalMessageInfo
+
+func (m *PriceHop) GetFromDenom() string {
+	if m != nil {
+		return m.FromDenom
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ValidatorSelectionAllocation.Size()
-		i -= size
-		if _, err := m.ValidatorSelectionAllocation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *PriceHop) GetToDenom() string {
+	if m != nil {
+		return m.ToDenom
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceHop) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenValue is the value of a denom in units of the osmosis base denom, and
+// the path of pools from the base denom used to derive it.
+type TokenValue struct {
+	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	Path  []PriceHop                             `protobuf:"bytes,3,rep,name=path,proto3" json:"path"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClaimsEnabled {
-		i--
-		if m.ClaimsEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+func (m *TokenValue) Reset()         { *m = TokenValue{} }
+func (m *TokenValue) String() string { return proto.CompactTextString(m) }
+func (*TokenValue) ProtoMessage()    {}
+func (*TokenValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{6}
+}
+func (m *TokenValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *TokenValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenValue.Merge(m, src)
+}
+func (m *TokenValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenValue.DiscardUnknown(m)
 }
 
-func (m *KeyedProtocolData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenValue proto.InternalMessageInfo
+
+func (m *TokenValue) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *KeyedProtocolData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TokenValue) GetPath() []PriceHop {
+	if m != nil {
+		return m.Path
+	}
+	return nil
 }
 
-func (m *KeyedProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProtocolData != nil {
-		{
-			size, err := m.ProtocolData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+// EpochTokenValues holds the token values calculated at the end of an epoch.
+type EpochTokenValues struct {
+	Epoch     int64        `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BaseDenom string       `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	Values    []TokenValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values"`
+}
+
+func (m *EpochTokenValues) Reset()         { *m = EpochTokenValues{} }
+func (m *EpochTokenValues) String() string { return proto.CompactTextString(m) }
+func (*EpochTokenValues) ProtoMessage()    {}
+func (*EpochTokenValues) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{7}
+}
+func (m *EpochTokenValues) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochTokenValues) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochTokenValues.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EpochTokenValues) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochTokenValues.Merge(m, src)
+}
+func (m *EpochTokenValues) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochTokenValues) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochTokenValues.DiscardUnknown(m)
 }
 
-func (m *ProtocolData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EpochTokenValues proto.InternalMessageInfo
+
+func (m *EpochTokenValues) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProtocolData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EpochTokenValues) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
 }
 
-func (m *ProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+func (m *EpochTokenValues) GetValues() []TokenValue {
+	if m != nil {
+		return m.Values
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintParticipationrewards(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParticipationrewards(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// ValidatorScore holds the scores of a validator calculated for validator
+// selection rewards.
+type ValidatorScore struct {
+	ValoperAddress    string                                 `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	VotingPower       cosmossdk_io_math.Int                  `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3,customtype=cosmossdk.io/math.Int" json:"voting_power"`
+	PowerPercentage   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=power_percentage,json=powerPercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"power_percentage"`
+	DistributionScore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=distribution_score,json=distributionScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"distribution_score"`
+	PerformanceScore  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=performance_score,json=performanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"performance_score"`
+	// score is the overall score; the product of the distribution and
+	// performance scores.
+	Score          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// rewards_score, uptime_score, commission_score and governance_score are
+	// the components of the performance score.
+	RewardsScore    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=rewards_score,json=rewardsScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewards_score"`
+	UptimeScore     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=uptime_score,json=uptimeScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime_score"`
+	CommissionScore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=commission_score,json=commissionScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_score"`
+	GovernanceScore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=governance_score,json=governanceScore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"governance_score"`
 }
-func (m *DistributionProportions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ValidatorSelectionAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	l = m.HoldingsAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	l = m.LockupAllocation.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	return n
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{8}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
+func (m *ValidatorScore) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
 	}
-	var l int
-	_ = l
-	l = m.DistributionProportions.Size()
-	n += 1 + l + sovParticipationrewards(uint64(l))
-	if m.ClaimsEnabled {
-		n += 2
+	return ""
+}
+
+// EpochZoneScore holds the validator scores of a zone calculated in an epoch.
+type EpochZoneScore struct {
+	ChainId          string                `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch            int64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height           int64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TotalVotingPower cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_voting_power,json=totalVotingPower,proto3,customtype=cosmossdk.io/math.Int" json:"total_voting_power"`
+	ValidatorScores  []ValidatorScore      `protobuf:"bytes,5,rep,name=validator_scores,json=validatorScores,proto3" json:"validator_scores"`
+}
+
+func (m *EpochZoneScore) Reset()         { *m = EpochZoneScore{} }
+func (m *EpochZoneScore) String() string { return proto.CompactTextString(m) }
+func (*EpochZoneScore) ProtoMessage()    {}
+func (*EpochZoneScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{9}
+}
+func (m *EpochZoneScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochZoneScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochZoneScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EpochZoneScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochZoneScore.Merge(m, src)
+}
+func (m *EpochZoneScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochZoneScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochZoneScore.DiscardUnknown(m)
 }
 
-func (m *KeyedProtocolData) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EpochZoneScore proto.InternalMessageInfo
+
+func (m *EpochZoneScore) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *EpochZoneScore) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochZoneScore) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EpochZoneScore) GetValidatorScores() []ValidatorScore {
+	if m != nil {
+		return m.ValidatorScores
+	}
+	return nil
+}
+
+// ValidatorMissedBlocks holds the number of blocks a validator missed within
+// the signed blocks window.
+type ValidatorMissedBlocks struct {
+	ValoperAddress string `protobuf:"bytes,1,opt,name=valoper_address,json=valoperAddress,proto3" json:"valoper_address,omitempty"`
+	MissedBlocks   int64  `protobuf:"varint,2,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+}
+
+func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
+func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlocks) ProtoMessage()    {}
+func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{10}
+}
+func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlocks.Merge(m, src)
+}
+func (m *ValidatorMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlocks proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlocks) GetValoperAddress() string {
+	if m != nil {
+		return m.ValoperAddress
+	}
+	return ""
+}
+
+func (m *ValidatorMissedBlocks) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+// ZoneSigningInfo holds the signing info of the validators of a zone, obtained
+// for validator uptime scoring.
+type ZoneSigningInfo struct {
+	ChainId            string                  `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SignedBlocksWindow int64                   `protobuf:"varint,2,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	Validators         []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *ZoneSigningInfo) Reset()         { *m = ZoneSigningInfo{} }
+func (m *ZoneSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ZoneSigningInfo) ProtoMessage()    {}
+func (*ZoneSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{11}
+}
+func (m *ZoneSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ZoneSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneSigningInfo.Merge(m, src)
+}
+func (m *ZoneSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneSigningInfo proto.InternalMessageInfo
+
+func (m *ZoneSigningInfo) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ZoneSigningInfo) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *ZoneSigningInfo) GetValidators() []ValidatorMissedBlocks {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// GovProposalVotes tracks the validators of a zone that voted on a governance
+// proposal, for validator governance participation scoring.
+type GovProposalVotes struct {
+	ChainId    string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// epoch is the epoch in which the proposal was first observed.
+	Epoch  int64    `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Voters []string `protobuf:"bytes,4,rep,name=voters,proto3" json:"voters,omitempty"`
+}
+
+func (m *GovProposalVotes) Reset()         { *m = GovProposalVotes{} }
+func (m *GovProposalVotes) String() string { return proto.CompactTextString(m) }
+func (*GovProposalVotes) ProtoMessage()    {}
+func (*GovProposalVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4fb4e5bb851c124, []int{12}
+}
+func (m *GovProposalVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovProposalVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovProposalVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovProposalVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovProposalVotes.Merge(m, src)
+}
+func (m *GovProposalVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovProposalVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovProposalVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovProposalVotes proto.InternalMessageInfo
+
+func (m *GovProposalVotes) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GovProposalVotes) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *GovProposalVotes) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GovProposalVotes) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("quicksilver.participationrewards.v1.ProtocolDataType", ProtocolDataType_name, ProtocolDataType_value)
+	proto.RegisterType((*DistributionProportions)(nil), "quicksilver.participationrewards.v1.DistributionProportions")
+	proto.RegisterType((*ScoringWeights)(nil), "quicksilver.participationrewards.v1.ScoringWeights")
+	proto.RegisterType((*Params)(nil), "quicksilver.participationrewards.v1.Params")
+	proto.RegisterType((*KeyedProtocolData)(nil), "quicksilver.participationrewards.v1.KeyedProtocolData")
+	proto.RegisterType((*ProtocolData)(nil), "quicksilver.participationrewards.v1.ProtocolData")
+	proto.RegisterType((*PriceHop)(nil), "quicksilver.participationrewards.v1.PriceHop")
+	proto.RegisterType((*TokenValue)(nil), "quicksilver.participationrewards.v1.TokenValue")
+	proto.RegisterType((*EpochTokenValues)(nil), "quicksilver.participationrewards.v1.EpochTokenValues")
+	proto.RegisterType((*ValidatorScore)(nil), "quicksilver.participationrewards.v1.ValidatorScore")
+	proto.RegisterType((*EpochZoneScore)(nil), "quicksilver.participationrewards.v1.EpochZoneScore")
+	proto.RegisterType((*ValidatorMissedBlocks)(nil), "quicksilver.participationrewards.v1.ValidatorMissedBlocks")
+	proto.RegisterType((*ZoneSigningInfo)(nil), "quicksilver.participationrewards.v1.ZoneSigningInfo")
+	proto.RegisterType((*GovProposalVotes)(nil), "quicksilver.participationrewards.v1.GovProposalVotes")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/participationrewards/v1/participationrewards.proto", fileDescriptor_d4fb4e5bb851c124)
+}
+
+var fileDescriptor_d4fb4e5bb851c124 = []byte{
+	// 1582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x4f,
+	0x15, 0x8f, 0x7f, 0xc6, 0x79, 0x76, 0x9c, 0xcd, 0xb4, 0x5f, 0xbe, 0x4e, 0x68, 0x9d, 0xe0, 0xef,
+	0x97, 0x92, 0x16, 0xc5, 0x6e, 0x5a, 0x71, 0xa9, 0x2a, 0xa4, 0x26, 0xa9, 0x4a, 0x44, 0x03, 0xd1,
+	0x26, 0x4d, 0x45, 0x85, 0x58, 0xc6, 0xbb, 0x93, 0xf5, 0xe0, 0xdd, 0x9d, 0xed, 0xcc, 0xda, 0x69,
+	0x10, 0x95, 0x10, 0xa7, 0x1e, 0x7b, 0x42, 0x08, 0x2e, 0x08, 0xfe, 0x04, 0xb8, 0xc3, 0xb1, 0xc7,
+	0x8a, 0x13, 0xe2, 0x50, 0xa1, 0xf6, 0xbf, 0xe0, 0x80, 0xd0, 0xfc, 0xb0, 0xbd, 0x4e, 0x9d, 0x2a,
+	0x45, 0x7b, 0x8a, 0xe7, 0xcd, 0x9b, 0xcf, 0x67, 0xe6, 0xf3, 0xe6, 0xbd, 0x79, 0x1b, 0xf8, 0xfe,
+	0xf3, 0x01, 0x75, 0xfb, 0x82, 0x06, 0x43, 0xc2, 0x3b, 0x31, 0xe6, 0x09, 0x75, 0x69, 0x8c, 0x13,
+	0xca, 0x22, 0x4e, 0x4e, 0x31, 0xf7, 0x44, 0x67, 0xb8, 0x35, 0xd3, 0xde, 0x8e, 0x39, 0x4b, 0x18,
+	0xfa, 0x2a, 0xb5, 0xbe, 0x3d, 0xd3, 0x6f, 0xb8, 0xb5, 0xba, 0xe2, 0x32, 0x11, 0x32, 0xe1, 0xa8,
+	0x25, 0x1d, 0x3d, 0xd0, 0xeb, 0x57, 0xaf, 0xfa, 0xcc, 0x67, 0xda, 0x2e, 0x7f, 0x69, 0x6b, 0xeb,
+	0xbf, 0x79, 0xf8, 0x72, 0x97, 0x8a, 0x84, 0xd3, 0xee, 0x40, 0x62, 0x1d, 0x70, 0x16, 0x33, 0x2e,
+	0x7f, 0x09, 0xf4, 0x9b, 0x1c, 0x34, 0x87, 0x38, 0xa0, 0x1e, 0x4e, 0x18, 0x77, 0x04, 0x09, 0x88,
+	0x2b, 0x27, 0x1c, 0x1c, 0x04, 0xcc, 0x55, 0xcc, 0x8d, 0xdc, 0x7a, 0x6e, 0x63, 0x61, 0xfb, 0xfe,
+	0x9b, 0x77, 0x6b, 0x73, 0xff, 0x7a, 0xb7, 0x76, 0xc3, 0xa7, 0x49, 0x6f, 0xd0, 0x6d, 0xbb, 0x2c,
+	0x34, 0xdc, 0xe6, 0xcf, 0xa6, 0xf0, 0xfa, 0x9d, 0xe4, 0x2c, 0x26, 0xa2, 0xbd, 0x4b, 0xdc, 0x7f,
+	0xfc, 0x75, 0x13, 0xcc, 0xd6, 0x76, 0x89, 0x6b, 0x5f, 0x1b, 0x73, 0x1c, 0x8e, 0x28, 0x1e, 0x8c,
+	0x19, 0x50, 0x08, 0x57, 0x7a, 0x2c, 0xf0, 0x68, 0xe4, 0x8b, 0x34, 0x71, 0x3e, 0x03, 0x62, 0x34,
+	0x02, 0x4e, 0xd1, 0x51, 0x58, 0x0e, 0x98, 0xdb, 0x1f, 0xc4, 0x69, 0xb2, 0x42, 0x06, 0x64, 0x96,
+	0x86, 0x9d, 0x50, 0xdd, 0x2b, 0xbe, 0xfa, 0xe3, 0xda, 0x5c, 0xeb, 0xd7, 0x05, 0xa8, 0x1f, 0xba,
+	0x8c, 0xd3, 0xc8, 0x7f, 0x4a, 0xa8, 0xdf, 0x4b, 0x04, 0x3a, 0x86, 0x79, 0x13, 0xd2, 0x4c, 0xf4,
+	0x1d, 0x81, 0xa1, 0x23, 0x28, 0x0f, 0xe2, 0x84, 0x86, 0x24, 0x13, 0xf5, 0x0c, 0x16, 0xfa, 0x29,
+	0x80, 0xcb, 0xc2, 0x90, 0x0a, 0x91, 0x95, 0x54, 0x29, 0x3c, 0x89, 0xee, 0xb3, 0x21, 0xe1, 0x11,
+	0x8e, 0x5c, 0xd2, 0x28, 0x66, 0x81, 0x3e, 0xc1, 0x33, 0x21, 0x78, 0x5d, 0x84, 0xf2, 0x01, 0xe6,
+	0x38, 0x14, 0xe8, 0x25, 0x34, 0xbc, 0x54, 0x36, 0x38, 0xf1, 0x24, 0x1d, 0x54, 0x2c, 0xaa, 0x77,
+	0xee, 0xb7, 0x2f, 0x91, 0x87, 0xed, 0x0b, 0x52, 0x6a, 0xbb, 0x28, 0xb7, 0x6e, 0x7f, 0xe9, 0x5d,
+	0x90, 0x71, 0xdf, 0x86, 0xba, 0x1b, 0x60, 0x1a, 0x0a, 0x87, 0x44, 0xb8, 0x1b, 0x10, 0x4f, 0x45,
+	0xaa, 0x62, 0x2f, 0x6a, 0xeb, 0x43, 0x6d, 0x44, 0x3f, 0x01, 0x14, 0xd2, 0xc8, 0x89, 0x19, 0x0b,
+	0x9c, 0x80, 0x3e, 0x1f, 0x50, 0x8f, 0x26, 0x67, 0x46, 0xfa, 0xef, 0x1a, 0x71, 0xbe, 0xd0, 0x47,
+	0x16, 0x5e, 0xbf, 0x4d, 0x59, 0x27, 0xc4, 0x49, 0xaf, 0xbd, 0x17, 0x25, 0x29, 0x2d, 0xf6, 0xa2,
+	0xc4, 0xb6, 0x42, 0x1a, 0x1d, 0x30, 0x16, 0x3c, 0x1e, 0x81, 0xa0, 0x00, 0xae, 0x84, 0xf8, 0x85,
+	0x13, 0x73, 0xea, 0x12, 0xc7, 0x23, 0x43, 0xaa, 0x33, 0x20, 0x0b, 0xe1, 0x97, 0x43, 0xfc, 0xe2,
+	0x40, 0xe2, 0xee, 0x8e, 0x60, 0xd1, 0x4d, 0x58, 0x56, 0x6c, 0xf2, 0x20, 0x1e, 0x4e, 0xb0, 0x83,
+	0x7d, 0xd2, 0x28, 0xad, 0xe7, 0x36, 0x8a, 0x76, 0x5d, 0x7a, 0x33, 0x16, 0xec, 0xe2, 0x04, 0x3f,
+	0xf0, 0x09, 0xea, 0xc2, 0x92, 0xd0, 0x69, 0xe2, 0x9c, 0xea, 0x3c, 0x69, 0x94, 0x55, 0x40, 0xee,
+	0x5e, 0x2a, 0x20, 0xd3, 0x29, 0x66, 0xe2, 0x50, 0x17, 0x53, 0xd6, 0x7b, 0x15, 0x79, 0x1d, 0x7e,
+	0x27, 0xaf, 0xc4, 0x4b, 0x58, 0xfe, 0x21, 0x39, 0x23, 0xde, 0x01, 0x67, 0x09, 0x73, 0xf5, 0x2e,
+	0x90, 0x05, 0x85, 0x3e, 0x39, 0xd3, 0x39, 0x69, 0xcb, 0x9f, 0xe8, 0x18, 0x16, 0x63, 0xe3, 0xa1,
+	0xf6, 0xaf, 0xc2, 0x55, 0xbd, 0xb3, 0x75, 0xa9, 0x2d, 0xa5, 0xb1, 0xed, 0x5a, 0x9c, 0x1a, 0xb5,
+	0x8e, 0xa0, 0x36, 0xc5, 0x8c, 0xa0, 0x28, 0x55, 0x35, 0xd4, 0xea, 0x37, 0xba, 0x0d, 0xc5, 0x31,
+	0x65, 0x6d, 0xfb, 0xda, 0x7f, 0xde, 0xad, 0x35, 0x48, 0xe4, 0x32, 0x59, 0xd0, 0x3a, 0xbf, 0x10,
+	0x2c, 0x6a, 0xdb, 0xf8, 0x74, 0x9f, 0x08, 0x81, 0x7d, 0x62, 0x2b, 0xcf, 0xd6, 0x5f, 0x72, 0x50,
+	0x51, 0x01, 0xf8, 0x01, 0x8b, 0xd1, 0x75, 0x80, 0x13, 0xce, 0x42, 0xc7, 0x23, 0x11, 0x0b, 0x0d,
+	0xf0, 0x82, 0xb4, 0xec, 0x4a, 0x03, 0x5a, 0x81, 0x4a, 0xc2, 0xcc, 0xa4, 0xaa, 0x16, 0xf6, 0x7c,
+	0xc2, 0xf4, 0x94, 0x0d, 0x25, 0x75, 0x3d, 0x32, 0xc9, 0x75, 0x0d, 0x25, 0xe9, 0xd4, 0x25, 0xa0,
+	0x9e, 0x68, 0x14, 0xd7, 0x0b, 0x1b, 0x45, 0x7b, 0x5e, 0x8e, 0xf7, 0x3c, 0xd1, 0xfa, 0x5b, 0x0e,
+	0xe0, 0x88, 0xf5, 0x49, 0x74, 0x8c, 0x83, 0x01, 0x41, 0x57, 0xa1, 0x94, 0xde, 0x72, 0xc9, 0x1b,
+	0xed, 0x69, 0x28, 0xa7, 0x33, 0xa9, 0x6c, 0x1a, 0x0a, 0x3d, 0x82, 0x62, 0x8c, 0x93, 0x5e, 0xa3,
+	0xb0, 0x5e, 0xd8, 0xa8, 0xde, 0xd9, 0xbc, 0x64, 0x4c, 0xb5, 0xbc, 0xe6, 0x82, 0x29, 0x80, 0xd6,
+	0x6f, 0x73, 0x60, 0x3d, 0x8c, 0x99, 0xdb, 0x9b, 0x1c, 0x43, 0xc8, 0x73, 0x10, 0x69, 0x53, 0xe7,
+	0x28, 0xd8, 0x7a, 0x20, 0xa3, 0xd2, 0xc5, 0x82, 0x4c, 0x09, 0xbf, 0x20, 0x2d, 0x5a, 0xfa, 0x7d,
+	0x28, 0xab, 0xbd, 0x09, 0xb3, 0xa9, 0xce, 0xa5, 0x36, 0x35, 0xa1, 0x35, 0xdb, 0x32, 0x20, 0xad,
+	0x3f, 0x54, 0xa0, 0x7e, 0x3c, 0x7e, 0x7c, 0x5d, 0xc6, 0x09, 0xfa, 0x0e, 0x2c, 0x0d, 0x71, 0xc0,
+	0x62, 0xc2, 0x1d, 0xec, 0x79, 0x9c, 0x08, 0xf3, 0x06, 0xd9, 0x75, 0x63, 0x7e, 0xa0, 0xad, 0xe8,
+	0x47, 0x50, 0x1b, 0xb2, 0x44, 0xa6, 0x63, 0xcc, 0x4e, 0x09, 0x6f, 0xe4, 0x3f, 0xbf, 0xfa, 0x54,
+	0x35, 0xc0, 0x81, 0x5c, 0x8f, 0x7c, 0xb0, 0x14, 0x90, 0x13, 0x13, 0xee, 0x92, 0x28, 0xc1, 0x7e,
+	0x36, 0x17, 0x6c, 0x49, 0xa1, 0x1e, 0x8c, 0x41, 0x51, 0x1f, 0xd0, 0x54, 0x89, 0x17, 0xf2, 0xdc,
+	0xd9, 0x14, 0xb8, 0x34, 0xae, 0x96, 0x93, 0xc2, 0x72, 0x4c, 0xf8, 0x09, 0xe3, 0xa1, 0x7c, 0x6f,
+	0x0c, 0x57, 0x29, 0x8b, 0x76, 0x22, 0x05, 0xab, 0xa9, 0x6c, 0x28, 0x69, 0xf8, 0x72, 0x16, 0x29,
+	0xa0, 0xa0, 0x10, 0x81, 0xa5, 0xc9, 0x5b, 0xec, 0x70, 0x9c, 0x90, 0xc6, 0x7c, 0x06, 0xe8, 0xf5,
+	0x09, 0xa8, 0x8d, 0x13, 0x82, 0x30, 0x2c, 0x9a, 0xeb, 0x6a, 0x14, 0xaa, 0x64, 0x40, 0x52, 0x33,
+	0x90, 0x5a, 0x1d, 0x07, 0x6a, 0xba, 0x5f, 0x31, 0x0c, 0x0b, 0x19, 0x30, 0x54, 0x35, 0xa2, 0x26,
+	0xf0, 0xc1, 0x4a, 0x49, 0xa5, 0x49, 0x20, 0x8b, 0xfb, 0x3b, 0x41, 0x1d, 0x13, 0x4d, 0x3a, 0x18,
+	0x43, 0x54, 0xcd, 0x82, 0x68, 0x82, 0xaa, 0x88, 0x5a, 0xbf, 0xcf, 0x43, 0x5d, 0x95, 0xad, 0x67,
+	0x2c, 0x32, 0x87, 0x5c, 0x81, 0x8a, 0xdb, 0xc3, 0x34, 0x72, 0xa8, 0x67, 0xca, 0xc2, 0xbc, 0x1a,
+	0xef, 0x79, 0x93, 0x7a, 0x96, 0x4f, 0xd7, 0xb3, 0x6f, 0x40, 0xb9, 0xa7, 0x1e, 0x57, 0x95, 0xcb,
+	0x05, 0xdb, 0x8c, 0x64, 0x07, 0x93, 0xb0, 0x04, 0x07, 0xce, 0x54, 0x0d, 0x29, 0xfe, 0x1f, 0x1d,
+	0x8c, 0x82, 0x39, 0x4e, 0x15, 0x12, 0x0f, 0xac, 0xd4, 0x47, 0x8b, 0xdc, 0xb6, 0x68, 0x94, 0xd6,
+	0x0b, 0x97, 0xee, 0x14, 0xa6, 0x0b, 0xa2, 0xa9, 0x98, 0x4b, 0xc3, 0x29, 0xab, 0x68, 0x11, 0xf8,
+	0x62, 0xec, 0xb8, 0x4f, 0x85, 0x20, 0xde, 0xb6, 0x6c, 0xf0, 0xc5, 0xe5, 0x0b, 0xe8, 0x57, 0xb0,
+	0x18, 0xaa, 0x85, 0x4e, 0x57, 0xad, 0x34, 0xc2, 0xd5, 0xc2, 0x14, 0x5a, 0xeb, 0xef, 0x39, 0x58,
+	0x52, 0xf2, 0x53, 0x3f, 0xa2, 0x91, 0xbf, 0x17, 0x9d, 0xb0, 0x4f, 0x05, 0xe1, 0x36, 0x5c, 0x15,
+	0xd4, 0x8f, 0xc6, 0x98, 0xce, 0x29, 0x8d, 0x3c, 0x76, 0x6a, 0xa0, 0x91, 0x9e, 0xd3, 0xd0, 0x4f,
+	0xd5, 0x0c, 0xfa, 0x39, 0xc0, 0xf8, 0x68, 0xa3, 0x57, 0xe5, 0xde, 0xe7, 0xe9, 0x94, 0x3e, 0xbe,
+	0x91, 0x2b, 0x85, 0xd9, 0xfa, 0x15, 0x58, 0x8f, 0xd8, 0x50, 0x75, 0xb9, 0x42, 0x45, 0x8a, 0x88,
+	0x4f, 0x1d, 0x61, 0x0d, 0xaa, 0xb1, 0xf1, 0x95, 0xb3, 0x79, 0xd5, 0x0c, 0xc2, 0xc8, 0x94, 0xbe,
+	0x68, 0x85, 0x73, 0x17, 0x6d, 0xc8, 0x12, 0xc2, 0x75, 0xfb, 0xb0, 0x60, 0x9b, 0xd1, 0xad, 0x3f,
+	0x95, 0xc1, 0x4a, 0xb7, 0x52, 0x47, 0xb2, 0x75, 0xba, 0x0e, 0x2b, 0xe7, 0x6d, 0x4f, 0x22, 0x8f,
+	0x9c, 0xd0, 0x88, 0x78, 0xd6, 0x1c, 0x6a, 0xc2, 0xea, 0xf9, 0xe9, 0x1d, 0x16, 0x45, 0xfa, 0xd3,
+	0xd4, 0xca, 0xa1, 0x6f, 0xc1, 0xf5, 0xf3, 0xf3, 0x3f, 0x96, 0x17, 0x92, 0x0a, 0xfd, 0x15, 0x61,
+	0xe5, 0xd1, 0x1a, 0x7c, 0xf3, 0xbc, 0x8b, 0xee, 0xb1, 0xd5, 0x5b, 0x6c, 0x15, 0x66, 0x39, 0x8c,
+	0x30, 0x18, 0x0b, 0xac, 0x22, 0x6a, 0x41, 0xf3, 0xbc, 0xc3, 0x3e, 0x09, 0xbb, 0x1c, 0x47, 0xc4,
+	0xb0, 0x94, 0xd0, 0xd7, 0x70, 0xed, 0xbc, 0xcf, 0x21, 0x3d, 0x51, 0x4a, 0x2a, 0x94, 0xf2, 0x6a,
+	0xbe, 0x92, 0x43, 0xad, 0x8f, 0x8f, 0xf3, 0x24, 0x24, 0x23, 0x94, 0x79, 0xe5, 0x33, 0x03, 0x49,
+	0xfa, 0xd8, 0x44, 0x10, 0x3e, 0x24, 0xc2, 0xaa, 0x28, 0xaf, 0x5b, 0xd0, 0x9a, 0xe5, 0xb5, 0x17,
+	0x25, 0x84, 0x13, 0x91, 0x1c, 0xba, 0x38, 0xc0, 0xdc, 0x5a, 0x50, 0xbe, 0x1b, 0xb0, 0x3e, 0xcb,
+	0xf7, 0x48, 0xa6, 0xeb, 0x36, 0xe3, 0x9c, 0x9d, 0x0a, 0x0b, 0x3e, 0xe5, 0xf9, 0x44, 0x49, 0x75,
+	0x38, 0x88, 0xe3, 0xe0, 0xcc, 0xaa, 0x2a, 0xcf, 0x2d, 0xb8, 0x39, 0xcb, 0xf3, 0x31, 0x19, 0x12,
+	0x8e, 0x7d, 0xb2, 0xcf, 0xbc, 0x41, 0x40, 0xb6, 0x71, 0x20, 0x6b, 0x98, 0x55, 0x53, 0x4b, 0x6e,
+	0x7c, 0x2c, 0xe3, 0x0e, 0x27, 0x42, 0x36, 0x03, 0x46, 0x80, 0x45, 0xe5, 0xf7, 0x3d, 0xd8, 0xbc,
+	0xc8, 0xcf, 0x88, 0x60, 0xf2, 0x76, 0x04, 0x5f, 0x57, 0xcb, 0xda, 0x70, 0xe3, 0x42, 0x78, 0xc6,
+	0x82, 0x1d, 0x46, 0x47, 0x27, 0x58, 0x52, 0xfe, 0x17, 0x5f, 0x9d, 0x9d, 0xc7, 0x2a, 0x64, 0x16,
+	0xfa, 0xfa, 0x63, 0x39, 0x76, 0x98, 0x08, 0x9f, 0x62, 0x11, 0xee, 0xb0, 0x28, 0xe1, 0xd8, 0x4d,
+	0xac, 0xe5, 0x59, 0xf7, 0x67, 0x9f, 0x45, 0xe4, 0x6c, 0x1f, 0xf3, 0x3e, 0x49, 0x2c, 0x34, 0x0b,
+	0x26, 0xe5, 0xa0, 0xda, 0x49, 0xeb, 0xca, 0x6a, 0xf1, 0xd5, 0x9f, 0x9b, 0x73, 0xdb, 0x3f, 0x7b,
+	0xf3, 0xbe, 0x99, 0x7b, 0xfb, 0xbe, 0x99, 0xfb, 0xf7, 0xfb, 0x66, 0xee, 0xf5, 0x87, 0xe6, 0xdc,
+	0xdb, 0x0f, 0xcd, 0xb9, 0x7f, 0x7e, 0x68, 0xce, 0x3d, 0xdb, 0x4d, 0x3d, 0x25, 0xa9, 0xa2, 0xb0,
+	0xf9, 0x4b, 0x16, 0x91, 0xb4, 0xa1, 0xf3, 0x62, 0xf6, 0xbf, 0xb4, 0xd4, 0x63, 0xd3, 0x2d, 0xab,
+	0x8f, 0x9b, 0xbb, 0xff, 0x1b, 0x00, 0x93, 0xad, 0xc4, 0x6c, 0x03, 0x13, 0x00, 0x00,
+}
+
+func (m *DistributionProportions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
+	{
+		size := m.LockupAllocation.Size()
+		i -= size
+		if _, err := m.LockupAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
 	}
-	if m.ProtocolData != nil {
-		l = m.ProtocolData.Size()
-		n += 1 + l + sovParticipationrewards(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HoldingsAllocation.Size()
+		i -= size
+		if _, err := m.HoldingsAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ValidatorSelectionAllocation.Size()
+		i -= size
+		if _, err := m.ValidatorSelectionAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScoringWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScoringWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScoringWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Governance.Size()
+		i -= size
+		if _, err := m.Governance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Rewards.Size()
+		i -= size
+		if _, err := m.Rewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScoringWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxPoolDataAge != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.MaxPoolDataAge))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinPoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ClaimsEnabled {
+		i--
+		if m.ClaimsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DistributionProportions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyedProtocolData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyedProtocolData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyedProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolData != nil {
+		{
+			size, err := m.ProtocolData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtocolData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA5 := make([]byte, len(m.PoolIds)*10)
+		var j4 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ToDenom) > 0 {
+		i -= len(m.ToDenom)
+		copy(dAtA[i:], m.ToDenom)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ToDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromDenom) > 0 {
+		i -= len(m.FromDenom)
+		copy(dAtA[i:], m.FromDenom)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.FromDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochTokenValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochTokenValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochTokenValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GovernanceScore.Size()
+		i -= size
+		if _, err := m.GovernanceScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.CommissionScore.Size()
+		i -= size
+		if _, err := m.CommissionScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.UptimeScore.Size()
+		i -= size
+		if _, err := m.UptimeScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RewardsScore.Size()
+		i -= size
+		if _, err := m.RewardsScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PerformanceScore.Size()
+		i -= size
+		if _, err := m.PerformanceScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DistributionScore.Size()
+		i -= size
+		if _, err := m.DistributionScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PowerPercentage.Size()
+		i -= size
+		if _, err := m.PowerPercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochZoneScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochZoneScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochZoneScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorScores) > 0 {
+		for iNdEx := len(m.ValidatorScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.TotalVotingPower.Size()
+		i -= size
+		if _, err := m.TotalVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocks != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValoperAddress) > 0 {
+		i -= len(m.ValoperAddress)
+		copy(dAtA[i:], m.ValoperAddress)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ValoperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParticipationrewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovProposalVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovProposalVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovProposalVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Voters[iNdEx])
+			copy(dAtA[i:], m.Voters[iNdEx])
+			i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.Voters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintParticipationrewards(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipationrewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipationrewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DistributionProportions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorSelectionAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.HoldingsAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.LockupAllocation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *ScoringWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Uptime.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Governance.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DistributionProportions.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if m.ClaimsEnabled {
+		n += 2
+	}
+	l = m.MinPoolLiquidity.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if m.MaxPoolDataAge != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.MaxPoolDataAge))
+	}
+	l = m.ScoringWeights.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *KeyedProtocolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.ProtocolData != nil {
+		l = m.ProtocolData.Size()
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	return n
+}
+
+func (m *ProtocolData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromDenom)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = len(m.ToDenom)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovParticipationrewards(uint64(e))
+		}
+		n += 1 + sovParticipationrewards(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *TokenValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochTokenValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Epoch))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.PowerPercentage.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.DistributionScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.PerformanceScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.RewardsScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.UptimeScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.CommissionScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	l = m.GovernanceScore.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	return n
+}
+
+func (m *EpochZoneScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Height))
+	}
+	l = m.TotalVotingPower.Size()
+	n += 1 + l + sovParticipationrewards(uint64(l))
+	if len(m.ValidatorScores) > 0 {
+		for _, e := range m.ValidatorScores {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValoperAddress)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.MissedBlocks))
+	}
+	return n
+}
+
+func (m *ZoneSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.SignedBlocksWindow))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GovProposalVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovParticipationrewards(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.ProposalId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovParticipationrewards(uint64(m.Epoch))
+	}
+	if len(m.Voters) > 0 {
+		for _, s := range m.Voters {
+			l = len(s)
+			n += 1 + l + sovParticipationrewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParticipationrewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipationrewards(x uint64) (n int) {
+	return sovParticipationrewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSelectionAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HoldingsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockupAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScoringWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScoringWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScoringWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Governance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClaimsEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolDataAge", wireType)
+			}
+			m.MaxPoolDataAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolDataAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoringWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyedProtocolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyedProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProtocolData == nil {
+				m.ProtocolData = &ProtocolData{}
+			}
+			if err := m.ProtocolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipationrewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParticipationrewards
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParticipationrewards
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParticipationrewards
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParticipationrewards
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, PriceHop{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochTokenValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochTokenValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochTokenValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, TokenValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipationrewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerPercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DistributionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerformanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardsScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UptimeScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovernanceScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipationrewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ProtocolData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovParticipationrewards(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovParticipationrewards(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParticipationrewards(x uint64) (n int) {
-	return sovParticipationrewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
+func (m *EpochZoneScore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -626,15 +3792,15 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributionProportions: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochZoneScore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributionProportions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochZoneScore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -662,13 +3828,49 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSelectionAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -696,15 +3898,15 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HoldingsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScores", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -714,23 +3916,23 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockupAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorScores = append(m.ValidatorScores, ValidatorScore{})
+			if err := m.ValidatorScores[len(m.ValidatorScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -755,7 +3957,7 @@ func (m *DistributionProportions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorMissedBlocks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -778,17 +3980,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorMissedBlocks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValoperAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -798,30 +4000,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DistributionProportions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValoperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			var v int
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -831,12 +4032,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissedBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClaimsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParticipationrewards(dAtA[iNdEx:])
@@ -858,7 +4058,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
+func (m *ZoneSigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -881,15 +4081,15 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyedProtocolData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZoneSigningInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyedProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZoneSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -917,11 +4117,30 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -948,10 +4167,8 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProtocolData == nil {
-				m.ProtocolData = &ProtocolData{}
-			}
-			if err := m.ProtocolData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorMissedBlocks{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -976,7 +4193,7 @@ func (m *KeyedProtocolData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtocolData) Unmarshal(dAtA []byte) error {
+func (m *GovProposalVotes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -999,15 +4216,15 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtocolData: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovProposalVotes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtocolData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovProposalVotes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1035,13 +4252,51 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipationrewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParticipationrewards
@@ -1051,25 +4306,23 @@ func (m *ProtocolData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParticipationrewards
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Voters = append(m.Voters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
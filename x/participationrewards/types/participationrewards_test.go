@@ -5,8 +5,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
 	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
 )
 
@@ -341,3 +344,64 @@ func TestKeyedProtocolData_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestZoneScore_EpochZoneScore(t *testing.T) {
+	zs := types.ZoneScore{
+		ZoneID:           "cosmoshub-4",
+		TotalVotingPower: math.NewInt(300),
+		ValidatorScores: map[string]*types.Validator{
+			"cosmosvaloper1b": {
+				PowerPercentage:   sdk.MustNewDecFromStr("0.666666666666666667"),
+				DistributionScore: sdk.MustNewDecFromStr("0.5"),
+				PerformanceScore:  sdk.OneDec(),
+				RewardsScore:      sdk.OneDec(),
+				UptimeScore:       sdk.MustNewDecFromStr("0.9"),
+				CommissionScore:   sdk.OneDec(),
+				GovernanceScore:   sdk.ZeroDec(),
+				Validator:         &icstypes.Validator{ValoperAddress: "cosmosvaloper1b", VotingPower: math.NewInt(200), CommissionRate: sdk.MustNewDecFromStr("0.05"), Score: sdk.MustNewDecFromStr("0.5")},
+			},
+			// performance and overall scores are not calculated for
+			// validators absent from the performance rewards.
+			"cosmosvaloper1a": {
+				PowerPercentage:   sdk.MustNewDecFromStr("0.333333333333333333"),
+				DistributionScore: sdk.OneDec(),
+				Validator:         &icstypes.Validator{ValoperAddress: "cosmosvaloper1a", VotingPower: math.NewInt(100)},
+			},
+		},
+	}
+
+	require.Equal(t, types.EpochZoneScore{
+		ChainId:          "cosmoshub-4",
+		Epoch:            10,
+		Height:           1000,
+		TotalVotingPower: math.NewInt(300),
+		ValidatorScores: []types.ValidatorScore{
+			{
+				ValoperAddress:    "cosmosvaloper1a",
+				VotingPower:       math.NewInt(100),
+				PowerPercentage:   sdk.MustNewDecFromStr("0.333333333333333333"),
+				DistributionScore: sdk.OneDec(),
+				PerformanceScore:  sdk.ZeroDec(),
+				Score:             sdk.ZeroDec(),
+				CommissionRate:    sdk.ZeroDec(),
+				RewardsScore:      sdk.ZeroDec(),
+				UptimeScore:       sdk.ZeroDec(),
+				CommissionScore:   sdk.ZeroDec(),
+				GovernanceScore:   sdk.ZeroDec(),
+			},
+			{
+				ValoperAddress:    "cosmosvaloper1b",
+				VotingPower:       math.NewInt(200),
+				PowerPercentage:   sdk.MustNewDecFromStr("0.666666666666666667"),
+				DistributionScore: sdk.MustNewDecFromStr("0.5"),
+				PerformanceScore:  sdk.OneDec(),
+				Score:             sdk.MustNewDecFromStr("0.5"),
+				CommissionRate:    sdk.MustNewDecFromStr("0.05"),
+				RewardsScore:      sdk.OneDec(),
+				UptimeScore:       sdk.MustNewDecFromStr("0.9"),
+				CommissionScore:   sdk.OneDec(),
+				GovernanceScore:   sdk.ZeroDec(),
+			},
+		},
+	}, zs.EpochZoneScore(10, 1000))
+}
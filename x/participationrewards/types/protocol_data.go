@@ -53,6 +53,12 @@ func UnmarshalProtocolData(datatype ProtocolDataType, data json.RawMessage) (Pro
 		return unmarshalProtocolData[*UmeeInterestScalarProtocolData](data)
 	case ProtocolDataTypeUmeeLeverageModuleBalance:
 		return unmarshalProtocolData[*UmeeLeverageModuleBalanceProtocolData](data)
+	case ProtocolDataTypeCosmWasmContract:
+		return unmarshalProtocolData[*CosmWasmContractProtocolData](data)
+	case ProtocolDataTypeMoneyMarket:
+		return unmarshalProtocolData[*MoneyMarketProtocolData](data)
+	case ProtocolDataTypeMoneyMarketDenom:
+		return unmarshalProtocolData[*MoneyMarketDenomProtocolData](data)
 	default:
 		return nil, ErrUnknownProtocolDataType
 	}
@@ -109,4 +115,7 @@ var (
 	_ ProtocolDataI = &LiquidAllowedDenomProtocolData{}
 	_ ProtocolDataI = &UmeeProtocolData{}
 	_ ProtocolDataI = &UmeeParamsProtocolData{}
+	_ ProtocolDataI = &CosmWasmContractProtocolData{}
+	_ ProtocolDataI = &MoneyMarketProtocolData{}
+	_ ProtocolDataI = &MoneyMarketDenomProtocolData{}
 )
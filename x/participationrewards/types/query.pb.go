@@ -5,11 +5,15 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_json "encoding/json"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -30,6 +34,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ClaimValidationError identifies the reason a claim proof is rejected.
+type ClaimValidationError int32
+
+const (
+	// The proof is valid.
+	ClaimValidationErrorNone ClaimValidationError = 0
+	// The proof height is not the epoch height of the source zone.
+	ClaimValidationErrorHeightMismatch ClaimValidationError = 1
+	// The proof ops do not prove the key and data.
+	ClaimValidationErrorInvalidProof ClaimValidationError = 2
+	// The claim type submodule rejected the proof.
+	ClaimValidationErrorSubmoduleRejected ClaimValidationError = 3
+	// The proof key duplicates that of an earlier proof; it is not credited.
+	ClaimValidationErrorDuplicateKey ClaimValidationError = 4
+)
+
+var ClaimValidationError_name = map[int32]string{
+	0: "ClaimValidationErrorNone",
+	1: "ClaimValidationErrorHeightMismatch",
+	2: "ClaimValidationErrorInvalidProof",
+	3: "ClaimValidationErrorSubmoduleRejected",
+	4: "ClaimValidationErrorDuplicateKey",
+}
+
+var ClaimValidationError_value = map[string]int32{
+	"ClaimValidationErrorNone":              0,
+	"ClaimValidationErrorHeightMismatch":    1,
+	"ClaimValidationErrorInvalidProof":      2,
+	"ClaimValidationErrorSubmoduleRejected": 3,
+	"ClaimValidationErrorDuplicateKey":      4,
+}
+
+func (x ClaimValidationError) String() string {
+	return proto.EnumName(ClaimValidationError_name, int32(x))
+}
+
+func (ClaimValidationError) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{0}
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -212,393 +256,3729 @@ func (m *QueryProtocolDataResponse) GetData() []encoding_json.RawMessage {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "quicksilver.participationrewards.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "quicksilver.participationrewards.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryProtocolDataRequest)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataRequest")
-	proto.RegisterType((*QueryProtocolDataResponse)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataResponse")
+// QueryTokenValuesRequest is the request type for the Query/TokenValues RPC
+// method.
+type QueryTokenValuesRequest struct {
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("quicksilver/participationrewards/v1/query.proto", fileDescriptor_bc16b3ccc632b3de)
+func (m *QueryTokenValuesRequest) Reset()         { *m = QueryTokenValuesRequest{} }
+func (m *QueryTokenValuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenValuesRequest) ProtoMessage()    {}
+func (*QueryTokenValuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{4}
 }
-
-var fileDescriptor_bc16b3ccc632b3de = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xc1, 0x8a, 0xd3, 0x40,
-	0x1c, 0xc6, 0x33, 0xdb, 0x5a, 0x70, 0x76, 0x0f, 0x32, 0xee, 0x21, 0x06, 0x49, 0x97, 0x78, 0x59,
-	0x28, 0x9b, 0x61, 0x77, 0x0f, 0x8a, 0x60, 0x5d, 0xca, 0x22, 0x78, 0x10, 0x34, 0x47, 0x11, 0x71,
-	0x36, 0x1d, 0xe2, 0xd8, 0x74, 0x26, 0xcd, 0x4c, 0xba, 0xc6, 0xd2, 0x8b, 0x4f, 0x20, 0xfa, 0x22,
-	0x3e, 0x46, 0x8f, 0x05, 0x11, 0x3c, 0x15, 0x69, 0x7d, 0x02, 0x8f, 0x9e, 0x64, 0x26, 0x39, 0xb4,
-	0x34, 0x87, 0x74, 0x6f, 0x7f, 0xfe, 0xc9, 0xf7, 0xfd, 0xbe, 0x6f, 0xfe, 0x10, 0x8f, 0x32, 0x16,
-	0x0e, 0x24, 0x8b, 0xc7, 0x34, 0xc5, 0x09, 0x49, 0x15, 0x0b, 0x59, 0x42, 0x14, 0x13, 0x3c, 0xa5,
-	0xd7, 0x24, 0xed, 0x4b, 0x3c, 0x3e, 0xc5, 0xa3, 0x8c, 0xa6, 0xb9, 0x9f, 0xa4, 0x42, 0x09, 0xf4,
-	0x60, 0x4d, 0xe0, 0x57, 0x09, 0xfc, 0xf1, 0xa9, 0x73, 0x18, 0x89, 0x48, 0x98, 0xff, 0xb1, 0x9e,
-	0x0a, 0xa9, 0x73, 0x3f, 0x12, 0x22, 0x8a, 0x29, 0x26, 0x09, 0xc3, 0x84, 0x73, 0xa1, 0x8c, 0x4c,
-	0x96, 0x5f, 0xbb, 0x75, 0x92, 0x54, 0x02, 0x8d, 0xde, 0x3b, 0x84, 0xe8, 0x95, 0xce, 0xf9, 0x92,
-	0xa4, 0x64, 0x28, 0x03, 0x3a, 0xca, 0xa8, 0x54, 0xde, 0x3b, 0x78, 0x77, 0x63, 0x2b, 0x13, 0xc1,
-	0x25, 0x45, 0xcf, 0x61, 0x2b, 0x31, 0x1b, 0x1b, 0x1c, 0x81, 0xe3, 0xfd, 0xb3, 0x8e, 0x5f, 0xa3,
-	0x96, 0x5f, 0x98, 0xf4, 0x9a, 0xb3, 0x45, 0xdb, 0x0a, 0x4a, 0x03, 0xef, 0x02, 0xda, 0x05, 0x41,
-	0xa7, 0x08, 0x45, 0x7c, 0x49, 0x14, 0x29, 0xe9, 0x08, 0xc1, 0xa6, 0xca, 0x13, 0x6a, 0x20, 0xb7,
-	0x03, 0x33, 0xa3, 0x3b, 0xb0, 0x31, 0xa0, 0xb9, 0xbd, 0x67, 0x56, 0x7a, 0xf4, 0xde, 0xc0, 0x7b,
-	0x15, 0x0e, 0x65, 0xd2, 0xa7, 0xb0, 0xd9, 0x27, 0x8a, 0xd8, 0xe0, 0xa8, 0x71, 0x7c, 0xd0, 0xeb,
-	0xfc, 0x5d, 0xb4, 0xf7, 0x73, 0x32, 0x8c, 0x1f, 0x7b, 0x7a, 0xeb, 0xfd, 0x5b, 0xb4, 0x6d, 0xca,
-	0x43, 0xd1, 0x67, 0x3c, 0xc2, 0x1f, 0xa4, 0xe0, 0x7e, 0x40, 0xae, 0x5f, 0x50, 0x29, 0x49, 0x44,
-	0x03, 0x23, 0x3c, 0xfb, 0xda, 0x80, 0xb7, 0x8c, 0x3d, 0xfa, 0x0e, 0x60, 0xab, 0xa8, 0x80, 0x1e,
-	0xd6, 0xea, 0xbb, 0xfd, 0x9e, 0xce, 0xa3, 0xdd, 0x85, 0x45, 0x11, 0xef, 0xfc, 0xf3, 0x8f, 0x3f,
-	0xdf, 0xf6, 0x4e, 0x50, 0x07, 0xd7, 0x3c, 0xb4, 0xce, 0xf9, 0x13, 0xc0, 0x83, 0xf5, 0x67, 0x41,
-	0x4f, 0x76, 0xe0, 0x6f, 0x1f, 0xc4, 0xe9, 0xde, 0x54, 0x5e, 0x96, 0x78, 0x66, 0x4a, 0x5c, 0xa0,
-	0x6e, 0xbd, 0x12, 0xa5, 0x85, 0xbe, 0x03, 0x9e, 0xe8, 0xeb, 0x4f, 0xf1, 0x64, 0x40, 0xf3, 0x69,
-	0xef, 0xed, 0x6c, 0xe9, 0x82, 0xf9, 0xd2, 0x05, 0xbf, 0x97, 0x2e, 0xf8, 0xb2, 0x72, 0xad, 0xf9,
-	0xca, 0xb5, 0x7e, 0xad, 0x5c, 0xeb, 0xf5, 0x65, 0xc4, 0xd4, 0xfb, 0xec, 0xca, 0x0f, 0xc5, 0x70,
-	0x9d, 0x71, 0xf2, 0x49, 0x70, 0xba, 0x01, 0xfd, 0x58, 0x8d, 0xd5, 0x14, 0x79, 0xd5, 0x32, 0xe8,
-	0xf3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x81, 0x87, 0x6f, 0xdf, 0x03, 0x00, 0x00,
+func (m *QueryTokenValuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenValuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenValuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenValuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenValuesRequest.Merge(m, src)
+}
+func (m *QueryTokenValuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenValuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenValuesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTokenValuesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of participation rewards parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ProtocolData returns the requested protocol data.
-	ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error)
+func (m *QueryTokenValuesRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTokenValuesResponse is the response type for the Query/TokenValues RPC
+// method.
+type QueryTokenValuesResponse struct {
+	TokenValues EpochTokenValues `protobuf:"bytes,1,opt,name=token_values,json=tokenValues,proto3" json:"token_values"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTokenValuesResponse) Reset()         { *m = QueryTokenValuesResponse{} }
+func (m *QueryTokenValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenValuesResponse) ProtoMessage()    {}
+func (*QueryTokenValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{5}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTokenValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTokenValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenValuesResponse.Merge(m, src)
+}
+func (m *QueryTokenValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenValuesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error) {
-	out := new(QueryProtocolDataResponse)
-	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ProtocolData", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTokenValuesResponse proto.InternalMessageInfo
+
+func (m *QueryTokenValuesResponse) GetTokenValues() EpochTokenValues {
+	if m != nil {
+		return m.TokenValues
 	}
-	return out, nil
+	return EpochTokenValues{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of participation rewards parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ProtocolData returns the requested protocol data.
-	ProtocolData(context.Context, *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error)
+// QueryPricePathRequest is the request type for the Query/PricePath RPC method.
+type QueryPricePathRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Epoch int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPricePathRequest) Reset()         { *m = QueryPricePathRequest{} }
+func (m *QueryPricePathRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPricePathRequest) ProtoMessage()    {}
+func (*QueryPricePathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPricePathRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ProtocolData(ctx context.Context, req *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProtocolData not implemented")
+func (m *QueryPricePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricePathRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPricePathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricePathRequest.Merge(m, src)
+}
+func (m *QueryPricePathRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricePathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricePathRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.participationrewards.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPricePathRequest proto.InternalMessageInfo
+
+func (m *QueryPricePathRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProtocolData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProtocolDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProtocolData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/quicksilver.participationrewards.v1.Query/ProtocolData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProtocolData(ctx, req.(*QueryProtocolDataRequest))
+func (m *QueryPricePathRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "quicksilver.participationrewards.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ProtocolData",
-			Handler:    _Query_ProtocolData_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "quicksilver/participationrewards/v1/query.proto",
+// QueryPricePathResponse is the response type for the Query/PricePath RPC
+// method.
+type QueryPricePathResponse struct {
+	Epoch      int64      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BaseDenom  string     `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	TokenValue TokenValue `protobuf:"bytes,3,opt,name=token_value,json=tokenValue,proto3" json:"token_value"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPricePathResponse) Reset()         { *m = QueryPricePathResponse{} }
+func (m *QueryPricePathResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPricePathResponse) ProtoMessage()    {}
+func (*QueryPricePathResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{7}
+}
+func (m *QueryPricePathResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPricePathResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPricePathResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPricePathResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPricePathResponse.Merge(m, src)
+}
+func (m *QueryPricePathResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPricePathResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPricePathResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPricePathResponse proto.InternalMessageInfo
+
+func (m *QueryPricePathResponse) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPricePathResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPricePathResponse) GetTokenValue() TokenValue {
+	if m != nil {
+		return m.TokenValue
 	}
-	return dAtA[:n], nil
+	return TokenValue{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidateClaimRequest is the request type for the Query/ValidateClaim
+// RPC method.
+type QueryValidateClaimRequest struct {
+	Claim *MsgSubmitClaim `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValidateClaimRequest) Reset()         { *m = QueryValidateClaimRequest{} }
+func (m *QueryValidateClaimRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateClaimRequest) ProtoMessage()    {}
+func (*QueryValidateClaimRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{8}
+}
+func (m *QueryValidateClaimRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateClaimRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateClaimRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateClaimRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateClaimRequest.Merge(m, src)
+}
+func (m *QueryValidateClaimRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateClaimRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateClaimRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProtocolDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateClaimRequest proto.InternalMessageInfo
+
+func (m *QueryValidateClaimRequest) GetClaim() *MsgSubmitClaim {
+	if m != nil {
+		return m.Claim
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProtocolDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProofValidation is the result of validating a single claim proof.
+type ProofValidation struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// amount is the amount the proof would credit.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Reason ClaimValidationError  `protobuf:"varint,3,opt,name=reason,proto3,enum=quicksilver.participationrewards.v1.ClaimValidationError" json:"reason,omitempty"`
+	Error  string                `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryProtocolDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+func (m *ProofValidation) Reset()         { *m = ProofValidation{} }
+func (m *ProofValidation) String() string { return proto.CompactTextString(m) }
+func (*ProofValidation) ProtoMessage()    {}
+func (*ProofValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{9}
+}
+func (m *ProofValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ProofValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofValidation.Merge(m, src)
+}
+func (m *ProofValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofValidation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofValidation proto.InternalMessageInfo
+
+func (m *ProofValidation) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProtocolDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProofValidation) GetReason() ClaimValidationError {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ClaimValidationErrorNone
 }
 
-func (m *QueryProtocolDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProofValidation) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueryProtocolDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Data[iNdEx])
-			copy(dAtA[i:], m.Data[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Data[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+// QueryValidateClaimResponse is the response type for the Query/ValidateClaim
+// RPC method.
+type QueryValidateClaimResponse struct {
+	// valid is true if submitting the claim would succeed.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// amount is the amount the claim would record.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// error is the reason the claim as a whole would be rejected, if any.
+	Error  string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Proofs []ProofValidation `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs"`
+}
+
+func (m *QueryValidateClaimResponse) Reset()         { *m = QueryValidateClaimResponse{} }
+func (m *QueryValidateClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateClaimResponse) ProtoMessage()    {}
+func (*QueryValidateClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{10}
+}
+func (m *QueryValidateClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidateClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateClaimResponse.Merge(m, src)
+}
+func (m *QueryValidateClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateClaimResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryValidateClaimResponse proto.InternalMessageInfo
+
+func (m *QueryValidateClaimResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryValidateClaimResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateClaimResponse) GetProofs() []ProofValidation {
+	if m != nil {
+		return m.Proofs
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryProtocolDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEstimatedRewardsRequest is the request type for the
+// Query/EstimatedRewards RPC method.
+type QueryEstimatedRewardsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryEstimatedRewardsRequest) Reset()         { *m = QueryEstimatedRewardsRequest{} }
+func (m *QueryEstimatedRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedRewardsRequest) ProtoMessage()    {}
+func (*QueryEstimatedRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{11}
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedRewardsRequest.Merge(m, src)
+}
+func (m *QueryEstimatedRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryEstimatedRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// ClaimTypeEstimatedRewards is the estimated holdings rewards for the claims
+// of a single claim type.
+type ClaimTypeEstimatedRewards struct {
+	ClaimType types.ClaimType `protobuf:"varint,1,opt,name=claim_type,proto3,enum=quicksilver.claimsmanager.v1.ClaimType" json:"claim_type,omitempty"`
+	// amount is the qAsset amount claimed.
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Rewards types1.Coin           `protobuf:"bytes,3,opt,name=rewards,proto3" json:"rewards"`
+}
+
+func (m *ClaimTypeEstimatedRewards) Reset()         { *m = ClaimTypeEstimatedRewards{} }
+func (m *ClaimTypeEstimatedRewards) String() string { return proto.CompactTextString(m) }
+func (*ClaimTypeEstimatedRewards) ProtoMessage()    {}
+func (*ClaimTypeEstimatedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{12}
+}
+func (m *ClaimTypeEstimatedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimTypeEstimatedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimTypeEstimatedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ClaimTypeEstimatedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimTypeEstimatedRewards.Merge(m, src)
+}
+func (m *ClaimTypeEstimatedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimTypeEstimatedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimTypeEstimatedRewards.DiscardUnknown(m)
 }
 
-func (m *QueryProtocolDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ClaimTypeEstimatedRewards proto.InternalMessageInfo
+
+func (m *ClaimTypeEstimatedRewards) GetClaimType() types.ClaimType {
+	if m != nil {
+		return m.ClaimType
 	}
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		for _, b := range m.Data {
-			l = len(b)
-			n += 1 + l + sovQuery(uint64(l))
+	return types.ClaimTypeUndefined
+}
+
+func (m *ClaimTypeEstimatedRewards) GetRewards() types1.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return types1.Coin{}
+}
+
+// ZoneEstimatedRewards is the estimated rewards for a single zone.
+type ZoneEstimatedRewards struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// holdings_allocation is the projected holdings allocation of the zone.
+	HoldingsAllocation cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=holdings_allocation,json=holdingsAllocation,proto3,customtype=cosmossdk.io/math.Int" json:"holdings_allocation"`
+	// validator_selection_allocation is the projected validator selection
+	// allocation of the zone.
+	ValidatorSelectionAllocation cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=validator_selection_allocation,json=validatorSelectionAllocation,proto3,customtype=cosmossdk.io/math.Int" json:"validator_selection_allocation"`
+	Holdings                     []ClaimTypeEstimatedRewards `protobuf:"bytes,4,rep,name=holdings,proto3" json:"holdings"`
+	HoldingsRewards              types1.Coin                 `protobuf:"bytes,5,opt,name=holdings_rewards,json=holdingsRewards,proto3" json:"holdings_rewards"`
+	ValidatorSelectionRewards    types1.Coin                 `protobuf:"bytes,6,opt,name=validator_selection_rewards,json=validatorSelectionRewards,proto3" json:"validator_selection_rewards"`
+}
+
+func (m *ZoneEstimatedRewards) Reset()         { *m = ZoneEstimatedRewards{} }
+func (m *ZoneEstimatedRewards) String() string { return proto.CompactTextString(m) }
+func (*ZoneEstimatedRewards) ProtoMessage()    {}
+func (*ZoneEstimatedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{13}
+}
+func (m *ZoneEstimatedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZoneEstimatedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ZoneEstimatedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ZoneEstimatedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneEstimatedRewards.Merge(m, src)
+}
+func (m *ZoneEstimatedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZoneEstimatedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneEstimatedRewards.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_ZoneEstimatedRewards proto.InternalMessageInfo
+
+func (m *ZoneEstimatedRewards) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ZoneEstimatedRewards) GetHoldings() []ClaimTypeEstimatedRewards {
+	if m != nil {
+		return m.Holdings
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *ZoneEstimatedRewards) GetHoldingsRewards() types1.Coin {
+	if m != nil {
+		return m.HoldingsRewards
+	}
+	return types1.Coin{}
+}
+
+func (m *ZoneEstimatedRewards) GetValidatorSelectionRewards() types1.Coin {
+	if m != nil {
+		return m.ValidatorSelectionRewards
+	}
+	return types1.Coin{}
+}
+
+// QueryEstimatedRewardsResponse is the response type for the
+// Query/EstimatedRewards RPC method.
+type QueryEstimatedRewardsResponse struct {
+	// epoch is the epoch of the token values used to allocate rewards to zones.
+	Epoch int64                  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Zones []ZoneEstimatedRewards `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones"`
+	Total types1.Coin            `protobuf:"bytes,3,opt,name=total,proto3" json:"total"`
+}
+
+func (m *QueryEstimatedRewardsResponse) Reset()         { *m = QueryEstimatedRewardsResponse{} }
+func (m *QueryEstimatedRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimatedRewardsResponse) ProtoMessage()    {}
+func (*QueryEstimatedRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{14}
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimatedRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimatedRewardsResponse.Merge(m, src)
+}
+func (m *QueryEstimatedRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimatedRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimatedRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimatedRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryEstimatedRewardsResponse) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryEstimatedRewardsResponse) GetZones() []ZoneEstimatedRewards {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *QueryEstimatedRewardsResponse) GetTotal() types1.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types1.Coin{}
+}
+
+// QueryValidatorScoresRequest is the request type for the
+// Query/ValidatorScores RPC method.
+type QueryValidatorScoresRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryValidatorScoresRequest) Reset()         { *m = QueryValidatorScoresRequest{} }
+func (m *QueryValidatorScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresRequest) ProtoMessage()    {}
+func (*QueryValidatorScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{15}
+}
+func (m *QueryValidatorScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresRequest.Merge(m, src)
+}
+func (m *QueryValidatorScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryValidatorScoresRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryValidatorScoresResponse is the response type for the
+// Query/ValidatorScores RPC method.
+type QueryValidatorScoresResponse struct {
+	ZoneScore EpochZoneScore `protobuf:"bytes,1,opt,name=zone_score,json=zoneScore,proto3" json:"zone_score"`
+}
+
+func (m *QueryValidatorScoresResponse) Reset()         { *m = QueryValidatorScoresResponse{} }
+func (m *QueryValidatorScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresResponse) ProtoMessage()    {}
+func (*QueryValidatorScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc16b3ccc632b3de, []int{16}
+}
+func (m *QueryValidatorScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresResponse.Merge(m, src)
+}
+func (m *QueryValidatorScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresResponse) GetZoneScore() EpochZoneScore {
+	if m != nil {
+		return m.ZoneScore
+	}
+	return EpochZoneScore{}
+}
+
+func init() {
+	proto.RegisterEnum("quicksilver.participationrewards.v1.ClaimValidationError", ClaimValidationError_name, ClaimValidationError_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "quicksilver.participationrewards.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "quicksilver.participationrewards.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProtocolDataRequest)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataRequest")
+	proto.RegisterType((*QueryProtocolDataResponse)(nil), "quicksilver.participationrewards.v1.QueryProtocolDataResponse")
+	proto.RegisterType((*QueryTokenValuesRequest)(nil), "quicksilver.participationrewards.v1.QueryTokenValuesRequest")
+	proto.RegisterType((*QueryTokenValuesResponse)(nil), "quicksilver.participationrewards.v1.QueryTokenValuesResponse")
+	proto.RegisterType((*QueryPricePathRequest)(nil), "quicksilver.participationrewards.v1.QueryPricePathRequest")
+	proto.RegisterType((*QueryPricePathResponse)(nil), "quicksilver.participationrewards.v1.QueryPricePathResponse")
+	proto.RegisterType((*QueryValidateClaimRequest)(nil), "quicksilver.participationrewards.v1.QueryValidateClaimRequest")
+	proto.RegisterType((*ProofValidation)(nil), "quicksilver.participationrewards.v1.ProofValidation")
+	proto.RegisterType((*QueryValidateClaimResponse)(nil), "quicksilver.participationrewards.v1.QueryValidateClaimResponse")
+	proto.RegisterType((*QueryEstimatedRewardsRequest)(nil), "quicksilver.participationrewards.v1.QueryEstimatedRewardsRequest")
+	proto.RegisterType((*ClaimTypeEstimatedRewards)(nil), "quicksilver.participationrewards.v1.ClaimTypeEstimatedRewards")
+	proto.RegisterType((*ZoneEstimatedRewards)(nil), "quicksilver.participationrewards.v1.ZoneEstimatedRewards")
+	proto.RegisterType((*QueryEstimatedRewardsResponse)(nil), "quicksilver.participationrewards.v1.QueryEstimatedRewardsResponse")
+	proto.RegisterType((*QueryValidatorScoresRequest)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresRequest")
+	proto.RegisterType((*QueryValidatorScoresResponse)(nil), "quicksilver.participationrewards.v1.QueryValidatorScoresResponse")
+}
+
+func init() {
+	proto.RegisterFile("quicksilver/participationrewards/v1/query.proto", fileDescriptor_bc16b3ccc632b3de)
+}
+
+var fileDescriptor_bc16b3ccc632b3de = []byte{
+	// 1409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x13, 0x47,
+	0x17, 0xce, 0xc6, 0x8e, 0x21, 0xc7, 0x7c, 0x58, 0x43, 0x78, 0x5f, 0xc7, 0x04, 0x27, 0xda, 0x7e,
+	0x51, 0x22, 0xbc, 0x4d, 0x52, 0x04, 0x81, 0x12, 0xf2, 0x45, 0x4b, 0x5a, 0x81, 0x60, 0xa1, 0xa8,
+	0x42, 0x08, 0x33, 0xd9, 0x1d, 0xec, 0x25, 0xeb, 0x1d, 0x67, 0x67, 0x6c, 0x30, 0x51, 0x6e, 0x7a,
+	0xd5, 0xcb, 0x56, 0xfd, 0x09, 0x55, 0xef, 0x7b, 0xd1, 0x7f, 0x50, 0xb5, 0xe2, 0xa6, 0x12, 0x6a,
+	0x85, 0xd4, 0xf6, 0x22, 0xa2, 0x84, 0x5f, 0xd0, 0xcb, 0x5e, 0x55, 0x33, 0x3b, 0x6b, 0xaf, 0xe3,
+	0x0d, 0x5a, 0x07, 0xee, 0x76, 0xce, 0xec, 0x79, 0xce, 0x79, 0x9e, 0x33, 0x33, 0xe7, 0x80, 0xb1,
+	0xde, 0x70, 0xac, 0x35, 0xe6, 0xb8, 0x4d, 0xe2, 0x1b, 0x75, 0xec, 0x73, 0xc7, 0x72, 0xea, 0x98,
+	0x3b, 0xd4, 0xf3, 0xc9, 0x43, 0xec, 0xdb, 0xcc, 0x68, 0x4e, 0x19, 0xeb, 0x0d, 0xe2, 0xb7, 0x4a,
+	0x75, 0x9f, 0x72, 0x8a, 0xde, 0x8a, 0x38, 0x94, 0xe2, 0x1c, 0x4a, 0xcd, 0xa9, 0xc2, 0x48, 0x85,
+	0x56, 0xa8, 0xfc, 0xdf, 0x10, 0x5f, 0x81, 0x6b, 0xa1, 0x68, 0x51, 0x56, 0xa3, 0xcc, 0x58, 0xc5,
+	0x8c, 0x18, 0xcd, 0xa9, 0x55, 0xc2, 0xf1, 0x94, 0x61, 0x51, 0xc7, 0x53, 0xfb, 0xa3, 0xc1, 0x7e,
+	0x39, 0x70, 0x0c, 0x16, 0x6a, 0x6b, 0xac, 0x42, 0x69, 0xc5, 0x25, 0x06, 0xae, 0x3b, 0x06, 0xf6,
+	0x3c, 0xca, 0x65, 0xc4, 0x70, 0xf7, 0x83, 0x28, 0x09, 0xcb, 0xc5, 0x4e, 0x8d, 0xd5, 0xb0, 0x87,
+	0x2b, 0xc4, 0x17, 0xd9, 0x77, 0x19, 0x94, 0xc7, 0x74, 0x12, 0xda, 0x35, 0xc2, 0x18, 0xae, 0x90,
+	0x30, 0xca, 0x5c, 0x12, 0x9f, 0x58, 0x45, 0xa4, 0xbf, 0x3e, 0x02, 0xe8, 0xba, 0x10, 0xf2, 0x1a,
+	0xf6, 0x71, 0x8d, 0x99, 0x64, 0xbd, 0x41, 0x18, 0xd7, 0xef, 0xc1, 0x91, 0x2e, 0x2b, 0xab, 0x53,
+	0x8f, 0x11, 0xb4, 0x02, 0x99, 0xba, 0xb4, 0xe4, 0xb5, 0x09, 0xed, 0x44, 0x76, 0x7a, 0xb2, 0x94,
+	0x40, 0xf7, 0x52, 0x00, 0xb2, 0x98, 0x7e, 0xb2, 0x35, 0x3e, 0x60, 0x2a, 0x00, 0x7d, 0x1e, 0xf2,
+	0x41, 0x04, 0x91, 0x85, 0x45, 0xdd, 0x65, 0xcc, 0xb1, 0x8a, 0x8e, 0x10, 0xa4, 0x79, 0xab, 0x4e,
+	0x64, 0x90, 0x61, 0x53, 0x7e, 0xa3, 0x1c, 0xa4, 0xd6, 0x48, 0x2b, 0x3f, 0x28, 0x4d, 0xe2, 0x53,
+	0xbf, 0x03, 0xa3, 0x31, 0x08, 0x2a, 0xd3, 0x8b, 0x90, 0xb6, 0x31, 0xc7, 0x79, 0x6d, 0x22, 0x75,
+	0xe2, 0xc0, 0xe2, 0xe4, 0x3f, 0x5b, 0xe3, 0xd9, 0x16, 0xae, 0xb9, 0xe7, 0x74, 0x61, 0xd5, 0xff,
+	0xdd, 0x1a, 0xcf, 0x13, 0xcf, 0xa2, 0xb6, 0xe3, 0x55, 0x8c, 0x07, 0x8c, 0x7a, 0x25, 0x13, 0x3f,
+	0xbc, 0x12, 0x08, 0x6b, 0x4a, 0x47, 0xdd, 0x80, 0xff, 0x4b, 0xf4, 0x9b, 0x74, 0x8d, 0x78, 0xb7,
+	0xb0, 0xdb, 0x20, 0xa1, 0x38, 0x68, 0x04, 0x86, 0x48, 0x9d, 0x5a, 0x55, 0x99, 0x5f, 0xca, 0x0c,
+	0x16, 0xfa, 0x63, 0xc8, 0xf7, 0x3a, 0xa8, 0x6c, 0xee, 0xc2, 0x01, 0x2e, 0xcc, 0xe5, 0xa6, 0xb4,
+	0x2b, 0xf5, 0x4e, 0x27, 0x52, 0xef, 0x92, 0x40, 0x8f, 0x80, 0x2a, 0x1d, 0xb3, 0xbc, 0x63, 0xd2,
+	0x97, 0xe0, 0xa8, 0x92, 0xc2, 0xb1, 0xc8, 0x35, 0xcc, 0xab, 0x91, 0x54, 0x6d, 0xe2, 0xd1, 0x9a,
+	0x92, 0x32, 0x58, 0x74, 0x08, 0x0c, 0x46, 0x09, 0x7c, 0xaf, 0xc1, 0xff, 0x76, 0xa2, 0xa8, 0xfc,
+	0x63, 0x19, 0xa3, 0xe3, 0x00, 0xe2, 0xd2, 0x94, 0x83, 0x08, 0x41, 0x65, 0x86, 0x85, 0x65, 0x59,
+	0x46, 0xb9, 0x05, 0xd9, 0x08, 0xe9, 0x7c, 0x4a, 0x72, 0x36, 0x12, 0x71, 0xee, 0xd0, 0x55, 0x6c,
+	0xa1, 0xc3, 0x56, 0xbf, 0xaf, 0xea, 0x7e, 0x0b, 0xbb, 0x8e, 0x8d, 0x39, 0x59, 0x12, 0x37, 0x29,
+	0x24, 0xbc, 0x02, 0x43, 0xf2, 0x66, 0x29, 0x89, 0x67, 0x12, 0x85, 0xbb, 0xc2, 0x2a, 0x37, 0x1a,
+	0xab, 0x35, 0x87, 0x07, 0x50, 0x01, 0x82, 0xfe, 0x4c, 0x83, 0xc3, 0xd7, 0x7c, 0x4a, 0xef, 0xab,
+	0x40, 0x0e, 0xf5, 0x84, 0x10, 0x8e, 0x67, 0x93, 0x47, 0x12, 0xfe, 0xa0, 0x19, 0x2c, 0xd0, 0x12,
+	0x64, 0x70, 0x8d, 0x36, 0x3c, 0x1e, 0x88, 0xb0, 0x38, 0x29, 0x72, 0xfe, 0x6b, 0x6b, 0xfc, 0x68,
+	0xf0, 0x5a, 0x30, 0x7b, 0xad, 0xe4, 0x50, 0xa3, 0x86, 0x79, 0xb5, 0xb4, 0xe2, 0xf1, 0xdf, 0x7e,
+	0x3c, 0x05, 0xc1, 0x86, 0x58, 0x99, 0xca, 0x15, 0x5d, 0x87, 0x8c, 0x4f, 0x30, 0xa3, 0x9e, 0x54,
+	0xea, 0xd0, 0xf4, 0x6c, 0xa2, 0xd4, 0x65, 0xc6, 0x9d, 0x04, 0x2f, 0xf9, 0x3e, 0xf5, 0x4d, 0x05,
+	0x24, 0xcb, 0x26, 0x0c, 0xf9, 0x74, 0x50, 0x7d, 0xb9, 0xd0, 0xff, 0xd6, 0xa0, 0x10, 0x27, 0x60,
+	0xa7, 0xd6, 0x4d, 0xb1, 0x21, 0x29, 0xee, 0x37, 0x83, 0xc5, 0x9b, 0xa1, 0xd8, 0xce, 0x27, 0x15,
+	0xc9, 0x07, 0x99, 0x90, 0xa9, 0x0b, 0x99, 0x59, 0x3e, 0x3d, 0x91, 0x3a, 0x91, 0x9d, 0xfe, 0x30,
+	0xd9, 0xa3, 0xd2, 0x5d, 0x99, 0xf6, 0xeb, 0x22, 0x91, 0xf4, 0xb3, 0x30, 0x26, 0x29, 0x5e, 0x62,
+	0xdc, 0xa9, 0x61, 0x4e, 0x6c, 0x33, 0xf0, 0x0c, 0x8f, 0x49, 0x1e, 0xf6, 0x61, 0xdb, 0xf6, 0x09,
+	0x63, 0xea, 0x66, 0x84, 0x4b, 0xfd, 0xa5, 0x06, 0xa3, 0x52, 0x90, 0x9b, 0xad, 0x3a, 0xd9, 0xe9,
+	0x8e, 0x3e, 0x01, 0x90, 0x87, 0xa3, 0xdc, 0x7e, 0x9f, 0x0e, 0x4d, 0xbf, 0xd7, 0x95, 0x6f, 0xf7,
+	0xbb, 0x1e, 0x56, 0x48, 0x80, 0x99, 0x11, 0xd7, 0x37, 0xa3, 0xe7, 0x2c, 0xec, 0x53, 0x8a, 0xa8,
+	0xdb, 0x35, 0x5a, 0x52, 0x7f, 0x89, 0x5b, 0x58, 0x52, 0xcd, 0xac, 0xb4, 0x44, 0x9d, 0x50, 0x9f,
+	0xf0, 0x7f, 0xfd, 0x9b, 0x34, 0x8c, 0xdc, 0xa6, 0x5e, 0x2f, 0xc3, 0x51, 0xd8, 0x6f, 0x55, 0xb1,
+	0xe3, 0x95, 0xd5, 0x09, 0x18, 0x36, 0xf7, 0xc9, 0xf5, 0x8a, 0x8d, 0xee, 0xc0, 0x91, 0x2a, 0x75,
+	0xc5, 0x9b, 0xc9, 0xca, 0xd8, 0x75, 0xa9, 0x25, 0x95, 0xdf, 0x0b, 0x01, 0x14, 0xe2, 0x2c, 0xb4,
+	0x61, 0xd0, 0x3a, 0x14, 0x9b, 0x41, 0x39, 0xa9, 0x5f, 0x66, 0xc4, 0x25, 0x96, 0x30, 0x47, 0x03,
+	0xa5, 0xfa, 0x0f, 0x34, 0xd6, 0x86, 0xbc, 0x11, 0x22, 0x46, 0x42, 0xde, 0x83, 0xfd, 0x61, 0x22,
+	0xea, 0xec, 0xcd, 0x25, 0xbf, 0x74, 0x71, 0xe7, 0x43, 0xa9, 0xdc, 0x46, 0x45, 0x9f, 0x42, 0xae,
+	0x2d, 0x59, 0x58, 0xaa, 0xa1, 0x64, 0xa5, 0x3a, 0x1c, 0x3a, 0x86, 0x95, 0x29, 0xc3, 0xb1, 0x38,
+	0x81, 0x42, 0xd8, 0x4c, 0x32, 0xd8, 0xd1, 0x5e, 0x45, 0x54, 0x00, 0xfd, 0x27, 0x0d, 0x8e, 0xef,
+	0x72, 0x6b, 0x5e, 0xd9, 0x07, 0x3e, 0x87, 0xa1, 0xc7, 0xd4, 0x23, 0x2c, 0x3f, 0x28, 0x35, 0x4c,
+	0xf6, 0x70, 0xc5, 0x1d, 0x3e, 0x95, 0x62, 0x80, 0x86, 0x4e, 0xc3, 0x10, 0xa7, 0x1c, 0xbb, 0x49,
+	0xcf, 0x76, 0xf0, 0xb7, 0x7e, 0x15, 0x8e, 0x45, 0x5f, 0x37, 0xea, 0xdf, 0xb0, 0xa8, 0xdf, 0x69,
+	0xde, 0xaf, 0x38, 0xdf, 0xf1, 0x6d, 0xf1, 0x11, 0x8c, 0xc5, 0xe3, 0x29, 0x4d, 0xbe, 0x00, 0x10,
+	0xf9, 0x96, 0x99, 0x30, 0xf7, 0xd5, 0x76, 0x64, 0x67, 0x17, 0x3a, 0x48, 0x44, 0xc5, 0x62, 0xf8,
+	0x71, 0x68, 0x38, 0xf9, 0xa7, 0x06, 0x23, 0x71, 0xef, 0x3b, 0x1a, 0x83, 0x7c, 0x9c, 0xfd, 0x2a,
+	0xf5, 0x48, 0x6e, 0x00, 0xbd, 0x0b, 0x7a, 0xdc, 0xee, 0x65, 0xe2, 0x54, 0xaa, 0xfc, 0x8a, 0xc3,
+	0x6a, 0x98, 0x5b, 0xd5, 0x9c, 0x86, 0xde, 0x86, 0x89, 0xb8, 0xff, 0x56, 0x3c, 0x79, 0x42, 0xe4,
+	0xfb, 0x9a, 0x1b, 0x44, 0xef, 0xc3, 0x3b, 0x71, 0x7f, 0x89, 0x7e, 0x49, 0xed, 0x86, 0x4b, 0x4c,
+	0xf2, 0x80, 0x58, 0x9c, 0xd8, 0xb9, 0xd4, 0x6e, 0x80, 0xcb, 0x8d, 0xba, 0xeb, 0x58, 0x98, 0x93,
+	0xcf, 0x48, 0x2b, 0x97, 0x2e, 0xa4, 0xbf, 0xfa, 0xae, 0x38, 0x30, 0xfd, 0x4b, 0x16, 0x86, 0xa4,
+	0xac, 0xe8, 0x07, 0x0d, 0x32, 0xc1, 0x84, 0x88, 0xce, 0x24, 0x92, 0xad, 0x77, 0x5c, 0x2d, 0x9c,
+	0xed, 0xdf, 0x31, 0xa8, 0x9e, 0x3e, 0xf3, 0xe5, 0xef, 0x2f, 0xbf, 0x1d, 0x3c, 0x85, 0x26, 0x8d,
+	0x84, 0x73, 0xb4, 0xc8, 0xf3, 0x99, 0x06, 0x07, 0xa2, 0x53, 0x27, 0xba, 0xd0, 0x47, 0xfc, 0xde,
+	0x79, 0xb7, 0x30, 0xb7, 0x57, 0x77, 0x45, 0xe2, 0x63, 0x49, 0x62, 0x1e, 0xcd, 0x25, 0x23, 0xa1,
+	0x20, 0x6c, 0xcc, 0xb1, 0xb1, 0x21, 0xba, 0xd1, 0xa6, 0xb1, 0xb1, 0x46, 0x5a, 0x9b, 0xe8, 0x57,
+	0x0d, 0xb2, 0x91, 0x49, 0x13, 0x7d, 0x94, 0x3c, 0xaf, 0xde, 0x31, 0xb9, 0x70, 0x61, 0x8f, 0xde,
+	0x8a, 0xd4, 0x82, 0x24, 0x75, 0x1e, 0xcd, 0x26, 0x22, 0x15, 0x1d, 0xaf, 0x8d, 0x0d, 0x79, 0x73,
+	0x37, 0xd1, 0xcf, 0x1a, 0x0c, 0xb7, 0x87, 0x59, 0x74, 0xae, 0x1f, 0x95, 0xbb, 0xe7, 0xe8, 0xc2,
+	0xf9, 0x3d, 0xf9, 0x2a, 0x26, 0x17, 0x25, 0x93, 0x59, 0x74, 0x26, 0x61, 0x79, 0x1c, 0x8b, 0x94,
+	0xeb, 0x98, 0x57, 0x8d, 0x0d, 0x39, 0x59, 0x6f, 0xa2, 0xa7, 0x1a, 0x1c, 0xec, 0x1a, 0xd6, 0x50,
+	0x1f, 0x27, 0x26, 0x6e, 0x4c, 0x2e, 0x5c, 0xdc, 0xb3, 0xbf, 0xe2, 0x34, 0x27, 0x39, 0x9d, 0x3d,
+	0xa7, 0x9d, 0xd4, 0x67, 0x12, 0xd1, 0x52, 0x6d, 0x87, 0x94, 0xe5, 0x10, 0x84, 0xb6, 0x35, 0xc8,
+	0xf5, 0xcc, 0x1e, 0x0b, 0xc9, 0xb3, 0xda, 0x65, 0xb0, 0x2b, 0x2c, 0xbe, 0x0e, 0x84, 0xe2, 0x76,
+	0x59, 0x72, 0x5b, 0x44, 0xf3, 0x89, 0x88, 0x91, 0x10, 0x26, 0xec, 0xc4, 0xc6, 0x86, 0x9a, 0x25,
+	0x37, 0xd1, 0x73, 0x0d, 0x0e, 0xef, 0xe8, 0x1b, 0x68, 0xbe, 0x6f, 0xe9, 0x77, 0xb4, 0xb0, 0xc2,
+	0xc2, 0x6b, 0x20, 0xec, 0x89, 0x62, 0x64, 0xec, 0x90, 0x30, 0xc6, 0x46, 0xd8, 0x42, 0x37, 0x17,
+	0xef, 0x3e, 0x79, 0x51, 0xd4, 0x9e, 0xbe, 0x28, 0x6a, 0xcf, 0x5f, 0x14, 0xb5, 0xaf, 0xb7, 0x8b,
+	0x03, 0x4f, 0xb7, 0x8b, 0x03, 0x7f, 0x6c, 0x17, 0x07, 0x6e, 0x2f, 0x57, 0x1c, 0x5e, 0x6d, 0xac,
+	0x96, 0x2c, 0x5a, 0x8b, 0x46, 0x39, 0x25, 0x1a, 0x5c, 0x57, 0xd8, 0x47, 0xf1, 0x81, 0xc5, 0xcb,
+	0xc4, 0x56, 0x33, 0xf2, 0xb9, 0x9a, 0xf9, 0x6f, 0x00, 0x2d, 0x70, 0xb1, 0xb2, 0x13, 0x12, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of participation rewards parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ProtocolData returns the requested protocol data.
+	ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error)
+	// TokenValues returns the token values calculated at the end of the given
+	// epoch, or of the latest epoch if epoch is zero.
+	TokenValues(ctx context.Context, in *QueryTokenValuesRequest, opts ...grpc.CallOption) (*QueryTokenValuesResponse, error)
+	// PricePath returns the value of a denom and the path of pools by which it
+	// was priced at the end of the given epoch, or of the latest epoch if epoch
+	// is zero.
+	PricePath(ctx context.Context, in *QueryPricePathRequest, opts ...grpc.CallOption) (*QueryPricePathResponse, error)
+	// ValidateClaim performs the proof and submodule checks of SubmitClaim
+	// without recording the claim, returning the amount each proof would credit
+	// and the reason any proof would be rejected.
+	ValidateClaim(ctx context.Context, in *QueryValidateClaimRequest, opts ...grpc.CallOption) (*QueryValidateClaimResponse, error)
+	// EstimatedRewards returns the participation rewards an address would be
+	// paid at the end of the current epoch, per zone and per claim type, based
+	// upon its current claims and intents and the latest token values.
+	EstimatedRewards(ctx context.Context, in *QueryEstimatedRewardsRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardsResponse, error)
+	// ValidatorScores returns the validator scores of a zone calculated in the
+	// given epoch, or the latest scores if epoch is zero.
+	ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolData(ctx context.Context, in *QueryProtocolDataRequest, opts ...grpc.CallOption) (*QueryProtocolDataResponse, error) {
+	out := new(QueryProtocolDataResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ProtocolData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenValues(ctx context.Context, in *QueryTokenValuesRequest, opts ...grpc.CallOption) (*QueryTokenValuesResponse, error) {
+	out := new(QueryTokenValuesResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/TokenValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PricePath(ctx context.Context, in *QueryPricePathRequest, opts ...grpc.CallOption) (*QueryPricePathResponse, error) {
+	out := new(QueryPricePathResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/PricePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateClaim(ctx context.Context, in *QueryValidateClaimRequest, opts ...grpc.CallOption) (*QueryValidateClaimResponse, error) {
+	out := new(QueryValidateClaimResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ValidateClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedRewards(ctx context.Context, in *QueryEstimatedRewardsRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardsResponse, error) {
+	out := new(QueryEstimatedRewardsResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/EstimatedRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error) {
+	out := new(QueryValidatorScoresResponse)
+	err := c.cc.Invoke(ctx, "/quicksilver.participationrewards.v1.Query/ValidatorScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of participation rewards parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ProtocolData returns the requested protocol data.
+	ProtocolData(context.Context, *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error)
+	// TokenValues returns the token values calculated at the end of the given
+	// epoch, or of the latest epoch if epoch is zero.
+	TokenValues(context.Context, *QueryTokenValuesRequest) (*QueryTokenValuesResponse, error)
+	// PricePath returns the value of a denom and the path of pools by which it
+	// was priced at the end of the given epoch, or of the latest epoch if epoch
+	// is zero.
+	PricePath(context.Context, *QueryPricePathRequest) (*QueryPricePathResponse, error)
+	// ValidateClaim performs the proof and submodule checks of SubmitClaim
+	// without recording the claim, returning the amount each proof would credit
+	// and the reason any proof would be rejected.
+	ValidateClaim(context.Context, *QueryValidateClaimRequest) (*QueryValidateClaimResponse, error)
+	// EstimatedRewards returns the participation rewards an address would be
+	// paid at the end of the current epoch, per zone and per claim type, based
+	// upon its current claims and intents and the latest token values.
+	EstimatedRewards(context.Context, *QueryEstimatedRewardsRequest) (*QueryEstimatedRewardsResponse, error)
+	// ValidatorScores returns the validator scores of a zone calculated in the
+	// given epoch, or the latest scores if epoch is zero.
+	ValidatorScores(context.Context, *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolData(ctx context.Context, req *QueryProtocolDataRequest) (*QueryProtocolDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolData not implemented")
+}
+func (*UnimplementedQueryServer) TokenValues(ctx context.Context, req *QueryTokenValuesRequest) (*QueryTokenValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenValues not implemented")
+}
+func (*UnimplementedQueryServer) PricePath(ctx context.Context, req *QueryPricePathRequest) (*QueryPricePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PricePath not implemented")
+}
+func (*UnimplementedQueryServer) ValidateClaim(ctx context.Context, req *QueryValidateClaimRequest) (*QueryValidateClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateClaim not implemented")
+}
+func (*UnimplementedQueryServer) EstimatedRewards(ctx context.Context, req *QueryEstimatedRewardsRequest) (*QueryEstimatedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedRewards not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorScores(ctx context.Context, req *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScores not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ProtocolData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolData(ctx, req.(*QueryProtocolDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/TokenValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenValues(ctx, req.(*QueryTokenValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PricePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPricePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PricePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/PricePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PricePath(ctx, req.(*QueryPricePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ValidateClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateClaim(ctx, req.(*QueryValidateClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/EstimatedRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedRewards(ctx, req.(*QueryEstimatedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quicksilver.participationrewards.v1.Query/ValidatorScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScores(ctx, req.(*QueryValidatorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "quicksilver.participationrewards.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ProtocolData",
+			Handler:    _Query_ProtocolData_Handler,
+		},
+		{
+			MethodName: "TokenValues",
+			Handler:    _Query_TokenValues_Handler,
+		},
+		{
+			MethodName: "PricePath",
+			Handler:    _Query_PricePath_Handler,
+		},
+		{
+			MethodName: "ValidateClaim",
+			Handler:    _Query_ValidateClaim_Handler,
+		},
+		{
+			MethodName: "EstimatedRewards",
+			Handler:    _Query_EstimatedRewards_Handler,
+		},
+		{
+			MethodName: "ValidatorScores",
+			Handler:    _Query_ValidatorScores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quicksilver/participationrewards/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Data[iNdEx])
+			copy(dAtA[i:], m.Data[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Data[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenValues.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricePathRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricePathRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricePathRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPricePathResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPricePathResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPricePathResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateClaimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateClaimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateClaimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimTypeEstimatedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimTypeEstimatedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimTypeEstimatedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ClaimType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZoneEstimatedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZoneEstimatedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZoneEstimatedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSelectionRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.HoldingsRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Holdings) > 0 {
+		for iNdEx := len(m.Holdings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holdings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ValidatorSelectionAllocation.Size()
+		i -= size
+		if _, err := m.ValidatorSelectionAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.HoldingsAllocation.Size()
+		i -= size
+		if _, err := m.HoldingsAllocation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimatedRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimatedRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimatedRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Zones) > 0 {
+		for iNdEx := len(m.Zones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Zones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ZoneScore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProtocolDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtocolDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTokenValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryTokenValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenValues.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPricePathRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryPricePathResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.TokenValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidateClaimRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimatedRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimTypeEstimatedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimType != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ZoneEstimatedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.HoldingsAllocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorSelectionAllocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Holdings) > 0 {
+		for _, e := range m.Holdings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.HoldingsRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorSelectionRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimatedRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.Zones) > 0 {
+		for _, e := range m.Zones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ZoneScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenValues.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricePathRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricePathRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricePathRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricePathResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricePathResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricePathResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateClaimRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateClaimRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateClaimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &MsgSubmitClaim{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ClaimValidationError(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, ProofValidation{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimatedRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimatedRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimTypeEstimatedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimTypeEstimatedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimTypeEstimatedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= types.ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZoneEstimatedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZoneEstimatedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZoneEstimatedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsAllocation", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.HoldingsAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionAllocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSelectionAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holdings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holdings = append(m.Holdings, ClaimTypeEstimatedRewards{})
+			if err := m.Holdings[len(m.Holdings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldingsRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HoldingsRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSelectionRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSelectionRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -620,7 +4000,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimatedRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -643,15 +4023,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimatedRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimatedRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -678,7 +4077,41 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Zones = append(m.Zones, ZoneEstimatedRewards{})
+			if err := m.Zones[len(m.Zones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -703,7 +4136,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,15 +4159,15 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -762,13 +4195,13 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -778,24 +4211,11 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -817,7 +4237,7 @@ func (m *QueryProtocolDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -840,17 +4260,17 @@ func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProtocolDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProtocolDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneScore", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -860,23 +4280,24 @@ func (m *QueryProtocolDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
-			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			if err := m.ZoneScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GovProposalHistoryLength is the number of epochs for which governance
+// proposals are tracked for validator participation scoring, from the epoch in
+// which they were first observed.
+const GovProposalHistoryLength = 30
+
+// PerformanceScore returns the weighted mean of the given performance score
+// components.
+func (sw *ScoringWeights) PerformanceScore(rewards, uptime, commission, governance sdk.Dec) sdk.Dec {
+	total := sw.Total()
+	if !total.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	return sw.Rewards.Mul(rewards).
+		Add(sw.Uptime.Mul(uptime)).
+		Add(sw.Commission.Mul(commission)).
+		Add(sw.Governance.Mul(governance)).
+		Quo(total)
+}
+
+// UptimeScore returns the proportion of the signed blocks window signed by the
+// given validator. Validators without signing info, and zones without a
+// signed blocks window, score one.
+func (zsi *ZoneSigningInfo) UptimeScore(valoper string) sdk.Dec {
+	if zsi.SignedBlocksWindow <= 0 {
+		return sdk.OneDec()
+	}
+
+	for _, val := range zsi.Validators {
+		if val.ValoperAddress != valoper {
+			continue
+		}
+		missed := sdk.NewDec(val.MissedBlocks).Quo(sdk.NewDec(zsi.SignedBlocksWindow))
+		if missed.GT(sdk.OneDec()) {
+			return sdk.ZeroDec()
+		}
+		return sdk.OneDec().Sub(missed)
+	}
+
+	return sdk.OneDec()
+}
+
+// CommissionScore returns zero if the commission rate of a validator has
+// increased from the previously scored rate, and one otherwise.
+func CommissionScore(previous, current sdk.Dec) sdk.Dec {
+	if previous.IsNil() || current.IsNil() || !current.GT(previous) {
+		return sdk.OneDec()
+	}
+	return sdk.ZeroDec()
+}
+
+// GovernanceScore returns the proportion of the given proposals on which the
+// given validator voted. Validators score one if there are no proposals.
+func GovernanceScore(proposals []GovProposalVotes, valoper string) sdk.Dec {
+	if len(proposals) == 0 {
+		return sdk.OneDec()
+	}
+
+	voted := int64(0)
+	for _, proposal := range proposals {
+		if proposal.HasVoted(valoper) {
+			voted++
+		}
+	}
+
+	return sdk.NewDec(voted).Quo(sdk.NewDec(int64(len(proposals))))
+}
+
+// HasVoted returns true if the given validator voted on the proposal.
+func (gpv *GovProposalVotes) HasVoted(valoper string) bool {
+	for _, voter := range gpv.Voters {
+		if voter == valoper {
+			return true
+		}
+	}
+	return false
+}
+
+// GetValidatorScore returns the score of the given validator.
+func (ezs *EpochZoneScore) GetValidatorScore(valoper string) (ValidatorScore, bool) {
+	for _, vs := range ezs.ValidatorScores {
+		if vs.ValoperAddress == valoper {
+			return vs, true
+		}
+	}
+	return ValidatorScore{}, false
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+func TestScoringWeights_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights types.ScoringWeights
+		wantErr bool
+	}{
+		{
+			"default",
+			types.DefaultScoringWeights,
+			false,
+		},
+		{
+			"mixed",
+			types.ScoringWeights{Rewards: sdk.NewDec(2), Uptime: sdk.OneDec(), Commission: sdk.ZeroDec(), Governance: sdk.MustNewDecFromStr("0.5")},
+			false,
+		},
+		{
+			"undefined",
+			types.ScoringWeights{Rewards: sdk.OneDec(), Uptime: sdk.ZeroDec(), Commission: sdk.ZeroDec()},
+			true,
+		},
+		{
+			"negative",
+			types.ScoringWeights{Rewards: sdk.OneDec(), Uptime: sdk.NewDec(-1), Commission: sdk.ZeroDec(), Governance: sdk.ZeroDec()},
+			true,
+		},
+		{
+			"zero total",
+			types.ScoringWeights{Rewards: sdk.ZeroDec(), Uptime: sdk.ZeroDec(), Commission: sdk.ZeroDec(), Governance: sdk.ZeroDec()},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.weights.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestScoringWeights_PerformanceScore(t *testing.T) {
+	rewards := sdk.MustNewDecFromStr("0.8")
+	uptime := sdk.MustNewDecFromStr("0.5")
+
+	// default weights use the rewards score alone.
+	require.Equal(t, rewards, types.DefaultScoringWeights.PerformanceScore(rewards, uptime, sdk.ZeroDec(), sdk.ZeroDec()))
+
+	weights := types.ScoringWeights{Rewards: sdk.NewDec(2), Uptime: sdk.OneDec(), Commission: sdk.OneDec(), Governance: sdk.ZeroDec()}
+	// (2*0.8 + 0.5 + 1) / 4
+	require.Equal(t, sdk.MustNewDecFromStr("0.775"), weights.PerformanceScore(rewards, uptime, sdk.OneDec(), sdk.ZeroDec()))
+}
+
+func TestZoneSigningInfo_UptimeScore(t *testing.T) {
+	zsi := types.ZoneSigningInfo{
+		ChainId:            "cosmoshub-4",
+		SignedBlocksWindow: 1000,
+		Validators: []types.ValidatorMissedBlocks{
+			{ValoperAddress: "cosmosvaloper1a", MissedBlocks: 100},
+			{ValoperAddress: "cosmosvaloper1b", MissedBlocks: 2000},
+		},
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("0.9"), zsi.UptimeScore("cosmosvaloper1a"))
+	require.Equal(t, sdk.ZeroDec(), zsi.UptimeScore("cosmosvaloper1b"))
+	require.Equal(t, sdk.OneDec(), zsi.UptimeScore("cosmosvaloper1c"))
+
+	zsi.SignedBlocksWindow = 0
+	require.Equal(t, sdk.OneDec(), zsi.UptimeScore("cosmosvaloper1a"))
+}
+
+func TestCommissionScore(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), types.CommissionScore(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")))
+	require.Equal(t, sdk.OneDec(), types.CommissionScore(sdk.MustNewDecFromStr("0.10"), sdk.MustNewDecFromStr("0.05")))
+	require.Equal(t, sdk.ZeroDec(), types.CommissionScore(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.10")))
+	require.Equal(t, sdk.OneDec(), types.CommissionScore(sdk.Dec{}, sdk.MustNewDecFromStr("0.10")))
+}
+
+func TestGovernanceScore(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), types.GovernanceScore(nil, "cosmosvaloper1a"))
+
+	proposals := []types.GovProposalVotes{
+		{ChainId: "cosmoshub-4", ProposalId: 1, Voters: []string{"cosmosvaloper1a", "cosmosvaloper1b"}},
+		{ChainId: "cosmoshub-4", ProposalId: 2, Voters: []string{"cosmosvaloper1a"}},
+		{ChainId: "cosmoshub-4", ProposalId: 3},
+		{ChainId: "cosmoshub-4", ProposalId: 4, Voters: []string{"cosmosvaloper1b"}},
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), types.GovernanceScore(proposals, "cosmosvaloper1a"))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), types.GovernanceScore(proposals, "cosmosvaloper1b"))
+	require.Equal(t, sdk.ZeroDec(), types.GovernanceScore(proposals, "cosmosvaloper1c"))
+}
@@ -0,0 +1,227 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/multierr"
+
+	"cosmossdk.io/math"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+)
+
+// CosmWasmContractProtocolData describes a CosmWasm contract that holds qAssets
+// on behalf of users, and how the qAsset holdings of a user are read from the
+// contract storage.
+//
+// The storage key of a user holding is the contract namespaced key formed of
+// Namespaces followed by the bech32 address of the user. The qAssets held are
+// the assets selected from the stored JSON value by AssetsPath; the amount of
+// each asset is selected by AmountPath, and its denom by DenomPath, or is
+// Denom for contracts that hold a single denom.
+//
+// Paths are dot separated object fields; a field suffixed with [] (or a bare
+// [] segment) selects every element of the array it names.
+type CosmWasmContractProtocolData struct {
+	// The chain on which the contract resides.
+	ChainID string
+	// The bech32 address of the contract.
+	ContractAddress string
+	// The storage namespaces preceding the user address in a holding key.
+	Namespaces []string
+	// The path to the assets in a holding value; empty selects the value.
+	AssetsPath string
+	// The path to the amount of an asset.
+	AmountPath string
+	// The path to the denom of an asset.
+	DenomPath string
+	// The denom of every asset, if DenomPath is not set.
+	Denom string
+}
+
+// CosmWasmAsset is an asset held by a CosmWasm contract on behalf of a user.
+type CosmWasmAsset struct {
+	Denom  string
+	Amount math.Int
+}
+
+func (cpd *CosmWasmContractProtocolData) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if cpd.ChainID == "" {
+		errs["ChainID"] = ErrUndefinedAttribute
+	}
+
+	if cpd.ContractAddress == "" {
+		errs["ContractAddress"] = ErrUndefinedAttribute
+	} else if _, err := addressutils.AccAddressFromBech32(cpd.ContractAddress, ""); err != nil {
+		errs["ContractAddress"] = ErrInvalidBech32
+	}
+
+	if len(cpd.Namespaces) == 0 {
+		errs["Namespaces"] = ErrUndefinedAttribute
+	}
+	for _, ns := range cpd.Namespaces {
+		if ns == "" || len(ns) > 255 {
+			errs["Namespaces"] = fmt.Errorf("invalid namespace %q", ns)
+		}
+	}
+
+	if _, err := parseJSONPath(cpd.AssetsPath); err != nil {
+		errs["AssetsPath"] = err
+	}
+
+	if cpd.AmountPath == "" {
+		errs["AmountPath"] = ErrUndefinedAttribute
+	} else if _, err := parseJSONPath(cpd.AmountPath); err != nil {
+		errs["AmountPath"] = err
+	}
+
+	switch {
+	case cpd.DenomPath == "" && cpd.Denom == "":
+		errs["Denom"] = ErrUndefinedAttribute
+	case cpd.DenomPath != "" && cpd.Denom != "":
+		errs["Denom"] = errors.New("only one of Denom and DenomPath may be set")
+	case cpd.DenomPath != "":
+		if _, err := parseJSONPath(cpd.DenomPath); err != nil {
+			errs["DenomPath"] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return multierr.Combine(utils.ErrorMapToSlice(errs)...)
+	}
+
+	return nil
+}
+
+func (cpd *CosmWasmContractProtocolData) GenerateKey() []byte {
+	return []byte(cpd.ChainID + "_" + cpd.ContractAddress)
+}
+
+// Assets returns the assets of the given contract holding value.
+func (cpd *CosmWasmContractProtocolData) Assets(data []byte) ([]CosmWasmAsset, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	assets, err := selectJSONPath(value, cpd.AssetsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]CosmWasmAsset, 0, len(assets))
+	for _, asset := range assets {
+		amount, err := selectJSONValue(asset, cpd.AmountPath)
+		if err != nil {
+			return nil, fmt.Errorf("amount: %w", err)
+		}
+		amt, ok := math.NewIntFromString(amount)
+		if !ok || amt.IsNegative() {
+			return nil, fmt.Errorf("invalid amount %q", amount)
+		}
+
+		denom := cpd.Denom
+		if cpd.DenomPath != "" {
+			if denom, err = selectJSONValue(asset, cpd.DenomPath); err != nil {
+				return nil, fmt.Errorf("denom: %w", err)
+			}
+		}
+
+		out = append(out, CosmWasmAsset{Denom: denom, Amount: amt})
+	}
+
+	return out, nil
+}
+
+type jsonPathSegment struct {
+	field string
+	all   bool
+}
+
+func parseJSONPath(path string) ([]jsonPathSegment, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(path, ".")
+	segments := make([]jsonPathSegment, 0, len(parts))
+	for _, part := range parts {
+		segment := jsonPathSegment{}
+		segment.field, segment.all = strings.CutSuffix(part, "[]")
+		if segment.field == "" && !segment.all {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+		if strings.ContainsAny(segment.field, "[]") {
+			return nil, fmt.Errorf("invalid path %q", path)
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// selectJSONPath returns the elements of the given decoded JSON value selected
+// by path.
+func selectJSONPath(value any, path string) ([]any, error) {
+	segments, err := parseJSONPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []any{value}
+	for _, segment := range segments {
+		next := make([]any, 0, len(values))
+		for _, v := range values {
+			if segment.field != "" {
+				obj, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("expected object at %q", segment.field)
+				}
+				if v, ok = obj[segment.field]; !ok {
+					return nil, fmt.Errorf("field %q not found", segment.field)
+				}
+			}
+			if !segment.all {
+				next = append(next, v)
+				continue
+			}
+			arr, ok := v.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected array at %q", segment.field)
+			}
+			next = append(next, arr...)
+		}
+		values = next
+	}
+
+	return values, nil
+}
+
+// selectJSONValue returns the single string or number selected by path.
+func selectJSONValue(value any, path string) (string, error) {
+	values, err := selectJSONPath(value, path)
+	if err != nil {
+		return "", err
+	}
+	if len(values) != 1 {
+		return "", fmt.Errorf("expected a single value at %q, got %d", path, len(values))
+	}
+
+	switch v := values[0].(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("expected string or number at %q", path)
+	}
+}
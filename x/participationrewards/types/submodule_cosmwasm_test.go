@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/quicksilver-zone/quicksilver/x/participationrewards/types"
+)
+
+func validCosmWasmContract() types.CosmWasmContractProtocolData {
+	return types.CosmWasmContractProtocolData{
+		ChainID:         "osmosis-1",
+		ContractAddress: "osmo1gy5gpqqlth0jpm9ydxlmff6g5mpnfvrfxd3mfc8dhyt03waumtzqt8exxr",
+		Namespaces:      []string{"positions"},
+		AssetsPath:      "[].collateral_assets[]",
+		AmountPath:      "asset.amount",
+		DenomPath:       "asset.info.native_token.denom",
+	}
+}
+
+func TestCosmWasmContractProtocolData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(*types.CosmWasmContractProtocolData)
+		wantErr  bool
+	}{
+		{"valid", func(*types.CosmWasmContractProtocolData) {}, false},
+		{"valid - fixed denom", func(cpd *types.CosmWasmContractProtocolData) { cpd.DenomPath, cpd.Denom = "", "uqatom" }, false},
+		{"valid - root assets", func(cpd *types.CosmWasmContractProtocolData) { cpd.AssetsPath = "" }, false},
+		{"no chain id", func(cpd *types.CosmWasmContractProtocolData) { cpd.ChainID = "" }, true},
+		{"no contract address", func(cpd *types.CosmWasmContractProtocolData) { cpd.ContractAddress = "" }, true},
+		{"invalid contract address", func(cpd *types.CosmWasmContractProtocolData) { cpd.ContractAddress = "osmo1invalid" }, true},
+		{"no namespaces", func(cpd *types.CosmWasmContractProtocolData) { cpd.Namespaces = nil }, true},
+		{"empty namespace", func(cpd *types.CosmWasmContractProtocolData) { cpd.Namespaces = []string{"positions", ""} }, true},
+		{"no amount path", func(cpd *types.CosmWasmContractProtocolData) { cpd.AmountPath = "" }, true},
+		{"invalid amount path", func(cpd *types.CosmWasmContractProtocolData) { cpd.AmountPath = "asset..amount" }, true},
+		{"invalid assets path", func(cpd *types.CosmWasmContractProtocolData) { cpd.AssetsPath = "assets[0]" }, true},
+		{"no denom", func(cpd *types.CosmWasmContractProtocolData) { cpd.DenomPath = "" }, true},
+		{"denom and denom path", func(cpd *types.CosmWasmContractProtocolData) { cpd.Denom = "uqatom" }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpd := validCosmWasmContract()
+			tt.malleate(&cpd)
+			err := cpd.ValidateBasic()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCosmWasmContractProtocolData_Assets(t *testing.T) {
+	data := []byte(`[
+		{"position_id":"1","collateral_assets":[
+			{"asset":{"info":{"native_token":{"denom":"ibc/qatom"}},"amount":"1000"}},
+			{"asset":{"info":{"native_token":{"denom":"uosmo"}},"amount":"50"}}
+		]},
+		{"position_id":"2","collateral_assets":[
+			{"asset":{"info":{"native_token":{"denom":"ibc/qatom"}},"amount":25}}
+		]}
+	]`)
+
+	cpd := validCosmWasmContract()
+	assets, err := cpd.Assets(data)
+	require.NoError(t, err)
+	require.Equal(t, []types.CosmWasmAsset{
+		{Denom: "ibc/qatom", Amount: math.NewInt(1000)},
+		{Denom: "uosmo", Amount: math.NewInt(50)},
+		{Denom: "ibc/qatom", Amount: math.NewInt(25)},
+	}, assets)
+
+	// single denom vault share.
+	vault := types.CosmWasmContractProtocolData{AmountPath: "balance", Denom: "ibc/qatom"}
+	assets, err = vault.Assets([]byte(`{"balance":"12345678901234567890","locked":true}`))
+	require.NoError(t, err)
+	require.Equal(t, []types.CosmWasmAsset{{Denom: "ibc/qatom", Amount: math.NewIntFromUint64(12345678901234567890)}}, assets)
+
+	// missing fields, non-integer and negative amounts are rejected.
+	_, err = vault.Assets([]byte(`{"shares":"1"}`))
+	require.Error(t, err)
+	_, err = vault.Assets([]byte(`{"balance":"1.5"}`))
+	require.Error(t, err)
+	_, err = vault.Assets([]byte(`{"balance":"-1"}`))
+	require.Error(t, err)
+	_, err = vault.Assets([]byte(`{"balance":{"amount":"1"}}`))
+	require.Error(t, err)
+	_, err = cpd.Assets([]byte(`{"collateral_assets":[]}`))
+	require.Error(t, err)
+	_, err = cpd.Assets([]byte(`not json`))
+	require.Error(t, err)
+}
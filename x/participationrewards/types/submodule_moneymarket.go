@@ -0,0 +1,253 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/multierr"
+
+	"cosmossdk.io/math"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	leveragetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
+	"github.com/quicksilver-zone/quicksilver/utils"
+)
+
+// MoneyMarketProtocolData describes an x/leverage-style money market: the
+// chain on which it runs, the layout of its module store, and the prefix of
+// the uToken denoms it issues against supplied tokens.
+//
+// Supplied tokens are held by ModuleAccount; the reserves, interest scalar,
+// adjusted total borrows and uToken supply of a token, and the collateral of
+// an account, are stored in the StoreKey store under the given prefixes, keyed
+// by prefix | denom | 0x00 (collateral: prefix | len(addr) | addr | denom | 0x00).
+type MoneyMarketProtocolData struct {
+	// The chain on which the money market resides.
+	ChainID string
+	// The store key of the money market module.
+	StoreKey string
+	// The name of the module account holding supplied tokens.
+	ModuleAccount string
+	// The denom prefix of uTokens.
+	UTokenPrefix string
+	// The bech32 prefix of accounts on the chain.
+	AccountPrefix string
+	// The fully qualified name of the module query service.
+	QueryService string
+	// Store key prefixes.
+	CollateralPrefix          tmbytes.HexBytes
+	ReservePrefix             tmbytes.HexBytes
+	InterestScalarPrefix      tmbytes.HexBytes
+	AdjustedTotalBorrowPrefix tmbytes.HexBytes
+	UTokenSupplyPrefix        tmbytes.HexBytes
+}
+
+// UmeeMoneyMarket returns the money market configuration of the Umee x/leverage module on chainID.
+func UmeeMoneyMarket(chainID string) MoneyMarketProtocolData {
+	return MoneyMarketProtocolData{
+		ChainID:                   chainID,
+		StoreKey:                  leveragetypes.StoreKey,
+		ModuleAccount:             leveragetypes.LeverageModuleName,
+		UTokenPrefix:              leveragetypes.UTokenPrefix,
+		AccountPrefix:             "umee",
+		QueryService:              "umee.leverage.v1.Query",
+		CollateralPrefix:          leveragetypes.KeyPrefixCollateralAmount,
+		ReservePrefix:             leveragetypes.KeyPrefixReserveAmount,
+		InterestScalarPrefix:      leveragetypes.KeyPrefixInterestScalar,
+		AdjustedTotalBorrowPrefix: leveragetypes.KeyPrefixAdjustedTotalBorrow,
+		UTokenSupplyPrefix:        leveragetypes.KeyPrefixUtokenSupply,
+	}
+}
+
+func (mpd *MoneyMarketProtocolData) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if mpd.ChainID == "" {
+		errs["ChainID"] = ErrUndefinedAttribute
+	}
+
+	if mpd.StoreKey == "" {
+		errs["StoreKey"] = ErrUndefinedAttribute
+	}
+
+	if mpd.ModuleAccount == "" {
+		errs["ModuleAccount"] = ErrUndefinedAttribute
+	}
+
+	if mpd.UTokenPrefix == "" {
+		errs["UTokenPrefix"] = ErrUndefinedAttribute
+	}
+
+	if mpd.AccountPrefix == "" {
+		errs["AccountPrefix"] = ErrUndefinedAttribute
+	}
+
+	if mpd.QueryService == "" {
+		errs["QueryService"] = ErrUndefinedAttribute
+	}
+
+	prefixes := map[string][]byte{
+		"CollateralPrefix":          mpd.CollateralPrefix,
+		"ReservePrefix":             mpd.ReservePrefix,
+		"InterestScalarPrefix":      mpd.InterestScalarPrefix,
+		"AdjustedTotalBorrowPrefix": mpd.AdjustedTotalBorrowPrefix,
+		"UTokenSupplyPrefix":        mpd.UTokenSupplyPrefix,
+	}
+	for name, prefix := range prefixes {
+		if len(prefix) == 0 {
+			errs[name] = ErrUndefinedAttribute
+			continue
+		}
+		// state keys are distinguished by prefix, so no prefix may be a prefix of another.
+		for other, otherPrefix := range prefixes {
+			if name != other && len(otherPrefix) > 0 && bytes.HasPrefix(prefix, otherPrefix) {
+				errs[name] = fmt.Errorf("overlaps %s", other)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return multierr.Combine(utils.ErrorMapToSlice(errs)...)
+	}
+
+	return nil
+}
+
+func (mpd *MoneyMarketProtocolData) GenerateKey() []byte {
+	return []byte(mpd.ChainID)
+}
+
+// StorePath returns the ICQ path of the money market module store.
+func (mpd *MoneyMarketProtocolData) StorePath() string {
+	return fmt.Sprintf("store/%s/key", mpd.StoreKey)
+}
+
+// ModuleAddress returns the address of the module account holding supplied tokens.
+func (mpd *MoneyMarketProtocolData) ModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(mpd.ModuleAccount)
+}
+
+// ToTokenDenom strips the uToken prefix from a denom, or returns an empty
+// string if it was not present, or was repeated.
+func (mpd *MoneyMarketProtocolData) ToTokenDenom(uTokenDenom string) string {
+	if !strings.HasPrefix(uTokenDenom, mpd.UTokenPrefix) {
+		return ""
+	}
+	denom := strings.TrimPrefix(uTokenDenom, mpd.UTokenPrefix)
+	if strings.HasPrefix(denom, mpd.UTokenPrefix) {
+		return ""
+	}
+	return denom
+}
+
+// ToUTokenDenom adds the uToken prefix to a denom, or returns an empty string
+// if the prefix was already present.
+func (mpd *MoneyMarketProtocolData) ToUTokenDenom(denom string) string {
+	if strings.HasPrefix(denom, mpd.UTokenPrefix) {
+		return ""
+	}
+	return mpd.UTokenPrefix + denom
+}
+
+// KeyReserveAmount returns the store key of the reserves of a token.
+func (mpd *MoneyMarketProtocolData) KeyReserveAmount(denom string) []byte {
+	return utils.ConcatBytes(1, mpd.ReservePrefix, []byte(denom))
+}
+
+// KeyInterestScalar returns the store key of the interest scalar of a token.
+func (mpd *MoneyMarketProtocolData) KeyInterestScalar(denom string) []byte {
+	return utils.ConcatBytes(1, mpd.InterestScalarPrefix, []byte(denom))
+}
+
+// KeyAdjustedTotalBorrow returns the store key of the adjusted total borrows of a token.
+func (mpd *MoneyMarketProtocolData) KeyAdjustedTotalBorrow(denom string) []byte {
+	return utils.ConcatBytes(1, mpd.AdjustedTotalBorrowPrefix, []byte(denom))
+}
+
+// KeyUTokenSupply returns the store key of the supply of a uToken.
+func (mpd *MoneyMarketProtocolData) KeyUTokenSupply(uTokenDenom string) []byte {
+	return utils.ConcatBytes(1, mpd.UTokenSupplyPrefix, []byte(uTokenDenom))
+}
+
+// KeyCollateralAmount returns the store key of the uToken collateral of an account.
+func (mpd *MoneyMarketProtocolData) KeyCollateralAmount(addr sdk.AccAddress, uTokenDenom string) []byte {
+	return utils.ConcatBytes(1, mpd.CollateralPrefix, address.MustLengthPrefix(addr), []byte(uTokenDenom))
+}
+
+// DenomFromKey extracts the denom from a key of the form prefix | denom | 0x00.
+func DenomFromKey(key, prefix []byte) (string, error) {
+	if len(key) < len(prefix)+2 || !bytes.HasPrefix(key, prefix) || key[len(key)-1] != 0x00 {
+		return "", fmt.Errorf("unexpected key %X for prefix %X", key, prefix)
+	}
+	return string(key[len(prefix) : len(key)-1]), nil
+}
+
+// MoneyMarketDenomProtocolData tracks the state of a token in a money market,
+// from which the token:uToken exchange rate is derived.
+type MoneyMarketDenomProtocolData struct {
+	// The chain on which the money market resides.
+	ChainID string
+	// The token denom.
+	Denom                string
+	Reserves             math.Int
+	ModuleBalance        math.Int
+	InterestScalar       sdk.Dec
+	AdjustedTotalBorrows sdk.Dec
+	UTokenSupply         math.Int
+	LastUpdated          time.Time
+}
+
+func (mdpd *MoneyMarketDenomProtocolData) ValidateBasic() error {
+	errs := make(map[string]error)
+
+	if mdpd.ChainID == "" {
+		errs["ChainID"] = ErrUndefinedAttribute
+	}
+
+	if mdpd.Denom == "" {
+		errs["Denom"] = ErrUndefinedAttribute
+	}
+
+	if len(errs) > 0 {
+		return multierr.Combine(utils.ErrorMapToSlice(errs)...)
+	}
+
+	return nil
+}
+
+func (mdpd *MoneyMarketDenomProtocolData) GenerateKey() []byte {
+	return []byte(fmt.Sprintf("%s_%s", mdpd.ChainID, mdpd.Denom))
+}
+
+// ExchangeRate derives the token:uToken exchange rate, being the tokens held
+// by the module plus borrowed tokens, less reserves, per uToken.
+func (mdpd *MoneyMarketDenomProtocolData) ExchangeRate() sdk.Dec {
+	uTokenSupply := intOrZero(mdpd.UTokenSupply)
+	if !uTokenSupply.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	totalBorrowed := decOrZero(mdpd.AdjustedTotalBorrows).Mul(decOrZero(mdpd.InterestScalar))
+	tokenSupply := sdk.NewDecFromInt(intOrZero(mdpd.ModuleBalance)).Add(totalBorrowed).Sub(sdk.NewDecFromInt(intOrZero(mdpd.Reserves)))
+
+	return tokenSupply.QuoInt(uTokenSupply)
+}
+
+// ExchangeUToken converts an amount of uTokens to its value in the token.
+func (mdpd *MoneyMarketDenomProtocolData) ExchangeUToken(amount math.Int) math.Int {
+	return sdk.NewDecFromInt(amount).Mul(mdpd.ExchangeRate()).TruncateInt()
+}
+
+func intOrZero(i math.Int) math.Int {
+	if i.IsNil() {
+		return math.ZeroInt()
+	}
+	return i
+}
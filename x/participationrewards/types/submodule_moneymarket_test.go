@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
+)
+
+func TestMoneyMarketProtocolData_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(mpd *MoneyMarketProtocolData)
+		wantErr  bool
+	}{
+		{
+			"valid",
+			func(mpd *MoneyMarketProtocolData) {},
+			false,
+		},
+		{
+			"blank",
+			func(mpd *MoneyMarketProtocolData) { *mpd = MoneyMarketProtocolData{} },
+			true,
+		},
+		{
+			"no uToken prefix",
+			func(mpd *MoneyMarketProtocolData) { mpd.UTokenPrefix = "" },
+			true,
+		},
+		{
+			"no reserve prefix",
+			func(mpd *MoneyMarketProtocolData) { mpd.ReservePrefix = nil },
+			true,
+		},
+		{
+			"overlapping prefixes",
+			func(mpd *MoneyMarketProtocolData) { mpd.InterestScalarPrefix = append(mpd.ReservePrefix, 0x01) },
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mpd := UmeeMoneyMarket("umee-1")
+			tt.malleate(&mpd)
+			err := mpd.ValidateBasic()
+			if tt.wantErr {
+				t.Logf("Error:\n%v\n", err)
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMoneyMarketProtocolData_Unmarshal(t *testing.T) {
+	data := `{"ChainID":"ux-1","StoreKey":"lending","ModuleAccount":"lending","UTokenPrefix":"x/","AccountPrefix":"ux","QueryService":"ux.lending.v1.Query","CollateralPrefix":"14","ReservePrefix":"15","InterestScalarPrefix":"18","AdjustedTotalBorrowPrefix":"19","UTokenSupplyPrefix":"1A"}`
+
+	pd, err := UnmarshalProtocolData(ProtocolDataTypeMoneyMarket, json.RawMessage(data))
+	require.NoError(t, err)
+	market, ok := pd.(*MoneyMarketProtocolData)
+	require.True(t, ok)
+	require.NoError(t, market.ValidateBasic())
+
+	require.Equal(t, "store/lending/key", market.StorePath())
+	require.Equal(t, []byte("\x15uatom\x00"), market.KeyReserveAmount("uatom"))
+	require.Equal(t, []byte("\x1ax/uatom\x00"), market.KeyUTokenSupply(market.ToUTokenDenom("uatom")))
+	require.Equal(t, "uatom", market.ToTokenDenom("x/uatom"))
+	require.Equal(t, "", market.ToTokenDenom("u/uatom"))
+	require.Equal(t, "", market.ToTokenDenom("x/x/uatom"))
+	require.Equal(t, "", market.ToUTokenDenom("x/uatom"))
+
+	addr := addressutils.GenerateAccAddressForTest()
+	key := market.KeyCollateralAmount(addr, "x/uatom")
+	require.Equal(t, byte(0x14), key[0])
+	require.Equal(t, byte(len(addr)), key[1])
+	require.Equal(t, []byte(addr), key[2:2+len(addr)])
+	require.Equal(t, []byte("x/uatom\x00"), key[2+len(addr):])
+
+	denom, err := DenomFromKey(market.KeyInterestScalar("uatom"), market.InterestScalarPrefix)
+	require.NoError(t, err)
+	require.Equal(t, "uatom", denom)
+	_, err = DenomFromKey(market.KeyInterestScalar("uatom"), market.ReservePrefix)
+	require.Error(t, err)
+}
+
+func TestMoneyMarketDenomProtocolData_ExchangeRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		state MoneyMarketDenomProtocolData
+		want  sdk.Dec
+	}{
+		{
+			"unset",
+			MoneyMarketDenomProtocolData{ChainID: "umee-1", Denom: "uatom"},
+			sdk.OneDec(),
+		},
+		{
+			"no borrows",
+			MoneyMarketDenomProtocolData{ChainID: "umee-1", Denom: "uatom", ModuleBalance: math.NewInt(1200), UTokenSupply: math.NewInt(1000)},
+			sdk.NewDecWithPrec(12, 1),
+		},
+		{
+			"borrows and reserves",
+			MoneyMarketDenomProtocolData{
+				ChainID:              "umee-1",
+				Denom:                "uatom",
+				ModuleBalance:        math.NewInt(1000),
+				AdjustedTotalBorrows: sdk.NewDec(500),
+				InterestScalar:       sdk.NewDecWithPrec(12, 1),
+				Reserves:             math.NewInt(100),
+				UTokenSupply:         math.NewInt(1000),
+			},
+			sdk.NewDecWithPrec(15, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.state.ExchangeRate())
+			require.Equal(t, tt.want.MulInt64(1000).TruncateInt(), tt.state.ExchangeUToken(math.NewInt(1000)))
+		})
+	}
+}
@@ -23,13 +23,14 @@ const (
 // OsmosisPoolProtocolData defines protocol state to track qAssets locked in
 // Osmosis pools.
 type OsmosisPoolProtocolData struct {
-	PoolID         uint64
-	PoolName       string
-	LastUpdated    time.Time
-	PoolData       json.RawMessage
-	PoolType       string
-	Denoms         map[string]DenomWithZone
-	IsIncentivized bool
+	PoolID            uint64
+	PoolName          string
+	LastUpdated       time.Time
+	LastUpdatedHeight int64
+	PoolData          json.RawMessage
+	PoolType          string
+	Denoms            map[string]DenomWithZone
+	IsIncentivized    bool
 }
 
 type DenomWithZone struct {
@@ -21,13 +21,14 @@ const (
 // OsmosisPoolProtocolData defines protocol state to track qAssets locked in
 // Osmosis pools.
 type OsmosisClPoolProtocolData struct {
-	PoolID         uint64
-	PoolName       string
-	LastUpdated    time.Time
-	PoolData       json.RawMessage
-	PoolType       string
-	Denoms         map[string]DenomWithZone
-	IsIncentivized bool
+	PoolID            uint64
+	PoolName          string
+	LastUpdated       time.Time
+	LastUpdatedHeight int64
+	PoolData          json.RawMessage
+	PoolType          string
+	Denoms            map[string]DenomWithZone
+	IsIncentivized    bool
 }
 
 func (opd *OsmosisClPoolProtocolData) GetPool() (cl.ConcentratedPoolExtension, error) {
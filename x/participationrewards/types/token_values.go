@@ -0,0 +1,15 @@
+package types
+
+// TokenValuesHistoryLength is the number of epochs for which token values are
+// retained; older entries are pruned as new entries are recorded.
+const TokenValuesHistoryLength = 365
+
+// GetTokenValue returns the token value of the given denom.
+func (etv *EpochTokenValues) GetTokenValue(denom string) (TokenValue, bool) {
+	for _, tv := range etv.Values {
+		if tv.Denom == denom {
+			return tv, true
+		}
+	}
+	return TokenValue{}, false
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/quicksilver-zone/quicksilver/utils"
+)
+
+// ZoneScoreHistoryLength is the number of epochs for which the validator
+// scores of each zone are retained; older entries are pruned as new entries
+// are recorded.
+const ZoneScoreHistoryLength = 30
+
+// EpochZoneScore returns the validator scores of the zone score as an
+// EpochZoneScore for the given epoch and height, sorted by validator address.
+// Scores not calculated are recorded as zero.
+func (zs *ZoneScore) EpochZoneScore(epoch, height int64) EpochZoneScore {
+	ezs := EpochZoneScore{
+		ChainId:          zs.ZoneID,
+		Epoch:            epoch,
+		Height:           height,
+		TotalVotingPower: zs.TotalVotingPower,
+		ValidatorScores:  make([]ValidatorScore, 0, len(zs.ValidatorScores)),
+	}
+	if ezs.TotalVotingPower.IsNil() {
+		ezs.TotalVotingPower = math.ZeroInt()
+	}
+
+	for _, valoper := range utils.Keys(zs.ValidatorScores) {
+		vs := zs.ValidatorScores[valoper]
+		score := ValidatorScore{
+			ValoperAddress:    valoper,
+			VotingPower:       math.ZeroInt(),
+			PowerPercentage:   decOrZero(vs.PowerPercentage),
+			DistributionScore: decOrZero(vs.DistributionScore),
+			PerformanceScore:  decOrZero(vs.PerformanceScore),
+			Score:             sdk.ZeroDec(),
+			CommissionRate:    sdk.ZeroDec(),
+			RewardsScore:      decOrZero(vs.RewardsScore),
+			UptimeScore:       decOrZero(vs.UptimeScore),
+			CommissionScore:   decOrZero(vs.CommissionScore),
+			GovernanceScore:   decOrZero(vs.GovernanceScore),
+		}
+		if vs.Validator != nil {
+			if !vs.VotingPower.IsNil() {
+				score.VotingPower = vs.VotingPower
+			}
+			score.Score = decOrZero(vs.Score)
+			score.CommissionRate = decOrZero(vs.CommissionRate)
+		}
+		ezs.ValidatorScores = append(ezs.ValidatorScores, score)
+	}
+
+	return ezs
+}
+
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
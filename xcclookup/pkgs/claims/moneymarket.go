@@ -14,7 +14,6 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 
 	leverage "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage"
-	leveragetypes "github.com/quicksilver-zone/quicksilver/third-party-chains/umee-types/leverage/types"
 	"github.com/quicksilver-zone/quicksilver/utils/addressutils"
 	cmtypes "github.com/quicksilver-zone/quicksilver/x/claimsmanager/types"
 	icstypes "github.com/quicksilver-zone/quicksilver/x/interchainstaking/types"
@@ -24,27 +23,30 @@ import (
 	"github.com/quicksilver-zone/quicksilver/xcclookup/pkgs/lookup"
 )
 
-func UmeeClaim(
+// MoneyMarketClaim builds claims for the qAsset uTokens an account holds as collateral in an
+// x/leverage-style money market, such as Umee.
+func MoneyMarketClaim(
 	ctx context.Context,
 	cfg lookup.Config,
 	cacheMgr *lookup.CacheManager,
+	market prewards.MoneyMarketProtocolData,
 	address string,
 	submitAddress string,
-	chain string,
 	height int64,
 ) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
 	log := logger.FromContext(ctx)
+	chain := market.ChainID
 
 	addrBytes, err := addressutils.AccAddressFromBech32(address, "")
 	if err != nil {
 		return nil, nil, err
 	}
-	umeeAddress, err := addressutils.EncodeAddressToBech32("umee", addrBytes)
+	marketAddress, err := addressutils.EncodeAddressToBech32(market.AccountPrefix, addrBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	log.Debug("Umee address encoding successful", "address", address, "umee_address", umeeAddress)
+	log.Debug("Money market address encoding successful", "address", address, "market_address", marketAddress, "chain", chain)
 
 	host, ok := cfg.Chains[chain]
 	if !ok {
@@ -63,12 +65,12 @@ func UmeeClaim(
 	interfaceRegistry := cdctypes.NewInterfaceRegistry()
 	marshaler := codec.NewProtoCodec(interfaceRegistry)
 
-	leveragequery := leverage.QueryAccountBalances{Address: umeeAddress}
+	leveragequery := leverage.QueryAccountBalances{Address: marketAddress}
 	bytes := marshaler.MustMarshal(&leveragequery)
 	// query for AllBalances; then iterate, match against accepted balances and requery with proof.
 	leverageaccountbalancesquery, err := client.ABCIQueryWithOptions(
 		ctx,
-		"/umee.leverage.v1.Query/AccountBalances",
+		"/"+market.QueryService+"/AccountBalances",
 		bytes,
 		rpcclient.ABCIQueryOptions{Height: height},
 	)
@@ -92,14 +94,14 @@ func UmeeClaim(
 	if err != nil {
 		return nil, nil, err
 	}
-	tokens := GetTokenMap(laCache, zoneCache, chain, leveragetypes.UTokenPrefix, ignores)
+	tokens := GetTokenMap(laCache, zoneCache, chain, market.UTokenPrefix, ignores)
 
 	msg := map[string]prewards.MsgSubmitClaim{}
 	assets := map[string]sdk.Coins{}
 
 	// leverage account balance
 	for _, coin := range leverageQueryResponse.Collateral {
-		if len(coin.GetDenom()) < 2 || coin.GetDenom()[0:2] != leveragetypes.UTokenPrefix {
+		if market.ToTokenDenom(coin.GetDenom()) == "" {
 			continue
 		}
 		tuple, ok := tokens[coin.GetDenom()]
@@ -112,19 +114,19 @@ func UmeeClaim(
 				UserAddress: submitAddress,
 				Zone:        tuple.chain,
 				SrcZone:     chain,
-				ClaimType:   cmtypes.ClaimTypeUmeeToken,
+				ClaimType:   cmtypes.ClaimTypeMoneyMarket,
 				Proofs:      make([]*cmtypes.Proof, 0),
 			}
 		}
 
-		lookupKey := leveragetypes.KeyCollateralAmount(addrBytes, coin.GetDenom())
+		lookupKey := market.KeyCollateralAmount(addrBytes, coin.GetDenom())
 		leveragequery, err := client.ABCIQueryWithOptions(
 			ctx,
-			"/store/leverage/key",
+			"/"+market.StorePath(),
 			lookupKey,
 			rpcclient.ABCIQueryOptions{Height: leverageaccountbalancesquery.Response.Height, Prove: true},
 		)
-		log.Debug("Querying for value (umee - leverage)", "prefix", string(lookupKey), "address", address, "chain", chain)
+		log.Debug("Querying for value (money market - collateral)", "prefix", string(lookupKey), "address", address, "chain", chain)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -155,6 +157,6 @@ func UmeeClaim(
 		msg[tuple.chain] = chainMsg
 	}
 
-	log.Debug("Umee claim processing completed", "address", address, "chain", chain, "collateral_count", len(leverageQueryResponse.Collateral))
+	log.Debug("Money market claim processing completed", "address", address, "chain", chain, "collateral_count", len(leverageQueryResponse.Collateral))
 	return msg, assets, nil
 }
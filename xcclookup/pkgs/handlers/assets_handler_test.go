@@ -64,8 +64,8 @@ func TestAssetsHandler_Handle(t *testing.T) {
 				GetOsmosisParamsFunc: func(ctx context.Context) ([]prewards.OsmosisParamsProtocolData, error) {
 					return make([]prewards.OsmosisParamsProtocolData, 0), nil
 				},
-				GetUmeeParamsFunc: func(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
-					return make([]prewards.UmeeParamsProtocolData, 0), nil
+				GetMoneyMarketsFunc: func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+					return make([]prewards.MoneyMarketProtocolData, 0), nil
 				},
 			}
 
@@ -96,8 +96,8 @@ func TestHandlersIntegration(t *testing.T) {
 			GetOsmosisParamsFunc: func(ctx context.Context) ([]prewards.OsmosisParamsProtocolData, error) {
 				return make([]prewards.OsmosisParamsProtocolData, 0), nil
 			},
-			GetUmeeParamsFunc: func(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
-				return make([]prewards.UmeeParamsProtocolData, 0), nil
+			GetMoneyMarketsFunc: func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+				return make([]prewards.MoneyMarketProtocolData, 0), nil
 			},
 		}
 
@@ -114,7 +114,7 @@ func TestHandlersIntegration(t *testing.T) {
 					},
 				}, nil
 			},
-			UmeeClaimFunc: func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+			MoneyMarketClaimFunc: func(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
 				return map[string]prewards.MsgSubmitClaim{
 						"umee-1": {UserAddress: address},
 					}, map[string]sdk.Coins{
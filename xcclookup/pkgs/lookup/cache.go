@@ -37,7 +37,7 @@ type Zone[T any] struct {
 type SupportedCacheTypes interface {
 	prewards.ConnectionProtocolData | prewards.OsmosisParamsProtocolData | prewards.OsmosisPoolProtocolData |
 		prewards.OsmosisClPoolProtocolData | prewards.LiquidAllowedDenomProtocolData |
-		prewards.UmeeParamsProtocolData | prewards.MembraneProtocolData | icstypes.Zone
+		prewards.MoneyMarketProtocolData | prewards.MembraneProtocolData | icstypes.Zone
 }
 
 func NewCacheManager() CacheManager {
@@ -100,9 +100,9 @@ func (m *CacheManager) GetLiquidAllowedDenoms(ctx context.Context) ([]prewards.L
 	return GetCache[prewards.LiquidAllowedDenomProtocolData](ctx, m)
 }
 
-// GetUmeeParams implements CacheManagerInterface
-func (m *CacheManager) GetUmeeParams(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
-	return GetCache[prewards.UmeeParamsProtocolData](ctx, m)
+// GetMoneyMarkets implements CacheManagerInterface
+func (m *CacheManager) GetMoneyMarkets(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+	return GetCache[prewards.MoneyMarketProtocolData](ctx, m)
 }
 
 // GetMembraneParams implements CacheManagerInterface
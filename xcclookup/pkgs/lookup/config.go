@@ -65,6 +65,6 @@ const (
 type Mocks struct {
 	OsmosisPools   []prewards.OsmosisPoolProtocolData `yaml:"osmosis_pools"`
 	Connections    []prewards.ConnectionProtocolData  `yaml:"connections"`
-	UmeeParams     []prewards.UmeeParamsProtocolData  `yaml:"umee_params"`
+	MoneyMarkets   []prewards.MoneyMarketProtocolData `yaml:"money_markets"`
 	MembraneParams []prewards.MembraneProtocolData    `yaml:"membrane_params"`
 }
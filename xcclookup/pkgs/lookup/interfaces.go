@@ -27,7 +27,7 @@ type CacheManagerInterface interface {
 	GetOsmosisPools(ctx context.Context) ([]prewards.OsmosisPoolProtocolData, error)
 	GetOsmosisClPools(ctx context.Context) ([]prewards.OsmosisClPoolProtocolData, error)
 	GetLiquidAllowedDenoms(ctx context.Context) ([]prewards.LiquidAllowedDenomProtocolData, error)
-	GetUmeeParams(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error)
+	GetMoneyMarkets(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error)
 	GetMembraneParams(ctx context.Context) ([]prewards.MembraneProtocolData, error)
 	GetZones(ctx context.Context) ([]icstypes.Zone, error)
 	AddMocks(ctx context.Context, mocks interface{}) error
@@ -62,7 +62,7 @@ type OsmosisClPool struct {
 // ClaimsServiceInterface defines the interface for claims operations
 type ClaimsServiceInterface interface {
 	OsmosisClaim(ctx context.Context, address, submitAddress, chain string, height int64) (OsmosisResult, error)
-	UmeeClaim(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
+	MoneyMarketClaim(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
 	LiquidClaim(ctx context.Context, address, submitAddress string, connection prewards.ConnectionProtocolData, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
 	MembraneClaim(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
 }
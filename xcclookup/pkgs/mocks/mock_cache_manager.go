@@ -16,7 +16,7 @@ type MockCacheManager struct {
 	GetOsmosisPoolsFunc        func(ctx context.Context) ([]prewards.OsmosisPoolProtocolData, error)
 	GetOsmosisClPoolsFunc      func(ctx context.Context) ([]prewards.OsmosisClPoolProtocolData, error)
 	GetLiquidAllowedDenomsFunc func(ctx context.Context) ([]prewards.LiquidAllowedDenomProtocolData, error)
-	GetUmeeParamsFunc          func(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error)
+	GetMoneyMarketsFunc        func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error)
 	GetMembraneParamsFunc      func(ctx context.Context) ([]prewards.MembraneProtocolData, error)
 	GetZonesFunc               func(ctx context.Context) ([]icstypes.Zone, error)
 	AddMocksFunc               func(ctx context.Context, mocks interface{}) error
@@ -62,12 +62,12 @@ func (m *MockCacheManager) GetLiquidAllowedDenoms(ctx context.Context) ([]prewar
 	return make([]prewards.LiquidAllowedDenomProtocolData, 0), nil
 }
 
-// GetUmeeParams calls the mock function
-func (m *MockCacheManager) GetUmeeParams(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
-	if m.GetUmeeParamsFunc != nil {
-		return m.GetUmeeParamsFunc(ctx)
+// GetMoneyMarkets calls the mock function
+func (m *MockCacheManager) GetMoneyMarkets(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+	if m.GetMoneyMarketsFunc != nil {
+		return m.GetMoneyMarketsFunc(ctx)
 	}
-	return make([]prewards.UmeeParamsProtocolData, 0), nil
+	return make([]prewards.MoneyMarketProtocolData, 0), nil
 }
 
 // GetMembraneParams calls the mock function
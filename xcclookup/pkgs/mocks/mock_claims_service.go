@@ -12,10 +12,10 @@ import (
 
 // MockClaimsService is a mock implementation of ClaimsServiceInterface
 type MockClaimsService struct {
-	OsmosisClaimFunc  func(ctx context.Context, address, submitAddress, chain string, height int64) (lookup.OsmosisResult, error)
-	UmeeClaimFunc     func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
-	LiquidClaimFunc   func(ctx context.Context, address, submitAddress string, connection prewards.ConnectionProtocolData, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
-	MembraneClaimFunc func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
+	OsmosisClaimFunc     func(ctx context.Context, address, submitAddress, chain string, height int64) (lookup.OsmosisResult, error)
+	MoneyMarketClaimFunc func(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
+	LiquidClaimFunc      func(ctx context.Context, address, submitAddress string, connection prewards.ConnectionProtocolData, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
+	MembraneClaimFunc    func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error)
 }
 
 // OsmosisClaim calls the mock function
@@ -26,10 +26,10 @@ func (m *MockClaimsService) OsmosisClaim(ctx context.Context, address, submitAdd
 	return lookup.OsmosisResult{}, nil
 }
 
-// UmeeClaim calls the mock function
-func (m *MockClaimsService) UmeeClaim(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
-	if m.UmeeClaimFunc != nil {
-		return m.UmeeClaimFunc(ctx, address, submitAddress, chain, height)
+// MoneyMarketClaim calls the mock function
+func (m *MockClaimsService) MoneyMarketClaim(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+	if m.MoneyMarketClaimFunc != nil {
+		return m.MoneyMarketClaimFunc(ctx, market, address, submitAddress, height)
 	}
 	return make(map[string]prewards.MsgSubmitClaim), make(map[string]sdk.Coins), nil
 }
@@ -67,8 +67,8 @@ func (s *AssetsService) GetAssets(ctx context.Context, address string) (*lookup.
 	// Process Osmosis claims
 	s.processOsmosisClaims(ctx, address, mappedAddresses, response, errs, &errsMutex, &wg)
 
-	// Process Umee claims
-	s.processUmeeClaims(ctx, address, mappedAddresses, response, errs, &errsMutex, &wg)
+	// Process money market claims
+	s.processMoneyMarketClaims(ctx, address, mappedAddresses, response, errs, &errsMutex, &wg)
 
 	// Process Membrane claims
 	s.processMembraneClaims(ctx, address, mappedAddresses, response, errs, &errsMutex, &wg)
@@ -172,7 +172,7 @@ func (s *AssetsService) processOsmosisClaims(
 	}
 }
 
-func (s *AssetsService) processUmeeClaims(
+func (s *AssetsService) processMoneyMarketClaims(
 	ctx context.Context,
 	address string,
 	mappedAddresses map[string]string,
@@ -181,51 +181,48 @@ func (s *AssetsService) processUmeeClaims(
 	errsMutex *sync.Mutex,
 	wg *sync.WaitGroup,
 ) {
-	umeeParamsCache, err := s.cacheManager.GetUmeeParams(ctx)
+	markets, err := s.cacheManager.GetMoneyMarkets(ctx)
 	if err != nil {
 		errsMutex.Lock()
-		errs["UmeeParams"] = err
-		errsMutex.Unlock()
-		return
-	}
-	if len(umeeParamsCache) == 0 {
-		errsMutex.Lock()
-		errs["UmeeConfig"] = errors.New("umee params not set")
+		errs["MoneyMarkets"] = err
 		errsMutex.Unlock()
 		return
 	}
 
-	chain := umeeParamsCache[0].ChainID
+	for _, market := range markets {
+		chain := market.ChainID
+		errKey := fmt.Sprintf("MoneyMarketClaim:%s", chain)
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		messages, assets, err := s.claimsService.UmeeClaim(ctx, address, address, chain, s.heights[chain])
-		if err != nil {
-			errsMutex.Lock()
-			errs["UmeeClaim"] = err
-			errsMutex.Unlock()
-			return
-		}
-		if messages != nil {
-			response.Update(ctx, messages, assets, "umee")
-		}
-	}()
-
-	if mappedAddress, ok := mappedAddresses[chain]; ok {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			messages, assets, err := s.claimsService.UmeeClaim(ctx, mappedAddress, address, chain, s.heights[chain])
+			messages, assets, err := s.claimsService.MoneyMarketClaim(ctx, market, address, address, s.heights[chain])
 			if err != nil {
 				errsMutex.Lock()
-				errs["UmeeClaim"] = err
+				errs[errKey] = err
 				errsMutex.Unlock()
+				return
 			}
 			if messages != nil {
-				response.Update(ctx, messages, assets, "umee")
+				response.Update(ctx, messages, assets, "moneymarket")
 			}
 		}()
+
+		if mappedAddress, ok := mappedAddresses[chain]; ok {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				messages, assets, err := s.claimsService.MoneyMarketClaim(ctx, market, mappedAddress, address, s.heights[chain])
+				if err != nil {
+					errsMutex.Lock()
+					errs[errKey] = err
+					errsMutex.Unlock()
+				}
+				if messages != nil {
+					response.Update(ctx, messages, assets, "moneymarket")
+				}
+			}()
+		}
 	}
 }
 
@@ -35,11 +35,11 @@ func TestAssetsService_GetAssets(t *testing.T) {
 		address            string
 		mockConnections    []prewards.ConnectionProtocolData
 		mockOsmosisParams  []prewards.OsmosisParamsProtocolData
-		mockUmeeParams     []prewards.UmeeParamsProtocolData
+		mockMoneyMarkets   []prewards.MoneyMarketProtocolData
 		mockMembraneParams []prewards.MembraneProtocolData
 		mockOsmosisResult  lookup.OsmosisResult
-		mockUmeeResult     map[string]prewards.MsgSubmitClaim
-		mockUmeeAssets     map[string]sdk.Coins
+		mockMarketResult   map[string]prewards.MsgSubmitClaim
+		mockMarketAssets   map[string]sdk.Coins
 		mockLiquidResult   map[string]prewards.MsgSubmitClaim
 		mockLiquidAssets   map[string]sdk.Coins
 		mockMembraneResult map[string]prewards.MsgSubmitClaim
@@ -56,8 +56,8 @@ func TestAssetsService_GetAssets(t *testing.T) {
 			mockOsmosisParams: []prewards.OsmosisParamsProtocolData{
 				{ChainID: "osmosis-1"},
 			},
-			mockUmeeParams: []prewards.UmeeParamsProtocolData{
-				{ChainID: "umee-1"},
+			mockMoneyMarkets: []prewards.MoneyMarketProtocolData{
+				prewards.UmeeMoneyMarket("umee-1"),
 			},
 			mockMembraneParams: []prewards.MembraneProtocolData{
 				{ContractAddress: "osmo1contractaddress"},
@@ -75,13 +75,13 @@ func TestAssetsService_GetAssets(t *testing.T) {
 					},
 				},
 			},
-			mockUmeeResult: map[string]prewards.MsgSubmitClaim{
+			mockMarketResult: map[string]prewards.MsgSubmitClaim{
 				"umee-1": {
 					UserAddress: "test-address",
-					ClaimType:   cmtypes.ClaimTypeUmeeToken,
+					ClaimType:   cmtypes.ClaimTypeMoneyMarket,
 				},
 			},
-			mockUmeeAssets: map[string]sdk.Coins{
+			mockMarketAssets: map[string]sdk.Coins{
 				"umee-1": sdk.NewCoins(sdk.NewCoin("utoken1", sdk.NewInt(200))),
 			},
 			mockLiquidResult: map[string]prewards.MsgSubmitClaim{
@@ -130,11 +130,11 @@ func TestAssetsService_GetAssets(t *testing.T) {
 					}
 					return tt.mockOsmosisParams, nil
 				},
-				GetUmeeParamsFunc: func(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
+				GetMoneyMarketsFunc: func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
 					if tt.mockError != nil {
 						return nil, tt.mockError
 					}
-					return tt.mockUmeeParams, nil
+					return tt.mockMoneyMarkets, nil
 				},
 				GetMembraneParamsFunc: func(ctx context.Context) ([]prewards.MembraneProtocolData, error) {
 					if tt.mockError != nil {
@@ -149,8 +149,8 @@ func TestAssetsService_GetAssets(t *testing.T) {
 				OsmosisClaimFunc: func(ctx context.Context, address, submitAddress, chain string, height int64) (lookup.OsmosisResult, error) {
 					return tt.mockOsmosisResult, nil
 				},
-				UmeeClaimFunc: func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
-					return tt.mockUmeeResult, tt.mockUmeeAssets, nil
+				MoneyMarketClaimFunc: func(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+					return tt.mockMarketResult, tt.mockMarketAssets, nil
 				},
 				LiquidClaimFunc: func(ctx context.Context, address, submitAddress string, connection prewards.ConnectionProtocolData, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
 					return tt.mockLiquidResult, tt.mockLiquidAssets, nil
@@ -241,9 +241,9 @@ func TestAssetsService_GetAssets_WithMappedAddresses(t *testing.T) {
 				{ChainID: "osmosis-1"},
 			}, nil
 		},
-		GetUmeeParamsFunc: func(ctx context.Context) ([]prewards.UmeeParamsProtocolData, error) {
-			return []prewards.UmeeParamsProtocolData{
-				{ChainID: "umee-1"},
+		GetMoneyMarketsFunc: func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+			return []prewards.MoneyMarketProtocolData{
+				prewards.UmeeMoneyMarket("umee-1"),
 			}, nil
 		},
 		GetMembraneParamsFunc: func(ctx context.Context) ([]prewards.MembraneProtocolData, error) {
@@ -274,15 +274,15 @@ func TestAssetsService_GetAssets_WithMappedAddresses(t *testing.T) {
 				},
 			}, nil
 		},
-		UmeeClaimFunc: func(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+		MoneyMarketClaimFunc: func(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
 			umeeMutex.Lock()
 			umeeAddressesUsed = append(umeeAddressesUsed, address)
 			umeeMutex.Unlock()
-			t.Logf("Umee claim called with address: %s", address)
+			t.Logf("Money market claim called with address: %s", address)
 			return map[string]prewards.MsgSubmitClaim{
 					"umee-1": {
 						UserAddress: submitAddress,
-						ClaimType:   cmtypes.ClaimTypeUmeeToken,
+						ClaimType:   cmtypes.ClaimTypeMoneyMarket,
 					},
 				}, map[string]sdk.Coins{
 					"umee-1": sdk.NewCoins(sdk.NewCoin("utoken1", sdk.NewInt(200))),
@@ -361,6 +361,50 @@ func TestAssetsService_GetAssets_WithMappedAddresses(t *testing.T) {
 	}
 }
 
+func TestAssetsService_ProcessMoneyMarketClaims(t *testing.T) {
+	otherMarket := prewards.UmeeMoneyMarket("lend-1")
+	otherMarket.AccountPrefix = "lend"
+
+	mockCacheManager := &mocks.MockCacheManager{
+		GetMoneyMarketsFunc: func(ctx context.Context) ([]prewards.MoneyMarketProtocolData, error) {
+			return []prewards.MoneyMarketProtocolData{prewards.UmeeMoneyMarket("umee-1"), otherMarket}, nil
+		},
+	}
+
+	var marketsUsed []string
+	var mu sync.Mutex
+	mockClaimsService := &mocks.MockClaimsService{
+		MoneyMarketClaimFunc: func(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+			mu.Lock()
+			marketsUsed = append(marketsUsed, market.ChainID)
+			mu.Unlock()
+			if market.ChainID == "lend-1" {
+				return nil, nil, errors.New("lend-1 unavailable")
+			}
+			return map[string]prewards.MsgSubmitClaim{
+					"cosmoshub-4": {UserAddress: submitAddress, Zone: "cosmoshub-4", SrcZone: market.ChainID, ClaimType: cmtypes.ClaimTypeMoneyMarket},
+				}, map[string]sdk.Coins{
+					market.ChainID: sdk.NewCoins(sdk.NewCoin("uqatom", sdk.NewInt(200))),
+				}, nil
+		},
+	}
+
+	service := NewAssetsService(lookup.Config{}, mockCacheManager, mockClaimsService, map[string]int64{"umee-1": 2000, "lend-1": 3000})
+
+	response := &lookup.Response{Messages: make([]prewards.MsgSubmitClaim, 0), Assets: make(map[string][]lookup.Asset)}
+	errs := make(map[string]error)
+	var errsMutex sync.Mutex
+	var wg sync.WaitGroup
+
+	service.processMoneyMarketClaims(t.Context(), "quick1originaladdress", map[string]string{"umee-1": "umee1mappedaddress"}, response, errs, &errsMutex, &wg)
+	wg.Wait()
+
+	assert.ElementsMatch(t, []string{"umee-1", "umee-1", "lend-1"}, marketsUsed, "each market should be claimed, and mapped addresses claimed on their chain")
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs["MoneyMarketClaim:lend-1"], "lend-1 unavailable")
+	assert.Equal(t, "moneymarket", response.GetAssets()["umee-1"][0].Type)
+}
+
 func TestNewAssetsService(t *testing.T) {
 	mockCacheManager := &mocks.MockCacheManager{}
 	mockClaimsService := &mocks.MockClaimsService{}
@@ -41,10 +41,10 @@ func (c *ClaimsService) OsmosisClaim(ctx context.Context, address, submitAddress
 	return lookup.OsmosisResult{}, lookup.ErrUnsupportedCacheManager
 }
 
-// UmeeClaim implements ClaimsServiceInterface
-func (c *ClaimsService) UmeeClaim(ctx context.Context, address, submitAddress, chain string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
+// MoneyMarketClaim implements ClaimsServiceInterface
+func (c *ClaimsService) MoneyMarketClaim(ctx context.Context, market prewards.MoneyMarketProtocolData, address, submitAddress string, height int64) (map[string]prewards.MsgSubmitClaim, map[string]sdk.Coins, error) {
 	if concreteCacheMgr, ok := c.cacheMgr.(*lookup.CacheManager); ok {
-		return claims.UmeeClaim(ctx, c.cfg, concreteCacheMgr, address, submitAddress, chain, height)
+		return claims.MoneyMarketClaim(ctx, c.cfg, concreteCacheMgr, market, address, submitAddress, height)
 	}
 	return nil, nil, lookup.ErrUnsupportedCacheManager
 }
@@ -123,9 +123,9 @@ func main() {
 		log.Error("Failed to add osmosis params cache", "error", err)
 		return
 	}
-	err = cacheMgr.Add(ctx, &lookup.Cache[prewards.UmeeParamsProtocolData]{}, cfg.SourceLcd+"/quicksilver/participationrewards/v1/protocoldata/ProtocolDataTypeUmeeParams/", lookup.DataTypeProtocolData, time.Hour*24)
+	err = cacheMgr.Add(ctx, &lookup.Cache[prewards.MoneyMarketProtocolData]{}, cfg.SourceLcd+"/quicksilver/participationrewards/v1/protocoldata/ProtocolDataTypeMoneyMarket/", lookup.DataTypeProtocolData, time.Hour*24)
 	if err != nil {
-		log.Error("Failed to add umee params cache", "error", err)
+		log.Error("Failed to add money market cache", "error", err)
 		return
 	}
 	err = cacheMgr.Add(ctx, &lookup.Cache[prewards.MembraneProtocolData]{}, cfg.SourceLcd+"/quicksilver/participationrewards/v1/protocoldata/ProtocolDataTypeMembraneParams/", lookup.DataTypeProtocolData, time.Hour*24)
@@ -163,7 +163,7 @@ func main() {
 
 	lookup.AddMocks(ctx, &cacheMgr, cfg.Mocks.OsmosisPools)
 	lookup.AddMocks(ctx, &cacheMgr, cfg.Mocks.Connections)
-	lookup.AddMocks(ctx, &cacheMgr, cfg.Mocks.UmeeParams)
+	lookup.AddMocks(ctx, &cacheMgr, cfg.Mocks.MoneyMarkets)
 	lookup.AddMocks(ctx, &cacheMgr, cfg.Mocks.MembraneParams)
 
 	r := mux.NewRouter()